@@ -0,0 +1,130 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// statusPath is the path of the admin status endpoint of the operations server of the orderer.
+const statusPath = "/admin/v1/status"
+
+func main() {
+	output, exit, err := executeForArgs(os.Args[1:])
+	if err != nil {
+		kingpin.Fatalf("parsing arguments: %s. Try --help", err)
+	}
+	fmt.Println(output)
+	os.Exit(exit)
+}
+
+func executeForArgs(args []string) (output string, exit int, err error) {
+	app := kingpin.New("osnadmin", "Orderer Service Node (OSN) administration")
+	orderer := app.Flag("orderer-address", "Address of the operations endpoint of the OSN, e.g. orderer.example.com:8443").Short('o').Required().String()
+	caFile := app.Flag("ca-file", "Path to the PEM encoded CA certificate of the TLS certificate of the operations endpoint. The endpoint is reached over plain HTTP if omitted").String()
+	clientCert := app.Flag("client-cert", "Path to the PEM encoded client certificate used for mutual TLS").String()
+	clientKey := app.Flag("client-key", "Path to the PEM encoded private key of the client certificate").String()
+	timeout := app.Flag("timeout", "Time to wait for the response of the OSN").Default("10s").Duration()
+
+	status := app.Command("status", "Report the channels of the OSN, their height, Raft state and cluster connectivity")
+	statusChannel := status.Flag("channel", "Report the status of this channel only").Short('c').String()
+
+	command, err := app.Parse(args)
+	if err != nil {
+		return "", 1, err
+	}
+
+	client, scheme, err := httpClient(*caFile, *clientCert, *clientKey, *timeout)
+	if err != nil {
+		return errorOutput(err), 1, nil
+	}
+
+	switch command {
+	case status.FullCommand():
+		target := url.URL{Scheme: scheme, Host: *orderer, Path: statusPath}
+		if *statusChannel != "" {
+			target.RawQuery = url.Values{"channel": []string{*statusChannel}}.Encode()
+		}
+		return get(client, target.String())
+	}
+
+	return "", 1, errors.Errorf("unknown command %s", command)
+}
+
+func httpClient(caFile, clientCert, clientKey string, timeout time.Duration) (*http.Client, string, error) {
+	if caFile == "" {
+		if clientCert != "" || clientKey != "" {
+			return nil, "", errors.New("client certificate and key require a CA file")
+		}
+		return &http.Client{Timeout: timeout}, "http", nil
+	}
+
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to read CA file")
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPEM) {
+		return nil, "", errors.Errorf("no PEM encoded certificates found in %s", caFile)
+	}
+	tlsConfig := &tls.Config{RootCAs: certPool}
+
+	if clientCert != "" || clientKey != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to load client certificate and key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}, "https", nil
+}
+
+func get(client *http.Client, target string) (string, int, error) {
+	resp, err := client.Get(target)
+	if err != nil {
+		return errorOutput(err), 1, nil
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errorOutput(errors.Wrap(err, "failed to read response")), 1, nil
+	}
+
+	body = bytes.TrimSpace(body)
+	exit := 0
+	if resp.StatusCode != http.StatusOK {
+		exit = 1
+	}
+
+	output := fmt.Sprintf("Status: %d", resp.StatusCode)
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, body, "", "\t"); err != nil {
+		return output + "\n" + string(body), exit, nil
+	}
+	return output + "\n" + indented.String(), exit, nil
+}
+
+func errorOutput(err error) string {
+	return fmt.Sprintf("Error: %s", err)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statusServer(requests chan<- *http.Request) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests <- req
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("channel") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"channel missing does not exist"}` + "\n"))
+			return
+		}
+		w.Write([]byte(`{"channels":[{"name":"mychannel","consensusType":"etcdraft","height":5}]}` + "\n"))
+	})
+}
+
+func TestStatus(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	srv := httptest.NewServer(statusServer(requests))
+	defer srv.Close()
+	address := strings.TrimPrefix(srv.URL, "http://")
+
+	output, exit, err := executeForArgs([]string{"status", "-o", address})
+	require.NoError(t, err)
+	assert.Equal(t, 0, exit)
+	assert.Equal(t, "Status: 200\n"+
+		"{\n"+
+		"\t\"channels\": [\n"+
+		"\t\t{\n"+
+		"\t\t\t\"name\": \"mychannel\",\n"+
+		"\t\t\t\"consensusType\": \"etcdraft\",\n"+
+		"\t\t\t\"height\": 5\n"+
+		"\t\t}\n"+
+		"\t]\n"+
+		"}", output)
+	req := <-requests
+	assert.Equal(t, http.MethodGet, req.Method)
+	assert.Equal(t, statusPath, req.URL.Path)
+	assert.Empty(t, req.URL.RawQuery)
+
+	output, exit, err = executeForArgs([]string{"status", "-o", address, "--channel", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exit)
+	assert.Equal(t, "Status: 404\n{\n\t\"error\": \"channel missing does not exist\"\n}", output)
+	req = <-requests
+	assert.Equal(t, "missing", req.URL.Query().Get("channel"))
+}
+
+func TestStatusTLS(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	srv := httptest.NewTLSServer(statusServer(requests))
+	defer srv.Close()
+	address := strings.TrimPrefix(srv.URL, "https://")
+
+	tempDir, err := ioutil.TempDir("", "osnadmin")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	caFile := filepath.Join(tempDir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	output, exit, err := executeForArgs([]string{"status", "-o", address, "--ca-file", caFile, "-c", "mychannel"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, exit)
+	assert.Contains(t, output, "Status: 200")
+	req := <-requests
+	assert.Equal(t, "mychannel", req.URL.Query().Get("channel"))
+
+	// Without a CA file the endpoint is reached over plain HTTP
+	output, exit, err = executeForArgs([]string{"status", "-o", address})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exit)
+	assert.Contains(t, output, "Status: 400")
+
+	output, exit, err = executeForArgs([]string{"status", "-o", address, "--ca-file", filepath.Join(tempDir, "missing.pem")})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exit)
+	assert.Contains(t, output, "Error: failed to read CA file")
+
+	output, exit, err = executeForArgs([]string{"status", "-o", address, "--ca-file", caFile, "--client-cert", caFile})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exit)
+	assert.Contains(t, output, "Error: failed to load client certificate and key")
+}
+
+func TestBadArguments(t *testing.T) {
+	_, exit, err := executeForArgs([]string{"status"})
+	assert.EqualError(t, err, "required flag --orderer-address not provided")
+	assert.Equal(t, 1, exit)
+
+	_, exit, err = executeForArgs([]string{"health", "-o", "orderer:8443"})
+	assert.EqualError(t, err, "expected command but got \"health\"")
+	assert.Equal(t, 1, exit)
+
+	output, exit, err := executeForArgs([]string{"status", "-o", "orderer:8443", "--client-key", "key.pem"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, exit)
+	assert.Equal(t, "Error: client certificate and key require a CA file", output)
+}
@@ -111,6 +111,10 @@ type Orderer interface {
 	// used for ordering
 	KafkaBrokers() []string
 
+	// RateLimits returns the limits on the rate at which organizations may broadcast
+	// transactions to the channel
+	RateLimits() *ab.RateLimits
+
 	// Organizations returns the organizations for the ordering service
 	Organizations() map[string]OrdererOrg
 
@@ -205,6 +209,10 @@ type OrdererCapabilities interface {
 
 	// ConsensusTypeMigration checks whether the orderer permits a consensus-type migration.
 	ConsensusTypeMigration() bool
+
+	// RateLimits specifies whether the channel config may bound the rate at which organizations
+	// broadcast transactions.
+	RateLimits() bool
 }
 
 // PolicyMapper is an interface for
@@ -40,6 +40,9 @@ const (
 	// KafkaBrokersKey is the cb.ConfigItem type key name for the KafkaBrokers message.
 	KafkaBrokersKey = "KafkaBrokers"
 
+	// RateLimitsKey is the cb.ConfigItem type key name for the RateLimits message.
+	RateLimitsKey = "RateLimits"
+
 	// EndpointsKey is the cb.COnfigValue key name for the Endpoints message in the OrdererOrgGroup.
 	EndpointsKey = "Endpoints"
 )
@@ -51,6 +54,7 @@ type OrdererProtos struct {
 	BatchTimeout        *ab.BatchTimeout
 	KafkaBrokers        *ab.KafkaBrokers
 	ChannelRestrictions *ab.ChannelRestrictions
+	RateLimits          *ab.RateLimits
 	Capabilities        *cb.Capabilities
 }
 
@@ -180,6 +184,12 @@ func (oc *OrdererConfig) MaxChannelsCount() uint64 {
 	return oc.protos.ChannelRestrictions.MaxCount
 }
 
+// RateLimits returns the limits on the rate at which organizations may
+// broadcast transactions to the channel.
+func (oc *OrdererConfig) RateLimits() *ab.RateLimits {
+	return oc.protos.RateLimits
+}
+
 // Organizations returns a map of the orgs in the channel.
 func (oc *OrdererConfig) Organizations() map[string]OrdererOrg {
 	return oc.orgs
@@ -195,6 +205,7 @@ func (oc *OrdererConfig) Validate() error {
 		oc.validateBatchSize,
 		oc.validateBatchTimeout,
 		oc.validateKafkaBrokers,
+		oc.validateRateLimits,
 	} {
 		if err := validator(); err != nil {
 			return err
@@ -217,6 +228,24 @@ func (oc *OrdererConfig) validateBatchSize() error {
 	if oc.protos.BatchSize.PreferredMaxBytes > oc.protos.BatchSize.AbsoluteMaxBytes {
 		return fmt.Errorf("Attempted to set the batch size preferred max bytes (%v) greater than the absolute max bytes (%v).", oc.protos.BatchSize.PreferredMaxBytes, oc.protos.BatchSize.AbsoluteMaxBytes)
 	}
+	if adaptive := oc.protos.BatchSize.Adaptive; adaptive != nil {
+		if oc.protos.ConsensusType.GetType() == "kafka" {
+			return fmt.Errorf("Attempted to enable adaptive batching with the kafka consensus type, which requires all orderers to cut batches identically")
+		}
+		if adaptive.MinMessageCount == 0 {
+			return fmt.Errorf("Attempted to set the adaptive batching min message count to an invalid value: 0")
+		}
+		if adaptive.MinMessageCount > oc.protos.BatchSize.MaxMessageCount {
+			return fmt.Errorf("Attempted to set the adaptive batching min message count (%v) greater than the max message count (%v).", adaptive.MinMessageCount, oc.protos.BatchSize.MaxMessageCount)
+		}
+		targetLatency, err := time.ParseDuration(adaptive.TargetLatency)
+		if err != nil {
+			return fmt.Errorf("Attempted to set the adaptive batching target latency to a invalid value: %s", err)
+		}
+		if targetLatency <= 0 {
+			return fmt.Errorf("Attempted to set the adaptive batching target latency to a non-positive value: %s", targetLatency)
+		}
+	}
 	return nil
 }
 
@@ -241,6 +270,47 @@ func (oc *OrdererConfig) validateKafkaBrokers() error {
 	return nil
 }
 
+func (oc *OrdererConfig) validateRateLimits() error {
+	if oc.protos.RateLimits.Default != nil || len(oc.protos.RateLimits.Organizations) > 0 {
+		if !oc.Capabilities().RateLimits() {
+			return fmt.Errorf("Attempted to set rate limits, which require the %s orderer capability", capabilities.OrdererV2_0)
+		}
+	}
+	if err := validateRateLimit(oc.protos.RateLimits.Default); err != nil {
+		return fmt.Errorf("Attempted to set the default rate limit to an invalid value: %s", err)
+	}
+	mspIDs := make(map[string]struct{})
+	for _, orgLimit := range oc.protos.RateLimits.Organizations {
+		if orgLimit.MspId == "" {
+			return fmt.Errorf("Attempted to set a rate limit without MSP ID")
+		}
+		if _, exists := mspIDs[orgLimit.MspId]; exists {
+			return fmt.Errorf("Attempted to set the rate limit of %s more than once", orgLimit.MspId)
+		}
+		mspIDs[orgLimit.MspId] = struct{}{}
+		if orgLimit.Limit == nil {
+			return fmt.Errorf("Attempted to set the rate limit of %s to an empty value", orgLimit.MspId)
+		}
+		if err := validateRateLimit(orgLimit.Limit); err != nil {
+			return fmt.Errorf("Attempted to set the rate limit of %s to an invalid value: %s", orgLimit.MspId, err)
+		}
+	}
+	return nil
+}
+
+func validateRateLimit(limit *ab.RateLimit) error {
+	if limit == nil {
+		return nil
+	}
+	if limit.TransactionsPerSecond == 0 {
+		return errors.New("transactions per second must be greater than 0")
+	}
+	if limit.Burst != 0 && limit.Burst < limit.TransactionsPerSecond {
+		return errors.Errorf("burst (%d) is smaller than the transactions per second (%d)", limit.Burst, limit.TransactionsPerSecond)
+	}
+	return nil
+}
+
 // This does just a barebones sanity check.
 func brokerEntrySeemsValid(broker string) bool {
 	if !strings.Contains(broker, ":") {
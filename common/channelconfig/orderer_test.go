@@ -9,6 +9,8 @@ package channelconfig
 import (
 	"testing"
 
+	"github.com/hyperledger/fabric/common/capabilities"
+	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/stretchr/testify/assert"
 )
@@ -29,6 +31,33 @@ func TestBatchSize(t *testing.T) {
 
 	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: &ab.BatchSize{MaxMessageCount: validMaxMessageCount, AbsoluteMaxBytes: validAbsoluteMaxBytes, PreferredMaxBytes: validAbsoluteMaxBytes + 1}}}
 	assert.Error(t, oc.validateBatchSize(), "PreferredMaxBytes larger to AbsoluteMaxBytes")
+
+	adaptiveBatchSize := func(minMessageCount uint32, targetLatency string) *ab.BatchSize {
+		return &ab.BatchSize{
+			MaxMessageCount:   validMaxMessageCount,
+			AbsoluteMaxBytes:  validAbsoluteMaxBytes,
+			PreferredMaxBytes: validPreferredMaxBytes,
+			Adaptive:          &ab.AdaptiveBatching{MinMessageCount: minMessageCount, TargetLatency: targetLatency},
+		}
+	}
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(2, "500ms"), ConsensusType: &ab.ConsensusType{Type: "etcdraft"}}}
+	assert.NoError(t, oc.validateBatchSize(), "Adaptive batching was valid")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(2, "500ms"), ConsensusType: &ab.ConsensusType{Type: "kafka"}}}
+	assert.EqualError(t, oc.validateBatchSize(), "Attempted to enable adaptive batching with the kafka consensus type, which requires all orderers to cut batches identically")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(0, "500ms")}}
+	assert.Error(t, oc.validateBatchSize(), "Adaptive MinMessageCount was zero")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(validMaxMessageCount+1, "500ms")}}
+	assert.Error(t, oc.validateBatchSize(), "Adaptive MinMessageCount larger than MaxMessageCount")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(2, "0s")}}
+	assert.Error(t, oc.validateBatchSize(), "Adaptive TargetLatency was zero")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{BatchSize: adaptiveBatchSize(2, "bogus")}}
+	assert.Error(t, oc.validateBatchSize(), "Adaptive TargetLatency was invalid")
 }
 
 func TestBatchTimeout(t *testing.T) {
@@ -42,6 +71,53 @@ func TestBatchTimeout(t *testing.T) {
 	assert.Error(t, oc.validateBatchTimeout(), "Zero batch timeout")
 }
 
+func TestRateLimits(t *testing.T) {
+	orgLimit := func(mspID string, limit *ab.RateLimit) *ab.OrganizationRateLimit {
+		return &ab.OrganizationRateLimit{MspId: mspID, Limit: limit}
+	}
+	v20 := &cb.Capabilities{Capabilities: map[string]*cb.Capability{capabilities.OrdererV2_0: {}}}
+
+	oc := &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{}}}
+	assert.NoError(t, oc.validateRateLimits(), "No rate limits")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{
+		Default:       &ab.RateLimit{TransactionsPerSecond: 10},
+		Organizations: []*ab.OrganizationRateLimit{orgLimit("Org1MSP", &ab.RateLimit{TransactionsPerSecond: 5, Burst: 20})},
+	}}}
+	assert.NoError(t, oc.validateRateLimits(), "Valid rate limits")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: &cb.Capabilities{}, RateLimits: &ab.RateLimits{
+		Default: &ab.RateLimit{TransactionsPerSecond: 10},
+	}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set rate limits, which require the V2_0 orderer capability")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{Default: &ab.RateLimit{}}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set the default rate limit to an invalid value: transactions per second must be greater than 0")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{
+		Organizations: []*ab.OrganizationRateLimit{orgLimit("Org1MSP", &ab.RateLimit{TransactionsPerSecond: 5, Burst: 2})},
+	}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set the rate limit of Org1MSP to an invalid value: burst (2) is smaller than the transactions per second (5)")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{
+		Organizations: []*ab.OrganizationRateLimit{orgLimit("", &ab.RateLimit{TransactionsPerSecond: 5})},
+	}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set a rate limit without MSP ID")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{
+		Organizations: []*ab.OrganizationRateLimit{orgLimit("Org1MSP", nil)},
+	}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set the rate limit of Org1MSP to an empty value")
+
+	oc = &OrdererConfig{protos: &OrdererProtos{Capabilities: v20, RateLimits: &ab.RateLimits{
+		Organizations: []*ab.OrganizationRateLimit{
+			orgLimit("Org1MSP", &ab.RateLimit{TransactionsPerSecond: 5}),
+			orgLimit("Org1MSP", &ab.RateLimit{TransactionsPerSecond: 6}),
+		},
+	}}}
+	assert.EqualError(t, oc.validateRateLimits(), "Attempted to set the rate limit of Org1MSP more than once")
+}
+
 func TestKafkaBrokers(t *testing.T) {
 	oc := &OrdererConfig{protos: &OrdererProtos{KafkaBrokers: &ab.KafkaBrokers{Brokers: []string{"127.0.0.1:9092", "foo.bar:9092"}}}}
 	assert.NoError(t, oc.validateKafkaBrokers(), "Valid kafka brokers")
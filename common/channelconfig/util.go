@@ -11,10 +11,14 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/policies"
 	cb "github.com/hyperledger/fabric/protos/common"
 	mspprotos "github.com/hyperledger/fabric/protos/msp"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
 )
 
 const (
@@ -27,6 +31,9 @@ const (
 	// AdminsPolicyKey is the key used for the read policy
 	AdminsPolicyKey = "Admins"
 
+	// BlockValidationPolicyKey is the key used for the block validation policy of the orderer
+	BlockValidationPolicyKey = "BlockValidation"
+
 	defaultHashingAlgorithm = bccsp.SHA256
 
 	defaultBlockDataHashingStructureWidth = math.MaxUint32
@@ -126,6 +133,24 @@ func BatchSizeValue(maxMessages, absoluteMaxBytes, preferredMaxBytes uint32) *St
 	}
 }
 
+// AdaptiveBatchSizeValue returns the config definition for an orderer batch size
+// which enables adaptive batching.
+// It is a value for the /Channel/Orderer group.
+func AdaptiveBatchSizeValue(maxMessages, absoluteMaxBytes, preferredMaxBytes, minMessages uint32, targetLatency string) *StandardConfigValue {
+	return &StandardConfigValue{
+		key: BatchSizeKey,
+		value: &ab.BatchSize{
+			MaxMessageCount:   maxMessages,
+			AbsoluteMaxBytes:  absoluteMaxBytes,
+			PreferredMaxBytes: preferredMaxBytes,
+			Adaptive: &ab.AdaptiveBatching{
+				MinMessageCount: minMessages,
+				TargetLatency:   targetLatency,
+			},
+		},
+	}
+}
+
 // BatchTimeoutValue returns the config definition for the orderer batch timeout.
 // It is a value for the /Channel/Orderer group.
 func BatchTimeoutValue(timeout string) *StandardConfigValue {
@@ -148,6 +173,19 @@ func ChannelRestrictionsValue(maxChannelCount uint64) *StandardConfigValue {
 	}
 }
 
+// RateLimitsValue returns the config definition for the rate limits of the
+// organizations broadcasting transactions to the channel.
+// It is a value for the /Channel/Orderer group.
+func RateLimitsValue(defaultLimit *ab.RateLimit, organizations []*ab.OrganizationRateLimit) *StandardConfigValue {
+	return &StandardConfigValue{
+		key: RateLimitsKey,
+		value: &ab.RateLimits{
+			Default:       defaultLimit,
+			Organizations: organizations,
+		},
+	}
+}
+
 // KafkaBrokersValue returns the config definition for the addresses of the ordering service's Kafka brokers.
 // It is a value for the /Channel/Orderer group.
 func KafkaBrokersValue(brokers []string) *StandardConfigValue {
@@ -233,3 +271,23 @@ func ACLValues(acls map[string]string) *StandardConfigValue {
 		value: a,
 	}
 }
+
+// BFTBlockValidationPolicy returns the BlockValidation policy of an orderer group of the BFT
+// consensus type with the given consenters, which requires the signatures of a quorum of them.
+func BFTBlockValidationPolicy(consenters []*bft.Consenter) *cb.ConfigPolicy {
+	signedBy := make([]*cb.SignaturePolicy, len(consenters))
+	identities := make([][]byte, len(consenters))
+	for i, consenter := range consenters {
+		signedBy[i] = cauthdsl.SignedBy(int32(i))
+		identities[i] = utils.MarshalOrPanic(&mspprotos.SerializedIdentity{
+			Mspid:   consenter.MspId,
+			IdBytes: consenter.Identity,
+		})
+	}
+	_, q := bft.Quorum(len(consenters))
+
+	return &cb.ConfigPolicy{
+		Policy:    policies.SignaturePolicy(BlockValidationPolicyKey, cauthdsl.Envelope(cauthdsl.NOutOf(int32(q), signedBy), identities)).Value(),
+		ModPolicy: AdminsPolicyKey,
+	}
+}
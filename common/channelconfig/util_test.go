@@ -11,6 +11,7 @@ import (
 
 	cb "github.com/hyperledger/fabric/protos/common"
 	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,9 +35,11 @@ func TestUtilsBasic(t *testing.T) {
 	basicTest(t, OrdererAddressesValue([]string{"foo:1", "bar:2"}))
 	basicTest(t, ConsensusTypeValue("foo", []byte("bar")))
 	basicTest(t, BatchSizeValue(1, 2, 3))
+	basicTest(t, AdaptiveBatchSizeValue(1, 2, 3, 1, "1s"))
 	basicTest(t, BatchTimeoutValue("1s"))
 	basicTest(t, ChannelRestrictionsValue(7))
 	basicTest(t, KafkaBrokersValue([]string{"foo:1", "bar:2"}))
+	basicTest(t, RateLimitsValue(&ab.RateLimit{TransactionsPerSecond: 10}, []*ab.OrganizationRateLimit{{MspId: "Org1MSP", Limit: &ab.RateLimit{TransactionsPerSecond: 5, Burst: 10}}}))
 	basicTest(t, MSPValue(&mspprotos.MSPConfig{}))
 	basicTest(t, CapabilitiesValue(map[string]bool{"foo": true, "bar": false}))
 	basicTest(t, AnchorPeersValue([]*pb.AnchorPeer{{}, {}}))
@@ -116,6 +116,13 @@ type Filtered interface {
 	IsFiltered() bool
 }
 
+// TxIDsResponseSender is implemented by response senders which are able to
+// send the transaction IDs of blocks in place of the blocks, for requests
+// with the TX_IDS content type.
+type TxIDsResponseSender interface {
+	SendTxIDsResponse(header *cb.BlockHeader, txIDs []string) error
+}
+
 // Server is a polymorphic structure to support generalization of this handler
 // to be able to deliver different type of responses.
 type Server struct {
@@ -264,6 +271,11 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 		return cb.Status_FORBIDDEN, nil
 	}
 
+	if err := checkContentType(srv, seekInfo.ContentType); err != nil {
+		logger.Warningf("[channel: %s] Received seekInfo message from %s with unsupported content type: %s", chdr.ChannelId, addr, err)
+		return cb.Status_BAD_REQUEST, nil
+	}
+
 	if seekInfo.Start == nil || seekInfo.Stop == nil {
 		logger.Warningf("[channel: %s] Received seekInfo message from %s with missing start or stop %v, %v", chdr.ChannelId, addr, seekInfo.Start, seekInfo.Stop)
 		return cb.Status_BAD_REQUEST, nil
@@ -339,7 +351,7 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 
 		logger.Debugf("[channel: %s] Delivering block [%d] for (%p) for %s", chdr.ChannelId, block.Header.Number, seekInfo, addr)
 
-		if err := srv.SendBlockResponse(block); err != nil {
+		if err := sendBlock(srv, seekInfo.ContentType, block); err != nil {
 			logger.Warningf("[channel: %s] Error sending to %s: %s", chdr.ChannelId, addr, err)
 			return cb.Status_INTERNAL_SERVER_ERROR, err
 		}
@@ -356,6 +368,56 @@ func (h *Handler) deliverBlocks(ctx context.Context, srv *Server, envelope *cb.E
 	return cb.Status_SUCCESS, nil
 }
 
+// checkContentType returns an error if the server cannot send blocks with the
+// given content type.
+func checkContentType(srv *Server, contentType ab.SeekInfo_SeekContentType) error {
+	if _, known := ab.SeekInfo_SeekContentType_name[int32(contentType)]; !known {
+		return errors.Errorf("unknown content type %d", contentType)
+	}
+	if contentType == ab.SeekInfo_BLOCK {
+		return nil
+	}
+	if isFiltered(srv) {
+		return errors.Errorf("content type %s is not supported for filtered blocks", contentType)
+	}
+	if _, ok := srv.ResponseSender.(TxIDsResponseSender); contentType == ab.SeekInfo_TX_IDS && !ok {
+		return errors.Errorf("content type %s is not supported by this deliver service", contentType)
+	}
+	return nil
+}
+
+// sendBlock sends the parts of the block requested by the content type.
+func sendBlock(srv *Server, contentType ab.SeekInfo_SeekContentType, block *cb.Block) error {
+	switch contentType {
+	case ab.SeekInfo_HEADER_WITH_SIG:
+		return srv.SendBlockResponse(&cb.Block{Header: block.Header, Metadata: block.Metadata})
+	case ab.SeekInfo_TX_IDS:
+		return srv.ResponseSender.(TxIDsResponseSender).SendTxIDsResponse(block.Header, txIDs(block))
+	default:
+		return srv.SendBlockResponse(block)
+	}
+}
+
+// txIDs returns the IDs of the transactions in the block, in the order of the
+// block. The ID of a transaction which cannot be decoded is empty.
+func txIDs(block *cb.Block) []string {
+	ids := make([]string, len(block.GetData().GetData()))
+	for i, envBytes := range block.GetData().GetData() {
+		env, err := utils.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			logger.Debugf("Failed to decode transaction %d of block [%d]: %s", i, block.Header.Number, err)
+			continue
+		}
+		chdr, err := utils.ChannelHeader(env)
+		if err != nil {
+			logger.Debugf("Failed to decode the channel header of transaction %d of block [%d]: %s", i, block.Header.Number, err)
+			continue
+		}
+		ids[i] = chdr.TxId
+	}
+	return ids
+}
+
 func (h *Handler) validateChannelHeader(ctx context.Context, chdr *cb.ChannelHeader) error {
 	if chdr.GetTimestamp() == nil {
 		err := errors.New("channel header in envelope must contain timestamp")
@@ -20,6 +20,12 @@ type filteredResponseSender interface {
 	deliver.Filtered
 }
 
+//go:generate counterfeiter -o mock/tx_ids_response_sender.go -fake-name TxIDsResponseSender . txIDsResponseSender
+type txIDsResponseSender interface {
+	deliver.ResponseSender
+	deliver.TxIDsResponseSender
+}
+
 func TestDeliver(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Deliver Suite")
@@ -568,6 +568,145 @@ var _ = Describe("Deliver", func() {
 			})
 		})
 
+		Context("when the content type is not a block", func() {
+			var block *cb.Block
+
+			BeforeEach(func() {
+				block = &cb.Block{
+					Header: &cb.BlockHeader{Number: 100},
+					Data: &cb.BlockData{
+						Data: [][]byte{
+							utils.MarshalOrPanic(&cb.Envelope{Payload: utils.MarshalOrPanic(&cb.Payload{
+								Header: &cb.Header{ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{TxId: "tx1"})},
+							})}),
+							[]byte("garbage"),
+							utils.MarshalOrPanic(&cb.Envelope{Payload: utils.MarshalOrPanic(&cb.Payload{
+								Header: &cb.Header{ChannelHeader: utils.MarshalOrPanic(&cb.ChannelHeader{TxId: "tx2"})},
+							})}),
+						},
+					},
+					Metadata: &cb.BlockMetadata{Metadata: [][]byte{[]byte("signatures")}},
+				}
+				fakeBlockIterator.NextReturns(block, cb.Status_SUCCESS)
+			})
+
+			Context("when the header and metadata of blocks are requested", func() {
+				BeforeEach(func() {
+					seekInfo.ContentType = ab.SeekInfo_HEADER_WITH_SIG
+				})
+
+				It("sends blocks without data", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendBlockResponseArgsForCall(0)).To(Equal(&cb.Block{
+						Header:   block.Header,
+						Metadata: block.Metadata,
+					}))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+				})
+
+				Context("when the response sender is filtered", func() {
+					BeforeEach(func() {
+						fakeFilteredResponseSender := &mock.FilteredResponseSender{}
+						fakeFilteredResponseSender.IsFilteredReturns(true)
+						server.ResponseSender = fakeFilteredResponseSender
+					})
+
+					It("sends a bad request message", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).NotTo(HaveOccurred())
+
+						sender := server.ResponseSender.(*mock.FilteredResponseSender)
+						Expect(sender.SendBlockResponseCallCount()).To(Equal(0))
+						Expect(sender.SendStatusResponseCallCount()).To(Equal(1))
+						Expect(sender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+					})
+				})
+			})
+
+			Context("when the transaction IDs of blocks are requested", func() {
+				var fakeTxIDsResponseSender *mock.TxIDsResponseSender
+
+				BeforeEach(func() {
+					seekInfo.ContentType = ab.SeekInfo_TX_IDS
+					fakeTxIDsResponseSender = &mock.TxIDsResponseSender{}
+					server.ResponseSender = fakeTxIDsResponseSender
+				})
+
+				It("sends the block header with the transaction IDs", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeTxIDsResponseSender.SendBlockResponseCallCount()).To(Equal(0))
+					Expect(fakeTxIDsResponseSender.SendTxIDsResponseCallCount()).To(Equal(1))
+					header, txIDs := fakeTxIDsResponseSender.SendTxIDsResponseArgsForCall(0)
+					Expect(header).To(Equal(block.Header))
+					Expect(txIDs).To(Equal([]string{"tx1", "", "tx2"}))
+					Expect(fakeTxIDsResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_SUCCESS))
+
+					Expect(fakeBlocksSent.AddCallCount()).To(Equal(1))
+				})
+
+				Context("when the client is not authorized", func() {
+					BeforeEach(func() {
+						fakePolicyChecker.CheckPolicyReturns(errors.New("no-access-for-you"))
+					})
+
+					It("sends a forbidden message", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeTxIDsResponseSender.SendTxIDsResponseCallCount()).To(Equal(0))
+						Expect(fakeTxIDsResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+						Expect(fakeTxIDsResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_FORBIDDEN))
+					})
+				})
+
+				Context("when the response sender cannot send transaction IDs", func() {
+					BeforeEach(func() {
+						server.ResponseSender = fakeResponseSender
+					})
+
+					It("sends a bad request message", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(0))
+						Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+						Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+					})
+				})
+
+				Context("when sending the transaction IDs fails", func() {
+					BeforeEach(func() {
+						fakeTxIDsResponseSender.SendTxIDsResponseReturns(errors.New("send-fails"))
+					})
+
+					It("returns the error", func() {
+						err := handler.Handle(context.Background(), server)
+						Expect(err).To(MatchError("send-fails"))
+					})
+				})
+			})
+
+			Context("when the content type is unknown", func() {
+				BeforeEach(func() {
+					seekInfo.ContentType = ab.SeekInfo_SeekContentType(42)
+				})
+
+				It("sends a bad request message", func() {
+					err := handler.Handle(context.Background(), server)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeResponseSender.SendBlockResponseCallCount()).To(Equal(0))
+					Expect(fakeResponseSender.SendStatusResponseCallCount()).To(Equal(1))
+					Expect(fakeResponseSender.SendStatusResponseArgsForCall(0)).To(Equal(cb.Status_BAD_REQUEST))
+				})
+			})
+		})
+
 		Context("when sending the block fails", func() {
 			BeforeEach(func() {
 				fakeResponseSender.SendBlockResponseReturns(errors.New("send-fails"))
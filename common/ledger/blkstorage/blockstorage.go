@@ -44,8 +44,16 @@ var (
 type BlockStoreProvider interface {
 	CreateBlockStore(ledgerid string) (BlockStore, error)
 	OpenBlockStore(ledgerid string) (BlockStore, error)
+	// BootstrapFromBlocks prepares the block store for the given ledger such that it contains only the
+	// given contiguous blocks (e.g., the blocks included in a ledger snapshot). The blocks preceding the first
+	// given block are treated as pruned. The block store is expected to be opened via OpenBlockStore afterwards.
+	// Invoking this function again with the same blocks is a no-op
+	BootstrapFromBlocks(ledgerid string, blocks []*common.Block) error
 	Exists(ledgerid string) (bool, error)
 	List() ([]string, error)
+	// Remove removes the block store of the given ledger along with its index.
+	// The block store is expected to have been shut down beforehand
+	Remove(ledgerid string) error
 	Close()
 }
 
@@ -62,5 +70,10 @@ type BlockStore interface {
 	RetrieveTxByBlockNumTranNum(blockNum uint64, tranNum uint64) (*common.Envelope, error)
 	RetrieveBlockByTxID(txID string) (*common.Block, error)
 	RetrieveTxValidationCodeByTxID(txID string) (peer.TxValidationCode, error)
+	// PruneBlocksBefore removes the blocks with a block number lower than `blockNum`. An implementation may
+	// retain some of these blocks, for instance, if they share the underlying storage unit with retained blocks.
+	// If `archiveDir` is not empty, the removed data is moved to the `archiveDir` instead of being deleted.
+	// Retrieving a removed block returns an error of type `*ledger.BlockPrunedErr`
+	PruneBlocksBefore(blockNum uint64, archiveDir string) error
 	Shutdown()
 }
@@ -150,6 +150,31 @@ func retrieveLastFileSuffix(rootDir string) (int, error) {
 	return biggestFileNum, err
 }
 
+func retrieveFirstFileSuffix(rootDir string) (int, error) {
+	logger.Debugf("retrieveFirstFileSuffix()")
+	smallestFileNum := -1
+	filesInfo, err := ioutil.ReadDir(rootDir)
+	if err != nil {
+		return -1, errors.Wrapf(err, "error reading dir %s", rootDir)
+	}
+	for _, fileInfo := range filesInfo {
+		name := fileInfo.Name()
+		if fileInfo.IsDir() || !isBlockFileName(name) {
+			continue
+		}
+		fileSuffix := strings.TrimPrefix(name, blockfilePrefix)
+		fileNum, err := strconv.Atoi(fileSuffix)
+		if err != nil {
+			return -1, err
+		}
+		if smallestFileNum == -1 || fileNum < smallestFileNum {
+			smallestFileNum = fileNum
+		}
+	}
+	logger.Debugf("retrieveFirstFileSuffix() - smallestFileNum = %d", smallestFileNum)
+	return smallestFileNum, nil
+}
+
 func isBlockFileName(name string) bool {
 	return strings.HasPrefix(name, blockfilePrefix)
 }
@@ -44,6 +44,8 @@ type blockfileMgr struct {
 	cpInfoCond        *sync.Cond
 	currentFileWriter *blockfileWriter
 	bcInfo            atomic.Value
+	pruneInfo         atomic.Value
+	pruneLock         sync.Mutex
 }
 
 /*
@@ -132,6 +134,13 @@ func newBlockfileMgr(id string, conf *Conf, indexConfig *blkstorage.IndexConfig,
 		panic(fmt.Sprintf("Could not truncate current file to known size in db: %s", err))
 	}
 
+	// Load the prune info that tracks the first block file and block available after pruning (if any)
+	pruneInfo, err := mgr.loadPruneInfo(cpInfo)
+	if err != nil {
+		panic(fmt.Sprintf("Could not get prune info from db: %s", err))
+	}
+	mgr.pruneInfo.Store(pruneInfo)
+
 	// Create a new KeyValue store database handler for the blocks index in the keyvalue database
 	if mgr.index, err = newBlockIndex(indexConfig, indexStore); err != nil {
 		panic(fmt.Sprintf("error in block index: %s", err))
@@ -337,13 +346,15 @@ func (mgr *blockfileMgr) syncIndex() error {
 		indexEmpty = true
 	}
 
-	//initialize index to file number:zero, offset:zero and blockNum:0
-	startFileNum := 0
+	//initialize index to the first available file (i.e., zero if the store has never been pruned), offset:zero
+	//and the first available block
+	pruneInfo := mgr.getPruneInfo()
+	startFileNum := pruneInfo.firstFileNum
 	startOffset := 0
 	skipFirstBlock := false
 	//get the last file that blocks were added to using the checkpoint info
 	endFileNum := mgr.cpInfo.latestFileChunkSuffixNum
-	startingBlockNum := uint64(0)
+	startingBlockNum := pruneInfo.firstBlockNum
 
 	//if the index stored in the db has value, update the index information with those values
 	if !indexEmpty {
@@ -356,10 +367,12 @@ func (mgr *blockfileMgr) syncIndex() error {
 		if flp, err = mgr.index.getBlockLocByBlockNum(lastBlockIndexed); err != nil {
 			return err
 		}
-		startFileNum = flp.fileSuffixNum
-		startOffset = flp.locPointer.offset
-		skipFirstBlock = true
-		startingBlockNum = lastBlockIndexed + 1
+		if flp.fileSuffixNum >= pruneInfo.firstFileNum {
+			startFileNum = flp.fileSuffixNum
+			startOffset = flp.locPointer.offset
+			skipFirstBlock = true
+			startingBlockNum = lastBlockIndexed + 1
+		}
 	} else {
 		logger.Debugf("No block indexed, Last block present in block files=[%d]", mgr.cpInfo.lastBlockNumber)
 	}
@@ -455,6 +468,9 @@ func (mgr *blockfileMgr) retrieveBlockByHash(blockHash []byte) (*common.Block, e
 	if err != nil {
 		return nil, err
 	}
+	if err := mgr.checkLocNotPruned(loc); err != nil {
+		return nil, err
+	}
 	return mgr.fetchBlock(loc)
 }
 
@@ -465,6 +481,9 @@ func (mgr *blockfileMgr) retrieveBlockByNumber(blockNum uint64) (*common.Block,
 	if blockNum == math.MaxUint64 {
 		blockNum = mgr.getBlockchainInfo().Height - 1
 	}
+	if err := mgr.checkBlockNotPruned(blockNum); err != nil {
+		return nil, err
+	}
 
 	loc, err := mgr.index.getBlockLocByBlockNum(blockNum)
 	if err != nil {
@@ -481,6 +500,9 @@ func (mgr *blockfileMgr) retrieveBlockByTxID(txID string) (*common.Block, error)
 	if err != nil {
 		return nil, err
 	}
+	if err := mgr.checkLocNotPruned(loc); err != nil {
+		return nil, err
+	}
 	return mgr.fetchBlock(loc)
 }
 
@@ -491,6 +513,9 @@ func (mgr *blockfileMgr) retrieveTxValidationCodeByTxID(txID string) (peer.TxVal
 
 func (mgr *blockfileMgr) retrieveBlockHeaderByNumber(blockNum uint64) (*common.BlockHeader, error) {
 	logger.Debugf("retrieveBlockHeaderByNumber() - blockNum = [%d]", blockNum)
+	if err := mgr.checkBlockNotPruned(blockNum); err != nil {
+		return nil, err
+	}
 	loc, err := mgr.index.getBlockLocByBlockNum(blockNum)
 	if err != nil {
 		return nil, err
@@ -507,6 +532,9 @@ func (mgr *blockfileMgr) retrieveBlockHeaderByNumber(blockNum uint64) (*common.B
 }
 
 func (mgr *blockfileMgr) retrieveBlocks(startNum uint64) (*blocksItr, error) {
+	if err := mgr.checkBlockNotPruned(startNum); err != nil {
+		return nil, err
+	}
 	return newBlockItr(mgr, startNum), nil
 }
 
@@ -516,11 +544,17 @@ func (mgr *blockfileMgr) retrieveTransactionByID(txID string) (*common.Envelope,
 	if err != nil {
 		return nil, err
 	}
+	if err := mgr.checkLocNotPruned(loc); err != nil {
+		return nil, err
+	}
 	return mgr.fetchTransactionEnvelope(loc)
 }
 
 func (mgr *blockfileMgr) retrieveTransactionByBlockNumTranNum(blockNum uint64, tranNum uint64) (*common.Envelope, error) {
 	logger.Debugf("retrieveTransactionByBlockNumTranNum() - blockNum = [%d], tranNum = [%d]", blockNum, tranNum)
+	if err := mgr.checkBlockNotPruned(blockNum); err != nil {
+		return nil, err
+	}
 	loc, err := mgr.index.getTXLocByBlockNumTranNum(blockNum, tranNum)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,94 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+const bootstrappingFileName = "bootstrappingfile.tmp"
+
+// bootstrapFromBlocks writes the given contiguous blocks to the first block file of an empty block store.
+// The file is first written under a temporary name and then renamed, so that a crash leaves either a complete
+// block file or no block file behind. On opening the block store, the checkpoint info, the prune info, and the
+// index get constructed from this block file
+func bootstrapFromBlocks(rootDir string, blocks []*common.Block) error {
+	if len(blocks) == 0 {
+		return errors.New("at least one block is required for bootstrapping the block store")
+	}
+	for i := 1; i < len(blocks); i++ {
+		if blocks[i].Header.Number != blocks[i-1].Header.Number+1 {
+			return errors.Errorf("blocks are not contiguous, block [%d] is followed by the block [%d]",
+				blocks[i-1].Header.Number, blocks[i].Header.Number)
+		}
+		if !bytes.Equal(blocks[i].Header.PreviousHash, blocks[i-1].Header.Hash()) {
+			return errors.Errorf("previous hash in the block [%d] does not match the hash of the block [%d]",
+				blocks[i].Header.Number, blocks[i-1].Header.Number)
+		}
+	}
+
+	if _, err := util.CreateDirIfMissing(rootDir); err != nil {
+		return errors.WithMessage(err, "error creating block storage root dir")
+	}
+	firstFileNum, err := retrieveFirstFileSuffix(rootDir)
+	if err != nil {
+		return err
+	}
+	if firstFileNum != -1 {
+		firstBlockNum, err := retriveFirstBlockNumFromFile(rootDir, firstFileNum)
+		if err != nil {
+			return err
+		}
+		if firstFileNum != 0 || firstBlockNum != blocks[0].Header.Number {
+			return errors.Errorf("block store [%s] is not empty", rootDir)
+		}
+		logger.Infof("Block store [%s] has already been bootstrapped", rootDir)
+		return nil
+	}
+
+	tmpFilePath := filepath.Join(rootDir, bootstrappingFileName)
+	if err := writeBlocksToFile(tmpFilePath, blocks); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFilePath, deriveBlockfilePath(rootDir, 0)); err != nil {
+		return errors.Wrapf(err, "error renaming the file [%s]", tmpFilePath)
+	}
+	logger.Infof("Bootstrapped block store [%s] with blocks [%d] to [%d]",
+		rootDir, blocks[0].Header.Number, blocks[len(blocks)-1].Header.Number)
+	return nil
+}
+
+func writeBlocksToFile(filePath string, blocks []*common.Block) error {
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0660)
+	if err != nil {
+		return errors.Wrapf(err, "error opening the file [%s]", filePath)
+	}
+	defer file.Close()
+	for _, block := range blocks {
+		blockBytes, _, err := serializeBlock(block)
+		if err != nil {
+			return errors.WithMessage(err, "error serializing block")
+		}
+		if _, err := file.Write(proto.EncodeVarint(uint64(len(blockBytes)))); err != nil {
+			return errors.Wrapf(err, "error writing to the file [%s]", filePath)
+		}
+		if _, err := file.Write(blockBytes); err != nil {
+			return errors.Wrapf(err, "error writing to the file [%s]", filePath)
+		}
+	}
+	if err := file.Sync(); err != nil {
+		return errors.Wrapf(err, "error syncing the file [%s]", filePath)
+	}
+	return nil
+}
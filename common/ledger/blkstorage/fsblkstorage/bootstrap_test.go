@@ -0,0 +1,79 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrapFromBlocks(t *testing.T) {
+	blocks := testutil.ConstructTestBlocks(t, 20)
+	env := newTestEnv(t, NewConf(testPath(), blocksFileSize(t, blocks[:4])))
+	defer env.Cleanup()
+	ledgerid := "testLedger"
+
+	require.NoError(t, env.provider.BootstrapFromBlocks(ledgerid, blocks[10:15]))
+	// bootstrapping again with the same blocks is a no-op
+	require.NoError(t, env.provider.BootstrapFromBlocks(ledgerid, blocks[10:15]))
+
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerid)
+	mgr := blkfileMgrWrapper.blockfileMgr
+	assert.Equal(t, &common.BlockchainInfo{
+		Height:            15,
+		CurrentBlockHash:  blocks[14].Header.Hash(),
+		PreviousBlockHash: blocks[14].Header.PreviousHash,
+	}, mgr.getBlockchainInfo())
+	assert.Equal(t, &pruneInfo{firstFileNum: 0, firstBlockNum: 10}, mgr.getPruneInfo())
+
+	_, err := mgr.retrieveBlockByNumber(9)
+	assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+	blkfileMgrWrapper.testGetBlockByNumber(blocks[10:15], 10, nil)
+	blkfileMgrWrapper.testGetBlockByHash(blocks[10:15], nil)
+	blkfileMgrWrapper.testGetBlockByTxID(blocks[10:15], nil)
+
+	blkfileMgrWrapper.addBlocks(blocks[15:])
+	blkfileMgrWrapper.close()
+
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerid)
+	defer blkfileMgrWrapper.close()
+	mgr = blkfileMgrWrapper.blockfileMgr
+	assert.Equal(t, uint64(20), mgr.getBlockchainInfo().Height)
+	blkfileMgrWrapper.testGetBlockByNumber(blocks[10:], 10, nil)
+	itr, err := mgr.retrieveBlocks(10)
+	require.NoError(t, err)
+	defer itr.Close()
+	for _, expectedBlock := range blocks[10:] {
+		b, err := itr.Next()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(expectedBlock, b.(*common.Block)))
+	}
+
+	assert.EqualError(t, env.provider.BootstrapFromBlocks(ledgerid, blocks[5:10]),
+		"block store ["+mgr.rootDir+"] is not empty")
+}
+
+func TestBootstrapFromBlocksErrors(t *testing.T) {
+	blocks := testutil.ConstructTestBlocks(t, 5)
+	env := newTestEnv(t, NewConf(testPath(), 0))
+	defer env.Cleanup()
+
+	assert.EqualError(t, env.provider.BootstrapFromBlocks("testLedger", nil),
+		"at least one block is required for bootstrapping the block store")
+	assert.EqualError(t, env.provider.BootstrapFromBlocks("testLedger", []*common.Block{blocks[1], blocks[3]}),
+		"blocks are not contiguous, block [1] is followed by the block [3]")
+	tamperedBlock := proto.Clone(blocks[2]).(*common.Block)
+	tamperedBlock.Header.PreviousHash = []byte("tampered-hash")
+	assert.EqualError(t, env.provider.BootstrapFromBlocks("testLedger", []*common.Block{blocks[1], tamperedBlock}),
+		"previous hash in the block [2] does not match the hash of the block [1]")
+}
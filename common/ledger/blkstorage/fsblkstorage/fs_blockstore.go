@@ -102,6 +102,12 @@ func (store *fsBlockStore) RetrieveTxValidationCodeByTxID(txID string) (peer.TxV
 	return store.fileMgr.retrieveTxValidationCodeByTxID(txID)
 }
 
+// PruneBlocksBefore removes the block files that contain only the blocks with a block number lower than `blockNum`.
+// If `archiveDir` is not empty, the block files are moved to the `archiveDir` instead of being deleted
+func (store *fsBlockStore) PruneBlocksBefore(blockNum uint64, archiveDir string) error {
+	return store.fileMgr.pruneBlocksBefore(blockNum, archiveDir)
+}
+
 // Shutdown shuts down the block store
 func (store *fsBlockStore) Shutdown() {
 	logger.Debugf("closing fs blockStore:%s", store.id)
@@ -147,6 +147,42 @@ func TestBlockStoreProvider(t *testing.T) {
 
 }
 
+func TestBlockStoreProviderRemove(t *testing.T) {
+	env := newTestEnv(t, NewConf(testPath(), 0))
+	defer env.Cleanup()
+
+	provider := env.provider
+	store1, _ := provider.OpenBlockStore("ledger1")
+	defer store1.Shutdown()
+	store2, _ := provider.OpenBlockStore("ledger2")
+
+	blocks := testutil.ConstructTestBlocks(t, 5)
+	for _, b := range blocks {
+		assert.NoError(t, store1.AddBlock(b))
+		assert.NoError(t, store2.AddBlock(b))
+	}
+
+	store2.Shutdown()
+	assert.NoError(t, provider.Remove("ledger2"))
+
+	exists, err := provider.Exists("ledger2")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	storeNames, err := provider.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ledger1"}, storeNames)
+	checkBlocks(t, blocks, store1)
+
+	// a block store re-created with the same id starts afresh
+	store2, _ = provider.OpenBlockStore("ledger2")
+	defer store2.Shutdown()
+	bcInfo, err := store2.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), bcInfo.Height)
+	_, err = store2.RetrieveBlockByHash(blocks[0].Header.Hash())
+	assert.Error(t, err)
+}
+
 func constructLedgerid(id int) string {
 	return fmt.Sprintf("ledger_%d", id)
 }
@@ -0,0 +1,225 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/pkg/errors"
+)
+
+var (
+	pruneInfoKey = []byte("blkMgrPruneInfo")
+)
+
+// pruneInfo tracks the lowest block file and the lowest block that are still available
+// in the block store after one or more pruning operations
+type pruneInfo struct {
+	firstFileNum  int
+	firstBlockNum uint64
+}
+
+func (i *pruneInfo) marshal() ([]byte, error) {
+	buffer := proto.NewBuffer([]byte{})
+	if err := buffer.EncodeVarint(uint64(i.firstFileNum)); err != nil {
+		return nil, errors.Wrapf(err, "error encoding the firstFileNum [%d]", i.firstFileNum)
+	}
+	if err := buffer.EncodeVarint(i.firstBlockNum); err != nil {
+		return nil, errors.Wrapf(err, "error encoding the firstBlockNum [%d]", i.firstBlockNum)
+	}
+	return buffer.Bytes(), nil
+}
+
+func (i *pruneInfo) unmarshal(b []byte) error {
+	buffer := proto.NewBuffer(b)
+	val, err := buffer.DecodeVarint()
+	if err != nil {
+		return err
+	}
+	i.firstFileNum = int(val)
+	if i.firstBlockNum, err = buffer.DecodeVarint(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (i *pruneInfo) String() string {
+	return fmt.Sprintf("firstFileNum=[%d], firstBlockNum=[%d]", i.firstFileNum, i.firstBlockNum)
+}
+
+// loadPruneInfo loads the prune info from the db. If the db does not contain the prune info
+// (e.g., the index has been dropped), the prune info is constructed from the block files
+func (mgr *blockfileMgr) loadPruneInfo(cpInfo *checkpointInfo) (*pruneInfo, error) {
+	b, err := mgr.db.Get(pruneInfoKey)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return constructPruneInfoFromBlockFiles(mgr.rootDir, cpInfo)
+	}
+	i := &pruneInfo{}
+	if err := i.unmarshal(b); err != nil {
+		return nil, err
+	}
+	logger.Debugf("loaded pruneInfo:%s", i)
+	return i, nil
+}
+
+func constructPruneInfoFromBlockFiles(rootDir string, cpInfo *checkpointInfo) (*pruneInfo, error) {
+	firstFileNum, err := retrieveFirstFileSuffix(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	if firstFileNum < 0 || cpInfo.isChainEmpty {
+		return &pruneInfo{}, nil
+	}
+	// the first block file may not begin with the genesis block either because of pruning
+	// or because the block store was bootstrapped from the blocks of a snapshot
+	i := &pruneInfo{firstFileNum: firstFileNum}
+	if firstFileNum == cpInfo.latestFileChunkSuffixNum && cpInfo.latestFileChunksize == 0 {
+		i.firstBlockNum = cpInfo.lastBlockNumber + 1
+	} else if i.firstBlockNum, err = retriveFirstBlockNumFromFile(rootDir, firstFileNum); err != nil {
+		return nil, err
+	}
+	logger.Infof("Prune info constructed from block files = %s", i)
+	return i, nil
+}
+
+func (mgr *blockfileMgr) getPruneInfo() *pruneInfo {
+	return mgr.pruneInfo.Load().(*pruneInfo)
+}
+
+// pruneBlocksBefore removes the block files that contain only the blocks with a block number
+// lower than the given block number. The file that is currently being appended to is never removed.
+// The prune info is persisted before any file is touched so that a crash in the middle of pruning
+// leaves only unreachable files behind. If archiveDir is not empty, the block files are moved to
+// the archiveDir instead of being deleted.
+func (mgr *blockfileMgr) pruneBlocksBefore(blockNum uint64, archiveDir string) error {
+	mgr.pruneLock.Lock()
+	defer mgr.pruneLock.Unlock()
+
+	currentPruneInfo := mgr.getPruneInfo()
+	mgr.cpInfoCond.L.Lock()
+	latestFileNum := mgr.cpInfo.latestFileChunkSuffixNum
+	latestFileChunksize := mgr.cpInfo.latestFileChunksize
+	mgr.cpInfoCond.L.Unlock()
+
+	newPruneInfo := &pruneInfo{currentPruneInfo.firstFileNum, currentPruneInfo.firstBlockNum}
+	for newPruneInfo.firstFileNum < latestFileNum {
+		nextFileNum := newPruneInfo.firstFileNum + 1
+		if nextFileNum == latestFileNum && latestFileChunksize == 0 {
+			// the latest file does not contain any block yet
+			break
+		}
+		firstBlockInNextFile, err := retriveFirstBlockNumFromFile(mgr.rootDir, nextFileNum)
+		if err != nil {
+			return err
+		}
+		if firstBlockInNextFile > blockNum {
+			break
+		}
+		newPruneInfo.firstFileNum = nextFileNum
+		newPruneInfo.firstBlockNum = firstBlockInNextFile
+	}
+
+	if newPruneInfo.firstFileNum == currentPruneInfo.firstFileNum {
+		logger.Debugf("No block file qualifies for pruning blocks before block [%d]", blockNum)
+		return nil
+	}
+
+	if archiveDir != "" {
+		if _, err := util.CreateDirIfMissing(archiveDir); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error creating archive dir [%s]", archiveDir))
+		}
+	}
+
+	b, err := newPruneInfo.marshal()
+	if err != nil {
+		return err
+	}
+	if err := mgr.db.Put(pruneInfoKey, b, true); err != nil {
+		return errors.WithMessage(err, "error saving prune info to db")
+	}
+	mgr.pruneInfo.Store(newPruneInfo)
+
+	for fileNum := currentPruneInfo.firstFileNum; fileNum < newPruneInfo.firstFileNum; fileNum++ {
+		filePath := deriveBlockfilePath(mgr.rootDir, fileNum)
+		if archiveDir == "" {
+			logger.Infof("Deleting block file [%s]", filePath)
+			err = os.Remove(filePath)
+		} else {
+			logger.Infof("Archiving block file [%s] to dir [%s]", filePath, archiveDir)
+			err = moveFile(filePath, filepath.Join(archiveDir, filepath.Base(filePath)))
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "error pruning block file [%s]", filePath)
+		}
+	}
+	logger.Infof("Pruned block files. Prune info = %s", newPruneInfo)
+	return nil
+}
+
+// checkBlockNotPruned returns a `ledger.BlockPrunedErr` if the given block has been pruned
+func (mgr *blockfileMgr) checkBlockNotPruned(blockNum uint64) error {
+	pi := mgr.getPruneInfo()
+	if blockNum < pi.firstBlockNum {
+		return &ledger.BlockPrunedErr{
+			FirstAvailableBlockNum: pi.firstBlockNum,
+			Msg:                    fmt.Sprintf("block [%d] is not available", blockNum),
+		}
+	}
+	return nil
+}
+
+// checkLocNotPruned returns a `ledger.BlockPrunedErr` if the given file location belongs to a pruned block file.
+// The entries of the txid based indexes are retained after pruning, so that the duplicate txid detection
+// keeps working, and hence a lookup may resolve to a pruned block file
+func (mgr *blockfileMgr) checkLocNotPruned(lp *fileLocPointer) error {
+	pi := mgr.getPruneInfo()
+	if lp.fileSuffixNum < pi.firstFileNum {
+		return &ledger.BlockPrunedErr{
+			FirstAvailableBlockNum: pi.firstBlockNum,
+			Msg:                    fmt.Sprintf("block file [%d] is not available", lp.fileSuffixNum),
+		}
+	}
+	return nil
+}
+
+// moveFile renames the file and falls back to copying the file if the destination
+// is on a different file system
+func moveFile(src, dest string) error {
+	if err := os.Rename(src, dest); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fsblkstorage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+	putil "github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneInfoMarshalUnmarshal(t *testing.T) {
+	info := &pruneInfo{firstFileNum: 3, firstBlockNum: 117}
+	b, err := info.marshal()
+	assert.NoError(t, err)
+	infoFromBytes := &pruneInfo{}
+	assert.NoError(t, infoFromBytes.unmarshal(b))
+	assert.Equal(t, info, infoFromBytes)
+}
+
+func TestBlockfileMgrPrune(t *testing.T) {
+	t.Run("delete", func(t *testing.T) {
+		testBlockfileMgrPrune(t, false)
+	})
+	t.Run("archive", func(t *testing.T) {
+		testBlockfileMgrPrune(t, true)
+	})
+}
+
+func testBlockfileMgrPrune(t *testing.T, archive bool) {
+	blocks := testutil.ConstructTestBlocks(t, 40)
+	env := newTestEnv(t, NewConf(testPath(), blocksFileSize(t, blocks[:10])))
+	defer env.Cleanup()
+	ledgerid := "testLedger"
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerid)
+	blkfileMgrWrapper.addBlocks(blocks)
+	mgr := blkfileMgrWrapper.blockfileMgr
+	require.True(t, mgr.cpInfo.latestFileChunkSuffixNum >= 3)
+
+	archiveDir := ""
+	if archive {
+		var err error
+		archiveDir, err = ioutil.TempDir("", "fsblkstorage-archive")
+		require.NoError(t, err)
+		defer os.RemoveAll(archiveDir)
+	}
+
+	firstBlockInFile2, err := retriveFirstBlockNumFromFile(mgr.rootDir, 2)
+	require.NoError(t, err)
+	require.NoError(t, mgr.pruneBlocksBefore(firstBlockInFile2, archiveDir))
+	assert.Equal(t, &pruneInfo{firstFileNum: 2, firstBlockNum: firstBlockInFile2}, mgr.getPruneInfo())
+
+	for _, fileNum := range []int{0, 1} {
+		_, err := os.Stat(deriveBlockfilePath(mgr.rootDir, fileNum))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(archiveDir, filepath.Base(deriveBlockfilePath(mgr.rootDir, fileNum))))
+		assert.Equal(t, archive, err == nil)
+	}
+
+	for _, blk := range blocks {
+		txID, err := putil.GetOrComputeTxIDFromEnvelope(blk.Data.Data[0])
+		require.NoError(t, err)
+		if blk.Header.Number >= firstBlockInFile2 {
+			b, err := mgr.retrieveBlockByNumber(blk.Header.Number)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(blk, b))
+			h, err := mgr.retrieveBlockHeaderByNumber(blk.Header.Number)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(blk.Header, h))
+			_, err = mgr.retrieveTransactionByID(txID)
+			assert.NoError(t, err)
+			continue
+		}
+		_, err = mgr.retrieveBlockByNumber(blk.Header.Number)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveBlockHeaderByNumber(blk.Header.Number)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveBlockByHash(blk.Header.Hash())
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveBlockByTxID(txID)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveTransactionByID(txID)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveTransactionByBlockNumTranNum(blk.Header.Number, 0)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		_, err = mgr.retrieveBlocks(blk.Header.Number)
+		assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+		// the txid index is retained for detecting the duplicate txids
+		_, err = mgr.retrieveTxValidationCodeByTxID(txID)
+		assert.NoError(t, err)
+	}
+
+	itr, err := mgr.retrieveBlocks(firstBlockInFile2)
+	require.NoError(t, err)
+	b, err := itr.Next()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(blocks[firstBlockInFile2], b.(*common.Block)))
+	itr.Close()
+
+	// pruning again for the same or a lower block number is a no-op
+	require.NoError(t, mgr.pruneBlocksBefore(firstBlockInFile2, archiveDir))
+	require.NoError(t, mgr.pruneBlocksBefore(1, archiveDir))
+	assert.Equal(t, &pruneInfo{firstFileNum: 2, firstBlockNum: firstBlockInFile2}, mgr.getPruneInfo())
+
+	// the file currently being appended to is never pruned
+	require.NoError(t, mgr.pruneBlocksBefore(100, archiveDir))
+	assert.Equal(t, mgr.cpInfo.latestFileChunkSuffixNum, mgr.getPruneInfo().firstFileNum)
+	_, err = os.Stat(deriveBlockfilePath(mgr.rootDir, mgr.cpInfo.latestFileChunkSuffixNum))
+	assert.NoError(t, err)
+	blkfileMgrWrapper.close()
+}
+
+func TestBlockfileMgrPruneRestartAndIndexRebuild(t *testing.T) {
+	blocks := testutil.ConstructTestBlocks(t, 40)
+	env := newTestEnv(t, NewConf(testPath(), blocksFileSize(t, blocks[:10])))
+	defer env.Cleanup()
+	ledgerid := "testLedger"
+	blkfileMgrWrapper := newTestBlockfileWrapper(env, ledgerid)
+	blkfileMgrWrapper.addBlocks(blocks[:30])
+	mgr := blkfileMgrWrapper.blockfileMgr
+	firstBlockInFile1, err := retriveFirstBlockNumFromFile(mgr.rootDir, 1)
+	require.NoError(t, err)
+	require.NoError(t, mgr.pruneBlocksBefore(firstBlockInFile1, ""))
+	blkfileMgrWrapper.close()
+	env.provider.Close()
+
+	// drop the block index so that it gets rebuilt from the remaining block files
+	require.NoError(t, os.RemoveAll(env.provider.conf.getIndexDir()))
+	env = newTestEnv(t, env.provider.conf)
+	blkfileMgrWrapper = newTestBlockfileWrapper(env, ledgerid)
+	defer blkfileMgrWrapper.close()
+	mgr = blkfileMgrWrapper.blockfileMgr
+	// the prune info is lost along with the index and hence, it is reconstructed from
+	// the first block file available on the file system
+	assert.Equal(t, &pruneInfo{firstFileNum: 1, firstBlockNum: firstBlockInFile1}, mgr.getPruneInfo())
+
+	blkfileMgrWrapper.addBlocks(blocks[30:])
+	_, err = mgr.retrieveBlockByNumber(firstBlockInFile1 - 1)
+	assert.IsType(t, &ledger.BlockPrunedErr{}, err)
+	b, err := mgr.retrieveBlockByNumber(firstBlockInFile1)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(blocks[firstBlockInFile1], b))
+}
+
+func blocksFileSize(t *testing.T, blocks []*common.Block) int {
+	size := 0
+	for _, block := range blocks {
+		by, _, err := serializeBlock(block)
+		require.NoError(t, err)
+		size += len(by) + len(proto.EncodeVarint(uint64(len(by))))
+	}
+	return size
+}
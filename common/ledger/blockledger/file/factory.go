@@ -28,6 +28,8 @@ import (
 type fileLedgerFactory struct {
 	blkstorageProvider blkstorage.BlockStoreProvider
 	ledgers            map[string]blockledger.ReadWriter
+	blockStores        map[string]blkstorage.BlockStore
+	retention          RetentionPolicy
 	mutex              sync.Mutex
 }
 
@@ -47,9 +49,26 @@ func (flf *fileLedgerFactory) GetOrCreate(chainID string) (blockledger.ReadWrite
 	if err != nil {
 		return nil, err
 	}
-	ledger = NewFileLedger(blockStore)
-	flf.ledgers[key] = ledger
-	return ledger, nil
+	fileLedger := NewFileLedger(blockStore)
+	fileLedger.retention = flf.retention
+	flf.ledgers[key] = fileLedger
+	if flf.blockStores != nil {
+		flf.blockStores[key] = blockStore
+	}
+	return fileLedger, nil
+}
+
+// Remove shuts down the ledger of the given chain if it is open, and removes its blocks
+func (flf *fileLedgerFactory) Remove(chainID string) error {
+	flf.mutex.Lock()
+	defer flf.mutex.Unlock()
+
+	if blockStore, ok := flf.blockStores[chainID]; ok {
+		blockStore.Shutdown()
+		delete(flf.blockStores, chainID)
+	}
+	delete(flf.ledgers, chainID)
+	return flf.blkstorageProvider.Remove(chainID)
 }
 
 // ChainIDs returns the chain IDs the factory is aware of
@@ -66,8 +85,8 @@ func (flf *fileLedgerFactory) Close() {
 	flf.blkstorageProvider.Close()
 }
 
-// New creates a new ledger factory
-func New(directory string, metricsProvider metrics.Provider) blockledger.Factory {
+// New creates a new ledger factory whose ledgers prune their blocks according to the given retention policy
+func New(directory string, metricsProvider metrics.Provider, retention RetentionPolicy) blockledger.Factory {
 	return &fileLedgerFactory{
 		blkstorageProvider: fsblkstorage.NewProvider(
 			fsblkstorage.NewConf(directory, -1),
@@ -75,6 +94,8 @@ func New(directory string, metricsProvider metrics.Provider) blockledger.Factory
 				AttrsToIndex: []blkstorage.IndexableAttr{blkstorage.IndexableAttrBlockNum}},
 			metricsProvider,
 		),
-		ledgers: make(map[string]blockledger.ReadWriter),
+		ledgers:     make(map[string]blockledger.ReadWriter),
+		blockStores: make(map[string]blkstorage.BlockStore),
+		retention:   retention,
 	}
 }
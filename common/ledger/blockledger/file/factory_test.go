@@ -19,12 +19,14 @@ package fileledger
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,6 +45,10 @@ func (mbsp *mockBlockStoreProvider) OpenBlockStore(ledgerid string) (blkstorage.
 	return mbsp.blockstore, mbsp.error
 }
 
+func (mbsp *mockBlockStoreProvider) BootstrapFromBlocks(ledgerid string, blocks []*cb.Block) error {
+	return mbsp.error
+}
+
 func (mbsp *mockBlockStoreProvider) Exists(ledgerid string) (bool, error) {
 	return mbsp.exists, mbsp.error
 }
@@ -51,6 +57,10 @@ func (mbsp *mockBlockStoreProvider) List() ([]string, error) {
 	return mbsp.list, mbsp.error
 }
 
+func (mbsp *mockBlockStoreProvider) Remove(ledgerid string) error {
+	return mbsp.error
+}
+
 func (mbsp *mockBlockStoreProvider) Close() {
 }
 
@@ -75,21 +85,42 @@ func TestMultiReinitialization(t *testing.T) {
 	dir, err := ioutil.TempDir("", "hyperledger_fabric")
 	assert.NoError(t, err, "Error creating temp dir: %s", err)
 
-	flf := New(dir, metricsProvider)
+	flf := New(dir, metricsProvider, RetentionPolicy{})
 	_, err = flf.GetOrCreate(genesisconfig.TestChainID)
 	assert.NoError(t, err, "Error GetOrCreate chain")
 	assert.Equal(t, 1, len(flf.ChainIDs()), "Expected 1 chain")
 	flf.Close()
 
-	flf = New(dir, metricsProvider)
+	flf = New(dir, metricsProvider, RetentionPolicy{})
 	_, err = flf.GetOrCreate("foo")
 	assert.NoError(t, err, "Error creating chain")
 	assert.Equal(t, 2, len(flf.ChainIDs()), "Expected chain to be recovered")
 	flf.Close()
 
-	flf = New(dir, metricsProvider)
+	flf = New(dir, metricsProvider, RetentionPolicy{})
 	_, err = flf.GetOrCreate("bar")
 	assert.NoError(t, err, "Error creating chain")
 	assert.Equal(t, 3, len(flf.ChainIDs()), "Expected chain to be recovered")
 	flf.Close()
 }
+
+func TestRemove(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hyperledger_fabric")
+	assert.NoError(t, err, "Error creating temp dir: %s", err)
+	defer os.RemoveAll(dir)
+
+	flf := New(dir, &disabled.Provider{}, RetentionPolicy{})
+	defer flf.Close()
+	fl, err := flf.GetOrCreate("foo")
+	assert.NoError(t, err, "Error creating chain")
+	assert.NoError(t, fl.Append(blockledger.CreateNextBlock(fl, []*cb.Envelope{{Payload: []byte("My Data")}})))
+	_, err = flf.GetOrCreate("bar")
+	assert.NoError(t, err, "Error creating chain")
+
+	assert.NoError(t, flf.Remove("foo"))
+	assert.Equal(t, []string{"bar"}, flf.ChainIDs())
+
+	fl, err = flf.GetOrCreate("foo")
+	assert.NoError(t, err, "Error re-creating chain")
+	assert.Equal(t, uint64(0), fl.Height(), "Expected re-created chain to be empty")
+}
@@ -7,11 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package fileledger
 
 import (
+	"sync"
+
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
+	coreledger "github.com/hyperledger/fabric/core/ledger"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
 )
 
 var logger = flogging.MustGetLogger("common.ledger.blockledger.file")
@@ -20,6 +24,18 @@ var logger = flogging.MustGetLogger("common.ledger.blockledger.file")
 type FileLedger struct {
 	blockStore FileLedgerBlockStore
 	signal     chan struct{}
+	retention  RetentionPolicy
+
+	// The number of the config block since which the blocks are retained,
+	// cached for the last config block it was found for
+	cachedLastConfigBlockNum     uint64
+	cachedRetainedConfigBlockNum uint64
+
+	// The number of the block since which the blocks are retained
+	// whatever the retention policy, if retainFromSet is true
+	retainLock    sync.Mutex
+	retainFrom    uint64
+	retainFromSet bool
 }
 
 // FileLedgerBlockStore defines the interface to interact with deliver when using a
@@ -28,6 +44,28 @@ type FileLedgerBlockStore interface {
 	AddBlock(block *cb.Block) error
 	GetBlockchainInfo() (*cb.BlockchainInfo, error)
 	RetrieveBlocks(startBlockNumber uint64) (ledger.ResultsIterator, error)
+	RetrieveBlockByNumber(blockNum uint64) (*cb.Block, error)
+	PruneBlocksBefore(blockNum uint64, archiveDir string) error
+}
+
+// RetentionPolicy determines which blocks are pruned from a FileLedger as blocks are appended.
+// The block store retains the block file that is currently being appended to and hence, the
+// number of blocks retained may be higher than the number of blocks specified by the policy.
+// Irrespective of the policy, the last config block and the blocks after it are always retained,
+// as these are required to resume ordering the channel.
+type RetentionPolicy struct {
+	// MaxBlocks is the number of most recent blocks retained. Zero means all blocks.
+	MaxBlocks uint64
+	// ConfigBlocks is the number of most recent config blocks since which the blocks
+	// are retained. Zero means all blocks.
+	ConfigBlocks uint64
+	// ArchiveDir is the directory the pruned block files are moved to.
+	// If it is empty, the pruned block files are deleted.
+	ArchiveDir string
+}
+
+func (rp RetentionPolicy) prunes() bool {
+	return rp.MaxBlocks > 0 || rp.ConfigBlocks > 0
 }
 
 // NewFileLedger creates a new FileLedger for interaction with the ledger
@@ -45,6 +83,10 @@ type fileLedgerIterator struct {
 // It returns an error if the next block is no longer retrievable.
 func (i *fileLedgerIterator) Next() (*cb.Block, cb.Status) {
 	result, err := i.commonIterator.Next()
+	if _, ok := err.(*coreledger.BlockPrunedErr); ok {
+		logger.Warning(err)
+		return nil, cb.Status_GONE
+	}
 	if err != nil {
 		logger.Error(err)
 		return nil, cb.Status_SERVICE_UNAVAILABLE
@@ -86,6 +128,15 @@ func (fl *FileLedger) Iterator(startPosition *ab.SeekPosition) (blockledger.Iter
 	}
 
 	iterator, err := fl.blockStore.RetrieveBlocks(startingBlockNumber)
+	if prunedErr, ok := err.(*coreledger.BlockPrunedErr); ok {
+		if _, oldest := startPosition.Type.(*ab.SeekPosition_Oldest); !oldest {
+			logger.Debugf("Blocks starting from [%d] are requested: %s", startingBlockNumber, prunedErr)
+			return &blockledger.PrunedErrorIterator{}, 0
+		}
+		// The oldest block is the lowest block that is still available
+		startingBlockNumber = prunedErr.FirstAvailableBlockNum
+		iterator, err = fl.blockStore.RetrieveBlocks(startingBlockNumber)
+	}
 	if err != nil {
 		return &blockledger.NotFoundErrorIterator{}, 0
 	}
@@ -102,12 +153,106 @@ func (fl *FileLedger) Height() uint64 {
 	return info.Height
 }
 
-// Append a new block to the ledger
+// Append a new block to the ledger, and prunes the blocks that
+// are no longer retained by the retention policy of the ledger.
 func (fl *FileLedger) Append(block *cb.Block) error {
 	err := fl.blockStore.AddBlock(block)
 	if err == nil {
 		close(fl.signal)
 		fl.signal = make(chan struct{})
+		fl.prune(block)
 	}
 	return err
 }
+
+// Prunes returns whether the retention policy of the ledger prunes blocks.
+func (fl *FileLedger) Prunes() bool {
+	return fl.retention.prunes()
+}
+
+// RetainFrom prevents the block with the given number and the blocks after it
+// from being pruned, whatever the retention policy of the ledger. Blocks which
+// were already pruned are not restored.
+func (fl *FileLedger) RetainFrom(blockNum uint64) {
+	fl.retainLock.Lock()
+	defer fl.retainLock.Unlock()
+	fl.retainFrom = blockNum
+	fl.retainFromSet = true
+}
+
+// prune prunes the blocks that are not retained by the retention policy of the
+// ledger, given the last block of the ledger. Failing to prune is not fatal, as
+// the blocks are pruned once another block is appended.
+func (fl *FileLedger) prune(lastBlock *cb.Block) {
+	if !fl.retention.prunes() {
+		return
+	}
+
+	lastConfigBlockNum, err := utils.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		logger.Warningf("Failed retrieving the last config block index from block [%d]: %s", lastBlock.Header.Number, err)
+		return
+	}
+
+	pruneBeforeBlockNum := lastConfigBlockNum
+	if fl.retention.MaxBlocks > 0 {
+		height := lastBlock.Header.Number + 1
+		if height <= fl.retention.MaxBlocks {
+			return
+		}
+		if height-fl.retention.MaxBlocks < pruneBeforeBlockNum {
+			pruneBeforeBlockNum = height - fl.retention.MaxBlocks
+		}
+	}
+	if fl.retention.ConfigBlocks > 0 {
+		retainedConfigBlockNum, err := fl.retainedConfigBlockNum(lastConfigBlockNum)
+		if err != nil {
+			logger.Warningf("Failed finding the config blocks to retain: %s", err)
+			return
+		}
+		if retainedConfigBlockNum < pruneBeforeBlockNum {
+			pruneBeforeBlockNum = retainedConfigBlockNum
+		}
+	}
+
+	fl.retainLock.Lock()
+	if fl.retainFromSet && fl.retainFrom < pruneBeforeBlockNum {
+		pruneBeforeBlockNum = fl.retainFrom
+	}
+	fl.retainLock.Unlock()
+	if pruneBeforeBlockNum == 0 {
+		return
+	}
+
+	if err := fl.blockStore.PruneBlocksBefore(pruneBeforeBlockNum, fl.retention.ArchiveDir); err != nil {
+		logger.Warningf("Failed pruning blocks before block [%d]: %s", pruneBeforeBlockNum, err)
+	}
+}
+
+// retainedConfigBlockNum returns the number of the oldest of the config blocks retained by the
+// retention policy, by walking back the chain of config blocks from the given last config block.
+// If the walk reaches pruned blocks, the lowest available block is returned.
+func (fl *FileLedger) retainedConfigBlockNum(lastConfigBlockNum uint64) (uint64, error) {
+	if fl.cachedRetainedConfigBlockNum != 0 && fl.cachedLastConfigBlockNum == lastConfigBlockNum {
+		return fl.cachedRetainedConfigBlockNum, nil
+	}
+
+	configBlockNum := lastConfigBlockNum
+	for i := uint64(1); i < fl.retention.ConfigBlocks && configBlockNum > 0; i++ {
+		prevBlock, err := fl.blockStore.RetrieveBlockByNumber(configBlockNum - 1)
+		if prunedErr, ok := err.(*coreledger.BlockPrunedErr); ok {
+			configBlockNum = prunedErr.FirstAvailableBlockNum
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if configBlockNum, err = utils.GetLastConfigIndexFromBlock(prevBlock); err != nil {
+			return 0, err
+		}
+	}
+
+	fl.cachedLastConfigBlockNum = lastConfigBlockNum
+	fl.cachedRetainedConfigBlockNum = configBlockNum
+	return configBlockNum, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	coreledger "github.com/hyperledger/fabric/core/ledger"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -42,7 +43,7 @@ func initialize(t *testing.T) (*testEnv, *FileLedger) {
 	name, err := ioutil.TempDir("", "hyperledger_fabric")
 	assert.NoError(t, err, "Error creating temp dir: %s", err)
 
-	flf := New(name, &disabled.Provider{}).(*fileLedgerFactory)
+	flf := New(name, &disabled.Provider{}, RetentionPolicy{}).(*fileLedgerFactory)
 	fl, err := flf.GetOrCreate(genesisconfig.TestChainID)
 	assert.NoError(t, err, "Error GetOrCreate chain")
 
@@ -109,6 +110,10 @@ func (mbs *mockBlockStore) RetrieveTxValidationCodeByTxID(txID string) (peer.TxV
 	return mbs.txValidationCode, mbs.defaultError
 }
 
+func (mbs *mockBlockStore) PruneBlocksBefore(blockNum uint64, archiveDir string) error {
+	return mbs.defaultError
+}
+
 func (*mockBlockStore) Shutdown() {
 }
 
@@ -157,7 +162,7 @@ func TestReinitialization(t *testing.T) {
 	tev.shutDown()
 
 	// re-initialize the ledger provider (not the test ledger itself!)
-	provider2 := New(tev.location, &disabled.Provider{})
+	provider2 := New(tev.location, &disabled.Provider{}, RetentionPolicy{})
 
 	// assert expected ledgers exist
 	chains := provider2.ChainIDs()
@@ -316,3 +321,173 @@ func getSampleEnvelopeWithSignatureHeader() *cb.Envelope {
 	payloadBytes := utils.MarshalOrPanic(payload)
 	return &cb.Envelope{Payload: payloadBytes}
 }
+
+// pruningBlockStore is a FileLedgerBlockStore of blocks in memory which prunes blocks
+// one at a time, and returns a BlockPrunedErr for the pruned blocks
+type pruningBlockStore struct {
+	mockBlockStore
+	blocks         []*cb.Block
+	firstAvailable uint64
+}
+
+func (pbs *pruningBlockStore) AddBlock(block *cb.Block) error {
+	pbs.blocks = append(pbs.blocks, block)
+	return nil
+}
+
+func (pbs *pruningBlockStore) GetBlockchainInfo() (*cb.BlockchainInfo, error) {
+	return &cb.BlockchainInfo{Height: uint64(len(pbs.blocks))}, nil
+}
+
+func (pbs *pruningBlockStore) RetrieveBlockByNumber(blockNum uint64) (*cb.Block, error) {
+	if blockNum < pbs.firstAvailable {
+		return nil, &coreledger.BlockPrunedErr{FirstAvailableBlockNum: pbs.firstAvailable}
+	}
+	return pbs.blocks[blockNum], nil
+}
+
+func (pbs *pruningBlockStore) RetrieveBlocks(startNum uint64) (cl.ResultsIterator, error) {
+	if startNum < pbs.firstAvailable {
+		return nil, &coreledger.BlockPrunedErr{FirstAvailableBlockNum: pbs.firstAvailable}
+	}
+	resultsIterator := &mockBlockStoreIterator{}
+	resultsIterator.On("Next").Return(pbs.blocks[startNum], nil).Once()
+	resultsIterator.On("Close").Return()
+	return resultsIterator, nil
+}
+
+func (pbs *pruningBlockStore) PruneBlocksBefore(blockNum uint64, archiveDir string) error {
+	if blockNum > pbs.firstAvailable {
+		pbs.firstAvailable = blockNum
+	}
+	return nil
+}
+
+// appendBlocks appends blocks to the ledger, where the blocks with the given numbers are config blocks
+func appendBlocks(t *testing.T, fl *FileLedger, count uint64, configBlockNums ...uint64) {
+	var lastConfig uint64
+	for num := uint64(0); num < count; num++ {
+		for _, configBlockNum := range configBlockNums {
+			if num == configBlockNum {
+				lastConfig = num
+			}
+		}
+		block := cb.NewBlock(num, nil)
+		block.Metadata.Metadata[cb.BlockMetadataIndex_LAST_CONFIG] = utils.MarshalOrPanic(&cb.Metadata{
+			Value: utils.MarshalOrPanic(&cb.LastConfig{Index: lastConfig}),
+		})
+		assert.NoError(t, fl.Append(block))
+	}
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	for _, testCase := range []struct {
+		name                 string
+		retention            RetentionPolicy
+		configBlockNums      []uint64
+		expectedPrunedBefore uint64
+	}{
+		{
+			name:                 "no retention policy",
+			configBlockNums:      []uint64{0, 3},
+			expectedPrunedBefore: 0,
+		},
+		{
+			name:                 "max blocks",
+			retention:            RetentionPolicy{MaxBlocks: 4},
+			configBlockNums:      []uint64{0, 8},
+			expectedPrunedBefore: 6,
+		},
+		{
+			name:                 "max blocks retains last config block",
+			retention:            RetentionPolicy{MaxBlocks: 2},
+			configBlockNums:      []uint64{0, 3},
+			expectedPrunedBefore: 3,
+		},
+		{
+			name:                 "config blocks",
+			retention:            RetentionPolicy{ConfigBlocks: 2},
+			configBlockNums:      []uint64{0, 3, 5, 7},
+			expectedPrunedBefore: 5,
+		},
+		{
+			name:                 "fewer config blocks than retained",
+			retention:            RetentionPolicy{ConfigBlocks: 3},
+			configBlockNums:      []uint64{0, 3},
+			expectedPrunedBefore: 0,
+		},
+		{
+			name:                 "max blocks and config blocks",
+			retention:            RetentionPolicy{MaxBlocks: 6, ConfigBlocks: 1},
+			configBlockNums:      []uint64{0, 9},
+			expectedPrunedBefore: 4,
+		},
+	} {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			store := &pruningBlockStore{}
+			fl := NewFileLedger(store)
+			fl.retention = testCase.retention
+
+			appendBlocks(t, fl, 10, testCase.configBlockNums...)
+			assert.Equal(t, testCase.expectedPrunedBefore, store.firstAvailable)
+		})
+	}
+}
+
+func TestRetainFrom(t *testing.T) {
+	store := &pruningBlockStore{}
+	fl := NewFileLedger(store)
+	assert.False(t, fl.Prunes())
+	fl.retention = RetentionPolicy{MaxBlocks: 2}
+	assert.True(t, fl.Prunes())
+
+	// Nothing is pruned while the first block is retained
+	fl.RetainFrom(0)
+	appendBlocks(t, fl, 10, 0, 9)
+	assert.Equal(t, uint64(0), store.firstAvailable)
+
+	// Blocks are pruned up to the retained block
+	store.blocks = nil
+	fl.RetainFrom(5)
+	appendBlocks(t, fl, 10, 0, 9)
+	assert.Equal(t, uint64(5), store.firstAvailable)
+
+	// The retention policy applies when it retains more blocks
+	store.blocks = nil
+	fl.RetainFrom(9)
+	appendBlocks(t, fl, 10, 0, 9)
+	assert.Equal(t, uint64(8), store.firstAvailable)
+}
+
+func TestPrunedBlocksIterator(t *testing.T) {
+	store := &pruningBlockStore{}
+	fl := NewFileLedger(store)
+	fl.retention = RetentionPolicy{MaxBlocks: 3}
+	appendBlocks(t, fl, 10, 0, 8)
+	assert.Equal(t, uint64(7), store.firstAvailable)
+
+	// The oldest block is the first block which is not pruned
+	it, num := fl.Iterator(&ab.SeekPosition{Type: &ab.SeekPosition_Oldest{}})
+	assert.Equal(t, uint64(7), num)
+	block, status := it.Next()
+	assert.Equal(t, cb.Status_SUCCESS, status)
+	assert.Equal(t, uint64(7), block.Header.Number)
+	it.Close()
+
+	// Pruned blocks are gone
+	it, _ = fl.Iterator(&ab.SeekPosition{Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: 2}}})
+	assert.IsType(t, &blockledger.PrunedErrorIterator{}, it)
+	_, status = it.Next()
+	assert.Equal(t, cb.Status_GONE, status)
+	it.Close()
+
+	// Blocks pruned while iterating are gone too
+	resultsIterator := &mockBlockStoreIterator{}
+	resultsIterator.On("Next").Return(nil, &coreledger.BlockPrunedErr{FirstAvailableBlockNum: 7})
+	resultsIterator.On("Close").Return()
+	it = &fileLedgerIterator{ledger: fl, commonIterator: resultsIterator}
+	_, status = it.Next()
+	assert.Equal(t, cb.Status_GONE, status)
+	it.Close()
+}
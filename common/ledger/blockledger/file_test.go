@@ -64,7 +64,7 @@ func (env *fileLedgerTestFactory) Persistent() bool {
 }
 
 func (env *fileLedgerTestFactory) New() (Factory, ReadWriter) {
-	flf := fileledger.New(env.location, &disabled.Provider{})
+	flf := fileledger.New(env.location, &disabled.Provider{}, fileledger.RetentionPolicy{})
 	fl, err := flf.GetOrCreate(genesisconfig.TestChainID)
 	if err != nil {
 		panic(err)
@@ -106,6 +106,24 @@ func TestIgnoreInvalidBlockFileName(t *testing.T) {
 	assert.Zero(t, chain.Height(), "Expected chain to be empty")
 }
 
+func TestRemove(t *testing.T) {
+	name, err := ioutil.TempDir("", "hyperledger_fabric")
+	assert.Nil(t, err, "Error creating temp dir: %s", err)
+	defer os.RemoveAll(name)
+
+	jlf := New(name)
+	_, err = jlf.GetOrCreate("foo")
+	assert.NoError(t, err)
+	_, err = jlf.GetOrCreate("bar")
+	assert.NoError(t, err)
+
+	assert.NoError(t, jlf.Remove("foo"))
+	assert.Equal(t, []string{"bar"}, jlf.ChainIDs())
+	_, err = os.Stat(path.Join(name, fmt.Sprintf(chainDirectoryFormatString, "foo")))
+	assert.True(t, os.IsNotExist(err), "Expected chain directory to be removed")
+	assert.Equal(t, 1, len(New(name).ChainIDs()), "Expected removed chain not to be recovered")
+}
+
 func TestClose(t *testing.T) {
 	name, err := ioutil.TempDir("", "hyperledger_fabric")
 	assert.Nil(t, err, "Error creating temp dir: %s", err)
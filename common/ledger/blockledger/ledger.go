@@ -20,6 +20,9 @@ type Factory interface {
 	// ChainIDs returns the chain IDs the Factory is aware of
 	ChainIDs() []string
 
+	// Remove removes the ledger of the given chain, along with its blocks
+	Remove(chainID string) error
+
 	// Close releases all resources acquired by the factory
 	Close()
 }
@@ -48,6 +51,15 @@ type Writer interface {
 	Append(block *cb.Block) error
 }
 
+// Pruner is implemented by ledgers which prune their blocks according to a retention policy
+type Pruner interface {
+	// Prunes returns whether blocks are pruned from the ledger
+	Prunes() bool
+	// RetainFrom prevents the block with the given number and the blocks after it from
+	// being pruned, whatever the retention policy of the ledger
+	RetainFrom(blockNum uint64)
+}
+
 //go:generate mockery -dir . -name ReadWriter -case underscore  -output mocks/
 
 // ReadWriter encapsulates the read/write functions of the ledger
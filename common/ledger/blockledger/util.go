@@ -36,6 +36,23 @@ func (nfei *NotFoundErrorIterator) ReadyChan() <-chan struct{} {
 // Close does nothing
 func (nfei *NotFoundErrorIterator) Close() {}
 
+// PrunedErrorIterator simply always returns an error of cb.Status_GONE,
+// and is useful for implementations of the Reader interface that prune blocks
+type PrunedErrorIterator struct{}
+
+// Next returns nil, cb.Status_GONE
+func (pei *PrunedErrorIterator) Next() (*cb.Block, cb.Status) {
+	return nil, cb.Status_GONE
+}
+
+// ReadyChan returns a closed channel
+func (pei *PrunedErrorIterator) ReadyChan() <-chan struct{} {
+	return closedChan
+}
+
+// Close does nothing
+func (pei *PrunedErrorIterator) Close() {}
+
 // CreateNextBlock provides a utility way to construct the next block from
 // contents and metadata for a given ledger
 // XXX This will need to be modified to accept marshaled envelopes
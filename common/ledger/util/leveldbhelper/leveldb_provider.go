@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
 )
@@ -27,6 +28,9 @@ import (
 var dbNameKeySep = []byte{0x00}
 var lastKeyIndicator = byte(0x01)
 
+// maxDeleteBatchSize is the maximum number of keys deleted in a single batch by the function DBHandle.DeleteAll
+const maxDeleteBatchSize = 1000
+
 // Provider enables to use a single leveldb as multiple logical leveldbs
 type Provider struct {
 	db        *DB
@@ -99,6 +103,31 @@ func (h *DBHandle) WriteBatch(batch *UpdateBatch, sync bool) error {
 	return nil
 }
 
+// DeleteAll deletes all the keys that belong to the db. The keys are deleted in batches of size
+// 'maxDeleteBatchSize' and hence, the deletion is not atomic
+func (h *DBHandle) DeleteAll() error {
+	itr := h.GetIterator(nil, nil)
+	defer itr.Release()
+	levelBatch := &leveldb.Batch{}
+	for itr.Next() {
+		// the key returned by the underlying iterator is the level key that already carries the db name
+		levelBatch.Delete(append([]byte{}, itr.Iterator.Key()...))
+		if levelBatch.Len() == maxDeleteBatchSize {
+			if err := h.db.WriteBatch(levelBatch, true); err != nil {
+				return err
+			}
+			levelBatch.Reset()
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return errors.Wrapf(err, "internal leveldb error while iterating over the db [%s]", h.dbName)
+	}
+	if levelBatch.Len() > 0 {
+		return h.db.WriteBatch(levelBatch, true)
+	}
+	return nil
+}
+
 // GetIterator gets an handle to iterator. The iterator should be released after the use.
 // The resultset contains all the keys that are present in the db between the startKey (inclusive) and the endKey (exclusive).
 // A nil startKey represents the first available key and a nil endKey represent a logical key after the last available key
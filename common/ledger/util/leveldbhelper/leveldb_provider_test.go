@@ -54,6 +54,33 @@ func TestIterator(t *testing.T) {
 	checkItrResults(t, itr3, createTestKeys(0, 19), createTestValues("db2", 0, 19))
 }
 
+func TestDeleteAll(t *testing.T) {
+	env := newTestProviderEnv(t, testDBPath)
+	defer env.cleanup()
+	p := env.provider
+
+	db1 := p.GetDBHandle("db1")
+	db2 := p.GetDBHandle("db2")
+	numKeys := maxDeleteBatchSize*2 + 10
+	for i := 0; i < numKeys; i++ {
+		db1.Put([]byte(createTestKey(i)), []byte(createTestValue("db1", i)), false)
+		db2.Put([]byte(createTestKey(i)), []byte(createTestValue("db2", i)), false)
+	}
+
+	assert.NoError(t, db1.DeleteAll())
+	itr1 := db1.GetIterator(nil, nil)
+	defer itr1.Release()
+	assert.False(t, itr1.Next())
+
+	// the other db remains intact
+	itr2 := db2.GetIterator(nil, nil)
+	defer itr2.Release()
+	checkItrResults(t, itr2, createTestKeys(0, numKeys-1), createTestValues("db2", 0, numKeys-1))
+
+	// deleting an empty db is a no-op
+	assert.NoError(t, db1.DeleteAll())
+}
+
 func TestBatchedUpdates(t *testing.T) {
 	env := newTestProviderEnv(t, testDBPath)
 	defer env.cleanup()
@@ -30,6 +30,8 @@ type Orderer struct {
 	KafkaBrokersVal []string
 	// MaxChannelsCountVal is returns as the result of MaxChannelsCount()
 	MaxChannelsCountVal uint64
+	// RateLimitsVal is returned as the result of RateLimits()
+	RateLimitsVal *ab.RateLimits
 	// OrganizationsVal is returned as the result of Organizations()
 	OrganizationsVal map[string]channelconfig.OrdererOrg
 	// CapabilitiesVal is returned as the result of Capabilities()
@@ -71,6 +73,11 @@ func (o *Orderer) MaxChannelsCount() uint64 {
 	return o.MaxChannelsCountVal
 }
 
+// RateLimits returns the RateLimitsVal
+func (o *Orderer) RateLimits() *ab.RateLimits {
+	return o.RateLimitsVal
+}
+
 // Organizations returns OrganizationsVal
 func (o *Orderer) Organizations() map[string]channelconfig.OrdererOrg {
 	return o.OrganizationsVal
@@ -96,6 +103,9 @@ type OrdererCapabilities struct {
 	ExpirationVal bool
 
 	ConsensusTypeMigrationVal bool
+
+	// RateLimitsVal is returned by RateLimits()
+	RateLimitsVal bool
 }
 
 // Supported returns SupportedErr
@@ -123,3 +133,8 @@ func (oc *OrdererCapabilities) ExpirationCheck() bool {
 func (oc *OrdererCapabilities) ConsensusTypeMigration() bool {
 	return oc.ConsensusTypeMigrationVal
 }
+
+// RateLimits returns RateLimitsVal
+func (oc *OrdererCapabilities) RateLimits() bool {
+	return oc.RateLimitsVal
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/msp"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -118,6 +120,22 @@ func addOrdererImplicitMetaPolicyDefaults(cg *cb.ConfigGroup) {
 	addImplicitMetaPolicyDefaults(cg)
 }
 
+// addBFTBlockValidationPolicy sets the BlockValidation policy of the orderer group to require
+// the signatures of a quorum of the consenters, so that blocks cannot be forged by a minority of
+// malicious ordering nodes.
+func addBFTBlockValidationPolicy(cg *cb.ConfigGroup, consensusMetadata []byte) error {
+	md := &bft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusMetadata, md); err != nil {
+		return errors.Wrapf(err, "cannot unmarshal metadata for orderer type %s", bft.TypeKey)
+	}
+	if len(md.Consenters) == 0 {
+		return errors.Errorf("%s configuration did not specify any consenter", bft.TypeKey)
+	}
+
+	cg.Policies[BlockValidationPolicyKey] = channelconfig.BFTBlockValidationPolicy(md.Consenters)
+	return nil
+}
+
 // addSignaturePolicyDefaults adds the Readers/Writers/Admins policies as signature policies requiring one signature from the given mspID.
 // If devMode is set to true, the Admins policy will accept arbitrary user certs for admin functions, otherwise it requires the cert satisfies
 // the admin role principal.
@@ -201,14 +219,28 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 			return nil, errors.Wrapf(err, "error adding policies to orderer group")
 		}
 	}
-	addValue(ordererGroup, channelconfig.BatchSizeValue(
-		conf.BatchSize.MaxMessageCount,
-		conf.BatchSize.AbsoluteMaxBytes,
-		conf.BatchSize.PreferredMaxBytes,
-	), channelconfig.AdminsPolicyKey)
+	if adaptive := conf.BatchSize.Adaptive; adaptive != nil {
+		addValue(ordererGroup, channelconfig.AdaptiveBatchSizeValue(
+			conf.BatchSize.MaxMessageCount,
+			conf.BatchSize.AbsoluteMaxBytes,
+			conf.BatchSize.PreferredMaxBytes,
+			adaptive.MinMessageCount,
+			adaptive.TargetLatency.String(),
+		), channelconfig.AdminsPolicyKey)
+	} else {
+		addValue(ordererGroup, channelconfig.BatchSizeValue(
+			conf.BatchSize.MaxMessageCount,
+			conf.BatchSize.AbsoluteMaxBytes,
+			conf.BatchSize.PreferredMaxBytes,
+		), channelconfig.AdminsPolicyKey)
+	}
 	addValue(ordererGroup, channelconfig.BatchTimeoutValue(conf.BatchTimeout.String()), channelconfig.AdminsPolicyKey)
 	addValue(ordererGroup, channelconfig.ChannelRestrictionsValue(conf.MaxChannels), channelconfig.AdminsPolicyKey)
 
+	if conf.RateLimits != nil {
+		addValue(ordererGroup, rateLimitsValue(conf.RateLimits), channelconfig.AdminsPolicyKey)
+	}
+
 	if len(conf.Capabilities) > 0 {
 		addValue(ordererGroup, channelconfig.CapabilitiesValue(conf.Capabilities), channelconfig.AdminsPolicyKey)
 	}
@@ -224,6 +256,13 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 		if consensusMetadata, err = etcdraft.Marshal(conf.EtcdRaft); err != nil {
 			return nil, errors.Errorf("cannot marshal metadata for orderer type %s: %s", etcdraft.TypeKey, err)
 		}
+	case bft.TypeKey:
+		if consensusMetadata, err = bft.Marshal(conf.BFT); err != nil {
+			return nil, errors.Errorf("cannot marshal metadata for orderer type %s: %s", bft.TypeKey, err)
+		}
+		if err = addBFTBlockValidationPolicy(ordererGroup, consensusMetadata); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errors.Errorf("unknown orderer type: %s", conf.OrdererType)
 	}
@@ -242,6 +281,28 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 	return ordererGroup, nil
 }
 
+// rateLimitsValue converts the rate limits of configtx.yaml to their config value.
+func rateLimitsValue(conf *genesisconfig.RateLimits) *channelconfig.StandardConfigValue {
+	var defaultLimit *ab.RateLimit
+	if conf.Default != nil {
+		defaultLimit = &ab.RateLimit{
+			TransactionsPerSecond: conf.Default.TransactionsPerSecond,
+			Burst:                 conf.Default.Burst,
+		}
+	}
+	var organizations []*ab.OrganizationRateLimit
+	for _, orgLimit := range conf.Organizations {
+		organizations = append(organizations, &ab.OrganizationRateLimit{
+			MspId: orgLimit.MSPID,
+			Limit: &ab.RateLimit{
+				TransactionsPerSecond: orgLimit.TransactionsPerSecond,
+				Burst:                 orgLimit.Burst,
+			},
+		})
+	}
+	return channelconfig.RateLimitsValue(defaultLimit, organizations)
+}
+
 // NewConsortiumsGroup returns an org component of the channel configuration.  It defines the crypto material for the
 // organization (its MSP).  It sets the mod_policy of all elements to "Admins".
 func NewConsortiumOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, error) {
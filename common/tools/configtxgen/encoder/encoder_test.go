@@ -8,6 +8,7 @@ package encoder_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/msp"
 	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	"github.com/hyperledger/fabric/protos/utils"
 )
@@ -235,6 +237,59 @@ var _ = Describe("Encoder", func() {
 			})
 		})
 
+		Context("when adaptive batching is configured", func() {
+			BeforeEach(func() {
+				conf.BatchSize = genesisconfig.BatchSize{
+					MaxMessageCount:   100,
+					AbsoluteMaxBytes:  1000,
+					PreferredMaxBytes: 500,
+					Adaptive: &genesisconfig.AdaptiveBatching{
+						MinMessageCount: 10,
+						TargetLatency:   500 * time.Millisecond,
+					},
+				}
+			})
+
+			It("adds it to the batch size", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				batchSize := &ab.BatchSize{}
+				err = proto.Unmarshal(cg.Values["BatchSize"].Value, batchSize)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batchSize.MaxMessageCount).To(Equal(uint32(100)))
+				Expect(batchSize.Adaptive).To(Equal(&ab.AdaptiveBatching{
+					MinMessageCount: 10,
+					TargetLatency:   "500ms",
+				}))
+			})
+		})
+
+		Context("when rate limits are configured", func() {
+			BeforeEach(func() {
+				conf.RateLimits = &genesisconfig.RateLimits{
+					Default: &genesisconfig.RateLimit{TransactionsPerSecond: 100},
+					Organizations: []genesisconfig.OrganizationRateLimit{
+						{MSPID: "Org1MSP", TransactionsPerSecond: 10, Burst: 50},
+					},
+				}
+			})
+
+			It("adds the rate limits value", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cg.Values["RateLimits"].ModPolicy).To(Equal("Admins"))
+				rateLimits := &ab.RateLimits{}
+				err = proto.Unmarshal(cg.Values["RateLimits"].Value, rateLimits)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(proto.Equal(rateLimits, &ab.RateLimits{
+					Default: &ab.RateLimit{TransactionsPerSecond: 100},
+					Organizations: []*ab.OrganizationRateLimit{
+						{MspId: "Org1MSP", Limit: &ab.RateLimit{TransactionsPerSecond: 10, Burst: 50}},
+					},
+				})).To(BeTrue())
+			})
+		})
+
 		Context("when the consensus type is Kafka", func() {
 			BeforeEach(func() {
 				conf.OrdererType = "kafka"
@@ -288,6 +343,69 @@ var _ = Describe("Encoder", func() {
 			})
 		})
 
+		Context("when the consensus type is bft", func() {
+			BeforeEach(func() {
+				conf.OrdererType = "bft"
+				conf.BFT = &bft.ConfigMetadata{
+					Options: &bft.Options{
+						RequestTimeout:    "10s",
+						ViewChangeTimeout: "20s",
+					},
+				}
+				for i := 1; i <= 4; i++ {
+					conf.BFT.Consenters = append(conf.BFT.Consenters, &bft.Consenter{
+						Id:            uint64(i),
+						Host:          fmt.Sprintf("orderer%d", i),
+						Port:          7050,
+						MspId:         "OrdererMSP",
+						Identity:      []byte("../../../../protos/orderer/etcdraft/testdata/tls-client-1.pem"),
+						ClientTlsCert: []byte("../../../../protos/orderer/etcdraft/testdata/tls-client-1.pem"),
+						ServerTlsCert: []byte("../../../../protos/orderer/etcdraft/testdata/tls-server-1.pem"),
+					})
+				}
+			})
+
+			It("adds the bft metadata and requires a quorum of consenters to sign blocks", func() {
+				cg, err := encoder.NewOrdererGroup(conf)
+				Expect(err).NotTo(HaveOccurred())
+				consensusType := &ab.ConsensusType{}
+				err = proto.Unmarshal(cg.Values["ConsensusType"].Value, consensusType)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(consensusType.Type).To(Equal("bft"))
+				metadata := &bft.ConfigMetadata{}
+				err = proto.Unmarshal(consensusType.Metadata, metadata)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(metadata.Options.RequestTimeout).To(Equal("10s"))
+				Expect(metadata.Consenters).To(HaveLen(4))
+				Expect(string(metadata.Consenters[0].Identity)).To(HavePrefix("-----BEGIN CERTIFICATE-----"))
+
+				Expect(cg.Policies["BlockValidation"].Policy.Type).To(Equal(int32(cb.Policy_SIGNATURE)))
+				Expect(cg.Policies["BlockValidation"].ModPolicy).To(Equal("Admins"))
+				policy := &cb.SignaturePolicyEnvelope{}
+				err = proto.Unmarshal(cg.Policies["BlockValidation"].Policy.Value, policy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policy.Identities).To(HaveLen(4))
+				Expect(policy.Rule.GetNOutOf().N).To(Equal(int32(3)))
+				Expect(policy.Rule.GetNOutOf().Rules).To(HaveLen(4))
+				identity := &msp.SerializedIdentity{}
+				err = proto.Unmarshal(policy.Identities[0].Principal, identity)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(identity.Mspid).To(Equal("OrdererMSP"))
+				Expect(identity.IdBytes).To(Equal(metadata.Consenters[0].Identity))
+			})
+
+			Context("when the bft configuration is bad", func() {
+				BeforeEach(func() {
+					conf.BFT.Consenters[0].Identity = nil
+				})
+
+				It("wraps and returns the error", func() {
+					_, err := encoder.NewOrdererGroup(conf)
+					Expect(err).To(MatchError("cannot marshal metadata for orderer type bft: cannot load identity for consenter orderer1:7050: open : no such file or directory"))
+				})
+			})
+		})
+
 		Context("when the consensus type is unknown", func() {
 			BeforeEach(func() {
 				conf.OrdererType = "bad-type"
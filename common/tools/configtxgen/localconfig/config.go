@@ -17,6 +17,7 @@ import (
 	"github.com/hyperledger/fabric/common/viperutil"
 	cf "github.com/hyperledger/fabric/core/config"
 	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	"github.com/spf13/viper"
 )
@@ -162,17 +163,49 @@ type Orderer struct {
 	BatchSize     BatchSize                `yaml:"BatchSize"`
 	Kafka         Kafka                    `yaml:"Kafka"`
 	EtcdRaft      *etcdraft.ConfigMetadata `yaml:"EtcdRaft"`
+	BFT           *bft.ConfigMetadata      `yaml:"BFT"`
 	Organizations []*Organization          `yaml:"Organizations"`
 	MaxChannels   uint64                   `yaml:"MaxChannels"`
+	RateLimits    *RateLimits              `yaml:"RateLimits"`
 	Capabilities  map[string]bool          `yaml:"Capabilities"`
 	Policies      map[string]*Policy       `yaml:"Policies"`
 }
 
 // BatchSize contains configuration affecting the size of batches.
 type BatchSize struct {
-	MaxMessageCount   uint32 `yaml:"MaxMessageCount"`
-	AbsoluteMaxBytes  uint32 `yaml:"AbsoluteMaxBytes"`
-	PreferredMaxBytes uint32 `yaml:"PreferredMaxBytes"`
+	MaxMessageCount   uint32            `yaml:"MaxMessageCount"`
+	AbsoluteMaxBytes  uint32            `yaml:"AbsoluteMaxBytes"`
+	PreferredMaxBytes uint32            `yaml:"PreferredMaxBytes"`
+	Adaptive          *AdaptiveBatching `yaml:"Adaptive"`
+}
+
+// AdaptiveBatching contains configuration for adjusting the size of batches
+// to the observed load.
+type AdaptiveBatching struct {
+	MinMessageCount uint32        `yaml:"MinMessageCount"`
+	TargetLatency   time.Duration `yaml:"TargetLatency"`
+}
+
+// RateLimits contains the limits on the rate at which organizations may
+// broadcast transactions to the channel.
+type RateLimits struct {
+	Default       *RateLimit              `yaml:"Default"`
+	Organizations []OrganizationRateLimit `yaml:"Organizations"`
+}
+
+// RateLimit contains the number of transactions per second an organization
+// may broadcast, and the size of the bursts it may send.
+type RateLimit struct {
+	TransactionsPerSecond uint32 `yaml:"TransactionsPerSecond"`
+	Burst                 uint32 `yaml:"Burst"`
+}
+
+// OrganizationRateLimit contains the rate limit of the organization with the
+// given MSP ID.
+type OrganizationRateLimit struct {
+	MSPID                 string `yaml:"MSPID"`
+	TransactionsPerSecond uint32 `yaml:"TransactionsPerSecond"`
+	Burst                 uint32 `yaml:"Burst"`
 }
 
 // Kafka contains configuration for the Kafka-based orderer.
@@ -202,6 +235,13 @@ var genesisDefaults = TopLevel{
 				SnapshotIntervalSize: 20 * 1024 * 1024, // 20 MB
 			},
 		},
+		BFT: &bft.ConfigMetadata{
+			Options: &bft.Options{
+				RequestTimeout:    "10s",
+				ViewChangeTimeout: "20s",
+				RequestPoolSize:   10000,
+			},
+		},
 	},
 }
 
@@ -461,6 +501,76 @@ loop:
 			cf.TranslatePathInPlace(configDir, &serverCertPath)
 			c.ServerTlsCert = []byte(serverCertPath)
 		}
+	case bft.TypeKey:
+		if ord.BFT == nil {
+			logger.Panicf("%s configuration missing", bft.TypeKey)
+		}
+		if ord.BFT.Options == nil {
+			logger.Infof("Orderer.BFT.Options unset, setting to %v", genesisDefaults.Orderer.BFT.Options)
+			ord.BFT.Options = genesisDefaults.Orderer.BFT.Options
+		}
+	bft_loop:
+		for {
+			switch {
+			case ord.BFT.Options.RequestTimeout == "":
+				logger.Infof("Orderer.BFT.Options.RequestTimeout unset, setting to %v", genesisDefaults.Orderer.BFT.Options.RequestTimeout)
+				ord.BFT.Options.RequestTimeout = genesisDefaults.Orderer.BFT.Options.RequestTimeout
+
+			case ord.BFT.Options.ViewChangeTimeout == "":
+				logger.Infof("Orderer.BFT.Options.ViewChangeTimeout unset, setting to %v", genesisDefaults.Orderer.BFT.Options.ViewChangeTimeout)
+				ord.BFT.Options.ViewChangeTimeout = genesisDefaults.Orderer.BFT.Options.ViewChangeTimeout
+
+			case ord.BFT.Options.RequestPoolSize == 0:
+				logger.Infof("Orderer.BFT.Options.RequestPoolSize unset, setting to %v", genesisDefaults.Orderer.BFT.Options.RequestPoolSize)
+				ord.BFT.Options.RequestPoolSize = genesisDefaults.Orderer.BFT.Options.RequestPoolSize
+
+			case len(ord.BFT.Consenters) == 0:
+				logger.Panicf("%s configuration did not specify any consenter", bft.TypeKey)
+
+			default:
+				break bft_loop
+			}
+		}
+
+		if _, err := time.ParseDuration(ord.BFT.Options.RequestTimeout); err != nil {
+			logger.Panicf("BFT RequestTimeout (%s) must be in time duration format", ord.BFT.Options.RequestTimeout)
+		}
+		if _, err := time.ParseDuration(ord.BFT.Options.ViewChangeTimeout); err != nil {
+			logger.Panicf("BFT ViewChangeTimeout (%s) must be in time duration format", ord.BFT.Options.ViewChangeTimeout)
+		}
+
+		for _, c := range ord.BFT.GetConsenters() {
+			if c.Id == 0 {
+				logger.Panicf("consenter info in %s configuration did not specify id", bft.TypeKey)
+			}
+			if c.Host == "" {
+				logger.Panicf("consenter info in %s configuration did not specify host", bft.TypeKey)
+			}
+			if c.Port == 0 {
+				logger.Panicf("consenter info in %s configuration did not specify port", bft.TypeKey)
+			}
+			if c.MspId == "" {
+				logger.Panicf("consenter info in %s configuration did not specify MSP ID", bft.TypeKey)
+			}
+			if c.Identity == nil {
+				logger.Panicf("consenter info in %s configuration did not specify identity", bft.TypeKey)
+			}
+			if c.ClientTlsCert == nil {
+				logger.Panicf("consenter info in %s configuration did not specify client TLS cert", bft.TypeKey)
+			}
+			if c.ServerTlsCert == nil {
+				logger.Panicf("consenter info in %s configuration did not specify server TLS cert", bft.TypeKey)
+			}
+			identityPath := string(c.GetIdentity())
+			cf.TranslatePathInPlace(configDir, &identityPath)
+			c.Identity = []byte(identityPath)
+			clientCertPath := string(c.GetClientTlsCert())
+			cf.TranslatePathInPlace(configDir, &clientCertPath)
+			c.ClientTlsCert = []byte(clientCertPath)
+			serverCertPath := string(c.GetServerTlsCert())
+			cf.TranslatePathInPlace(configDir, &serverCertPath)
+			c.ServerTlsCert = []byte(serverCertPath)
+		}
 	default:
 		logger.Panicf("unknown orderer type: %s", ord.OrdererType)
 	}
@@ -7,9 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package localconfig
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/hyperledger/fabric/core/config/configtest"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -270,4 +272,99 @@ func TestConsensusSpecificInit(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("bft", func(t *testing.T) {
+		makeProfile := func(consenters []*bft.Consenter, options *bft.Options) *Profile {
+			return &Profile{
+				Orderer: &Orderer{
+					OrdererType: "bft",
+					BFT: &bft.ConfigMetadata{
+						Consenters: consenters,
+						Options:    options,
+					},
+				},
+			}
+		}
+		newConsenter := func() *bft.Consenter {
+			return &bft.Consenter{
+				Id:            1,
+				Host:          "node-1.example.com",
+				Port:          7050,
+				MspId:         "OrdererMSP",
+				Identity:      []byte("path/to/identity"),
+				ClientTlsCert: []byte("path/to/client/cert"),
+				ServerTlsCert: []byte("path/to/server/cert"),
+			}
+		}
+
+		t.Run("BFT section not specified in profile", func(t *testing.T) {
+			profile := &Profile{
+				Orderer: &Orderer{
+					OrdererType: "bft",
+				},
+			}
+
+			assert.Panics(t, func() {
+				profile.completeInitialization(devConfigDir)
+			})
+		})
+
+		t.Run("nil consenter set", func(t *testing.T) {
+			profile := makeProfile(nil, nil)
+
+			assert.Panics(t, func() {
+				profile.completeInitialization(devConfigDir)
+			})
+		})
+
+		t.Run("invalid consenters specification", func(t *testing.T) {
+			for _, mutate := range []func(c *bft.Consenter){
+				func(c *bft.Consenter) { c.Id = 0 },
+				func(c *bft.Consenter) { c.Host = "" },
+				func(c *bft.Consenter) { c.Port = 0 },
+				func(c *bft.Consenter) { c.MspId = "" },
+				func(c *bft.Consenter) { c.Identity = nil },
+				func(c *bft.Consenter) { c.ClientTlsCert = nil },
+				func(c *bft.Consenter) { c.ServerTlsCert = nil },
+			} {
+				consenter := newConsenter()
+				mutate(consenter)
+				profile := makeProfile([]*bft.Consenter{consenter}, nil)
+
+				assert.Panics(t, func() {
+					profile.completeInitialization(devConfigDir)
+				})
+			}
+		})
+
+		t.Run("nil Options", func(t *testing.T) {
+			profile := makeProfile([]*bft.Consenter{newConsenter()}, nil)
+			profile.completeInitialization(devConfigDir)
+
+			assert.Equal(t, genesisDefaults.Orderer.BFT.Options, profile.Orderer.BFT.Options,
+				"Options should be set to the default value")
+			assert.Equal(t, filepath.Join(devConfigDir, "path/to/identity"), string(profile.Orderer.BFT.Consenters[0].Identity),
+				"Identity path should be translated relative to the config directory")
+		})
+
+		t.Run("request timeout specified in Options", func(t *testing.T) {
+			profile := makeProfile([]*bft.Consenter{newConsenter()}, &bft.Options{RequestTimeout: "5s"})
+			profile.completeInitialization(devConfigDir)
+
+			assert.Equal(t, "5s", profile.Orderer.BFT.Options.RequestTimeout,
+				"RequestTimeout should be set to the specified value")
+			assert.Equal(t, genesisDefaults.Orderer.BFT.Options.ViewChangeTimeout, profile.Orderer.BFT.Options.ViewChangeTimeout,
+				"ViewChangeTimeout should be set to the default value")
+			assert.Equal(t, genesisDefaults.Orderer.BFT.Options.RequestPoolSize, profile.Orderer.BFT.Options.RequestPoolSize,
+				"RequestPoolSize should be set to the default value")
+		})
+
+		t.Run("panic on invalid ViewChangeTimeout", func(t *testing.T) {
+			profile := makeProfile([]*bft.Consenter{newConsenter()}, &bft.Options{ViewChangeTimeout: "20"})
+
+			assert.Panics(t, func() {
+				profile.completeInitialization(devConfigDir)
+			})
+		})
+	})
 }
@@ -57,6 +57,25 @@ var (
 	computeUpdateChannelID = computeUpdate.Flag("channel_id", "The name of the channel for this update.").Required().String()
 	computeUpdateDest      = computeUpdate.Flag("output", "A file to write the JSON document to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 
+	migrate = app.Command("migrate", "Computes the config updates which migrate the channels of an ordering service from Kafka to Raft.")
+
+	migrateMaintenance       = migrate.Command("maintenance", "Computes the config update which makes a channel enter maintenance mode.")
+	migrateMaintenanceSource = migrateMaintenance.Flag("input", "A file containing the latest config block of the channel.").Required().File()
+	migrateMaintenanceDest   = migrateMaintenance.Flag("output", "A file to write the config update envelope to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+
+	migrateConsensus         = migrate.Command("consensus", "Computes the config update which changes the consensus type of a channel in maintenance mode to etcdraft.")
+	migrateConsensusSource   = migrateConsensus.Flag("input", "A file containing the latest config block of the channel.").Required().File()
+	migrateConsensusMetadata = migrateConsensus.Flag("metadata", "A file containing the marshaled etcdraft.ConfigMetadata of the Raft cluster.").Required().File()
+	migrateConsensusDest     = migrateConsensus.Flag("output", "A file to write the config update envelope to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+
+	migrateNormal       = migrate.Command("normal", "Computes the config update which makes a channel exit maintenance mode.")
+	migrateNormalSource = migrateNormal.Flag("input", "A file containing the latest config block of the channel.").Required().File()
+	migrateNormalDest   = migrateNormal.Flag("output", "A file to write the config update envelope to.").Default(os.Stdout.Name()).OpenFile(os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+
+	migrateCheck       = migrate.Command("check", "Reports the migration status of the channels and checks that they may all undergo the given step.")
+	migrateCheckStep   = migrateCheck.Flag("step", "The step of the migration to check the preconditions of.").Required().Enum("maintenance", "consensus", "normal")
+	migrateCheckBlocks = migrateCheck.Arg("blocks", "Files containing the latest config block of every channel, including the system channel.").Required().ExistingFiles()
+
 	version = app.Command("version", "Show version information")
 )
 
@@ -91,6 +110,33 @@ func main() {
 		if err != nil {
 			app.Fatalf("Error computing update: %s", err)
 		}
+	case migrateMaintenance.FullCommand():
+		defer (*migrateMaintenanceSource).Close()
+		defer (*migrateMaintenanceDest).Close()
+		err := migrateToMaintenance(*migrateMaintenanceSource, *migrateMaintenanceDest)
+		if err != nil {
+			app.Fatalf("Error computing update: %s", err)
+		}
+	case migrateConsensus.FullCommand():
+		defer (*migrateConsensusSource).Close()
+		defer (*migrateConsensusMetadata).Close()
+		defer (*migrateConsensusDest).Close()
+		err := migrateToRaft(*migrateConsensusSource, *migrateConsensusMetadata, *migrateConsensusDest)
+		if err != nil {
+			app.Fatalf("Error computing update: %s", err)
+		}
+	case migrateNormal.FullCommand():
+		defer (*migrateNormalSource).Close()
+		defer (*migrateNormalDest).Close()
+		err := migrateToNormal(*migrateNormalSource, *migrateNormalDest)
+		if err != nil {
+			app.Fatalf("Error computing update: %s", err)
+		}
+	case migrateCheck.FullCommand():
+		err := checkMigration(*migrateCheckStep, *migrateCheckBlocks, os.Stdout)
+		if err != nil {
+			app.Fatalf("Migration preconditions not met: %s", err)
+		}
 	// "version" command
 	case version.FullCommand():
 		printVersion()
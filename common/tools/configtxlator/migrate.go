@@ -0,0 +1,153 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/orderer/consensus/migration"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+func migrateToMaintenance(input, output *os.File) error {
+	channelID, config, err := readConfigBlock(input)
+	if err != nil {
+		return err
+	}
+	updated, err := migration.EnterMaintenance(channelID, config)
+	if err != nil {
+		return err
+	}
+	return writeConfigUpdate(channelID, config, updated, output)
+}
+
+func migrateToRaft(input, metadata, output *os.File) error {
+	channelID, config, err := readConfigBlock(input)
+	if err != nil {
+		return err
+	}
+	metadataIn, err := ioutil.ReadAll(metadata)
+	if err != nil {
+		return errors.Wrapf(err, "error reading Raft metadata")
+	}
+	raftMetadata := &etcdraft.ConfigMetadata{}
+	err = proto.Unmarshal(metadataIn, raftMetadata)
+	if err != nil {
+		return errors.Wrapf(err, "error unmarshaling Raft metadata")
+	}
+	updated, err := migration.SwitchToRaft(channelID, config, raftMetadata)
+	if err != nil {
+		return err
+	}
+	return writeConfigUpdate(channelID, config, updated, output)
+}
+
+func migrateToNormal(input, output *os.File) error {
+	channelID, config, err := readConfigBlock(input)
+	if err != nil {
+		return err
+	}
+	updated, err := migration.ExitMaintenance(channelID, config)
+	if err != nil {
+		return err
+	}
+	return writeConfigUpdate(channelID, config, updated, output)
+}
+
+func checkMigration(step string, blockFiles []string, output io.Writer) error {
+	configs := map[string]*cb.Config{}
+	for _, blockFile := range blockFiles {
+		f, err := os.Open(blockFile)
+		if err != nil {
+			return errors.Wrapf(err, "error opening %s", blockFile)
+		}
+		channelID, config, err := readConfigBlock(f)
+		f.Close()
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error reading %s", blockFile))
+		}
+		if _, exists := configs[channelID]; exists {
+			return errors.Errorf("more than one config block was given for channel %s", channelID)
+		}
+		configs[channelID] = config
+	}
+
+	statuses, err := migration.CheckPreconditions(migration.Transition(step), configs)
+
+	w := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHANNEL\tCONSENSUS TYPE\tSTATE\tSTEP")
+	for _, status := range statuses {
+		channel := status.Channel
+		if status.SystemChannel {
+			channel += " (system)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", channel, status.ConsensusType, status.State, status.Step)
+	}
+	w.Flush()
+
+	return err
+}
+
+// readConfigBlock returns the channel ID and the config in the config block read from input.
+func readConfigBlock(input io.Reader) (string, *cb.Config, error) {
+	in, err := ioutil.ReadAll(input)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error reading config block")
+	}
+	block, err := utils.UnmarshalBlock(in)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error unmarshaling config block")
+	}
+	env, err := utils.ExtractEnvelope(block, 0)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error extracting envelope from config block")
+	}
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error extracting channel header from config block")
+	}
+	if chdr.Type != int32(cb.HeaderType_CONFIG) {
+		return "", nil, errors.Errorf("block is not a config block, its header type is %d", chdr.Type)
+	}
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error unmarshaling payload of config block")
+	}
+	configEnv, err := configtx.UnmarshalConfigEnvelope(payload.Data)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "error unmarshaling config envelope")
+	}
+	if configEnv.Config == nil {
+		return "", nil, errors.New("config block has no config")
+	}
+	return chdr.ChannelId, configEnv.Config, nil
+}
+
+func writeConfigUpdate(channelID string, original, updated *cb.Config, output io.Writer) error {
+	env, err := migration.ConfigUpdate(channelID, original, updated)
+	if err != nil {
+		return err
+	}
+	outBytes, err := proto.Marshal(env)
+	if err != nil {
+		return errors.Wrapf(err, "error marshaling config update envelope")
+	}
+	_, err = output.Write(outBytes)
+	if err != nil {
+		return errors.Wrapf(err, "error writing config update envelope to output")
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ const (
 
 	//Cscc resources
 	Cscc_JoinChain                = "cscc/JoinChain"
+	Cscc_JoinChainBySnapshot      = "cscc/JoinChainBySnapshot"
 	Cscc_GetConfigBlock           = "cscc/GetConfigBlock"
 	Cscc_GetChannels              = "cscc/GetChannels"
 	Cscc_GetConfigTree            = "cscc/GetConfigTree"
@@ -10,9 +10,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/gossip/privdata"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	"github.com/pkg/errors"
@@ -34,13 +38,38 @@ type AccessControlEvaluator interface {
 	Evaluate(signatureSet []*common.SignedData) error
 }
 
+// StateDBIndexManagerProvider provides the StateDBIndexManager of the ledger of a channel
+type StateDBIndexManagerProvider interface {
+	// GetStateDBIndexManager returns the StateDBIndexManager of the ledger of the given channel
+	GetStateDBIndexManager(channelID string) (ledger.StateDBIndexManager, error)
+}
+
+// StateDBIndexManagerProviderFunc is an adapter that allows using a function as a StateDBIndexManagerProvider
+type StateDBIndexManagerProviderFunc func(channelID string) (ledger.StateDBIndexManager, error)
+
+// GetStateDBIndexManager returns the StateDBIndexManager of the ledger of the given channel
+func (f StateDBIndexManagerProviderFunc) GetStateDBIndexManager(channelID string) (ledger.StateDBIndexManager, error) {
+	return f(channelID)
+}
+
+// PvtDataReconciliationSupport provides the means for inspecting and driving
+// the reconciliation of the missing private data of a channel
+type PvtDataReconciliationSupport interface {
+	// GetMissingPvtDataTracker returns the MissingPvtDataTracker of the ledger of the given channel
+	GetMissingPvtDataTracker(channelID string) (ledger.MissingPvtDataTracker, error)
+	// GetPvtDataReconciler returns the reconciler of the missing private data of the given channel
+	GetPvtDataReconciler(channelID string) (privdata.PvtDataReconciler, error)
+}
+
 // NewAdminServer creates and returns a Admin service instance.
-func NewAdminServer(ace AccessControlEvaluator) *ServerAdmin {
+func NewAdminServer(ace AccessControlEvaluator, imp StateDBIndexManagerProvider, prs PvtDataReconciliationSupport) *ServerAdmin {
 	s := &ServerAdmin{
 		v: &validator{
 			ace: ace,
 		},
 		specAtStartup: flogging.Global.Spec(),
+		imp:           imp,
+		prs:           prs,
 	}
 	return s
 }
@@ -50,6 +79,8 @@ type ServerAdmin struct {
 	v requestValidator
 
 	specAtStartup string
+	imp           StateDBIndexManagerProvider
+	prs           PvtDataReconciliationSupport
 }
 
 func (s *ServerAdmin) GetStatus(ctx context.Context, env *common.Envelope) (*pb.ServerStatus, error) {
@@ -140,3 +171,267 @@ func (s *ServerAdmin) SetLogSpec(ctx context.Context, env *common.Envelope) (*pb
 	}
 	return logResponse, nil
 }
+
+func (s *ServerAdmin) ListIndexes(ctx context.Context, env *common.Envelope) (*pb.IndexesResponse, error) {
+	request, indexManager, err := s.indexRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := indexManager.ListIndexes(request.Namespace, request.Collection)
+	if err != nil {
+		return nil, err
+	}
+	return toIndexesResponse(indexes...), nil
+}
+
+func (s *ServerAdmin) CreateIndex(ctx context.Context, env *common.Envelope) (*pb.IndexesResponse, error) {
+	request, indexManager, err := s.indexRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	if request.IndexDefinition == "" {
+		return nil, errors.New("index definition is empty")
+	}
+	index, err := indexManager.CreateIndex(request.Namespace, request.Collection, request.IndexDefinition)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("Created index [%s] of design document [%s] for namespace [%s] and collection [%s] on channel [%s]",
+		index.Name, index.DesignDocument, request.Namespace, request.Collection, request.ChannelId)
+	return toIndexesResponse(index), nil
+}
+
+func (s *ServerAdmin) DropIndex(ctx context.Context, env *common.Envelope) (*empty.Empty, error) {
+	request, indexManager, err := s.indexRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	if request.DesignDoc == "" || request.IndexName == "" {
+		return nil, errors.New("design document and index name are required")
+	}
+	if err := indexManager.DropIndex(request.Namespace, request.Collection, request.DesignDoc, request.IndexName); err != nil {
+		return nil, err
+	}
+	logger.Infof("Dropped index [%s] of design document [%s] for namespace [%s] and collection [%s] on channel [%s]",
+		request.IndexName, request.DesignDoc, request.Namespace, request.Collection, request.ChannelId)
+	return &empty.Empty{}, nil
+}
+
+func (s *ServerAdmin) ExplainQuery(ctx context.Context, env *common.Envelope) (*pb.IndexesResponse, error) {
+	request, indexManager, err := s.indexRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	if request.Query == "" {
+		return nil, errors.New("query is empty")
+	}
+	index, err := indexManager.ExplainQuery(request.Namespace, request.Collection, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	return toIndexesResponse(index), nil
+}
+
+// indexRequest validates the given envelope and returns the IndexRequest contained in it along with the
+// StateDBIndexManager of the channel that the request refers to
+func (s *ServerAdmin) indexRequest(ctx context.Context, env *common.Envelope) (*pb.IndexRequest, ledger.StateDBIndexManager, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, nil, err
+	}
+	request := op.GetIndexReq()
+	if request == nil {
+		return nil, nil, errors.New("request is nil")
+	}
+	if request.ChannelId == "" || request.Namespace == "" {
+		return nil, nil, errors.New("channel ID and namespace are required")
+	}
+	if s.imp == nil {
+		return nil, nil, errors.New("managing indexes is not supported by this peer")
+	}
+	indexManager, err := s.imp.GetStateDBIndexManager(request.ChannelId)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, fmt.Sprintf("cannot manage the indexes of channel [%s]", request.ChannelId))
+	}
+	return request, indexManager, nil
+}
+
+func toIndexesResponse(indexes ...*ledger.StateDBIndex) *pb.IndexesResponse {
+	response := &pb.IndexesResponse{}
+	for _, index := range indexes {
+		response.Indexes = append(response.Indexes, &pb.StateDBIndex{
+			DesignDoc:  index.DesignDocument,
+			Name:       index.Name,
+			Definition: index.Definition,
+		})
+	}
+	return response
+}
+
+func (s *ServerAdmin) ListMissingPvtData(ctx context.Context, env *common.Envelope) (*pb.PvtDataResponse, error) {
+	request, err := s.pvtDataRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	missingPvtData, status, err := listMissingPvtData(s.prs, request)
+	if err != nil {
+		return nil, err
+	}
+	return toPvtDataResponse(missingPvtData, status), nil
+}
+
+func (s *ServerAdmin) ReconcilePvtData(ctx context.Context, env *common.Envelope) (*pb.PvtDataResponse, error) {
+	request, err := s.pvtDataRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	missingPvtData, status, err := reconcilePvtData(s.prs, request)
+	if err != nil {
+		return nil, err
+	}
+	return toPvtDataResponse(missingPvtData, status), nil
+}
+
+func (s *ServerAdmin) MarkPvtDataUnrecoverable(ctx context.Context, env *common.Envelope) (*pb.PvtDataResponse, error) {
+	request, err := s.pvtDataRequest(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	marked, status, err := markPvtDataUnrecoverable(s.prs, request)
+	if err != nil {
+		return nil, err
+	}
+	return toPvtDataResponse(marked, status), nil
+}
+
+// pvtDataRequest validates the given envelope and returns the PvtDataRequest contained in it
+func (s *ServerAdmin) pvtDataRequest(ctx context.Context, env *common.Envelope) (*pb.PvtDataRequest, error) {
+	op, err := s.v.validate(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	request := op.GetPvtDataReq()
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	if err := validatePvtDataRequest(s.prs, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// validatePvtDataRequest checks that the given PvtDataRequest can be served
+func validatePvtDataRequest(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) error {
+	if request.ChannelId == "" {
+		return errors.New("channel ID is required")
+	}
+	if request.StartBlock > request.EndBlock {
+		return errors.Errorf("invalid block range [%d - %d]", request.StartBlock, request.EndBlock)
+	}
+	if prs == nil {
+		return errors.New("managing the reconciliation of private data is not supported by this peer")
+	}
+	return nil
+}
+
+// listMissingPvtData returns the private data missing in the blocks range of the given request,
+// along with the status of the reconciliation of the channel
+func listMissingPvtData(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) ([]*ledger.MissingPvtDataDetails, *privdata.ReconciliationStatus, error) {
+	missingPvtData, err := filteredMissingPvtData(prs, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	reconciler, err := pvtDataReconciler(prs, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return missingPvtData, reconciler.Status(), nil
+}
+
+// reconcilePvtData reconciles the missing private data of the blocks range of the given request and
+// returns the private data that is still missing along with the outcome of the reconciliation
+func reconcilePvtData(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) ([]*ledger.MissingPvtDataDetails, *privdata.ReconciliationStatus, error) {
+	reconciler, err := pvtDataReconciler(prs, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger.Infof("Reconciling the missing private data of blocks range [%d - %d] on channel [%s]",
+		request.StartBlock, request.EndBlock, request.ChannelId)
+	if _, err := reconciler.ReconcileBlockRange(request.StartBlock, request.EndBlock); err != nil {
+		return nil, nil, err
+	}
+	missingPvtData, err := filteredMissingPvtData(prs, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return missingPvtData, reconciler.Status(), nil
+}
+
+// markPvtDataUnrecoverable marks the missing private data of the given request as unrecoverable
+// and returns the marked data along with the status of the reconciliation of the channel
+func markPvtDataUnrecoverable(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) ([]*ledger.MissingPvtDataDetails, *privdata.ReconciliationStatus, error) {
+	reconciler, err := pvtDataReconciler(prs, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	marked, err := reconciler.MarkUnrecoverable(request.StartBlock, request.EndBlock, request.Namespace, request.Collection)
+	if err != nil {
+		return nil, nil, err
+	}
+	return marked, reconciler.Status(), nil
+}
+
+func pvtDataReconciler(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) (privdata.PvtDataReconciler, error) {
+	reconciler, err := prs.GetPvtDataReconciler(request.ChannelId)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("cannot get the private data reconciler of channel [%s]", request.ChannelId))
+	}
+	return reconciler, nil
+}
+
+// filteredMissingPvtData returns the private data missing in the blocks range of the given request
+// that belongs to the namespace and the collection of the request
+func filteredMissingPvtData(prs PvtDataReconciliationSupport, request *pb.PvtDataRequest) ([]*ledger.MissingPvtDataDetails, error) {
+	tracker, err := prs.GetMissingPvtDataTracker(request.ChannelId)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("cannot get the missing private data tracker of channel [%s]", request.ChannelId))
+	}
+	missingPvtData, err := tracker.ListMissingPvtData(request.StartBlock, request.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	var filtered []*ledger.MissingPvtDataDetails
+	for _, missing := range missingPvtData {
+		if (request.Namespace != "" && missing.Namespace != request.Namespace) ||
+			(request.Collection != "" && missing.Collection != request.Collection) {
+			continue
+		}
+		filtered = append(filtered, missing)
+	}
+	return filtered, nil
+}
+
+func toPvtDataResponse(missingPvtData []*ledger.MissingPvtDataDetails, status *privdata.ReconciliationStatus) *pb.PvtDataResponse {
+	response := &pb.PvtDataResponse{}
+	for _, missing := range missingPvtData {
+		response.MissingPvtData = append(response.MissingPvtData, &pb.MissingPvtData{
+			BlockNum:      missing.BlockNum,
+			TxNum:         missing.TxNum,
+			Namespace:     missing.Namespace,
+			Collection:    missing.Collection,
+			Eligible:      missing.IsEligible,
+			Unrecoverable: missing.IsUnrecoverable,
+		})
+	}
+	if status != nil {
+		response.ReconciliationStatus = &pb.ReconciliationStatus{
+			StartTime:      &timestamp.Timestamp{Seconds: status.StartTime.Unix(), Nanos: int32(status.StartTime.Nanosecond())},
+			DurationMillis: int64(status.Duration / time.Millisecond),
+			Reconciled:     uint64(status.Reconciled),
+		}
+		if status.Err != nil {
+			response.ReconciliationStatus.Error = status.Err.Error()
+		}
+	}
+	return response
+}
@@ -10,11 +10,18 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/testutil"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	"github.com/hyperledger/fabric/gossip/privdata/mocks"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,8 +42,89 @@ func (v *mockValidator) validate(ctx context.Context, env *common.Envelope) (*pb
 	return args.Get(0).(*pb.AdminOperation), nil
 }
 
+type mockIndexManager struct {
+	mock.Mock
+}
+
+func (m *mockIndexManager) ListIndexes(namespace, collection string) ([]*ledger.StateDBIndex, error) {
+	args := m.Called(namespace, collection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ledger.StateDBIndex), args.Error(1)
+}
+
+func (m *mockIndexManager) CreateIndex(namespace, collection, indexDefinition string) (*ledger.StateDBIndex, error) {
+	args := m.Called(namespace, collection, indexDefinition)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ledger.StateDBIndex), args.Error(1)
+}
+
+func (m *mockIndexManager) DropIndex(namespace, collection, designDoc, indexName string) error {
+	args := m.Called(namespace, collection, designDoc, indexName)
+	return args.Error(0)
+}
+
+func (m *mockIndexManager) ExplainQuery(namespace, collection, query string) (*ledger.StateDBIndex, error) {
+	args := m.Called(namespace, collection, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ledger.StateDBIndex), args.Error(1)
+}
+
+type mockReconciler struct {
+	mock.Mock
+}
+
+func (m *mockReconciler) Start() {}
+
+func (m *mockReconciler) Stop() {}
+
+func (m *mockReconciler) Status() *privdata.ReconciliationStatus {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*privdata.ReconciliationStatus)
+}
+
+func (m *mockReconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	args := m.Called(startBlock, endBlock)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockReconciler) MarkUnrecoverable(startBlock, endBlock uint64, namespace, collection string) ([]*ledger.MissingPvtDataDetails, error) {
+	args := m.Called(startBlock, endBlock, namespace, collection)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ledger.MissingPvtDataDetails), args.Error(1)
+}
+
+type mockPvtDataReconciliationSupport struct {
+	tracker    *mocks.MissingPvtDataTracker
+	reconciler *mockReconciler
+}
+
+func (m *mockPvtDataReconciliationSupport) GetMissingPvtDataTracker(channelID string) (ledger.MissingPvtDataTracker, error) {
+	if channelID != "mychannel" {
+		return nil, errors.Errorf("channel [%s] does not exist", channelID)
+	}
+	return m.tracker, nil
+}
+
+func (m *mockPvtDataReconciliationSupport) GetPvtDataReconciler(channelID string) (privdata.PvtDataReconciler, error) {
+	if channelID != "mychannel" {
+		return nil, errors.Errorf("No private data handler for %s", channelID)
+	}
+	return m.reconciler, nil
+}
+
 func TestGetStatus(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -46,7 +134,7 @@ func TestGetStatus(t *testing.T) {
 }
 
 func TestStartServer(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	mv.On("validate").Return(nil, nil).Once()
@@ -56,10 +144,10 @@ func TestStartServer(t *testing.T) {
 }
 
 func TestForbidden(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
-	mv.On("validate").Return(nil, accessDenied).Times(7)
+	mv.On("validate").Return(nil, accessDenied).Times(14)
 
 	ctx := context.Background()
 	status, err := adminServer.GetStatus(ctx, nil)
@@ -85,10 +173,31 @@ func TestForbidden(t *testing.T) {
 
 	_, err = adminServer.StartServer(ctx, nil)
 	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.ListIndexes(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.CreateIndex(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.DropIndex(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.ExplainQuery(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.ListMissingPvtData(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.ReconcilePvtData(ctx, nil)
+	assert.Equal(t, accessDenied, err)
+
+	_, err = adminServer.MarkPvtDataUnrecoverable(ctx, nil)
+	assert.Equal(t, accessDenied, err)
 }
 
 func TestLoggingCalls(t *testing.T) {
-	adminServer := NewAdminServer(nil)
+	adminServer := NewAdminServer(nil, nil, nil)
 	adminServer.v = &mockValidator{}
 	mv := adminServer.v.(*mockValidator)
 	flogging.MustGetLogger("test")
@@ -219,3 +328,235 @@ func TestLoggingCalls(t *testing.T) {
 		}
 	}
 }
+
+func TestIndexCalls(t *testing.T) {
+	indexManager := &mockIndexManager{}
+	imp := StateDBIndexManagerProviderFunc(func(channelID string) (ledger.StateDBIndexManager, error) {
+		if channelID != "mychannel" {
+			return nil, errors.Errorf("channel [%s] does not exist", channelID)
+		}
+		return indexManager, nil
+	})
+	adminServer := NewAdminServer(nil, imp, nil)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapIndexRequest := func(ir *pb.IndexRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_IndexReq{
+				IndexReq: ir,
+			},
+		}
+	}
+	ctx := context.Background()
+	index := &ledger.StateDBIndex{DesignDocument: "indexOwnerDoc", Name: "indexOwner", Definition: `{"index":{"fields":["owner"]}}`}
+	expectedResponse := &pb.IndexesResponse{Indexes: []*pb.StateDBIndex{{DesignDoc: "indexOwnerDoc", Name: "indexOwner", Definition: `{"index":{"fields":["owner"]}}`}}}
+
+	t.Run("ListIndexes", func(t *testing.T) {
+		indexManager.On("ListIndexes", "mycc", "").Return([]*ledger.StateDBIndex{index}, nil).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc"}), nil).Once()
+		resp, err := adminServer.ListIndexes(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expectedResponse, resp))
+
+		indexManager.On("ListIndexes", "mycc", "coll1").Return(nil, errors.New("list-error")).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", Collection: "coll1"}), nil).Once()
+		_, err = adminServer.ListIndexes(ctx, nil)
+		assert.EqualError(t, err, "list-error")
+	})
+
+	t.Run("CreateIndex", func(t *testing.T) {
+		indexManager.On("CreateIndex", "mycc", "coll1", index.Definition).Return(index, nil).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", Collection: "coll1", IndexDefinition: index.Definition}), nil).Once()
+		resp, err := adminServer.CreateIndex(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expectedResponse, resp))
+
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc"}), nil).Once()
+		_, err = adminServer.CreateIndex(ctx, nil)
+		assert.EqualError(t, err, "index definition is empty")
+
+		indexManager.On("CreateIndex", "mycc", "", "bad-def").Return(nil, errors.New("create-error")).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", IndexDefinition: "bad-def"}), nil).Once()
+		_, err = adminServer.CreateIndex(ctx, nil)
+		assert.EqualError(t, err, "create-error")
+	})
+
+	t.Run("DropIndex", func(t *testing.T) {
+		indexManager.On("DropIndex", "mycc", "", "indexOwnerDoc", "indexOwner").Return(nil).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", DesignDoc: "indexOwnerDoc", IndexName: "indexOwner"}), nil).Once()
+		_, err := adminServer.DropIndex(ctx, nil)
+		assert.NoError(t, err)
+
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", DesignDoc: "indexOwnerDoc"}), nil).Once()
+		_, err = adminServer.DropIndex(ctx, nil)
+		assert.EqualError(t, err, "design document and index name are required")
+
+		indexManager.On("DropIndex", "mycc", "", "indexOwnerDoc", "missing").Return(errors.New("drop-error")).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", DesignDoc: "indexOwnerDoc", IndexName: "missing"}), nil).Once()
+		_, err = adminServer.DropIndex(ctx, nil)
+		assert.EqualError(t, err, "drop-error")
+	})
+
+	t.Run("ExplainQuery", func(t *testing.T) {
+		query := `{"selector":{"owner":"tom"}}`
+		indexManager.On("ExplainQuery", "mycc", "", query).Return(index, nil).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", Query: query}), nil).Once()
+		resp, err := adminServer.ExplainQuery(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expectedResponse, resp))
+
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc"}), nil).Once()
+		_, err = adminServer.ExplainQuery(ctx, nil)
+		assert.EqualError(t, err, "query is empty")
+
+		indexManager.On("ExplainQuery", "mycc", "", "bad-query").Return(nil, errors.New("explain-error")).Once()
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc", Query: "bad-query"}), nil).Once()
+		_, err = adminServer.ExplainQuery(ctx, nil)
+		assert.EqualError(t, err, "explain-error")
+	})
+
+	t.Run("InvalidRequests", func(t *testing.T) {
+		mv.On("validate").Return(&pb.AdminOperation{}, nil).Once()
+		_, err := adminServer.ListIndexes(ctx, nil)
+		assert.EqualError(t, err, "request is nil")
+
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel"}), nil).Once()
+		_, err = adminServer.ListIndexes(ctx, nil)
+		assert.EqualError(t, err, "channel ID and namespace are required")
+
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "nochannel", Namespace: "mycc"}), nil).Once()
+		_, err = adminServer.ListIndexes(ctx, nil)
+		assert.EqualError(t, err, "cannot manage the indexes of channel [nochannel]: channel [nochannel] does not exist")
+
+		noIndexAdminServer := NewAdminServer(nil, nil, nil)
+		noIndexAdminServer.v = mv
+		mv.On("validate").Return(wrapIndexRequest(&pb.IndexRequest{ChannelId: "mychannel", Namespace: "mycc"}), nil).Once()
+		_, err = noIndexAdminServer.ListIndexes(ctx, nil)
+		assert.EqualError(t, err, "managing indexes is not supported by this peer")
+	})
+
+	indexManager.AssertExpectations(t)
+}
+
+func TestPvtDataCalls(t *testing.T) {
+	prs := &mockPvtDataReconciliationSupport{
+		tracker:    &mocks.MissingPvtDataTracker{},
+		reconciler: &mockReconciler{},
+	}
+	adminServer := NewAdminServer(nil, nil, prs)
+	adminServer.v = &mockValidator{}
+	mv := adminServer.v.(*mockValidator)
+
+	wrapPvtDataRequest := func(pr *pb.PvtDataRequest) *pb.AdminOperation {
+		return &pb.AdminOperation{
+			Content: &pb.AdminOperation_PvtDataReq{
+				PvtDataReq: pr,
+			},
+		}
+	}
+	ctx := context.Background()
+	missingPvtData := []*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", IsEligible: true},
+		{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"},
+		{BlockNum: 4, TxNum: 5, Namespace: "othercc", Collection: "coll1", IsEligible: true, IsUnrecoverable: true},
+	}
+	startTime := time.Unix(1000, 500)
+	status := &privdata.ReconciliationStatus{StartTime: startTime, Duration: 2 * time.Second, Reconciled: 3, Err: errors.New("fetch-error")}
+	expectedStatus := &pb.ReconciliationStatus{StartTime: &timestamp.Timestamp{Seconds: 1000, Nanos: 500}, DurationMillis: 2000, Reconciled: 3, Error: "fetch-error"}
+
+	t.Run("ListMissingPvtData", func(t *testing.T) {
+		prs.tracker.On("ListMissingPvtData", uint64(0), uint64(10)).Return(missingPvtData, nil).Twice()
+		prs.reconciler.On("Status").Return(status).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 10}), nil).Once()
+		resp, err := adminServer.ListMissingPvtData(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.PvtDataResponse{
+			MissingPvtData: []*pb.MissingPvtData{
+				{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true},
+				{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"},
+				{BlockNum: 4, TxNum: 5, Namespace: "othercc", Collection: "coll1", Eligible: true, Unrecoverable: true},
+			},
+			ReconciliationStatus: expectedStatus,
+		}, resp))
+
+		prs.reconciler.On("Status").Return(nil).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 10, Namespace: "mycc", Collection: "coll2"}), nil).Once()
+		resp, err = adminServer.ListMissingPvtData(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.PvtDataResponse{
+			MissingPvtData: []*pb.MissingPvtData{{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"}},
+		}, resp))
+
+		prs.tracker.On("ListMissingPvtData", uint64(5), uint64(5)).Return(nil, errors.New("list-error")).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", StartBlock: 5, EndBlock: 5}), nil).Once()
+		_, err = adminServer.ListMissingPvtData(ctx, nil)
+		assert.EqualError(t, err, "list-error")
+	})
+
+	t.Run("ReconcilePvtData", func(t *testing.T) {
+		prs.reconciler.On("ReconcileBlockRange", uint64(2), uint64(3)).Return(1, nil).Once()
+		prs.tracker.On("ListMissingPvtData", uint64(2), uint64(3)).Return(missingPvtData[1:2], nil).Once()
+		prs.reconciler.On("Status").Return(status).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", StartBlock: 2, EndBlock: 3}), nil).Once()
+		resp, err := adminServer.ReconcilePvtData(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.PvtDataResponse{
+			MissingPvtData:       []*pb.MissingPvtData{{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"}},
+			ReconciliationStatus: expectedStatus,
+		}, resp))
+
+		prs.reconciler.On("ReconcileBlockRange", uint64(0), uint64(1)).Return(0, errors.New("reconcile-error")).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 1}), nil).Once()
+		_, err = adminServer.ReconcilePvtData(ctx, nil)
+		assert.EqualError(t, err, "reconcile-error")
+	})
+
+	t.Run("MarkPvtDataUnrecoverable", func(t *testing.T) {
+		marked := []*ledger.MissingPvtDataDetails{{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", IsEligible: true, IsUnrecoverable: true}}
+		prs.reconciler.On("MarkUnrecoverable", uint64(0), uint64(2), "mycc", "coll1").Return(marked, nil).Once()
+		prs.reconciler.On("Status").Return(nil).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 2, Namespace: "mycc", Collection: "coll1"}), nil).Once()
+		resp, err := adminServer.MarkPvtDataUnrecoverable(ctx, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(&pb.PvtDataResponse{
+			MissingPvtData: []*pb.MissingPvtData{{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true, Unrecoverable: true}},
+		}, resp))
+
+		prs.reconciler.On("MarkUnrecoverable", uint64(0), uint64(2), "", "").Return(nil, errors.New("mark-error")).Once()
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 2}), nil).Once()
+		_, err = adminServer.MarkPvtDataUnrecoverable(ctx, nil)
+		assert.EqualError(t, err, "mark-error")
+	})
+
+	t.Run("InvalidRequests", func(t *testing.T) {
+		mv.On("validate").Return(&pb.AdminOperation{}, nil).Once()
+		_, err := adminServer.ListMissingPvtData(ctx, nil)
+		assert.EqualError(t, err, "request is nil")
+
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{EndBlock: 10}), nil).Once()
+		_, err = adminServer.ListMissingPvtData(ctx, nil)
+		assert.EqualError(t, err, "channel ID is required")
+
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", StartBlock: 10, EndBlock: 5}), nil).Once()
+		_, err = adminServer.ReconcilePvtData(ctx, nil)
+		assert.EqualError(t, err, "invalid block range [10 - 5]")
+
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "nochannel", EndBlock: 10}), nil).Once()
+		_, err = adminServer.ListMissingPvtData(ctx, nil)
+		assert.EqualError(t, err, "cannot get the missing private data tracker of channel [nochannel]: channel [nochannel] does not exist")
+
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "nochannel", EndBlock: 10}), nil).Once()
+		_, err = adminServer.MarkPvtDataUnrecoverable(ctx, nil)
+		assert.EqualError(t, err, "cannot get the private data reconciler of channel [nochannel]: No private data handler for nochannel")
+
+		noPvtDataAdminServer := NewAdminServer(nil, nil, nil)
+		noPvtDataAdminServer.v = mv
+		mv.On("validate").Return(wrapPvtDataRequest(&pb.PvtDataRequest{ChannelId: "mychannel", EndBlock: 10}), nil).Once()
+		_, err = noPvtDataAdminServer.ListMissingPvtData(ctx, nil)
+		assert.EqualError(t, err, "managing the reconciliation of private data is not supported by this peer")
+	})
+
+	prs.tracker.AssertExpectations(t)
+	prs.reconciler.AssertExpectations(t)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MissingPvtDataPath is the path of the operations endpoint that lists the missing private data
+	MissingPvtDataPath = "/admin/v1/pvtdata/missing"
+	// ReconcilePvtDataPath is the path of the operations endpoint that reconciles the missing private data
+	ReconcilePvtDataPath = "/admin/v1/pvtdata/reconcile"
+	// UnrecoverablePvtDataPath is the path of the operations endpoint that marks the missing private data as unrecoverable
+	UnrecoverablePvtDataPath = "/admin/v1/pvtdata/unrecoverable"
+)
+
+// PvtDataHandler serves the inspection and the reconciliation of the missing private data of
+// the channels of the peer on the operations endpoint. The channel, the blocks range and the
+// optional namespace and collection of a request are passed in the "channel", "start", "end",
+// "namespace" and "collection" query parameters.
+type PvtDataHandler struct {
+	Support PvtDataReconciliationSupport
+}
+
+// NewPvtDataHandler returns a PvtDataHandler that relies on the given PvtDataReconciliationSupport.
+func NewPvtDataHandler(prs PvtDataReconciliationSupport) *PvtDataHandler {
+	return &PvtDataHandler{Support: prs}
+}
+
+// MissingPvtData describes the private data of a collection that is missing for a transaction.
+type MissingPvtData struct {
+	BlockNum      uint64 `json:"block_num"`
+	TxNum         uint64 `json:"tx_num"`
+	Namespace     string `json:"namespace"`
+	Collection    string `json:"collection"`
+	Eligible      bool   `json:"eligible"`
+	Unrecoverable bool   `json:"unrecoverable"`
+}
+
+// ReconciliationStatus describes the most recent attempt to reconcile the missing private data of a channel.
+type ReconciliationStatus struct {
+	StartTime      time.Time `json:"start_time"`
+	DurationMillis int64     `json:"duration_millis"`
+	Reconciled     int       `json:"reconciled"`
+	InProgress     bool      `json:"in_progress"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// PvtDataResponse is the payload of a successful response of the PvtDataHandler.
+type PvtDataResponse struct {
+	MissingPvtData       []MissingPvtData      `json:"missing_pvt_data"`
+	ReconciliationStatus *ReconciliationStatus `json:"reconciliation_status,omitempty"`
+}
+
+// ServeHTTP lists the missing private data on GET requests to the MissingPvtDataPath, and reconciles
+// it or marks it as unrecoverable on POST requests to the ReconcilePvtDataPath or the UnrecoverablePvtDataPath.
+func (h *PvtDataHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	var serve func(PvtDataReconciliationSupport, *pb.PvtDataRequest) ([]*ledger.MissingPvtDataDetails, *privdata.ReconciliationStatus, error)
+	method := http.MethodPost
+	switch req.URL.Path {
+	case MissingPvtDataPath:
+		serve, method = listMissingPvtData, http.MethodGet
+	case ReconcilePvtDataPath:
+		serve = reconcilePvtData
+	case UnrecoverablePvtDataPath:
+		serve = markPvtDataUnrecoverable
+	default:
+		h.sendResponse(resp, http.StatusNotFound, errorResponse{Error: fmt.Sprintf("invalid path: %s", req.URL.Path)})
+		return
+	}
+	if req.Method != method {
+		resp.Header().Set("Allow", method)
+		h.sendResponse(resp, http.StatusMethodNotAllowed, errorResponse{Error: fmt.Sprintf("invalid request method: %s", req.Method)})
+		return
+	}
+
+	request, err := pvtDataRequestFromQuery(req)
+	if err == nil {
+		err = validatePvtDataRequest(h.Support, request)
+	}
+	if err != nil {
+		h.sendResponse(resp, http.StatusBadRequest, errorResponse{Error: err.Error()})
+		return
+	}
+
+	missingPvtData, status, err := serve(h.Support, request)
+	if err != nil {
+		h.sendResponse(resp, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+		return
+	}
+	h.sendResponse(resp, http.StatusOK, toHTTPPvtDataResponse(missingPvtData, status))
+}
+
+func pvtDataRequestFromQuery(req *http.Request) (*pb.PvtDataRequest, error) {
+	query := req.URL.Query()
+	request := &pb.PvtDataRequest{
+		ChannelId:  query.Get("channel"),
+		Namespace:  query.Get("namespace"),
+		Collection: query.Get("collection"),
+	}
+	var err error
+	if request.StartBlock, err = blockNumFromQuery(query, "start"); err != nil {
+		return nil, err
+	}
+	if request.EndBlock, err = blockNumFromQuery(query, "end"); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func blockNumFromQuery(query url.Values, param string) (uint64, error) {
+	value := query.Get(param)
+	if value == "" {
+		return 0, errors.Errorf("the %s block is required", param)
+	}
+	blockNum, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s block: %s", param, value)
+	}
+	return blockNum, nil
+}
+
+func toHTTPPvtDataResponse(missingPvtData []*ledger.MissingPvtDataDetails, status *privdata.ReconciliationStatus) PvtDataResponse {
+	response := PvtDataResponse{MissingPvtData: []MissingPvtData{}}
+	for _, missing := range missingPvtData {
+		response.MissingPvtData = append(response.MissingPvtData, MissingPvtData{
+			BlockNum:      missing.BlockNum,
+			TxNum:         missing.TxNum,
+			Namespace:     missing.Namespace,
+			Collection:    missing.Collection,
+			Eligible:      missing.IsEligible,
+			Unrecoverable: missing.IsUnrecoverable,
+		})
+	}
+	if status != nil {
+		response.ReconciliationStatus = &ReconciliationStatus{
+			StartTime:      status.StartTime,
+			DurationMillis: int64(status.Duration / time.Millisecond),
+			Reconciled:     status.Reconciled,
+			InProgress:     status.InProgress,
+		}
+		if status.Err != nil {
+			response.ReconciliationStatus.Error = status.Err.Error()
+		}
+	}
+	return response
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h *PvtDataHandler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/gossip/privdata"
+	"github.com/hyperledger/fabric/gossip/privdata/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPvtDataHandler(t *testing.T) {
+	prs := &mockPvtDataReconciliationSupport{
+		tracker:    &mocks.MissingPvtDataTracker{},
+		reconciler: &mockReconciler{},
+	}
+	handler := NewPvtDataHandler(prs)
+
+	serve := func(method, target string) (int, map[string]interface{}) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(method, target, nil))
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+		return resp.Code, body
+	}
+	decode := func(t *testing.T, method, target string) PvtDataResponse {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(method, target, nil))
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		var response PvtDataResponse
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &response))
+		return response
+	}
+
+	missingPvtData := []*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", IsEligible: true},
+		{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"},
+	}
+	startTime := time.Unix(1000, 0).UTC()
+	status := &privdata.ReconciliationStatus{StartTime: startTime, Duration: 2 * time.Second, Reconciled: 3, InProgress: true}
+	expectedStatus := &ReconciliationStatus{StartTime: startTime, DurationMillis: 2000, Reconciled: 3, InProgress: true}
+
+	t.Run("ListMissingPvtData", func(t *testing.T) {
+		prs.tracker.On("ListMissingPvtData", uint64(0), uint64(10)).Return(missingPvtData, nil).Twice()
+		prs.reconciler.On("Status").Return(status).Once()
+		response := decode(t, http.MethodGet, MissingPvtDataPath+"?channel=mychannel&start=0&end=10")
+		assert.Equal(t, PvtDataResponse{
+			MissingPvtData: []MissingPvtData{
+				{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true},
+				{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"},
+			},
+			ReconciliationStatus: expectedStatus,
+		}, response)
+
+		prs.reconciler.On("Status").Return(nil).Once()
+		response = decode(t, http.MethodGet, MissingPvtDataPath+"?channel=mychannel&start=0&end=10&namespace=mycc&collection=coll2")
+		assert.Equal(t, PvtDataResponse{MissingPvtData: []MissingPvtData{{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"}}}, response)
+
+		prs.tracker.On("ListMissingPvtData", uint64(5), uint64(5)).Return(nil, errors.New("list-error")).Once()
+		code, body := serve(http.MethodGet, MissingPvtDataPath+"?channel=mychannel&start=5&end=5")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, map[string]interface{}{"error": "list-error"}, body)
+	})
+
+	t.Run("ReconcilePvtData", func(t *testing.T) {
+		prs.reconciler.On("ReconcileBlockRange", uint64(2), uint64(3)).Return(1, nil).Once()
+		prs.tracker.On("ListMissingPvtData", uint64(2), uint64(3)).Return(missingPvtData[1:], nil).Once()
+		prs.reconciler.On("Status").Return(status).Once()
+		response := decode(t, http.MethodPost, ReconcilePvtDataPath+"?channel=mychannel&start=2&end=3")
+		assert.Equal(t, PvtDataResponse{
+			MissingPvtData:       []MissingPvtData{{BlockNum: 3, TxNum: 0, Namespace: "mycc", Collection: "coll2"}},
+			ReconciliationStatus: expectedStatus,
+		}, response)
+
+		prs.reconciler.On("ReconcileBlockRange", uint64(0), uint64(1)).Return(0, errors.New("reconcile-error")).Once()
+		code, body := serve(http.MethodPost, ReconcilePvtDataPath+"?channel=mychannel&start=0&end=1")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, map[string]interface{}{"error": "reconcile-error"}, body)
+	})
+
+	t.Run("MarkPvtDataUnrecoverable", func(t *testing.T) {
+		marked := []*ledger.MissingPvtDataDetails{{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", IsEligible: true, IsUnrecoverable: true}}
+		prs.reconciler.On("MarkUnrecoverable", uint64(0), uint64(2), "mycc", "coll1").Return(marked, nil).Once()
+		prs.reconciler.On("Status").Return(nil).Once()
+		response := decode(t, http.MethodPost, UnrecoverablePvtDataPath+"?channel=mychannel&start=0&end=2&namespace=mycc&collection=coll1")
+		assert.Equal(t, PvtDataResponse{
+			MissingPvtData: []MissingPvtData{{BlockNum: 2, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true, Unrecoverable: true}},
+		}, response)
+	})
+
+	t.Run("InvalidRequests", func(t *testing.T) {
+		tests := []struct {
+			method        string
+			target        string
+			expectedCode  int
+			expectedError string
+		}{
+			{http.MethodPost, MissingPvtDataPath + "?channel=mychannel&start=0&end=1", http.StatusMethodNotAllowed, "invalid request method: POST"},
+			{http.MethodGet, ReconcilePvtDataPath + "?channel=mychannel&start=0&end=1", http.StatusMethodNotAllowed, "invalid request method: GET"},
+			{http.MethodGet, "/admin/v1/pvtdata/other", http.StatusNotFound, "invalid path: /admin/v1/pvtdata/other"},
+			{http.MethodGet, MissingPvtDataPath + "?start=0&end=1", http.StatusBadRequest, "channel ID is required"},
+			{http.MethodGet, MissingPvtDataPath + "?channel=mychannel&end=1", http.StatusBadRequest, "the start block is required"},
+			{http.MethodGet, MissingPvtDataPath + "?channel=mychannel&start=0&end=x", http.StatusBadRequest, "invalid end block: x"},
+			{http.MethodPost, ReconcilePvtDataPath + "?channel=mychannel&start=10&end=5", http.StatusBadRequest, "invalid block range [10 - 5]"},
+			{http.MethodPost, UnrecoverablePvtDataPath + "?channel=nochannel&start=0&end=5", http.StatusInternalServerError,
+				"cannot get the private data reconciler of channel [nochannel]: No private data handler for nochannel"},
+		}
+		for _, test := range tests {
+			code, body := serve(test.method, test.target)
+			assert.Equal(t, test.expectedCode, code, test.target)
+			assert.Equal(t, map[string]interface{}{"error": test.expectedError}, body, test.target)
+		}
+
+		noPvtDataHandler := NewPvtDataHandler(nil)
+		resp := httptest.NewRecorder()
+		noPvtDataHandler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, MissingPvtDataPath+"?channel=mychannel&start=0&end=1", nil))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "managing the reconciliation of private data is not supported by this peer")
+	})
+
+	prs.tracker.AssertExpectations(t)
+	prs.reconciler.AssertExpectations(t)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hyperledger/fabric/core/container/ccintf"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/core/peer"
 	"github.com/hyperledger/fabric/protos/common"
 	pb "github.com/hyperledger/fabric/protos/peer"
@@ -211,6 +212,8 @@ func (h *Handler) handleMessageReadyState(msg *pb.ChaincodeMessage) error {
 		go h.HandleTransaction(msg, h.HandlePutState)
 	case pb.ChaincodeMessage_DEL_STATE:
 		go h.HandleTransaction(msg, h.HandleDelState)
+	case pb.ChaincodeMessage_PURGE_PRIVATE_DATA:
+		go h.HandleTransaction(msg, h.HandlePurgePrivateData)
 	case pb.ChaincodeMessage_INVOKE_CHAINCODE:
 		go h.HandleTransaction(msg, h.HandleInvokeChaincode)
 	case pb.ChaincodeMessage_GET_STATE:
@@ -934,15 +937,41 @@ func (h *Handler) HandleGetHistoryForKey(msg *pb.ChaincodeMessage, txContext *Tr
 		return nil, errors.Wrap(err, "unmarshal failed")
 	}
 
-	historyIter, err := txContext.HistoryQueryExecutor.GetHistoryForKey(chaincodeName, getHistoryForKey.Key)
+	historyMetadata, err := getHistoryQueryMetadataFromBytes(getHistoryForKey.Metadata)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, err
 	}
 
-	totalReturnLimit := calculateTotalReturnLimit(nil)
+	// the page size and the bookmark are handled the same way as for the other paginated queries
+	metadata := &pb.QueryMetadata{}
+	if historyMetadata != nil {
+		metadata.PageSize = historyMetadata.PageSize
+		metadata.Bookmark = historyMetadata.Bookmark
+	}
+	totalReturnLimit := calculateTotalReturnLimit(metadata)
+	isPaginated := isMetadataSetForPagination(metadata)
+
+	var historyIter commonledger.ResultsIterator
+	collection := getHistoryForKey.Collection
+	switch {
+	case isCollectionSet(collection):
+		if txContext.IsInitTransaction {
+			return nil, errors.New("private data APIs are not allowed in chaincode Init()")
+		}
+		historyIter, err = txContext.HistoryQueryExecutor.GetPrivateDataHashHistoryWithOptions(chaincodeName, collection,
+			util.ComputeStringHash(getHistoryForKey.Key), historyQueryOptionsFromMetadata(historyMetadata))
+	case historyMetadata != nil:
+		historyIter, err = txContext.HistoryQueryExecutor.GetHistoryForKeyWithOptions(chaincodeName, getHistoryForKey.Key,
+			historyQueryOptionsFromMetadata(historyMetadata))
+	default:
+		historyIter, err = txContext.HistoryQueryExecutor.GetHistoryForKey(chaincodeName, getHistoryForKey.Key)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	txContext.InitializeQueryContext(iterID, historyIter)
-	payload, err := h.QueryResponseBuilder.BuildQueryResponse(txContext, historyIter, iterID, false, totalReturnLimit)
+	payload, err := h.QueryResponseBuilder.BuildQueryResponse(txContext, historyIter, iterID, isPaginated, totalReturnLimit)
 	if err != nil {
 		txContext.CleanupQueryContext(iterID)
 		return nil, errors.WithStack(err)
@@ -958,6 +987,32 @@ func (h *Handler) HandleGetHistoryForKey(msg *pb.ChaincodeMessage, txContext *Tr
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payloadBytes, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+func getHistoryQueryMetadataFromBytes(metadataBytes []byte) (*pb.HistoryQueryMetadata, error) {
+	if metadataBytes != nil {
+		metadata := &pb.HistoryQueryMetadata{}
+		err := proto.Unmarshal(metadataBytes, metadata)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal failed")
+		}
+		return metadata, nil
+	}
+	return nil, nil
+}
+
+func historyQueryOptionsFromMetadata(metadata *pb.HistoryQueryMetadata) *ledger.HistoryQueryOptions {
+	if metadata == nil {
+		return &ledger.HistoryQueryOptions{}
+	}
+	return &ledger.HistoryQueryOptions{
+		StartBlock: metadata.StartBlock,
+		EndBlock:   metadata.EndBlock,
+		StartTime:  metadata.StartTime,
+		EndTime:    metadata.EndTime,
+		Descending: metadata.Descending,
+		Bookmark:   metadata.Bookmark,
+	}
+}
+
 func isCollectionSet(collection string) bool {
 	return collection != ""
 }
@@ -1129,6 +1184,28 @@ func (h *Handler) HandleDelState(msg *pb.ChaincodeMessage, txContext *Transactio
 	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
 }
 
+func (h *Handler) HandlePurgePrivateData(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
+	purgePrivateData := &pb.DelState{}
+	err := proto.Unmarshal(msg.Payload, purgePrivateData)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+
+	if !isCollectionSet(purgePrivateData.Collection) {
+		return nil, errors.New("only private data can be purged")
+	}
+	if txContext.IsInitTransaction {
+		return nil, errors.New("private data APIs are not allowed in chaincode Init()")
+	}
+	err = txContext.TXSimulator.PurgePrivateData(h.ChaincodeName(), purgePrivateData.Collection, purgePrivateData.Key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Send response msg back to chaincode.
+	return &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: msg.Txid, ChannelId: msg.ChannelId}, nil
+}
+
 // Handles requests that modify ledger state
 func (h *Handler) HandleInvokeChaincode(msg *pb.ChaincodeMessage, txContext *TransactionContext) (*pb.ChaincodeMessage, error) {
 	chaincodeLogger.Debugf("[%s] C-call-C", shorttxid(msg.Txid))
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
 	"github.com/hyperledger/fabric/common/mocks/config"
 	"github.com/hyperledger/fabric/common/util"
@@ -20,6 +21,8 @@ import (
 	"github.com/hyperledger/fabric/core/chaincode/mock"
 	"github.com/hyperledger/fabric/core/common/ccprovider"
 	"github.com/hyperledger/fabric/core/common/sysccprovider"
+	"github.com/hyperledger/fabric/core/ledger"
+	ledgerutil "github.com/hyperledger/fabric/core/ledger/util"
 	pb "github.com/hyperledger/fabric/protos/peer"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -864,6 +867,91 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	Describe("HandlePurgePrivateData", func() {
+		var incomingMessage *pb.ChaincodeMessage
+		var request *pb.DelState
+
+		BeforeEach(func() {
+			request = &pb.DelState{
+				Key:        "purge-key",
+				Collection: "collection-name",
+			}
+			payload, err := proto.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			incomingMessage = &pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_PURGE_PRIVATE_DATA,
+				Payload:   payload,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}
+		})
+
+		It("calls PurgePrivateData on the transaction simulator", func() {
+			resp, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(Equal(&pb.ChaincodeMessage{
+				Type:      pb.ChaincodeMessage_RESPONSE,
+				Txid:      "tx-id",
+				ChannelId: "channel-id",
+			}))
+
+			Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(1))
+			ccname, collection, key := fakeTxSimulator.PurgePrivateDataArgsForCall(0)
+			Expect(ccname).To(Equal("cc-instance-name"))
+			Expect(collection).To(Equal("collection-name"))
+			Expect(key).To(Equal("purge-key"))
+		})
+
+		Context("when unmarshalling the request fails", func() {
+			BeforeEach(func() {
+				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+			})
+		})
+
+		Context("when collection is not set", func() {
+			BeforeEach(func() {
+				request.Collection = ""
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("only private data can be purged"))
+				Expect(fakeTxSimulator.PurgePrivateDataCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when PurgePrivateData fails due to ledger error", func() {
+			BeforeEach(func() {
+				fakeTxSimulator.PurgePrivateDataReturns(errors.New("papaya"))
+			})
+
+			It("returns an error", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("papaya"))
+			})
+		})
+
+		Context("when PurgePrivateData fails due to Init transaction", func() {
+			BeforeEach(func() {
+				txContext.IsInitTransaction = true
+			})
+
+			It("returns the error from errorIfInitTransaction", func() {
+				_, err := handler.HandlePurgePrivateData(incomingMessage, txContext)
+				Expect(err).To(MatchError("private data APIs are not allowed in chaincode Init()"))
+			})
+		})
+	})
+
 	Describe("HandleGetState", func() {
 		var (
 			incomingMessage  *pb.ChaincodeMessage
@@ -2000,6 +2088,138 @@ var _ = Describe("Handler", func() {
 			Expect(iterID).To(Equal("generated-query-id"))
 		})
 
+		Context("when history query metadata is provided", func() {
+			BeforeEach(func() {
+				metadata := &pb.HistoryQueryMetadata{
+					StartBlock: 5,
+					EndBlock:   10,
+					StartTime:  &timestamp.Timestamp{Seconds: 100},
+					EndTime:    &timestamp.Timestamp{Seconds: 200},
+					Descending: true,
+				}
+				metadataBytes, err := proto.Marshal(metadata)
+				Expect(err).NotTo(HaveOccurred())
+				request.Metadata = metadataBytes
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+
+				fakeHistoryQueryExecutor.GetHistoryForKeyWithOptionsReturns(fakeIterator, nil)
+			})
+
+			It("calls GetHistoryForKeyWithOptions on the history query executor", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyCallCount()).To(Equal(0))
+				Expect(fakeHistoryQueryExecutor.GetHistoryForKeyWithOptionsCallCount()).To(Equal(1))
+				ccname, key, options := fakeHistoryQueryExecutor.GetHistoryForKeyWithOptionsArgsForCall(0)
+				Expect(ccname).To(Equal("cc-instance-name"))
+				Expect(key).To(Equal("history-key"))
+				Expect(options).To(Equal(&ledger.HistoryQueryOptions{
+					StartBlock: 5,
+					EndBlock:   10,
+					StartTime:  &timestamp.Timestamp{Seconds: 100},
+					EndTime:    &timestamp.Timestamp{Seconds: 200},
+					Descending: true,
+				}))
+			})
+
+			It("builds a query response without pagination", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeQueryResponseBuilder.BuildQueryResponseCallCount()).To(Equal(1))
+				_, iter, _, isPaginated, totalReturnLimit := fakeQueryResponseBuilder.BuildQueryResponseArgsForCall(0)
+				Expect(iter).To(Equal(fakeIterator))
+				Expect(isPaginated).To(BeFalse())
+				Expect(totalReturnLimit).To(Equal(int32(10000)))
+			})
+
+			Context("when the page size is set", func() {
+				BeforeEach(func() {
+					metadata := &pb.HistoryQueryMetadata{PageSize: 5, Bookmark: "0a01"}
+					metadataBytes, err := proto.Marshal(metadata)
+					Expect(err).NotTo(HaveOccurred())
+					request.Metadata = metadataBytes
+					payload, err := proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+					incomingMessage.Payload = payload
+				})
+
+				It("builds a paginated query response", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, _, options := fakeHistoryQueryExecutor.GetHistoryForKeyWithOptionsArgsForCall(0)
+					Expect(options.Bookmark).To(Equal("0a01"))
+					Expect(fakeQueryResponseBuilder.BuildQueryResponseCallCount()).To(Equal(1))
+					_, _, _, isPaginated, totalReturnLimit := fakeQueryResponseBuilder.BuildQueryResponseArgsForCall(0)
+					Expect(isPaginated).To(BeTrue())
+					Expect(totalReturnLimit).To(Equal(int32(5)))
+				})
+			})
+
+			Context("when unmarshalling the metadata fails", func() {
+				BeforeEach(func() {
+					request.Metadata = []byte("this-is-a-bogus-metadata")
+					payload, err := proto.Marshal(request)
+					Expect(err).NotTo(HaveOccurred())
+					incomingMessage.Payload = payload
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("unmarshal failed: proto: can't skip unknown wire type 4"))
+				})
+			})
+		})
+
+		Context("when a collection is provided", func() {
+			BeforeEach(func() {
+				request.Collection = "collection-name"
+				payload, err := proto.Marshal(request)
+				Expect(err).NotTo(HaveOccurred())
+				incomingMessage.Payload = payload
+
+				fakeHistoryQueryExecutor.GetPrivateDataHashHistoryWithOptionsReturns(fakeIterator, nil)
+			})
+
+			It("calls GetPrivateDataHashHistoryWithOptions with the hash of the key", func() {
+				_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeHistoryQueryExecutor.GetPrivateDataHashHistoryWithOptionsCallCount()).To(Equal(1))
+				ccname, collection, keyHash, options := fakeHistoryQueryExecutor.GetPrivateDataHashHistoryWithOptionsArgsForCall(0)
+				Expect(ccname).To(Equal("cc-instance-name"))
+				Expect(collection).To(Equal("collection-name"))
+				Expect(keyHash).To(Equal(ledgerutil.ComputeStringHash("history-key")))
+				Expect(options).To(Equal(&ledger.HistoryQueryOptions{}))
+			})
+
+			Context("and the transaction is for chaincode Init", func() {
+				BeforeEach(func() {
+					txContext.IsInitTransaction = true
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("private data APIs are not allowed in chaincode Init()"))
+				})
+			})
+
+			Context("when the history query executor fails", func() {
+				BeforeEach(func() {
+					fakeHistoryQueryExecutor.GetPrivateDataHashHistoryWithOptionsReturns(nil, errors.New("anchovies"))
+				})
+
+				It("returns an error", func() {
+					_, err := handler.HandleGetHistoryForKey(incomingMessage, txContext)
+					Expect(err).To(MatchError("anchovies"))
+				})
+			})
+		})
+
 		Context("when unmarshalling the request fails", func() {
 			BeforeEach(func() {
 				incomingMessage.Payload = []byte("this-is-a-bogus-payload")
@@ -5,6 +5,7 @@ import (
 	sync "sync"
 
 	ledger "github.com/hyperledger/fabric/common/ledger"
+	ledgera "github.com/hyperledger/fabric/core/ledger"
 )
 
 type HistoryQueryExecutor struct {
@@ -22,6 +23,37 @@ type HistoryQueryExecutor struct {
 		result1 ledger.ResultsIterator
 		result2 error
 	}
+	GetHistoryForKeyWithOptionsStub        func(string, string, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)
+	getHistoryForKeyWithOptionsMutex       sync.RWMutex
+	getHistoryForKeyWithOptionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 *ledgera.HistoryQueryOptions
+	}
+	getHistoryForKeyWithOptionsReturns struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	getHistoryForKeyWithOptionsReturnsOnCall map[int]struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	GetPrivateDataHashHistoryWithOptionsStub        func(string, string, []byte, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)
+	getPrivateDataHashHistoryWithOptionsMutex       sync.RWMutex
+	getPrivateDataHashHistoryWithOptionsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+		arg4 *ledgera.HistoryQueryOptions
+	}
+	getPrivateDataHashHistoryWithOptionsReturns struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
+	getPrivateDataHashHistoryWithOptionsReturnsOnCall map[int]struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -90,11 +122,151 @@ func (fake *HistoryQueryExecutor) GetHistoryForKeyReturnsOnCall(i int, result1 l
 	}{result1, result2}
 }
 
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptions(arg1 string, arg2 string, arg3 *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error) {
+	fake.getHistoryForKeyWithOptionsMutex.Lock()
+	ret, specificReturn := fake.getHistoryForKeyWithOptionsReturnsOnCall[len(fake.getHistoryForKeyWithOptionsArgsForCall)]
+	fake.getHistoryForKeyWithOptionsArgsForCall = append(fake.getHistoryForKeyWithOptionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 *ledgera.HistoryQueryOptions
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetHistoryForKeyWithOptions", []interface{}{arg1, arg2, arg3})
+	fake.getHistoryForKeyWithOptionsMutex.Unlock()
+	if fake.GetHistoryForKeyWithOptionsStub != nil {
+		return fake.GetHistoryForKeyWithOptionsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getHistoryForKeyWithOptionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptionsCallCount() int {
+	fake.getHistoryForKeyWithOptionsMutex.RLock()
+	defer fake.getHistoryForKeyWithOptionsMutex.RUnlock()
+	return len(fake.getHistoryForKeyWithOptionsArgsForCall)
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptionsCalls(stub func(string, string, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)) {
+	fake.getHistoryForKeyWithOptionsMutex.Lock()
+	defer fake.getHistoryForKeyWithOptionsMutex.Unlock()
+	fake.GetHistoryForKeyWithOptionsStub = stub
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptionsArgsForCall(i int) (string, string, *ledgera.HistoryQueryOptions) {
+	fake.getHistoryForKeyWithOptionsMutex.RLock()
+	defer fake.getHistoryForKeyWithOptionsMutex.RUnlock()
+	argsForCall := fake.getHistoryForKeyWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptionsReturns(result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyWithOptionsMutex.Lock()
+	defer fake.getHistoryForKeyWithOptionsMutex.Unlock()
+	fake.GetHistoryForKeyWithOptionsStub = nil
+	fake.getHistoryForKeyWithOptionsReturns = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetHistoryForKeyWithOptionsReturnsOnCall(i int, result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getHistoryForKeyWithOptionsMutex.Lock()
+	defer fake.getHistoryForKeyWithOptionsMutex.Unlock()
+	fake.GetHistoryForKeyWithOptionsStub = nil
+	if fake.getHistoryForKeyWithOptionsReturnsOnCall == nil {
+		fake.getHistoryForKeyWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 ledgera.QueryResultsIterator
+			result2 error
+		})
+	}
+	fake.getHistoryForKeyWithOptionsReturnsOnCall[i] = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptions(arg1 string, arg2 string, arg3 []byte, arg4 *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error) {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.getPrivateDataHashHistoryWithOptionsMutex.Lock()
+	ret, specificReturn := fake.getPrivateDataHashHistoryWithOptionsReturnsOnCall[len(fake.getPrivateDataHashHistoryWithOptionsArgsForCall)]
+	fake.getPrivateDataHashHistoryWithOptionsArgsForCall = append(fake.getPrivateDataHashHistoryWithOptionsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []byte
+		arg4 *ledgera.HistoryQueryOptions
+	}{arg1, arg2, arg3Copy, arg4})
+	fake.recordInvocation("GetPrivateDataHashHistoryWithOptions", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.getPrivateDataHashHistoryWithOptionsMutex.Unlock()
+	if fake.GetPrivateDataHashHistoryWithOptionsStub != nil {
+		return fake.GetPrivateDataHashHistoryWithOptionsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPrivateDataHashHistoryWithOptionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptionsCallCount() int {
+	fake.getPrivateDataHashHistoryWithOptionsMutex.RLock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.RUnlock()
+	return len(fake.getPrivateDataHashHistoryWithOptionsArgsForCall)
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptionsCalls(stub func(string, string, []byte, *ledgera.HistoryQueryOptions) (ledgera.QueryResultsIterator, error)) {
+	fake.getPrivateDataHashHistoryWithOptionsMutex.Lock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.Unlock()
+	fake.GetPrivateDataHashHistoryWithOptionsStub = stub
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptionsArgsForCall(i int) (string, string, []byte, *ledgera.HistoryQueryOptions) {
+	fake.getPrivateDataHashHistoryWithOptionsMutex.RLock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.RUnlock()
+	argsForCall := fake.getPrivateDataHashHistoryWithOptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptionsReturns(result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getPrivateDataHashHistoryWithOptionsMutex.Lock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.Unlock()
+	fake.GetPrivateDataHashHistoryWithOptionsStub = nil
+	fake.getPrivateDataHashHistoryWithOptionsReturns = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *HistoryQueryExecutor) GetPrivateDataHashHistoryWithOptionsReturnsOnCall(i int, result1 ledgera.QueryResultsIterator, result2 error) {
+	fake.getPrivateDataHashHistoryWithOptionsMutex.Lock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.Unlock()
+	fake.GetPrivateDataHashHistoryWithOptionsStub = nil
+	if fake.getPrivateDataHashHistoryWithOptionsReturnsOnCall == nil {
+		fake.getPrivateDataHashHistoryWithOptionsReturnsOnCall = make(map[int]struct {
+			result1 ledgera.QueryResultsIterator
+			result2 error
+		})
+	}
+	fake.getPrivateDataHashHistoryWithOptionsReturnsOnCall[i] = struct {
+		result1 ledgera.QueryResultsIterator
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *HistoryQueryExecutor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getHistoryForKeyMutex.RLock()
 	defer fake.getHistoryForKeyMutex.RUnlock()
+	fake.getHistoryForKeyWithOptionsMutex.RLock()
+	defer fake.getHistoryForKeyWithOptionsMutex.RUnlock()
+	fake.getPrivateDataHashHistoryWithOptionsMutex.RLock()
+	defer fake.getPrivateDataHashHistoryWithOptionsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -548,6 +548,17 @@ func (stub *ChaincodeStub) DelPrivateData(collection string, key string) error {
 	return stub.handler.handleDelState(collection, key, stub.ChannelId, stub.TxID)
 }
 
+// PurgePrivateData documentation can be found in interfaces.go
+func (stub *ChaincodeStub) PurgePrivateData(collection string, key string) error {
+	if collection == "" {
+		return fmt.Errorf("collection must not be an empty string")
+	}
+	if key == "" {
+		return fmt.Errorf("key must not be an empty string")
+	}
+	return stub.handler.handlePurgePrivateData(collection, key, stub.ChannelId, stub.TxID)
+}
+
 // GetPrivateDataByRange documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetPrivateDataByRange(collection, startKey, endKey string) (StateQueryIteratorInterface, error) {
 	if collection == "" {
@@ -708,13 +719,54 @@ func (stub *ChaincodeStub) GetStateByRange(startKey, endKey string) (StateQueryI
 
 // GetHistoryForKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) GetHistoryForKey(key string) (HistoryQueryIteratorInterface, error) {
-	response, err := stub.handler.handleGetHistoryForKey(key, stub.ChannelId, stub.TxID)
+	response, err := stub.handler.handleGetHistoryForKey("", key, nil, stub.ChannelId, stub.TxID)
 	if err != nil {
 		return nil, err
 	}
 	return &HistoryQueryIterator{CommonIterator: &CommonIterator{stub.handler, stub.ChannelId, stub.TxID, response, 0}}, nil
 }
 
+// GetHistoryForKeyWithOptions documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetHistoryForKeyWithOptions(key string,
+	options *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	// Access public data by setting the collection to empty string
+	collection := ""
+	return stub.handleGetHistoryForKey(collection, key, options)
+}
+
+// GetPrivateDataHashHistory documentation can be found in interfaces.go
+func (stub *ChaincodeStub) GetPrivateDataHashHistory(collection, key string,
+	options *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if collection == "" {
+		return nil, nil, fmt.Errorf("collection must not be an empty string")
+	}
+	return stub.handleGetHistoryForKey(collection, key, options)
+}
+
+func (stub *ChaincodeStub) handleGetHistoryForKey(collection, key string,
+	options *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+	if options == nil {
+		options = &pb.HistoryQueryMetadata{}
+	}
+	metadata, err := proto.Marshal(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := stub.handler.handleGetHistoryForKey(collection, key, metadata, stub.ChannelId, stub.TxID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iterator := &HistoryQueryIterator{CommonIterator: &CommonIterator{stub.handler, stub.ChannelId, stub.TxID, response, 0}}
+	responseMetadata, err := createQueryResponseMetadata(response.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return iterator, responseMetadata, nil
+}
+
 //CreateCompositeKey documentation can be found in interfaces.go
 func (stub *ChaincodeStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
 	return createCompositeKey(objectType, attributes)
@@ -233,6 +233,23 @@ type ChaincodeStubInterface interface {
 	// update ledger, and should limit use to read-only chaincode operations.
 	GetHistoryForKey(key string) (HistoryQueryIteratorInterface, error)
 
+	// GetHistoryForKeyWithOptions returns a history of key values across time,
+	// similar to GetHistoryForKey, with the history bounded and ordered as per the
+	// given options. The history may be limited to the updates committed in a range
+	// of blocks (startBlock and endBlock, both inclusive; an endBlock of 0 means no
+	// upper bound) and/or to the updates whose transaction timestamp falls in a time
+	// range (startTime inclusive, endTime exclusive). When descending is set, the
+	// most recent update is returned first.
+	// When pageSize is greater than 0, the iterator can be used to fetch the first
+	// `pageSize` historic values and the returned ResponseMetadata carries the
+	// bookmark to be set in the options for fetching the next page. An empty
+	// bookmark in the ResponseMetadata means that there are no more historic values.
+	// GetHistoryForKeyWithOptions requires peer configuration
+	// core.ledger.history.enableHistoryDatabase to be true.
+	// Similar to GetHistoryForKey, the query is NOT re-executed during validation
+	// phase and should be limited to read-only chaincode operations.
+	GetHistoryForKeyWithOptions(key string, options *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error)
+
 	// GetPrivateData returns the value of the specified `key` from the specified
 	// `collection`. Note that GetPrivateData doesn't read data from the
 	// private writeset, which has not been committed to the `collection`. In
@@ -244,6 +261,19 @@ type ChaincodeStubInterface interface {
 	// `collection`
 	GetPrivateDataHash(collection, key string) ([]byte, error)
 
+	// GetPrivateDataHashHistory returns a history of the hashes of the values of the
+	// specified `key` in the specified `collection`. For each historic update, the
+	// hash of the value (nil for a delete) and the associated transaction id and
+	// timestamp are returned. Because only the hashes are used, the history is available
+	// on the peers that are not members of the collection as well. The options
+	// (which may be nil) bound, order, and paginate the history in the same way as
+	// for GetHistoryForKeyWithOptions.
+	// GetPrivateDataHashHistory requires peer configuration
+	// core.ledger.history.enableHistoryDatabase to be true. The history covers only
+	// the blocks committed after the history database started recording the hashes
+	// of private data writes.
+	GetPrivateDataHashHistory(collection, key string, options *pb.HistoryQueryMetadata) (HistoryQueryIteratorInterface, *pb.QueryResponseMetadata, error)
+
 	// PutPrivateData puts the specified `key` and `value` into the transaction's
 	// private writeset. Note that only hash of the private writeset goes into the
 	// transaction proposal response (which is sent to the client who issued the
@@ -265,6 +295,14 @@ type ChaincodeStubInterface interface {
 	// when the transaction is validated and successfully committed.
 	DelPrivateData(collection, key string) error
 
+	// PurgePrivateData records the specified `key` to be purged in the private
+	// writeset of the transaction. Like DelPrivateData, the `key` and its value
+	// are deleted from the collection when the transaction is validated and
+	// successfully committed. In addition, all the previous versions of the
+	// private data of the `key` are erased from the private data store of the
+	// peers, while the hashes of the writes recorded in the ledger remain.
+	PurgePrivateData(collection, key string) error
+
 	// SetPrivateDataValidationParameter sets the key-level endorsement policy
 	// for the private data specified by `key`.
 	SetPrivateDataValidationParameter(collection, key string, ep []byte) error
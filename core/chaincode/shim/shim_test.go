@@ -8,6 +8,7 @@ package shim
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -81,6 +82,8 @@ func (t *shimTestCC) Invoke(stub ChaincodeStubInterface) pb.Response {
 		return t.rangeq(stub, args)
 	} else if function == "historyq" {
 		return t.historyq(stub, args)
+	} else if function == "historyqWithOptions" {
+		return t.historyqWithOptions(stub, args)
 	} else if function == "richq" {
 		return t.richq(stub, args)
 	} else if function == "putep" {
@@ -290,6 +293,36 @@ func (t *shimTestCC) richq(stub ChaincodeStubInterface, args []string) pb.Respon
 	return Success(buffer.Bytes())
 }
 
+// historyqWithOptions calls a descending and paginated history query
+func (t *shimTestCC) historyqWithOptions(stub ChaincodeStubInterface, args []string) pb.Response {
+	if len(args) < 1 {
+		return Error("Incorrect number of arguments. Expecting 1")
+	}
+
+	key := args[0]
+
+	if _, _, err := stub.GetPrivateDataHashHistory("", key, nil); err == nil {
+		return Error("Expected an error for an empty collection")
+	}
+
+	resultsIterator, responseMetadata, err := stub.GetHistoryForKeyWithOptions(key, &pb.HistoryQueryMetadata{Descending: true, PageSize: 1})
+	if err != nil {
+		return Error(err.Error())
+	}
+	defer resultsIterator.Close()
+
+	var values []string
+	for resultsIterator.HasNext() {
+		response, err := resultsIterator.Next()
+		if err != nil {
+			return Error(err.Error())
+		}
+		values = append(values, string(response.Value))
+	}
+
+	return Success([]byte(fmt.Sprintf("%s %s", strings.Join(values, ","), responseMetadata.Bookmark)))
+}
+
 // rangeq calls range query
 func (t *shimTestCC) historyq(stub ChaincodeStubInterface, args []string) pb.Response {
 	if len(args) < 1 {
@@ -876,6 +909,32 @@ func TestInvoke(t *testing.T) {
 	//wait for done
 	processDone(t, done, false)
 
+	//history query with options
+
+	//create the response
+	historyQueryResponse = &pb.QueryResponse{Results: []*pb.QueryResultBytes{
+		{ResultBytes: utils.MarshalOrPanic(&lproto.KeyModification{TxId: "6", Value: []byte("100")})}},
+		Metadata: utils.MarshalOrPanic(&pb.QueryResponseMetadata{FetchedRecordsCount: 1, Bookmark: "0105"})}
+	payload = utils.MarshalOrPanic(historyQueryResponse)
+
+	respSet = &mockpeer.MockResponseSet{
+		DoneFunc:  errorFunc,
+		ErrorFunc: errorFunc,
+		Responses: []*mockpeer.MockResponse{
+			{RecvMsg: &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_GET_HISTORY_FOR_KEY, Txid: "7b", ChannelId: channelId}, RespMsg: &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Payload: payload, Txid: "7b", ChannelId: channelId}},
+			{RecvMsg: &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_QUERY_STATE_CLOSE, Txid: "7b", ChannelId: channelId}, RespMsg: &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_RESPONSE, Txid: "7b", ChannelId: channelId}},
+			{RecvMsg: &pb.ChaincodeMessage{Type: pb.ChaincodeMessage_COMPLETED, Txid: "7b", ChannelId: channelId}, RespMsg: nil},
+		},
+	}
+	peerSide.SetResponses(respSet)
+
+	ci = &pb.ChaincodeInput{Args: [][]byte{[]byte("historyqWithOptions"), []byte("A")}, Decorations: nil}
+	payload = utils.MarshalOrPanic(ci)
+	peerSide.Send(&pb.ChaincodeMessage{Type: pb.ChaincodeMessage_TRANSACTION, Payload: payload, Txid: "7b", ChannelId: channelId})
+
+	//wait for done
+	processDone(t, done, false)
+
 	//query result
 
 	//create the response
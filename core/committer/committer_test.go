@@ -37,6 +37,11 @@ func (m *mockLedger) GetConfigHistoryRetriever() (ledger2.ConfigHistoryRetriever
 	return args.Get(0).(ledger2.ConfigHistoryRetriever), args.Error(1)
 }
 
+func (m *mockLedger) GetStateDBIndexManager() (ledger2.StateDBIndexManager, error) {
+	args := m.Called()
+	return args.Get(0).(ledger2.StateDBIndexManager), args.Error(1)
+}
+
 func (m *mockLedger) GetBlockchainInfo() (*common.BlockchainInfo, error) {
 	info := &common.BlockchainInfo{
 		Height:            m.height,
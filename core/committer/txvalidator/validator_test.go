@@ -1690,6 +1690,11 @@ func (m *mockLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever,
 	return args.Get(0).(ledger.ConfigHistoryRetriever), nil
 }
 
+func (m *mockLedger) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	args := m.Called()
+	return args.Get(0).(ledger.StateDBIndexManager), args.Error(1)
+}
+
 func (m *mockLedger) CommitPvtDataOfOldBlocks(blockPvtData []*ledger.BlockPvtData) ([]*ledger.PvtdataHashMismatch, error) {
 	return nil, nil
 }
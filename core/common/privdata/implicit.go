@@ -0,0 +1,152 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+const (
+	// implicitCollectionNamePrefix is the prefix of the names of the implicit collections;
+	// the name of the implicit collection of an organization is the prefix followed by the MSP ID
+	implicitCollectionNamePrefix = "_implicit_org_"
+
+	// the channel config is persisted in the state by the peer (see core/peer/configtx_processor.go)
+	channelConfigNamespace = ""
+	channelConfigKey       = "resourcesconfigtx.CHANNEL_CONFIG_KEY"
+
+	// the namespace where the chaincodes are deployed
+	lsccNamespace = "lscc"
+)
+
+// ImplicitCollectionNameForOrg returns the name of the implicit collection of the given organization
+func ImplicitCollectionNameForOrg(mspID string) string {
+	return implicitCollectionNamePrefix + mspID
+}
+
+// MSPIDIfImplicitCollection returns true and the MSP ID of the organization if the given
+// collection name is the name of an implicit collection; it returns false otherwise
+func MSPIDIfImplicitCollection(collectionName string) (bool, string) {
+	if !strings.HasPrefix(collectionName, implicitCollectionNamePrefix) {
+		return false, ""
+	}
+	mspID := collectionName[len(implicitCollectionNamePrefix):]
+	if mspID == "" {
+		return false, ""
+	}
+	return true, mspID
+}
+
+// GenerateImplicitCollectionForOrg generates the configuration of the implicit collection of the given
+// organization. The members of the collection are the members of the organization, which are the only
+// ones that can read its private data. The private data is disseminated at endorsement time to at most
+// one peer of the organization (without requiring any), the other peers pull it when committing the
+// block. The private data never expires.
+func GenerateImplicitCollectionForOrg(mspID string) *common.StaticCollectionConfig {
+	return &common.StaticCollectionConfig{
+		Name: ImplicitCollectionNameForOrg(mspID),
+		MemberOrgsPolicy: &common.CollectionPolicyConfig{
+			Payload: &common.CollectionPolicyConfig_SignaturePolicy{
+				SignaturePolicy: cauthdsl.SignedByMspMember(mspID),
+			},
+		},
+		RequiredPeerCount: 0,
+		MaximumPeerCount:  1,
+		MemberOnlyRead:    true,
+	}
+}
+
+// RetrieveImplicitCollectionFromState returns the configuration of the implicit collection with the given
+// name of the given chaincode. It returns nil if the chaincode is not deployed, or if the name is not the
+// name of the implicit collection of one of the application organizations of the channel, according to the
+// channel config persisted in the given state
+func RetrieveImplicitCollectionFromState(chaincodeName, collectionName string, state State) (*common.StaticCollectionConfig, error) {
+	isImplicit, mspID := MSPIDIfImplicitCollection(collectionName)
+	if !isImplicit {
+		return nil, nil
+	}
+	chaincodeData, err := state.GetState(lsccNamespace, chaincodeName)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while retrieving chaincode "+chaincodeName)
+	}
+	if chaincodeData == nil {
+		return nil, nil
+	}
+	orgs, err := RetrieveChannelOrgsFromState(state)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		if org == mspID {
+			return GenerateImplicitCollectionForOrg(mspID), nil
+		}
+	}
+	return nil, nil
+}
+
+// RetrieveChannelOrgsFromState returns the MSP IDs of the application organizations of the channel,
+// according to the channel config persisted in the given state
+func RetrieveChannelOrgsFromState(state State) ([]string, error) {
+	configBytes, err := state.GetState(channelConfigNamespace, channelConfigKey)
+	if err != nil {
+		return nil, errors.WithMessage(err, "error while retrieving the channel config")
+	}
+	if configBytes == nil {
+		return nil, nil
+	}
+	config := &common.Config{}
+	if err := proto.Unmarshal(configBytes, config); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the channel config")
+	}
+	appGroup := config.GetChannelGroup().GetGroups()[channelconfig.ApplicationGroupKey]
+	var orgs []string
+	for orgName, orgGroup := range appGroup.GetGroups() {
+		mspValue, exists := orgGroup.GetValues()[channelconfig.MSPKey]
+		if !exists {
+			return nil, errors.Errorf("MSP of organization %s is not defined in the channel config", orgName)
+		}
+		mspID, err := mspIDFromConfig(mspValue.Value)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error while retrieving the MSP ID of organization "+orgName)
+		}
+		orgs = append(orgs, mspID)
+	}
+	sort.Strings(orgs)
+	return orgs, nil
+}
+
+func mspIDFromConfig(mspConfigBytes []byte) (string, error) {
+	mspConfig := &mspprotos.MSPConfig{}
+	if err := proto.Unmarshal(mspConfigBytes, mspConfig); err != nil {
+		return "", errors.Wrap(err, "error unmarshalling the MSP config")
+	}
+	switch msp.ProviderType(mspConfig.Type) {
+	case msp.FABRIC:
+		fabricMSPConfig := &mspprotos.FabricMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.Config, fabricMSPConfig); err != nil {
+			return "", errors.Wrap(err, "error unmarshalling the fabric MSP config")
+		}
+		return fabricMSPConfig.Name, nil
+	case msp.IDEMIX:
+		idemixMSPConfig := &mspprotos.IdemixMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.Config, idemixMSPConfig); err != nil {
+			return "", errors.Wrap(err, "error unmarshalling the idemix MSP config")
+		}
+		return idemixMSPConfig.Name, nil
+	default:
+		return "", errors.Errorf("unsupported MSP type %d", mspConfig.Type)
+	}
+}
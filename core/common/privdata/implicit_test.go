@@ -0,0 +1,175 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privdata
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	lm "github.com/hyperledger/fabric/common/mocks/ledger"
+	"github.com/hyperledger/fabric/protos/common"
+	mspprotos "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func channelConfigWithOrgs(mspIDs ...string) []byte {
+	appGroup := &common.ConfigGroup{Groups: map[string]*common.ConfigGroup{}}
+	for _, mspID := range mspIDs {
+		mspConfig := &mspprotos.MSPConfig{Config: utils.MarshalOrPanic(&mspprotos.FabricMSPConfig{Name: mspID})}
+		appGroup.Groups[mspID+"Org"] = &common.ConfigGroup{
+			Values: map[string]*common.ConfigValue{
+				"MSP": {Value: utils.MarshalOrPanic(mspConfig)},
+			},
+		}
+	}
+	config := &common.Config{
+		ChannelGroup: &common.ConfigGroup{
+			Groups: map[string]*common.ConfigGroup{"Application": appGroup},
+		},
+	}
+	return utils.MarshalOrPanic(config)
+}
+
+func TestImplicitCollectionName(t *testing.T) {
+	name := ImplicitCollectionNameForOrg("Org1MSP")
+	assert.Equal(t, "_implicit_org_Org1MSP", name)
+
+	isImplicit, mspID := MSPIDIfImplicitCollection(name)
+	assert.True(t, isImplicit)
+	assert.Equal(t, "Org1MSP", mspID)
+
+	isImplicit, _ = MSPIDIfImplicitCollection("mycollection")
+	assert.False(t, isImplicit)
+
+	isImplicit, _ = MSPIDIfImplicitCollection("_implicit_org_")
+	assert.False(t, isImplicit)
+}
+
+func TestGenerateImplicitCollectionForOrg(t *testing.T) {
+	conf := GenerateImplicitCollectionForOrg("Org1MSP")
+	assert.Equal(t, "_implicit_org_Org1MSP", conf.Name)
+	assert.True(t, conf.MemberOnlyRead)
+	assert.Equal(t, int32(0), conf.RequiredPeerCount)
+	assert.Equal(t, int32(1), conf.MaximumPeerCount)
+	assert.Equal(t, uint64(0), conf.BlockToLive)
+
+	sc := &SimpleCollection{}
+	assert.NoError(t, sc.Setup(conf, &mockDeserializer{}))
+	assert.Equal(t, []string{"Org1MSP"}, sc.MemberOrgs())
+}
+
+func TestRetrieveChannelOrgsFromState(t *testing.T) {
+	wState := map[string]map[string][]byte{"": {}}
+	qe := &lm.MockQueryExecutor{State: wState}
+
+	orgs, err := RetrieveChannelOrgsFromState(qe)
+	assert.NoError(t, err)
+	assert.Empty(t, orgs)
+
+	wState[""][channelConfigKey] = channelConfigWithOrgs("Org2MSP", "Org1MSP")
+	orgs, err = RetrieveChannelOrgsFromState(qe)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org1MSP", "Org2MSP"}, orgs)
+
+	wState[""][channelConfigKey] = []byte("barf")
+	_, err = RetrieveChannelOrgsFromState(qe)
+	assert.Contains(t, err.Error(), "error unmarshalling the channel config")
+
+	config := &common.Config{
+		ChannelGroup: &common.ConfigGroup{
+			Groups: map[string]*common.ConfigGroup{
+				"Application": {Groups: map[string]*common.ConfigGroup{"Org1": {}}},
+			},
+		},
+	}
+	wState[""][channelConfigKey] = utils.MarshalOrPanic(config)
+	_, err = RetrieveChannelOrgsFromState(qe)
+	assert.EqualError(t, err, "MSP of organization Org1 is not defined in the channel config")
+
+	config.ChannelGroup.Groups["Application"].Groups["Org1"].Values = map[string]*common.ConfigValue{
+		"MSP": {Value: utils.MarshalOrPanic(&mspprotos.MSPConfig{Type: 5})},
+	}
+	wState[""][channelConfigKey] = utils.MarshalOrPanic(config)
+	_, err = RetrieveChannelOrgsFromState(qe)
+	assert.EqualError(t, err, "error while retrieving the MSP ID of organization Org1: unsupported MSP type 5")
+}
+
+func TestRetrieveImplicitCollectionFromState(t *testing.T) {
+	wState := map[string]map[string][]byte{
+		"":     {channelConfigKey: channelConfigWithOrgs("Org1MSP", "Org2MSP")},
+		"lscc": {"cc": []byte("chaincode-data")},
+	}
+	qe := &lm.MockQueryExecutor{State: wState}
+
+	conf, err := RetrieveImplicitCollectionFromState("cc", "_implicit_org_Org2MSP", qe)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(GenerateImplicitCollectionForOrg("Org2MSP"), conf))
+
+	// not an organization of the channel
+	conf, err = RetrieveImplicitCollectionFromState("cc", "_implicit_org_Org3MSP", qe)
+	assert.NoError(t, err)
+	assert.Nil(t, conf)
+
+	// not an implicit collection
+	conf, err = RetrieveImplicitCollectionFromState("cc", "mycollection", qe)
+	assert.NoError(t, err)
+	assert.Nil(t, conf)
+
+	// chaincode not deployed
+	conf, err = RetrieveImplicitCollectionFromState("cc2", "_implicit_org_Org1MSP", qe)
+	assert.NoError(t, err)
+	assert.Nil(t, conf)
+}
+
+func TestCollectionStoreImplicitCollections(t *testing.T) {
+	wState := map[string]map[string][]byte{
+		"":     {channelConfigKey: channelConfigWithOrgs("Org1MSP", "Org2MSP")},
+		"lscc": {"cc": []byte("chaincode-data")},
+	}
+	support := &mockStoreSupport{Qe: &lm.MockQueryExecutor{State: wState}}
+	cs := NewSimpleCollectionStore(support)
+
+	// the chaincode does not define any collection explicitly
+	ccr := common.CollectionCriteria{Channel: "ch", Namespace: "cc", Collection: "_implicit_org_Org1MSP"}
+	c, err := cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org1MSP"}, c.MemberOrgs())
+
+	ap, err := cs.RetrieveCollectionAccessPolicy(ccr)
+	assert.NoError(t, err)
+	assert.True(t, ap.IsMemberOnlyRead())
+
+	pc, err := cs.RetrieveCollectionPersistenceConfigs(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), pc.BlockToLive())
+
+	ccr.Collection = "_implicit_org_Org3MSP"
+	_, err = cs.RetrieveCollection(ccr)
+	assert.Equal(t, NoSuchCollectionError(ccr), err)
+
+	// the collections defined explicitly take precedence
+	explicitConf := &common.StaticCollectionConfig{
+		Name:             "_implicit_org_Org1MSP",
+		MemberOrgsPolicy: GenerateImplicitCollectionForOrg("Org2MSP").MemberOrgsPolicy,
+		BlockToLive:      10,
+	}
+	ccp := &common.CollectionConfigPackage{Config: []*common.CollectionConfig{{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{StaticCollectionConfig: explicitConf},
+	}}}
+	wState["lscc"][BuildCollectionKVSKey("cc")] = utils.MarshalOrPanic(ccp)
+
+	ccr.Collection = "_implicit_org_Org1MSP"
+	c, err = cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org2MSP"}, c.MemberOrgs())
+
+	ccr.Collection = "_implicit_org_Org2MSP"
+	c, err = cs.RetrieveCollection(ccr)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Org2MSP"}, c.MemberOrgs())
+}
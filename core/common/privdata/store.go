@@ -93,14 +93,20 @@ func ParseCollectionConfig(colBytes []byte) (*common.CollectionConfigPackage, er
 }
 
 func (c *simpleCollectionStore) retrieveCollectionConfig(cc common.CollectionCriteria, qe ledger.QueryExecutor) (*common.StaticCollectionConfig, error) {
-	collections, err := c.retrieveCollectionConfigPackage(cc, qe)
-	if err != nil {
-		return nil, err
+	if qe == nil {
+		var err error
+		qe, err = c.s.GetQueryExecutorForLedger(cc.Channel)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("could not retrieve query executor for collection criteria %#v", cc))
+		}
+		defer qe.Done()
 	}
-	if collections == nil {
-		return nil, nil
+
+	collections, err := RetrieveCollectionConfigPackageFromState(cc, qe)
+	if _, isNoSuchCollection := err.(NoSuchCollectionError); err != nil && !isNoSuchCollection {
+		return nil, err
 	}
-	for _, cconf := range collections.Config {
+	for _, cconf := range collections.GetConfig() {
 		switch cconf := cconf.Payload.(type) {
 		case *common.CollectionConfig_StaticCollectionConfig:
 			if cconf.StaticCollectionConfig.Name == cc.Collection {
@@ -110,6 +116,15 @@ func (c *simpleCollectionStore) retrieveCollectionConfig(cc common.CollectionCri
 			return nil, errors.New("unexpected collection type")
 		}
 	}
+
+	// the collections defined explicitly take precedence over the implicit collections of the organizations
+	implicitCollection, err := RetrieveImplicitCollectionFromState(cc.Namespace, cc.Collection, qe)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error while retrieving implicit collection for collection criteria %#v", cc))
+	}
+	if implicitCollection != nil {
+		return implicitCollection, nil
+	}
 	return nil, NoSuchCollectionError(cc)
 }
 
@@ -64,6 +64,14 @@ type BlocksDeliverer interface {
 	Send(*common.Envelope) error
 }
 
+// NewestBlockProber probes the ordering service nodes, other than the one
+// blocks are currently delivered from, for the newest block they have.
+type NewestBlockProber interface {
+	// ProbeNewestBlock returns the highest sequence number among the
+	// verified newest blocks of the other ordering service nodes
+	ProbeNewestBlock() (uint64, error)
+}
+
 type streamClient interface {
 	BlocksDeliverer
 
@@ -87,9 +95,21 @@ type blocksProviderImpl struct {
 	done int32
 
 	wrongStatusThreshold int
+
+	ledgerInfo LedgerInfo
+
+	prober NewestBlockProber
+
+	censorshipTimeout time.Duration
 }
 
-const wrongStatusThreshold = 10
+const (
+	wrongStatusThreshold = 10
+
+	// censorshipChecksPerTimeout is the number of times the ledger
+	// height is checked within a block censorship timeout
+	censorshipChecksPerTimeout = 5
+)
 
 var maxRetryDelay = time.Second * 10
 var logger = flogging.MustGetLogger("blocksProvider")
@@ -105,12 +125,35 @@ func NewBlocksProvider(chainID string, client streamClient, gossip GossipService
 	}
 }
 
+// NewBlocksProviderWithCensorshipMonitor creates a blocks deliverer instance which also
+// monitors the ordering service node it is connected to. If the node withholds blocks, that is,
+// the ledger has not advanced for the given timeout while other ordering service nodes have
+// newer blocks, the blocks deliverer disconnects from it and connects to another node.
+func NewBlocksProviderWithCensorshipMonitor(chainID string, client streamClient, gossip GossipServiceAdapter, mcs api.MessageCryptoService,
+	ledgerInfo LedgerInfo, prober NewestBlockProber, censorshipTimeout time.Duration) BlocksProvider {
+	return &blocksProviderImpl{
+		chainID:              chainID,
+		client:               client,
+		gossip:               gossip,
+		mcs:                  mcs,
+		wrongStatusThreshold: wrongStatusThreshold,
+		ledgerInfo:           ledgerInfo,
+		prober:               prober,
+		censorshipTimeout:    censorshipTimeout,
+	}
+}
+
 // DeliverBlocks used to pull out blocks from the ordering service to
 // distributed them across peers
 func (b *blocksProviderImpl) DeliverBlocks() {
 	errorStatusCounter := 0
 	statusCounter := 0
 	defer b.client.Close()
+	if b.prober != nil {
+		stopMonitor := make(chan struct{})
+		defer close(stopMonitor)
+		go b.monitorCensorship(stopMonitor)
+	}
 	for !b.isDone() {
 		msg, err := b.client.Recv()
 		if err != nil {
@@ -181,6 +224,51 @@ func (b *blocksProviderImpl) DeliverBlocks() {
 	}
 }
 
+// monitorCensorship periodically checks whether the ledger advances, and if it did not
+// advance for the censorship timeout while other ordering service nodes have blocks
+// the ledger lacks, disconnects from the ordering service node that withholds them.
+func (b *blocksProviderImpl) monitorCensorship(stop <-chan struct{}) {
+	ticker := time.NewTicker(b.censorshipTimeout / censorshipChecksPerTimeout)
+	defer ticker.Stop()
+
+	lastHeight, _ := b.ledgerInfo.LedgerHeight()
+	lastProgress := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		height, err := b.ledgerInfo.LedgerHeight()
+		if err != nil {
+			logger.Warningf("[%s] Failed obtaining ledger height: %s", b.chainID, err)
+			continue
+		}
+		if height != lastHeight {
+			lastHeight = height
+			lastProgress = time.Now()
+			continue
+		}
+		if time.Since(lastProgress) < b.censorshipTimeout {
+			continue
+		}
+		// Probe again only after another timeout, whatever the outcome
+		lastProgress = time.Now()
+
+		newest, err := b.prober.ProbeNewestBlock()
+		if err != nil {
+			logger.Debugf("[%s] Failed probing the ordering service for newer blocks: %s", b.chainID, err)
+			continue
+		}
+		if newest < height {
+			continue
+		}
+		logger.Warningf("[%s] No blocks were received for %v while the ordering service has block [%d] and the ledger height is %d, "+
+			"switching to another ordering service node", b.chainID, b.censorshipTimeout, newest, height)
+		b.client.Disconnect()
+	}
+}
+
 // Stop stops blocks delivery provider
 func (b *blocksProviderImpl) Stop() {
 	atomic.StoreInt32(&b.done, 1)
@@ -369,3 +369,81 @@ func TestBlockVerificationFailure(t *testing.T) {
 	mcs.On("VerifyBlock", mock.Anything).Return(errors.New("Invalid signature"))
 	makeTestCase(uint64(0), mcs, false, rcvr)(t)
 }
+
+type mockProber struct {
+	newest uint64
+	err    error
+	probes int32
+}
+
+func (p *mockProber) ProbeNewestBlock() (uint64, error) {
+	atomic.AddInt32(&p.probes, 1)
+	return p.newest, p.err
+}
+
+func (p *mockProber) probeCount() int {
+	return int(atomic.LoadInt32(&p.probes))
+}
+
+func TestBlocksProviderCensorshipMonitor(t *testing.T) {
+	// Scenario: the ordering service node the blocks provider is connected to
+	// withholds blocks. Ensure the blocks provider disconnects from it only
+	// when other ordering service nodes have blocks the ledger lacks.
+	newProvider := func(prober *mockProber) (*mocks.MockBlocksDeliverer, func()) {
+		closed := make(chan struct{})
+		bd := &mocks.MockBlocksDeliverer{
+			DisconnectCalled: make(chan struct{}, 100),
+			MockRecv: func(mock *mocks.MockBlocksDeliverer) (*orderer.DeliverResponse, error) {
+				<-closed
+				return nil, errors.New("closed")
+			},
+		}
+		gossipServiceAdapter := &mocks.MockGossipServiceAdapter{GossipBlockDisseminations: make(chan uint64)}
+		provider := NewBlocksProviderWithCensorshipMonitor("***TEST_CHAINID***", bd, gossipServiceAdapter, &mockMCS{},
+			&mocks.MockLedgerInfo{Height: 10}, prober, time.Millisecond*200)
+		done := make(chan struct{})
+		go func() {
+			provider.DeliverBlocks()
+			close(done)
+		}()
+		return bd, func() {
+			provider.Stop()
+			close(closed)
+			<-done
+		}
+	}
+
+	t.Run("withheld blocks", func(t *testing.T) {
+		prober := &mockProber{newest: 10}
+		bd, stop := newProvider(prober)
+		defer stop()
+		waitUntilOrFail(t, func() bool {
+			return len(bd.DisconnectCalled) > 0
+		})
+	})
+
+	t.Run("no newer blocks", func(t *testing.T) {
+		prober := &mockProber{newest: 9}
+		bd, stop := newProvider(prober)
+		waitUntilOrFail(t, func() bool {
+			return prober.probeCount() > 1
+		})
+		stop()
+		assert.Len(t, bd.DisconnectCalled, 0)
+
+		// The monitor stops along with the blocks provider
+		probes := prober.probeCount()
+		time.Sleep(time.Millisecond * 500)
+		assert.Equal(t, probes, prober.probeCount())
+	})
+
+	t.Run("probe failure", func(t *testing.T) {
+		prober := &mockProber{err: errors.New("no ordering service node could be probed")}
+		bd, stop := newProvider(prober)
+		waitUntilOrFail(t, func() bool {
+			return prober.probeCount() > 1
+		})
+		stop()
+		assert.Len(t, bd.DisconnectCalled, 0)
+	})
+}
@@ -258,6 +258,13 @@ func (bc *broadcastClient) Disconnect() {
 	bc.blocksDeliverer = nil
 }
 
+// currentEndpoint returns the endpoint the client is connected to, or an empty string if not connected
+func (bc *broadcastClient) currentEndpoint() string {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+	return bc.endpoint
+}
+
 // UpdateEndpoints update endpoints to new values
 func (bc *broadcastClient) UpdateEndpoints(endpoints []comm.EndpointCriteria) {
 	bc.mutex.Lock()
@@ -30,6 +30,7 @@ const (
 	defaultReConnectTotalTimeThreshold = time.Second * 60 * 60
 	defaultConnectionTimeout           = time.Second * 3
 	defaultReConnectBackoffThreshold   = time.Hour
+	defaultBlockCensorshipTimeout      = time.Second * 30
 )
 
 func getReConnectTotalTimeThreshold() time.Duration {
@@ -44,6 +45,10 @@ func getReConnectBackoffThreshold() time.Duration {
 	return util.GetDurationOrDefault("peer.deliveryclient.reConnectBackoffThreshold", defaultReConnectBackoffThreshold)
 }
 
+func getBlockCensorshipTimeout() time.Duration {
+	return util.GetDurationOrDefault("peer.deliveryclient.blockCensorshipTimeout", defaultBlockCensorshipTimeout)
+}
+
 func staticRootsEnabled() bool {
 	return viper.GetBool("peer.deliveryclient.staticRootsEnabled")
 }
@@ -99,6 +104,10 @@ type Config struct {
 	// Gossip enables to enumerate peers in the channel, send a message to peers,
 	// and add a block to the gossip state transfer layer
 	Gossip blocksprovider.GossipServiceAdapter
+	// MonitorBlockCensorship indicates whether the ordering node that blocks are received from
+	// is monitored for withholding blocks. This is meant for BFT ordering services only, as the
+	// monitoring periodically queries all the ordering nodes of the channel
+	MonitorBlockCensorship bool
 }
 
 // ConnectionCriteria defines how to connect to ordering service nodes.
@@ -227,8 +236,15 @@ func (d *deliverServiceImpl) StartDeliverForChannel(chainID string, ledgerInfo b
 	} else {
 		client := d.newClient(chainID, ledgerInfo)
 		logger.Info("This peer will retrieve blocks from ordering service and disseminate to other peers in the organization for channel", chainID)
+		var bp blocksprovider.BlocksProvider
+		if d.conf.MonitorBlockCensorship {
+			prober := d.newProber(chainID, client)
+			bp = blocksprovider.NewBlocksProviderWithCensorshipMonitor(chainID, client, d.conf.Gossip, d.conf.CryptoSvc, ledgerInfo, prober, getBlockCensorshipTimeout())
+		} else {
+			bp = blocksprovider.NewBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc)
+		}
 		d.deliverClients[chainID] = &deliverClient{
-			bp:      blocksprovider.NewBlocksProvider(chainID, client, d.conf.Gossip, d.conf.CryptoSvc),
+			bp:      bp,
 			bclient: client,
 		}
 		go d.launchBlockProvider(chainID, finalizer)
@@ -308,6 +324,21 @@ func (d *deliverServiceImpl) newClient(chainID string, ledgerInfoProvider blocks
 	return bClient
 }
 
+func (d *deliverServiceImpl) newProber(chainID string, client *broadcastClient) *newestBlockProber {
+	requester := &blocksRequester{
+		tls:     viper.GetBool("peer.tls.enabled"),
+		chainID: chainID,
+	}
+	return &newestBlockProber{
+		chainID:      chainID,
+		client:       client,
+		connect:      d.conf.ConnFactory(chainID, d.connConfig.OrdererEndpointOverrides),
+		createClient: d.conf.ABCFactory,
+		verifyBlock:  d.conf.CryptoSvc.VerifyBlock,
+		tlsCertHash:  requester.getTLSCertHash,
+	}
+}
+
 func DefaultConnectionFactory(channelID string, endpointOverrides map[string]*comm.OrdererEndpoint) func(endpointCriteria comm.EndpointCriteria) (*grpc.ClientConn, error) {
 	return func(criteria comm.EndpointCriteria) (*grpc.ClientConn, error) {
 		dialOpts := []grpc.DialOption{grpc.WithBlock()}
@@ -0,0 +1,113 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliverclient
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/localmsp"
+	"github.com/hyperledger/fabric/core/comm"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// newestBlockProber probes the ordering service nodes other than the one the
+// broadcast client is connected to for their newest block. Blocks are verified
+// before they are taken into account, so that a node cannot make the peer believe
+// blocks are withheld by presenting blocks that do not exist.
+type newestBlockProber struct {
+	chainID      string
+	client       *broadcastClient
+	connect      func(endpointCriteria comm.EndpointCriteria) (*grpc.ClientConn, error)
+	createClient clientFactory
+	verifyBlock  func(chainID gossipcommon.ChainID, seqNum uint64, signedBlock []byte) error
+	tlsCertHash  func() []byte
+}
+
+// ProbeNewestBlock returns the highest sequence number among the verified newest
+// blocks of the other ordering service nodes
+func (p *newestBlockProber) ProbeNewestBlock() (uint64, error) {
+	current := p.client.currentEndpoint()
+
+	var newest uint64
+	var found bool
+	for _, endpoint := range p.client.prod.GetEndpoints() {
+		if endpoint.Endpoint == current {
+			continue
+		}
+		seq, err := p.probe(endpoint)
+		if err != nil {
+			logger.Debugf("[%s] Failed probing %s for its newest block: %s", p.chainID, endpoint.Endpoint, err)
+			continue
+		}
+		if !found || seq > newest {
+			newest = seq
+		}
+		found = true
+	}
+
+	if !found {
+		return 0, errors.Errorf("no ordering service node other than %s could be probed", current)
+	}
+	return newest, nil
+}
+
+func (p *newestBlockProber) probe(endpoint comm.EndpointCriteria) (uint64, error) {
+	conn, err := p.connect(endpoint)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), getConnectionTimeout())
+	defer cancel()
+	stream, err := p.createClient(conn).Deliver(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	env, err := newestBlockSeekEnvelope(p.chainID, p.tlsCertHash())
+	if err != nil {
+		return 0, err
+	}
+	if err := stream.Send(env); err != nil {
+		return 0, err
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+	block := resp.GetBlock()
+	if block == nil || block.Header == nil {
+		return 0, errors.Errorf("expected a block but got %v", resp)
+	}
+
+	marshaledBlock, err := proto.Marshal(block)
+	if err != nil {
+		return 0, err
+	}
+	if err := p.verifyBlock(gossipcommon.ChainID(p.chainID), block.Header.Number, marshaledBlock); err != nil {
+		return 0, errors.WithMessage(err, "failed verifying block")
+	}
+	return block.Header.Number, nil
+}
+
+func newestBlockSeekEnvelope(chainID string, tlsCertHash []byte) (*common.Envelope, error) {
+	newest := &orderer.SeekPosition{Type: &orderer.SeekPosition_Newest{Newest: &orderer.SeekNewest{}}}
+	seekInfo := &orderer.SeekInfo{
+		Start:    newest,
+		Stop:     newest,
+		Behavior: orderer.SeekInfo_FAIL_IF_NOT_READY,
+	}
+	return utils.CreateSignedEnvelopeWithTLSBinding(common.HeaderType_DELIVER_SEEK_INFO, chainID, localmsp.NewSigner(), seekInfo, int32(0), uint64(0), tlsCertHash)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package deliverclient
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/comm"
+	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// newestBlockOrderer serves its newest block to deliver requests that seek it
+type newestBlockOrderer struct {
+	t      *testing.T
+	newest uint64
+}
+
+func (*newestBlockOrderer) Broadcast(orderer.AtomicBroadcast_BroadcastServer) error {
+	panic("not implemented")
+}
+
+func (o *newestBlockOrderer) Deliver(stream orderer.AtomicBroadcast_DeliverServer) error {
+	env, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	require.NoError(o.t, err)
+	seekInfo := &orderer.SeekInfo{}
+	require.NoError(o.t, proto.Unmarshal(payload.Data, seekInfo))
+	assert.NotNil(o.t, seekInfo.Start.GetNewest())
+	assert.NotNil(o.t, seekInfo.Stop.GetNewest())
+	assert.Equal(o.t, orderer.SeekInfo_FAIL_IF_NOT_READY, seekInfo.Behavior)
+
+	return stream.Send(&orderer.DeliverResponse{
+		Type: &orderer.DeliverResponse_Block{
+			Block: &common.Block{Header: &common.BlockHeader{Number: o.newest}},
+		},
+	})
+}
+
+func startNewestBlockOrderer(t *testing.T, newest uint64) (string, func()) {
+	lsnr, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	orderer.RegisterAtomicBroadcastServer(srv, &newestBlockOrderer{t: t, newest: newest})
+	go srv.Serve(lsnr)
+	return lsnr.Addr().String(), srv.Stop
+}
+
+func TestNewestBlockProber(t *testing.T) {
+	endpoint1, stop1 := startNewestBlockOrderer(t, 5)
+	defer stop1()
+	endpoint2, stop2 := startNewestBlockOrderer(t, 8)
+	defer stop2()
+	endpoint3, stop3 := startNewestBlockOrderer(t, 12)
+	defer stop3()
+
+	endpoints := []comm.EndpointCriteria{
+		{Endpoint: endpoint1, Organizations: []string{"org"}},
+		{Endpoint: endpoint2, Organizations: []string{"org"}},
+		{Endpoint: endpoint3, Organizations: []string{"org"}},
+	}
+	connFactory := DefaultConnectionFactory("TEST_CHAINID", nil)
+
+	newProber := func(current string, verifyBlock func(gossipcommon.ChainID, uint64, []byte) error) *newestBlockProber {
+		client := NewBroadcastClient(comm.NewConnectionProducer(connFactory, endpoints), DefaultABCFactory, nil, nil)
+		client.endpoint = current
+		return &newestBlockProber{
+			chainID:      "TEST_CHAINID",
+			client:       client,
+			connect:      connFactory,
+			createClient: DefaultABCFactory,
+			verifyBlock:  verifyBlock,
+			tlsCertHash:  func() []byte { return nil },
+		}
+	}
+
+	t.Run("current endpoint is not probed", func(t *testing.T) {
+		newest, err := newProber(endpoint3, (&mockMCS{}).VerifyBlock).ProbeNewestBlock()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), newest)
+	})
+
+	t.Run("blocks failing verification are ignored", func(t *testing.T) {
+		verifyBlock := func(_ gossipcommon.ChainID, seqNum uint64, _ []byte) error {
+			if seqNum == 8 {
+				return errors.New("forged block")
+			}
+			return nil
+		}
+		newest, err := newProber(endpoint3, verifyBlock).ProbeNewestBlock()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), newest)
+	})
+
+	t.Run("no endpoint could be probed", func(t *testing.T) {
+		verifyBlock := func(gossipcommon.ChainID, uint64, []byte) error {
+			return errors.New("forged block")
+		}
+		_, err := newProber(endpoint1, verifyBlock).ProbeNewestBlock()
+		assert.EqualError(t, err, "no ordering service node other than "+endpoint1+" could be probed")
+	})
+}
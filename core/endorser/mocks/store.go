@@ -5,6 +5,7 @@ import ledger "github.com/hyperledger/fabric/core/ledger"
 import mock "github.com/stretchr/testify/mock"
 import protostransientstore "github.com/hyperledger/fabric/protos/transientstore"
 import rwset "github.com/hyperledger/fabric/protos/ledger/rwset"
+import time "time"
 import transientstore "github.com/hyperledger/fabric/core/transientstore"
 
 // Store is an autogenerated mock type for the Store type
@@ -98,6 +99,20 @@ func (_m *Store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 	return r0
 }
 
+// PurgeByTime provides a mock function with given fields: persistedBefore
+func (_m *Store) PurgeByTime(persistedBefore time.Time) error {
+	ret := _m.Called(persistedBefore)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(persistedBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PurgeByTxids provides a mock function with given fields: txids
 func (_m *Store) PurgeByTxids(txids []string) error {
 	ret := _m.Called(txids)
@@ -57,7 +57,7 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 			if err != nil {
 				return nil, errors.WithMessage(err, fmt.Sprintf("error while retrieving collection config for chaincode %#v", namespace))
 			}
-			if cb == nil {
+			if cb == nil && !writesOnlyToImplicitCollections(pvtRwset) {
 				return nil, errors.New(fmt.Sprintf("no collection config for chaincode %#v", namespace))
 			}
 
@@ -69,11 +69,47 @@ func (as *rwSetAssembler) AssemblePvtRWSet(privData *rwset.TxPvtReadWriteSet, tx
 
 			txPvtRwSetWithConfig.CollectionConfigs[namespace] = colCP
 		}
+		as.addImplicitCollectionConfigs(txPvtRwSetWithConfig.CollectionConfigs[namespace], pvtRwset)
 	}
 	as.trimCollectionConfigs(txPvtRwSetWithConfig)
 	return txPvtRwSetWithConfig, nil
 }
 
+// addImplicitCollectionConfigs adds to the given package the configuration of the implicit collections
+// of the organizations the given private read-write set refers to, unless they are defined explicitly.
+// The presence of the implicit collections is validated by the simulator upon writing the private data
+func (as *rwSetAssembler) addImplicitCollectionConfigs(colCP *common.CollectionConfigPackage, pvtRwset *rwset.NsPvtReadWriteSet) {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		isImplicit, mspID := privdata.MSPIDIfImplicitCollection(col.CollectionName)
+		if !isImplicit || containsCollectionConfig(colCP, col.CollectionName) {
+			continue
+		}
+		colCP.Config = append(colCP.Config, &common.CollectionConfig{
+			Payload: &common.CollectionConfig_StaticCollectionConfig{
+				StaticCollectionConfig: privdata.GenerateImplicitCollectionForOrg(mspID),
+			},
+		})
+	}
+}
+
+func writesOnlyToImplicitCollections(pvtRwset *rwset.NsPvtReadWriteSet) bool {
+	for _, col := range pvtRwset.CollectionPvtRwset {
+		if isImplicit, _ := privdata.MSPIDIfImplicitCollection(col.CollectionName); !isImplicit {
+			return false
+		}
+	}
+	return true
+}
+
+func containsCollectionConfig(colCP *common.CollectionConfigPackage, collectionName string) bool {
+	for _, conf := range colCP.Config {
+		if colConf := conf.GetStaticCollectionConfig(); colConf != nil && colConf.Name == collectionName {
+			return true
+		}
+	}
+	return false
+}
+
 func (as *rwSetAssembler) trimCollectionConfigs(pvtData *transientstore.TxPvtReadWriteSetWithConfigInfo) {
 	flags := make(map[string]map[string]struct{})
 	for _, pvtRWset := range pvtData.PvtRwset.NsPvtRwset {
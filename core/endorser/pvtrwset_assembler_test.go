@@ -88,3 +88,61 @@ func TestAssemblePvtRWSet(t *testing.T) {
 	assert.Equal(t, 1, len(pvtReadWriteSetWithConfigInfo.PvtRwset.NsPvtRwset))
 
 }
+
+func TestAssemblePvtRWSetImplicitCollections(t *testing.T) {
+	collectionsConfigCC1 := &common.CollectionConfigPackage{
+		Config: []*common.CollectionConfig{
+			{
+				Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "mycollection-1",
+					},
+				},
+			},
+		},
+	}
+	colB, err := proto.Marshal(collectionsConfigCC1)
+	assert.NoError(t, err)
+
+	configRetriever := &mockCollectionConfigRetriever{}
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("myCC")).Return(colB, nil)
+	configRetriever.On("GetState", "lscc", privdata.BuildCollectionKVSKey("myCC2")).Return([]byte(nil), nil)
+
+	assembler := rwSetAssembler{}
+
+	privData := &rwset.TxPvtReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+			{
+				Namespace: "myCC",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{CollectionName: "mycollection-1", Rwset: []byte{1, 2, 3}},
+					{CollectionName: "_implicit_org_Org1MSP", Rwset: []byte{4, 5, 6}},
+				},
+			},
+			{
+				// a chaincode without collections defined explicitly
+				Namespace: "myCC2",
+				CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+					{CollectionName: "_implicit_org_Org2MSP", Rwset: []byte{7, 8, 9}},
+				},
+			},
+		},
+	}
+
+	pvtReadWriteSetWithConfigInfo, err := assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.NoError(t, err)
+	configs := pvtReadWriteSetWithConfigInfo.CollectionConfigs["myCC"]
+	assert.Equal(t, 2, len(configs.Config))
+	assert.Equal(t, "mycollection-1", configs.Config[0].GetStaticCollectionConfig().Name)
+	assert.True(t, proto.Equal(privdata.GenerateImplicitCollectionForOrg("Org1MSP"), configs.Config[1].GetStaticCollectionConfig()))
+	configs = pvtReadWriteSetWithConfigInfo.CollectionConfigs["myCC2"]
+	assert.Equal(t, 1, len(configs.Config))
+	assert.True(t, proto.Equal(privdata.GenerateImplicitCollectionForOrg("Org2MSP"), configs.Config[0].GetStaticCollectionConfig()))
+
+	// a collection that is neither defined explicitly nor implicit
+	privData.NsPvtRwset[1].CollectionPvtRwset = append(privData.NsPvtRwset[1].CollectionPvtRwset,
+		&rwset.CollectionPvtReadWriteSet{CollectionName: "mycollection-2", Rwset: []byte{1}})
+	_, err = assembler.AssemblePvtRWSet(privData, configRetriever)
+	assert.EqualError(t, err, "no collection config for chaincode \"myCC2\"")
+}
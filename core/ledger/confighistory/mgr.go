@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package confighistory
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
@@ -28,6 +29,13 @@ const (
 type Mgr interface {
 	ledger.StateListener
 	GetRetriever(ledgerID string, ledgerInfoRetriever LedgerInfoRetriever) ledger.ConfigHistoryRetriever
+	// ExportConfigHistory supplies all the entries of the config history of the given ledger,
+	// in the order of the keys, to the function consume
+	ExportConfigHistory(ledgerID string, consume func(key, value []byte) error) error
+	// ImportConfigHistory loads the entries supplied by the function nextEntry into the config history
+	// of the given ledger. The function nextEntry is expected to return a nil key when all the entries
+	// have been supplied
+	ImportConfigHistory(ledgerID string, nextEntry func() (key, value []byte, err error)) error
 	Close()
 }
 
@@ -96,6 +104,38 @@ func (m *mgr) GetRetriever(ledgerID string, ledgerInfoRetriever LedgerInfoRetrie
 	return &retriever{dbHandle: m.dbProvider.getDB(ledgerID), ledgerInfoRetriever: ledgerInfoRetriever}
 }
 
+// ExportConfigHistory implements the function in the interface 'Mgr'
+func (m *mgr) ExportConfigHistory(ledgerID string, consume func(key, value []byte) error) error {
+	itr := m.dbProvider.getDB(ledgerID).GetIterator(nil, nil)
+	defer itr.Release()
+	for itr.Next() {
+		if err := consume(itr.Key(), itr.Value()); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(itr.Error(), "error while iterating over the config history")
+}
+
+// ImportConfigHistory implements the function in the interface 'Mgr'
+func (m *mgr) ImportConfigHistory(ledgerID string, nextEntry func() (key, value []byte, err error)) error {
+	dbHandle := m.dbProvider.getDB(ledgerID)
+	batch := newBatch()
+	for {
+		key, value, err := nextEntry()
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			break
+		}
+		if !bytes.HasPrefix(key, []byte(keyPrefix)) {
+			return errors.Errorf("unexpected key [%#v] in the config history", key)
+		}
+		batch.Put(key, value)
+	}
+	return dbHandle.writeBatch(batch, true)
+}
+
 // Close implements the function in the interface 'Mgr'
 func (m *mgr) Close() {
 	m.dbProvider.Close()
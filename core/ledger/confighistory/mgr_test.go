@@ -121,6 +121,56 @@ type testEnv struct {
 	t      *testing.T
 }
 
+func TestExportImportConfigHistory(t *testing.T) {
+	dbPath := "/tmp/fabric/core/ledger/confighistory"
+	mockCCInfoProvider := &mock.DeployedChaincodeInfoProvider{}
+	env := newTestEnv(t, dbPath, mockCCInfoProvider)
+	mgr := env.mgr
+	defer env.cleanup()
+	chaincodeName := "chaincode1"
+	for _, committingBlockNum := range []uint64{5, 10, 15} {
+		collConfigPackage := sampleCollectionConfigPackage("ledger1", committingBlockNum)
+		testutilEquipMockCCInfoProviderToReturnDesiredCollConfig(mockCCInfoProvider, chaincodeName, collConfigPackage)
+		assert.NoError(t, mgr.HandleStateUpdates(&ledger.StateUpdateTrigger{
+			LedgerID:           "ledger1",
+			CommittingBlockNum: committingBlockNum},
+		))
+	}
+
+	type kv struct{ key, value []byte }
+	var exported []*kv
+	assert.NoError(t, mgr.ExportConfigHistory("ledger1", func(key, value []byte) error {
+		exported = append(exported, &kv{append([]byte{}, key...), append([]byte{}, value...)})
+		return nil
+	}))
+	assert.Len(t, exported, 3)
+
+	remaining := exported
+	assert.NoError(t, mgr.ImportConfigHistory("ledger2", func() ([]byte, []byte, error) {
+		if len(remaining) == 0 {
+			return nil, nil, nil
+		}
+		e := remaining[0]
+		remaining = remaining[1:]
+		return e.key, e.value, nil
+	}))
+
+	dummyLedgerInfoRetriever := &dummyLedgerInfoRetriever{info: &common.BlockchainInfo{Height: 20}}
+	retriever := mgr.GetRetriever("ledger2", dummyLedgerInfoRetriever)
+	for _, committingBlockNum := range []uint64{5, 10, 15} {
+		collConfig, err := retriever.MostRecentCollectionConfigBelow(committingBlockNum+1, chaincodeName)
+		assert.NoError(t, err)
+		assert.Equal(t, sampleCollectionConfigPackage("ledger1", committingBlockNum), collConfig.CollectionConfig)
+		assert.Equal(t, committingBlockNum, collConfig.CommittingBlockNum)
+	}
+
+	err := mgr.ImportConfigHistory("ledger3", func() ([]byte, []byte, error) {
+		return []byte("unexpected-key"), []byte("value"), nil
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected key")
+}
+
 func newTestEnv(t *testing.T, dbPath string, ccInfoProvider ledger.DeployedChaincodeInfoProvider) *testEnv {
 	env := &testEnv{dbPath: dbPath, t: t}
 	env.cleanup()
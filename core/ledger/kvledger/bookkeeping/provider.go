@@ -37,7 +37,13 @@ type provider struct {
 
 // NewProvider instantiates a new provider
 func NewProvider() Provider {
-	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: getInternalBookkeeperPath()})
+	return NewProviderWithPath(getInternalBookkeeperPath())
+}
+
+// NewProviderWithPath instantiates a new provider that maintains the bookkeeping at the given path
+// instead of the path configured for the peer
+func NewProviderWithPath(dbPath string) Provider {
+	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: dbPath})
 	return &provider{dbProvider: dbProvider}
 }
 
@@ -7,8 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/pkg/errors"
 )
@@ -66,3 +72,64 @@ func dropHistoryDB() error {
 	err := os.RemoveAll(histroryDBPath)
 	return errors.Wrapf(err, "error removing the HistoryDB located at %s", histroryDBPath)
 }
+
+// dropLedgerDBs drops the stateDB, the configHistoryDB, the bookkeeperDB, and the historyDB of the given
+// ledgers only, in the same order as dropDBs. Unlike dropDBs, which removes the directories shared by all the
+// ledgers, this deletes the data of the given ledgers from the shared databases. The state is dropped from
+// the goleveldb irrespective of the configured state database, as the state in the goleveldb is either the
+// one being dropped or a stale copy left behind by a move to another state database. Moreover, if the state
+// database is other than goleveldb, the state is dropped from the configured state database as well
+func dropLedgerDBs(ledgerIDs []string, metricsProvider metrics.Provider) error {
+	stateLeveldbProvider := stateleveldb.NewVersionedDBProvider()
+	for _, ledgerID := range ledgerIDs {
+		logger.Infof("Dropping the state of the ledger [%s] from StateLevelDB at location [%s]", ledgerID, ledgerconfig.GetStateLevelDBPath())
+		if err := stateLeveldbProvider.Drop(ledgerID); err != nil {
+			stateLeveldbProvider.Close()
+			return err
+		}
+	}
+	stateLeveldbProvider.Close()
+	if err := dropStateDBsIfNotLevelDB(ledgerIDs, metricsProvider); err != nil {
+		return err
+	}
+
+	if err := dropLevelDBHandles(ledgerconfig.GetConfigHistoryPath(), "ConfigHistoryDB", ledgerIDs); err != nil {
+		return err
+	}
+
+	bookkeepingProvider := bookkeeping.NewProvider()
+	defer bookkeepingProvider.Close()
+	for _, ledgerID := range ledgerIDs {
+		logger.Infof("Dropping the data of the ledger [%s] from BookkeeperDB at location [%s]", ledgerID, ledgerconfig.GetInternalBookkeeperPath())
+		for _, cat := range []bookkeeping.Category{bookkeeping.PvtdataExpiry, bookkeeping.MetadataPresenceIndicator} {
+			if err := bookkeepingProvider.GetDBHandle(ledgerID, cat).DeleteAll(); err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("error while dropping the data of the ledger [%s] from the BookkeeperDB", ledgerID))
+			}
+		}
+	}
+
+	return dropLevelDBHandles(ledgerconfig.GetHistoryLevelDBPath(), "HistoryDB", ledgerIDs)
+}
+
+// dropStateDBsIfNotLevelDB drops the state of the given ledgers from the configured state database, unless
+// it is goleveldb, which can be dropped without connecting to a database server
+func dropStateDBsIfNotLevelDB(ledgerIDs []string, metricsProvider metrics.Provider) error {
+	if stateDatabase := ledgerconfig.GetStateDatabase(); stateDatabase == "" || stateDatabase == "goleveldb" {
+		return nil
+	}
+	return privacyenabledstate.DropStateDBs(ledgerIDs, metricsProvider)
+}
+
+// dropLevelDBHandles deletes the data of the given ledgers from the leveldb at the given path, in which each
+// ledger maintains its data under a db handle named after the ledger
+func dropLevelDBHandles(dbPath, dbDesc string, ledgerIDs []string) error {
+	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: dbPath})
+	defer dbProvider.Close()
+	for _, ledgerID := range ledgerIDs {
+		logger.Infof("Dropping the data of the ledger [%s] from %s at location [%s]", ledgerID, dbDesc, dbPath)
+		if err := dbProvider.GetDBHandle(ledgerID).DeleteAll(); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error while dropping the data of the ledger [%s] from the %s", ledgerID, dbDesc))
+		}
+	}
+	return nil
+}
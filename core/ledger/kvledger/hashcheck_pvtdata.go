@@ -11,8 +11,10 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric/protos/utils"
 )
 
@@ -57,7 +59,10 @@ func findValidAndInvalidBlockPvtData(blockPvtData *ledger.BlockPvtData, blockSto
 		// (2) validate passed pvtData against the pvtData hash in the tx rwset.
 		logger.Debugf("Constructing valid and invalid pvtData using rwset of blockNum:[%d], txNum:[%d]",
 			blockPvtData.BlockNum, txPvtData.SeqInBlock)
-		validData, invalidData := findValidAndInvalidTxPvtData(txPvtData, txRWSet, blockPvtData.BlockNum)
+		validData, invalidData, err := findValidAndInvalidTxPvtData(txPvtData, txRWSet, blockPvtData.BlockNum, blockStore)
+		if err != nil {
+			return nil, nil, err
+		}
 
 		// (3) append validData to validPvtDataPvt list of this block and
 		// invalidData to invalidPvtData list
@@ -88,16 +93,18 @@ func retrieveRwsetForTx(blkNum uint64, txNum uint64, blockStore *ledgerstorage.S
 	return txRWSet, nil
 }
 
-func findValidAndInvalidTxPvtData(txPvtData *ledger.TxPvtData, txRWSet *rwsetutil.TxRwSet, blkNum uint64) (
-	*ledger.TxPvtData, []*ledger.PvtdataHashMismatch,
-) {
+func findValidAndInvalidTxPvtData(txPvtData *ledger.TxPvtData, txRWSet *rwsetutil.TxRwSet, blkNum uint64,
+	blockStore *ledgerstorage.Store) (*ledger.TxPvtData, []*ledger.PvtdataHashMismatch, error) {
 	var invalidPvtData []*ledger.PvtdataHashMismatch
 	var toDeleteNsColl []*nsColl
 	// Compare the hash of pvtData with the hash present in the rwset to
 	// find valid and invalid pvt data
 	for _, nsRwset := range txPvtData.WriteSet.NsPvtRwset {
 		txNum := txPvtData.SeqInBlock
-		invalidData, invalidNsColl := findInvalidNsPvtData(nsRwset, txRWSet, blkNum, txNum)
+		invalidData, invalidNsColl, err := findInvalidNsPvtData(nsRwset, txRWSet, blkNum, txNum, blockStore)
+		if err != nil {
+			return nil, nil, err
+		}
 		invalidPvtData = append(invalidPvtData, invalidData...)
 		toDeleteNsColl = append(toDeleteNsColl, invalidNsColl...)
 	}
@@ -107,18 +114,17 @@ func findValidAndInvalidTxPvtData(txPvtData *ledger.TxPvtData, txRWSet *rwsetuti
 	if len(txPvtData.WriteSet.NsPvtRwset) == 0 {
 		// denotes that all namespaces had
 		// invalid pvt data
-		return nil, invalidPvtData
+		return nil, invalidPvtData, nil
 	}
-	return txPvtData, invalidPvtData
+	return txPvtData, invalidPvtData, nil
 }
 
 type nsColl struct {
 	ns, coll string
 }
 
-func findInvalidNsPvtData(nsRwset *rwset.NsPvtReadWriteSet, txRWSet *rwsetutil.TxRwSet, blkNum, txNum uint64) (
-	[]*ledger.PvtdataHashMismatch, []*nsColl,
-) {
+func findInvalidNsPvtData(nsRwset *rwset.NsPvtReadWriteSet, txRWSet *rwsetutil.TxRwSet, blkNum, txNum uint64,
+	blockStore *ledgerstorage.Store) ([]*ledger.PvtdataHashMismatch, []*nsColl, error) {
 	var invalidPvtData []*ledger.PvtdataHashMismatch
 	var invalidNsColl []*nsColl
 
@@ -134,6 +140,16 @@ func findInvalidNsPvtData(nsRwset *rwset.NsPvtReadWriteSet, txRWSet *rwsetutil.T
 		}
 
 		if !bytes.Equal(util.ComputeSHA256(collPvtRwset.Rwset), rwsetHash) {
+			// a peer which has purged some keys of the pvtData serves the pvtData without these keys
+			purged, err := isPvtDataWithoutPurgedKeys(ns, collPvtRwset, txRWSet, blkNum, txNum, blockStore)
+			if err != nil {
+				return nil, nil, err
+			}
+			if purged {
+				logger.Debugf("Accepting the pvtData of namespace: %s collection: %s of txNum %d in BlkNum %d "+
+					"without the keys purged afterwards", ns, coll, txNum, blkNum)
+				continue
+			}
 			invalidPvtData = append(invalidPvtData, &ledger.PvtdataHashMismatch{
 				BlockNum:     blkNum,
 				TxNum:        txNum,
@@ -143,5 +159,52 @@ func findInvalidNsPvtData(nsRwset *rwset.NsPvtReadWriteSet, txRWSet *rwsetutil.T
 			invalidNsColl = append(invalidNsColl, &nsColl{ns, coll})
 		}
 	}
-	return invalidPvtData, invalidNsColl
+	return invalidPvtData, invalidNsColl, nil
+}
+
+// isPvtDataWithoutPurgedKeys returns true if the given pvtData of a collection matches the hashed
+// write-set of the collection in the tx rwset once the keys purged after the tx are left out of both
+func isPvtDataWithoutPurgedKeys(ns string, collPvtRwset *rwset.CollectionPvtReadWriteSet, txRWSet *rwsetutil.TxRwSet,
+	blkNum, txNum uint64, blockStore *ledgerstorage.Store) (bool, error) {
+	hashedRwSet := getCollHashedRwSet(txRWSet, ns, collPvtRwset.CollectionName)
+	if hashedRwSet == nil {
+		return false, nil
+	}
+
+	var keyHashes [][]byte
+	for _, hashedWrite := range hashedRwSet.HashedWrites {
+		keyHashes = append(keyHashes, hashedWrite.KeyHash)
+	}
+	for _, metadataWrite := range hashedRwSet.MetadataWrites {
+		keyHashes = append(keyHashes, metadataWrite.KeyHash)
+	}
+	txHeight := version.NewHeight(blkNum, txNum)
+	purgedKeyHashes := make(map[string]bool)
+	for _, keyHash := range keyHashes {
+		purgeHeight, err := blockStore.GetPvtDataPurgeHeight(ns, collPvtRwset.CollectionName, keyHash)
+		if err != nil {
+			return false, err
+		}
+		if purgeHeight != nil && txHeight.Compare(purgeHeight) < 0 {
+			purgedKeyHashes[string(keyHash)] = true
+		}
+	}
+	if len(purgedKeyHashes) == 0 {
+		return false, nil
+	}
+	return rwsetutil.MatchesHashedRwSetWithoutPurgedKeys(collPvtRwset, hashedRwSet, purgedKeyHashes)
+}
+
+func getCollHashedRwSet(txRWSet *rwsetutil.TxRwSet, ns, coll string) *kvrwset.HashedRWSet {
+	for _, nsRwSet := range txRWSet.NsRwSets {
+		if nsRwSet.NameSpace != ns {
+			continue
+		}
+		for _, collHashedRwSet := range nsRwSet.CollHashedRwSets {
+			if collHashedRwSet.CollectionName == coll {
+				return collHashedRwSet.HashedRwSet
+			}
+		}
+	}
+	return nil
 }
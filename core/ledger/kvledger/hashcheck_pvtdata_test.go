@@ -15,7 +15,9 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -139,6 +141,72 @@ func TestConstructValidInvalidBlocksPvtData(t *testing.T) {
 	assert.ElementsMatch(t, expectedHashMismatches, hashMismatches)
 }
 
+func TestConstructValidBlocksPvtDataWithoutPurgedKeys(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+
+	_, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	lg, _ := provider.Create(gb)
+	defer lg.Close()
+	blockStore := lg.(*kvLedger).blockStore
+
+	builder := rwsetutil.NewRWSetBuilder()
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "key-1", []byte("value-1"))
+	builder.AddToPvtAndHashedWriteSet("ns-1", "coll-1", "key-2", []byte("value-2"))
+	simRes, err := builder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	pubSimResBytes, err := proto.Marshal(simRes.PubSimulationResults)
+	assert.NoError(t, err)
+	blk1 := testutil.ConstructBlock(t, 1, gb.Header.Hash(), [][]byte{pubSimResBytes}, false)
+	missingData := make(ledger.TxMissingPvtDataMap)
+	missingData.Add(0, "ns-1", "coll-1", true)
+	assert.NoError(t, blockStore.CommitWithPvtData(&ledger.BlockAndPvtData{Block: blk1, MissingPvtData: missingData}))
+
+	// the pvtData is served by a peer which has purged the key-2
+	purgedPvtData := proto.Clone(simRes.PvtSimulationResults).(*rwset.TxPvtReadWriteSet)
+	key2Purged := map[string]bool{string(util.ComputeStringHash("key-2")): true}
+	_, err = rwsetutil.RemovePurgedKeys(purgedPvtData.NsPvtRwset[0].CollectionPvtRwset[0], key2Purged)
+	assert.NoError(t, err)
+	blocksPvtData := func() []*ledger.BlockPvtData {
+		return []*ledger.BlockPvtData{{
+			BlockNum:  1,
+			WriteSets: map[uint64]*ledger.TxPvtData{0: {SeqInBlock: 0, WriteSet: proto.Clone(purgedPvtData).(*rwset.TxPvtReadWriteSet)}},
+		}}
+	}
+
+	// the purge of the key-2 has not been committed yet
+	blocksValidPvtData, hashMismatches, err := constructValidAndInvalidPvtData(blocksPvtData(), blockStore)
+	assert.NoError(t, err)
+	assert.Empty(t, blocksValidPvtData)
+	assert.Len(t, hashMismatches, 1)
+
+	assert.NoError(t, blockStore.PurgePvtDataKeys([]*ledger.PurgedPvtdataKey{
+		{Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-2"), BlockNum: 2, TxNum: 0},
+	}))
+	blocksValidPvtData, hashMismatches, err = constructValidAndInvalidPvtData(blocksPvtData(), blockStore)
+	assert.NoError(t, err)
+	assert.Empty(t, hashMismatches)
+	assert.Len(t, blocksValidPvtData[1], 1)
+	assert.True(t, proto.Equal(purgedPvtData, blocksValidPvtData[1][0].WriteSet))
+
+	// the pvtData without the purged key must still match the hashes of the remaining keys
+	tamperedPvtData := proto.Clone(purgedPvtData).(*rwset.TxPvtReadWriteSet)
+	tamperedKVRwSet, err := proto.Marshal(&kvrwset.KVRWSet{
+		Writes: []*kvrwset.KVWrite{{Key: "key-1", Value: []byte("tampered-value")}},
+	})
+	assert.NoError(t, err)
+	tamperedPvtData.NsPvtRwset[0].CollectionPvtRwset[0].Rwset = tamperedKVRwSet
+	blocksValidPvtData, hashMismatches, err = constructValidAndInvalidPvtData([]*ledger.BlockPvtData{{
+		BlockNum:  1,
+		WriteSets: map[uint64]*ledger.TxPvtData{0: {SeqInBlock: 0, WriteSet: tamperedPvtData}},
+	}}, blockStore)
+	assert.NoError(t, err)
+	assert.Empty(t, blocksValidPvtData)
+	assert.Len(t, hashMismatches, 1)
+}
+
 func produceSamplePvtdata(t *testing.T, txNum uint64, nsColls []string, values [][]byte) (*ledger.TxPvtData, []byte) {
 	builder := rwsetutil.NewRWSetBuilder()
 	for index, nsColl := range nsColls {
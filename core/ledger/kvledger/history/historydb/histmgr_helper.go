@@ -25,6 +25,8 @@ import (
 // CompositeKeySep is a nil byte used as a separator between different components of a composite key
 var CompositeKeySep = []byte{0x00}
 
+const hashedDataNsJoiner = "$$h"
+
 //ConstructCompositeHistoryKey builds the History Key of namespace~key~blocknum~trannum
 // using an order preserving encoding so that history query results are ordered by height
 func ConstructCompositeHistoryKey(ns string, key string, blocknum uint64, trannum uint64) []byte {
@@ -54,6 +56,20 @@ func ConstructPartialCompositeHistoryKey(ns string, key string, endkey bool) []b
 	return compositeKey
 }
 
+// ConstructBlockBoundHistoryKey builds the History Key namespace~key~blocknum, which
+// precedes the History Keys of all the transactions in the block for the namespace~key
+func ConstructBlockBoundHistoryKey(ns string, key string, blocknum uint64) []byte {
+	compositeKey := ConstructPartialCompositeHistoryKey(ns, key, false)
+	return append(compositeKey, util.EncodeOrderPreservingVarUint64(blocknum)...)
+}
+
+// ConstructHashedDataHistoryNamespace returns the namespace under which the history of the key hashes
+// written to a collection is maintained. As the chaincode names cannot contain the character '$', the
+// returned namespace does not clash with the namespace of any chaincode
+func ConstructHashedDataHistoryNamespace(ns string, coll string) string {
+	return ns + hashedDataNsJoiner + coll
+}
+
 //SplitCompositeHistoryKey splits the key bytes using a separator
 func SplitCompositeHistoryKey(bytesToSplit []byte, separator []byte) ([]byte, []byte) {
 	split := bytes.SplitN(bytesToSplit, separator, 2)
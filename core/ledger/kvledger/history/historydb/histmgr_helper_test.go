@@ -17,8 +17,10 @@ limitations under the License.
 package historydb
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -46,3 +48,16 @@ func TestSplitCompositeKey(t *testing.T) {
 	// second position should hold the extra bytes that were split off
 	assert.Equal(t, []byte("extra bytes to split"), extraBytes)
 }
+
+func TestConstructBlockBoundHistoryKey(t *testing.T) {
+	blockBoundKey := ConstructBlockBoundHistoryKey("ns1", "key1", 5)
+	assert.Equal(t, append([]byte("ns1"+strKeySep+"key1"+strKeySep), util.EncodeOrderPreservingVarUint64(5)...), blockBoundKey)
+
+	// the bound key of a block sorts before the keys of the transactions in the block and after the keys of the previous block
+	assert.True(t, bytes.Compare(blockBoundKey, ConstructCompositeHistoryKey("ns1", "key1", 5, 0)) < 0)
+	assert.True(t, bytes.Compare(ConstructCompositeHistoryKey("ns1", "key1", 4, 1000), blockBoundKey) < 0)
+}
+
+func TestConstructHashedDataHistoryNamespace(t *testing.T) {
+	assert.Equal(t, "ns1$$hcoll1", ConstructHashedDataHistoryNamespace("ns1", "coll1"))
+}
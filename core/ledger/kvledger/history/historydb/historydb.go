@@ -39,4 +39,7 @@ type HistoryDB interface {
 	ShouldRecover(lastAvailableBlock uint64) (bool, uint64, error)
 	CommitLostBlock(blockAndPvtdata *ledger.BlockAndPvtData) error
 	Name() string
+	// VerifyBlock returns an error if the history records for the keys written
+	// by the valid transactions in the given (already committed) block are not present
+	VerifyBlock(block *common.Block) error
 }
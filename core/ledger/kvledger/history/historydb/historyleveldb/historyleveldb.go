@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 )
 
 var logger historydbLogger = flogging.MustGetLogger("historyleveldb")
@@ -84,18 +85,68 @@ func (historyDB *historyDB) Close() {
 func (historyDB *historyDB) Commit(block *common.Block) error {
 
 	blockNo := block.Header.Number
-	//Set the starting tranNo to 0
-	var tranNo uint64
-
 	dbBatch := leveldbhelper.NewUpdateBatch()
 
 	logger.Debugf("Channel [%s]: Updating history database for blockNo [%v] with [%d] transactions",
 		historyDB.dbName, blockNo, len(block.Data.Data))
 
+	tranNo, err := historyDB.forEachValidWrite(block, true, func(ns, writeKey string, tranNo uint64) error {
+		//composite key for history records is in the form ns~key~blockNo~tranNo
+		compositeHistoryKey := historydb.ConstructCompositeHistoryKey(ns, writeKey, blockNo, tranNo)
+
+		// No value is required, write an empty byte array (emptyValue) since Put() of nil is not allowed
+		dbBatch.Put(compositeHistoryKey, emptyValue)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// add savepoint for recovery purpose
+	height := version.NewHeight(blockNo, tranNo)
+	dbBatch.Put(savePointKey, height.ToBytes())
+
+	// write the block's history records and savepoint to LevelDB
+	// Setting sync to true as a precaution, false may be an ok optimization after further testing.
+	if err := historyDB.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+
+	logger.Debugf("Channel [%s]: Updates committed to history database for blockNo [%v]", historyDB.dbName, blockNo)
+	return nil
+}
+
+// VerifyBlock implements method in HistoryDB interface
+func (historyDB *historyDB) VerifyBlock(block *common.Block) error {
+	blockNo := block.Header.Number
+	// the history records for the key hashes are not verified, as they are not present for the blocks
+	// that were committed before the history of the private data hashes started being maintained
+	_, err := historyDB.forEachValidWrite(block, false, func(ns, writeKey string, tranNo uint64) error {
+		val, err := historyDB.db.Get(historydb.ConstructCompositeHistoryKey(ns, writeKey, blockNo, tranNo))
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			return errors.Errorf("the history record for the key [%s] in the namespace [%s] written by the transaction [%d] of the block [%d] is missing",
+				writeKey, ns, tranNo, blockNo)
+		}
+		return nil
+	})
+	return err
+}
+
+// forEachValidWrite invokes the supplied function for each key written by the valid endorser transactions
+// in the block and returns the number of transactions in the block. If includeHashedWrites is true, the function
+// is invoked for each key hash written to a collection as well, with the namespace that is derived via the function
+// historydb.ConstructHashedDataHistoryNamespace
+func (historyDB *historyDB) forEachValidWrite(block *common.Block, includeHashedWrites bool, f func(ns, writeKey string, tranNo uint64) error) (uint64, error) {
+	//Set the starting tranNo to 0
+	var tranNo uint64
+
 	// Get the invalidation byte array for the block
 	txsFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
 
-	// write each tran's write set to history db
+	// process each tran's write set
 	for _, envBytes := range block.Data.Data {
 
 		// If the tran is marked as invalid, skip it
@@ -108,17 +159,17 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 
 		env, err := putils.GetEnvelopeFromBlock(envBytes)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		payload, err := putils.GetPayload(env)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		if common.HeaderType(chdr.Type) == common.HeaderType_ENDORSER_TRANSACTION {
@@ -126,7 +177,7 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 			// extract actions from the envelope message
 			respPayload, err := putils.GetActionFromEnvelope(envBytes)
 			if err != nil {
-				return err
+				return 0, err
 			}
 
 			//preparation for extracting RWSet from transaction
@@ -135,21 +186,28 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 			// Get the Result from the Action and then Unmarshal
 			// it into a TxReadWriteSet using custom unmarshalling
 			if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
-				return err
+				return 0, err
 			}
 			// for each transaction, loop through the namespaces and writesets
-			// and add a history record for each write
 			for _, nsRWSet := range txRWSet.NsRwSets {
 				ns := nsRWSet.NameSpace
 
 				for _, kvWrite := range nsRWSet.KvRwSet.Writes {
-					writeKey := kvWrite.Key
-
-					//composite key for history records is in the form ns~key~blockNo~tranNo
-					compositeHistoryKey := historydb.ConstructCompositeHistoryKey(ns, writeKey, blockNo, tranNo)
+					if err := f(ns, kvWrite.Key, tranNo); err != nil {
+						return 0, err
+					}
+				}
 
-					// No value is required, write an empty byte array (emptyValue) since Put() of nil is not allowed
-					dbBatch.Put(compositeHistoryKey, emptyValue)
+				if !includeHashedWrites {
+					continue
+				}
+				for _, collHashedRwSet := range nsRWSet.CollHashedRwSets {
+					hashedNs := historydb.ConstructHashedDataHistoryNamespace(ns, collHashedRwSet.CollectionName)
+					for _, kvWriteHash := range collHashedRwSet.HashedRwSet.HashedWrites {
+						if err := f(hashedNs, string(kvWriteHash.KeyHash), tranNo); err != nil {
+							return 0, err
+						}
+					}
 				}
 			}
 
@@ -158,19 +216,7 @@ func (historyDB *historyDB) Commit(block *common.Block) error {
 		}
 		tranNo++
 	}
-
-	// add savepoint for recovery purpose
-	height := version.NewHeight(blockNo, tranNo)
-	dbBatch.Put(savePointKey, height.ToBytes())
-
-	// write the block's history records and savepoint to LevelDB
-	// Setting sync to true as a precaution, false may be an ok optimization after further testing.
-	if err := historyDB.db.WriteBatch(dbBatch, true); err != nil {
-		return err
-	}
-
-	logger.Debugf("Channel [%s]: Updates committed to history database for blockNo [%v]", historyDB.dbName, blockNo)
-	return nil
+	return tranNo, nil
 }
 
 // NewHistoryQueryExecutor implements method in HistoryDB interface
@@ -7,9 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package historyleveldb
 
 import (
+	"bytes"
+	"encoding/hex"
+	"math"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
 	commonledger "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
@@ -40,21 +46,106 @@ func (q *LevelHistoryDBQueryExecutor) GetHistoryForKey(namespace string, key str
 
 	// range scan to find any history records starting with namespace~key
 	dbItr := q.historyDB.db.GetIterator(compositeStartKey, compositeEndKey)
-	return newHistoryScanner(compositeStartKey, namespace, key, dbItr, q.blockStore), nil
+	return newHistoryScanner(compositeStartKey, namespace, key, dbItr, q.blockStore, &ledger.HistoryQueryOptions{},
+		func(tranEnvelope *common.Envelope) (*queryresult.KeyModification, error) {
+			return getKeyModificationFromTran(tranEnvelope, namespace, key)
+		},
+	), nil
+}
+
+// GetHistoryForKeyWithOptions implements method in interface `ledger.HistoryQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetHistoryForKeyWithOptions(namespace string, key string,
+	options *ledger.HistoryQueryOptions) (ledger.QueryResultsIterator, error) {
+	return q.getHistoryWithOptions(namespace, key, options,
+		func(tranEnvelope *common.Envelope) (*queryresult.KeyModification, error) {
+			return getKeyModificationFromTran(tranEnvelope, namespace, key)
+		},
+	)
+}
+
+// GetPrivateDataHashHistoryWithOptions implements method in interface `ledger.HistoryQueryExecutor`
+func (q *LevelHistoryDBQueryExecutor) GetPrivateDataHashHistoryWithOptions(namespace, collection string, keyHash []byte,
+	options *ledger.HistoryQueryOptions) (ledger.QueryResultsIterator, error) {
+	hashedNs := historydb.ConstructHashedDataHistoryNamespace(namespace, collection)
+	return q.getHistoryWithOptions(hashedNs, string(keyHash), options,
+		func(tranEnvelope *common.Envelope) (*queryresult.KeyModification, error) {
+			return getKeyHashModificationFromTran(tranEnvelope, namespace, collection, keyHash)
+		},
+	)
+}
+
+func (q *LevelHistoryDBQueryExecutor) getHistoryWithOptions(namespace string, key string, options *ledger.HistoryQueryOptions,
+	keyModificationFromTran func(*common.Envelope) (*queryresult.KeyModification, error)) (ledger.QueryResultsIterator, error) {
+
+	if ledgerconfig.IsHistoryDBEnabled() == false {
+		return nil, errors.New("history database not enabled")
+	}
+	if options == nil {
+		options = &ledger.HistoryQueryOptions{}
+	}
+	if options.EndBlock != 0 && options.StartBlock > options.EndBlock {
+		return nil, errors.Errorf("invalid block range: the start block [%d] is greater than the end block [%d]", options.StartBlock, options.EndBlock)
+	}
+
+	compositePartialKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, false)
+	compositeStartKey := compositePartialKey
+	if options.StartBlock > 0 {
+		compositeStartKey = historydb.ConstructBlockBoundHistoryKey(namespace, key, options.StartBlock)
+	}
+	compositeEndKey := historydb.ConstructPartialCompositeHistoryKey(namespace, key, true)
+	if options.EndBlock != 0 && options.EndBlock < math.MaxUint64 {
+		compositeEndKey = historydb.ConstructBlockBoundHistoryKey(namespace, key, options.EndBlock+1)
+	}
+
+	// the bookmark is the blockNumTranNum suffix of the history key from which the scan is to be resumed
+	if options.Bookmark != "" {
+		blockNumTranNumBytes, err := hex.DecodeString(options.Bookmark)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid bookmark [%s]", options.Bookmark)
+		}
+		bookmarkKey := append(append([]byte{}, compositePartialKey...), blockNumTranNumBytes...)
+		if !options.Descending && bytes.Compare(bookmarkKey, compositeStartKey) > 0 {
+			compositeStartKey = bookmarkKey
+		}
+		// the end key is exclusive and hence, the smallest key that is greater than the bookmark key is used
+		bookmarkEndKey := append(bookmarkKey, 0x00)
+		if options.Descending && bytes.Compare(bookmarkEndKey, compositeEndKey) < 0 {
+			compositeEndKey = bookmarkEndKey
+		}
+	}
+
+	dbItr := q.historyDB.db.GetIterator(compositeStartKey, compositeEndKey)
+	return newHistoryScanner(compositePartialKey, namespace, key, dbItr, q.blockStore, options, keyModificationFromTran), nil
 }
 
 //historyScanner implements ResultsIterator for iterating through history results
 type historyScanner struct {
-	compositePartialKey []byte //compositePartialKey includes namespace~key
-	namespace           string
-	key                 string
-	dbItr               iterator.Iterator
-	blockStore          blkstorage.BlockStore
+	compositePartialKey     []byte //compositePartialKey includes namespace~key
+	namespace               string
+	key                     string
+	dbItr                   iterator.Iterator
+	blockStore              blkstorage.BlockStore
+	options                 *ledger.HistoryQueryOptions
+	keyModificationFromTran func(*common.Envelope) (*queryresult.KeyModification, error)
+	positioned              bool
 }
 
 func newHistoryScanner(compositePartialKey []byte, namespace string, key string,
-	dbItr iterator.Iterator, blockStore blkstorage.BlockStore) *historyScanner {
-	return &historyScanner{compositePartialKey, namespace, key, dbItr, blockStore}
+	dbItr iterator.Iterator, blockStore blkstorage.BlockStore, options *ledger.HistoryQueryOptions,
+	keyModificationFromTran func(*common.Envelope) (*queryresult.KeyModification, error)) *historyScanner {
+	return &historyScanner{compositePartialKey, namespace, key, dbItr, blockStore, options, keyModificationFromTran, false}
+}
+
+// moveNext moves the underlying db iterator to the next history record, as per the order in the options
+func (scanner *historyScanner) moveNext() bool {
+	if !scanner.options.Descending {
+		return scanner.dbItr.Next()
+	}
+	if !scanner.positioned {
+		scanner.positioned = true
+		return scanner.dbItr.Last()
+	}
+	return scanner.dbItr.Prev()
 }
 
 // Next iterates to the next key from history scanner, decodes blockNumTranNumBytes to get blockNum and tranNum,
@@ -69,7 +160,7 @@ func newHistoryScanner(compositePartialKey []byte, namespace string, key string,
 // return a history query result out of the order.
 func (scanner *historyScanner) Next() (commonledger.QueryResult, error) {
 	for {
-		if !scanner.dbItr.Next() {
+		if !scanner.moveNext() {
 			return nil, nil
 		}
 		historyKey := scanner.dbItr.Key() // history key is in the form namespace~key~blocknum~trannum
@@ -116,7 +207,7 @@ func (scanner *historyScanner) Next() (commonledger.QueryResult, error) {
 		}
 
 		// Get the txid, key write value, timestamp, and delete indicator associated with this transaction
-		queryResult, err := getKeyModificationFromTran(tranEnvelope, scanner.namespace, scanner.key)
+		queryResult, err := scanner.keyModificationFromTran(tranEnvelope)
 		if err != nil {
 			return nil, err
 		}
@@ -129,52 +220,68 @@ func (scanner *historyScanner) Next() (commonledger.QueryResult, error) {
 				historyKey, scanner.key)
 			continue
 		}
+		if !scanner.inTimeRange(queryResult.Timestamp) {
+			logger.Debugf("Skipping the history record for namespace:%s key:%s from transaction %s as it is outside the time range",
+				scanner.namespace, scanner.key, queryResult.TxId)
+			continue
+		}
 		logger.Debugf("Found historic key value for namespace:%s key:%s from transaction %s",
-			scanner.namespace, scanner.key, queryResult.(*queryresult.KeyModification).TxId)
+			scanner.namespace, scanner.key, queryResult.TxId)
 		return queryResult, nil
 	}
 }
 
+// inTimeRange returns true if the given transaction timestamp is not before the start time
+// and before the end time, as set in the options
+func (scanner *historyScanner) inTimeRange(ts *timestamp.Timestamp) bool {
+	if startTime := scanner.options.StartTime; startTime != nil && compareTimestamps(ts, startTime) < 0 {
+		return false
+	}
+	if endTime := scanner.options.EndTime; endTime != nil && compareTimestamps(ts, endTime) >= 0 {
+		return false
+	}
+	return true
+}
+
 func (scanner *historyScanner) Close() {
 	scanner.dbItr.Release()
 }
 
-// getTxIDandKeyWriteValueFromTran inspects a transaction for writes to a given key
-func getKeyModificationFromTran(tranEnvelope *common.Envelope, namespace string, key string) (commonledger.QueryResult, error) {
-	logger.Debugf("Entering getKeyModificationFromTran()\n", namespace, key)
-
-	// extract action from the envelope
-	payload, err := putils.GetPayload(tranEnvelope)
-	if err != nil {
-		return nil, err
+// GetBookmarkAndClose returns the position of the next history record in the underlying db iterator,
+// which is used for resuming the scan, and releases the iterator. An empty bookmark is returned if
+// there are no more records
+func (scanner *historyScanner) GetBookmarkAndClose() string {
+	defer scanner.Close()
+	if !scanner.moveNext() {
+		return ""
 	}
+	_, blockNumTranNumBytes := historydb.SplitCompositeHistoryKey(scanner.dbItr.Key(), scanner.compositePartialKey)
+	return hex.EncodeToString(blockNumTranNumBytes)
+}
 
-	tx, err := putils.GetTransaction(payload.Data)
-	if err != nil {
-		return nil, err
+func compareTimestamps(ts1, ts2 *timestamp.Timestamp) int {
+	switch {
+	case ts1.GetSeconds() < ts2.GetSeconds():
+		return -1
+	case ts1.GetSeconds() > ts2.GetSeconds():
+		return 1
+	case ts1.GetNanos() < ts2.GetNanos():
+		return -1
+	case ts1.GetNanos() > ts2.GetNanos():
+		return 1
 	}
+	return 0
+}
 
-	_, respPayload, err := putils.GetPayloads(tx.Actions[0])
-	if err != nil {
-		return nil, err
-	}
+// getKeyModificationFromTran inspects a transaction for writes to a given key
+func getKeyModificationFromTran(tranEnvelope *common.Envelope, namespace string, key string) (*queryresult.KeyModification, error) {
+	logger.Debugf("Entering getKeyModificationFromTran()\n", namespace, key)
 
-	chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	txID, timestamp, txRWSet, err := getTxRWSetFromTran(tranEnvelope)
 	if err != nil {
 		return nil, err
 	}
 
-	txID := chdr.TxId
-	timestamp := chdr.Timestamp
-
-	txRWSet := &rwsetutil.TxRwSet{}
-
-	// Get the Result from the Action and then Unmarshal
-	// it into a TxReadWriteSet using custom unmarshalling
-	if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
-		return nil, err
-	}
-
 	// look for the namespace and key by looping through the transaction's ReadWriteSets
 	for _, nsRWSet := range txRWSet.NsRwSets {
 		if nsRWSet.NameSpace == namespace {
@@ -193,6 +300,67 @@ func getKeyModificationFromTran(tranEnvelope *common.Envelope, namespace string,
 	return nil, nil
 }
 
+// getKeyHashModificationFromTran inspects a transaction for writes to a given key hash in a collection. The value
+// of the returned KeyModification is the hash of the value
+func getKeyHashModificationFromTran(tranEnvelope *common.Envelope, namespace, collection string, keyHash []byte) (*queryresult.KeyModification, error) {
+	txID, timestamp, txRWSet, err := getTxRWSetFromTran(tranEnvelope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nsRWSet := range txRWSet.NsRwSets {
+		if nsRWSet.NameSpace != namespace {
+			continue
+		}
+		for _, collHashedRwSet := range nsRWSet.CollHashedRwSets {
+			if collHashedRwSet.CollectionName != collection {
+				continue
+			}
+			for _, kvWriteHash := range collHashedRwSet.HashedRwSet.HashedWrites {
+				if bytes.Equal(kvWriteHash.KeyHash, keyHash) {
+					return &queryresult.KeyModification{TxId: txID, Value: kvWriteHash.ValueHash,
+						Timestamp: timestamp, IsDelete: kvWriteHash.IsDelete}, nil
+				}
+			}
+		}
+	}
+	logger.Debugf("key hash [%#v] not found in the collection [%s] of namespace [%s] in the transaction's ReadWriteSets", keyHash, collection, namespace)
+	return nil, nil
+}
+
+// getTxRWSetFromTran returns the txid, the timestamp, and the read-write set of a transaction
+func getTxRWSetFromTran(tranEnvelope *common.Envelope) (string, *timestamp.Timestamp, *rwsetutil.TxRwSet, error) {
+	// extract action from the envelope
+	payload, err := putils.GetPayload(tranEnvelope)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	tx, err := putils.GetTransaction(payload.Data)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	_, respPayload, err := putils.GetPayloads(tx.Actions[0])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	txRWSet := &rwsetutil.TxRwSet{}
+
+	// Get the Result from the Action and then Unmarshal
+	// it into a TxReadWriteSet using custom unmarshalling
+	if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+		return "", nil, nil, err
+	}
+	return chdr.TxId, chdr.Timestamp, txRWSet, nil
+}
+
 // decodeBlockNumTranNum decodes blockNumTranNumBytes to get blockNum and tranNum.
 func decodeBlockNumTranNum(blockNumTranNumBytes []byte) (uint64, uint64, error) {
 	blockNum, blockBytesConsumed, err := util.DecodeOrderPreservingVarUint64(blockNumTranNumBytes)
@@ -12,14 +12,18 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/blkstorage"
 	"github.com/hyperledger/fabric/common/ledger/testutil"
 	util2 "github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb/historyleveldb/fakes"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/ledger/queryresult"
@@ -520,6 +524,244 @@ func TestHistoryWithBlockNumber256(t *testing.T) {
 	assert.Equal(t, "value256", valueInBlock256)
 }
 
+func TestVerifyBlock(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	bg, gb := testutil.NewBlockGenerator(t, "ledger1", false)
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+	assert.NoError(t, env.testHistoryDB.VerifyBlock(gb))
+
+	txid := util2.GenerateUUID()
+	simulator, _ := env.txmgr.NewTxSimulator(txid)
+	simulator.SetState("ns1", "key1", []byte("value1"))
+	simulator.Done()
+	simRes, _ := simulator.GetTxSimulationResults()
+	pubSimResBytes, _ := simRes.GetPubSimulationBytes()
+	block1 := bg.NextBlock([][]byte{pubSimResBytes})
+
+	// the block is not yet committed to the history database
+	err := env.testHistoryDB.VerifyBlock(block1)
+	assert.EqualError(t, err, "the history record for the key [key1] in the namespace [ns1] written by the transaction [0] of the block [1] is missing")
+
+	assert.NoError(t, env.testHistoryDB.Commit(block1))
+	assert.NoError(t, env.testHistoryDB.VerifyBlock(block1))
+}
+
+func TestHistoryWithOptions(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	store1 := testutilCommitBlocksWithWrites(t, env, "ledger1", 10)
+	defer store1.Shutdown()
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err, "Error upon NewHistoryQueryExecutor")
+
+	testcases := []struct {
+		name         string
+		options      *ledger.HistoryQueryOptions
+		expectedVals []string
+	}{
+		{
+			name:         "nil-options",
+			options:      nil,
+			expectedVals: []string{"value1", "value2", "value3", "value4", "value5", "value6", "value7", "value8", "value9", "value10"},
+		},
+		{
+			name:         "start-block",
+			options:      &ledger.HistoryQueryOptions{StartBlock: 8},
+			expectedVals: []string{"value8", "value9", "value10"},
+		},
+		{
+			name:         "end-block",
+			options:      &ledger.HistoryQueryOptions{EndBlock: 2},
+			expectedVals: []string{"value1", "value2"},
+		},
+		{
+			name:         "block-range",
+			options:      &ledger.HistoryQueryOptions{StartBlock: 4, EndBlock: 6},
+			expectedVals: []string{"value4", "value5", "value6"},
+		},
+		{
+			name:         "single-block",
+			options:      &ledger.HistoryQueryOptions{StartBlock: 5, EndBlock: 5},
+			expectedVals: []string{"value5"},
+		},
+		{
+			name:         "descending",
+			options:      &ledger.HistoryQueryOptions{Descending: true},
+			expectedVals: []string{"value10", "value9", "value8", "value7", "value6", "value5", "value4", "value3", "value2", "value1"},
+		},
+		{
+			name:         "descending-block-range",
+			options:      &ledger.HistoryQueryOptions{StartBlock: 4, EndBlock: 6, Descending: true},
+			expectedVals: []string{"value6", "value5", "value4"},
+		},
+		{
+			name:         "block-range-beyond-height",
+			options:      &ledger.HistoryQueryOptions{StartBlock: 20, EndBlock: 30},
+			expectedVals: []string{},
+		},
+		{
+			name:         "start-time-in-future",
+			options:      &ledger.HistoryQueryOptions{StartTime: &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()}},
+			expectedVals: []string{},
+		},
+		{
+			name: "time-range",
+			options: &ledger.HistoryQueryOptions{
+				StartBlock: 9,
+				StartTime:  &timestamp.Timestamp{Seconds: time.Now().Add(-time.Hour).Unix()},
+				EndTime:    &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()},
+			},
+			expectedVals: []string{"value9", "value10"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", tc.options)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedVals, testutilRetrieveValues(t, itr))
+		})
+	}
+
+	t.Run("end-time-is-exclusive", func(t *testing.T) {
+		itr, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", &ledger.HistoryQueryOptions{StartBlock: 1, EndBlock: 1})
+		assert.NoError(t, err)
+		kmod, err := itr.Next()
+		assert.NoError(t, err)
+		itr.Close()
+		ts := kmod.(*queryresult.KeyModification).Timestamp
+
+		itr, err = qhistory.GetHistoryForKeyWithOptions("ns1", "key", &ledger.HistoryQueryOptions{StartBlock: 1, EndBlock: 1, StartTime: ts})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"value1"}, testutilRetrieveValues(t, itr))
+		itr, err = qhistory.GetHistoryForKeyWithOptions("ns1", "key", &ledger.HistoryQueryOptions{StartBlock: 1, EndBlock: 1, EndTime: ts})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, testutilRetrieveValues(t, itr))
+	})
+
+	t.Run("invalid-block-range", func(t *testing.T) {
+		_, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", &ledger.HistoryQueryOptions{StartBlock: 6, EndBlock: 4})
+		assert.EqualError(t, err, "invalid block range: the start block [6] is greater than the end block [4]")
+	})
+
+	t.Run("invalid-bookmark", func(t *testing.T) {
+		_, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", &ledger.HistoryQueryOptions{Bookmark: "not-a-hex-string"})
+		assert.Contains(t, err.Error(), "invalid bookmark [not-a-hex-string]")
+	})
+
+	t.Run("history-disabled", func(t *testing.T) {
+		viper.Set("ledger.history.enableHistoryDatabase", "false")
+		defer viper.Set("ledger.history.enableHistoryDatabase", "true")
+		_, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", nil)
+		assert.EqualError(t, err, "history database not enabled")
+		_, err = qhistory.GetPrivateDataHashHistoryWithOptions("ns1", "coll1", util.ComputeStringHash("pvtkey"), nil)
+		assert.EqualError(t, err, "history database not enabled")
+	})
+}
+
+func TestHistoryWithOptionsPagination(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	store1 := testutilCommitBlocksWithWrites(t, env, "ledger1", 10)
+	defer store1.Shutdown()
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err, "Error upon NewHistoryQueryExecutor")
+
+	testcases := []struct {
+		name          string
+		options       *ledger.HistoryQueryOptions
+		expectedPages [][]string
+	}{
+		{
+			name:          "ascending",
+			options:       &ledger.HistoryQueryOptions{},
+			expectedPages: [][]string{{"value1", "value2", "value3", "value4"}, {"value5", "value6", "value7", "value8"}, {"value9", "value10"}},
+		},
+		{
+			name:          "descending",
+			options:       &ledger.HistoryQueryOptions{Descending: true},
+			expectedPages: [][]string{{"value10", "value9", "value8", "value7"}, {"value6", "value5", "value4", "value3"}, {"value2", "value1"}},
+		},
+		{
+			name:          "descending-block-range",
+			options:       &ledger.HistoryQueryOptions{StartBlock: 2, EndBlock: 7, Descending: true},
+			expectedPages: [][]string{{"value7", "value6", "value5", "value4"}, {"value3", "value2"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			options := *tc.options
+			for i, expectedPage := range tc.expectedPages {
+				itr, err := qhistory.GetHistoryForKeyWithOptions("ns1", "key", &options)
+				assert.NoError(t, err)
+				page := []string{}
+				for len(page) < 4 {
+					kmod, err := itr.Next()
+					assert.NoError(t, err)
+					if kmod == nil {
+						break
+					}
+					page = append(page, string(kmod.(*queryresult.KeyModification).Value))
+				}
+				assert.Equal(t, expectedPage, page)
+				options.Bookmark = itr.GetBookmarkAndClose()
+				if i == len(tc.expectedPages)-1 {
+					assert.Equal(t, "", options.Bookmark)
+				} else {
+					assert.NotEqual(t, "", options.Bookmark)
+				}
+			}
+		})
+	}
+}
+
+func TestPrivateDataHashHistory(t *testing.T) {
+	env := newTestHistoryEnv(t)
+	defer env.cleanup()
+	store1 := testutilCommitBlocksWithWrites(t, env, "ledger1", 5)
+	defer store1.Shutdown()
+
+	qhistory, err := env.testHistoryDB.NewHistoryQueryExecutor(store1)
+	assert.NoError(t, err, "Error upon NewHistoryQueryExecutor")
+
+	keyHash := util.ComputeStringHash("pvtkey")
+	itr, err := qhistory.GetPrivateDataHashHistoryWithOptions("ns1", "coll1", keyHash, &ledger.HistoryQueryOptions{StartBlock: 2, Descending: true})
+	assert.NoError(t, err)
+	expectedValueHashes := [][]byte{}
+	for i := 5; i >= 2; i-- {
+		expectedValueHashes = append(expectedValueHashes, util.ComputeStringHash(fmt.Sprintf("pvtvalue%d", i)))
+	}
+	valueHashes := [][]byte{}
+	for {
+		kmod, err := itr.Next()
+		assert.NoError(t, err)
+		if kmod == nil {
+			break
+		}
+		assert.NotEqual(t, "", kmod.(*queryresult.KeyModification).TxId)
+		valueHashes = append(valueHashes, kmod.(*queryresult.KeyModification).Value)
+	}
+	assert.Equal(t, expectedValueHashes, valueHashes)
+
+	// the history of the hashed key is not exposed as the history of a public key
+	publicItr, err := qhistory.GetHistoryForKey("ns1", string(keyHash))
+	assert.NoError(t, err)
+	kmod, err := publicItr.Next()
+	assert.NoError(t, err)
+	assert.Nil(t, kmod)
+
+	// no history for other collections
+	itr, err = qhistory.GetPrivateDataHashHistoryWithOptions("ns1", "coll2", keyHash, nil)
+	assert.NoError(t, err)
+	kmod, err = itr.Next()
+	assert.NoError(t, err)
+	assert.Nil(t, kmod)
+}
+
 func TestName(t *testing.T) {
 	env := newTestHistoryEnv(t)
 	defer env.cleanup()
@@ -543,6 +785,45 @@ func testutilVerifyResults(t *testing.T, hqe ledger.HistoryQueryExecutor, ns, ke
 	assert.Equal(t, expectedVals, retrievedVals)
 }
 
+// testutilCommitBlocksWithWrites commits the given number of blocks (in addition to the genesis block), each with a
+// single transaction that sets "ns1", "key" to "value<blockNum>" and the private data "ns1", "coll1", "pvtkey" to "pvtvalue<blockNum>"
+func testutilCommitBlocksWithWrites(t *testing.T, env *levelDBLockBasedHistoryEnv, ledgerid string, numBlocks int) blkstorage.BlockStore {
+	store, err := env.testBlockStorageEnv.provider.OpenBlockStore(ledgerid)
+	assert.NoError(t, err, "Error upon provider.OpenBlockStore()")
+
+	bg, gb := testutil.NewBlockGenerator(t, ledgerid, false)
+	assert.NoError(t, store.AddBlock(gb))
+	assert.NoError(t, env.testHistoryDB.Commit(gb))
+
+	for i := 1; i <= numBlocks; i++ {
+		rwsetBuilder := rwsetutil.NewRWSetBuilder()
+		rwsetBuilder.AddToWriteSet("ns1", "key", []byte(fmt.Sprintf("value%d", i)))
+		rwsetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "pvtkey", []byte(fmt.Sprintf("pvtvalue%d", i)))
+		simRes, err := rwsetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		pubSimResBytes, err := simRes.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		block := bg.NextBlock([][]byte{pubSimResBytes})
+		assert.NoError(t, store.AddBlock(block))
+		assert.NoError(t, env.testHistoryDB.Commit(block))
+	}
+	return store
+}
+
+func testutilRetrieveValues(t *testing.T, itr ledger.QueryResultsIterator) []string {
+	defer itr.Close()
+	retrievedVals := []string{}
+	for {
+		kmod, err := itr.Next()
+		assert.NoError(t, err)
+		if kmod == nil {
+			break
+		}
+		retrievedVals = append(retrievedVals, string(kmod.(*queryresult.KeyModification).Value))
+	}
+	return retrievedVals
+}
+
 // testutilCheckKeyInRange check if falseKey falls in range query when searching for desiredKey
 func testutilCheckKeyInRange(t *testing.T, hqe ledger.HistoryQueryExecutor, ns, desiredKey, falseKey string, expectedMatchCount int) {
 	itr, err := hqe.GetHistoryForKey(ns, desiredKey)
@@ -8,6 +8,7 @@ package kvledger
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -41,11 +42,16 @@ type kvLedger struct {
 	ledgerID               string
 	blockStore             *ledgerstorage.Store
 	txtmgmt                txmgr.TxMgr
+	versionedDB            privacyenabledstate.DB
 	historyDB              historydb.HistoryDB
 	configHistoryRetriever ledger.ConfigHistoryRetriever
 	blockAPIsRWLock        *sync.RWMutex
 	stats                  *ledgerStats
 	commitHash             []byte
+	// retentionPolicy is the policy used for pruning the blocks every pruneInterval blocks
+	// after they are committed. It is nil if the blocks are never pruned
+	retentionPolicy commonledger.PrunePolicy
+	pruneInterval   uint64
 }
 
 // NewKVLedger constructs new `KVLedger`
@@ -63,10 +69,16 @@ func newKVLedger(
 	logger.Debugf("Creating KVLedger ledgerID=%s: ", ledgerID)
 	// Create a kvLedger for this chain/ledger, which encasulates the underlying
 	// id store, blockstore, txmgr (state database), history database
-	l := &kvLedger{ledgerID: ledgerID, blockStore: blockStore, historyDB: historyDB, blockAPIsRWLock: &sync.RWMutex{}}
+	l := &kvLedger{ledgerID: ledgerID, blockStore: blockStore, versionedDB: versionedDB, historyDB: historyDB, blockAPIsRWLock: &sync.RWMutex{}}
 
-	// Retrieves the current commit hash from the blockstore
 	var err error
+	l.retentionPolicy, err = retentionPolicy(ledgerID)
+	if err != nil {
+		return nil, err
+	}
+	l.pruneInterval = ledgerconfig.GetBlockRetentionPruneInterval()
+
+	// Retrieves the current commit hash from the blockstore
 	l.commitHash, err = l.lastPersistedCommitHash()
 	if err != nil {
 		return nil, err
@@ -85,13 +97,12 @@ func newKVLedger(
 		return nil, err
 	}
 	l.initBlockStore(btlPolicy)
+	l.stats = stats
 	//Recover both state DB and history DB if they are out of sync with block storage
 	if err := l.recoverDBs(); err != nil {
 		return nil, err
 	}
 	l.configHistoryRetriever = configHistoryMgr.GetRetriever(ledgerID, l)
-
-	l.stats = stats
 	return l, nil
 }
 
@@ -121,14 +132,17 @@ func (l *kvLedger) lastPersistedCommitHash() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	return commitHashFromBlock(block)
+}
 
+func commitHashFromBlock(block *common.Block) ([]byte, error) {
 	if len(block.Metadata.Metadata) < int(common.BlockMetadataIndex_COMMIT_HASH+1) {
 		logger.Debugf("Last block metadata does not contain commit hash")
 		return nil, nil
 	}
 
 	commitHash := &common.Metadata{}
-	err = proto.Unmarshal(block.Metadata.Metadata[common.BlockMetadataIndex_COMMIT_HASH], commitHash)
+	err := proto.Unmarshal(block.Metadata.Metadata[common.BlockMetadataIndex_COMMIT_HASH], commitHash)
 	if err != nil {
 		return nil, errors.Wrap(err, "error unmarshaling last persisted commit hash")
 	}
@@ -249,21 +263,39 @@ func (l *kvLedger) filterYetToCommitBlocks(blocksPvtData map[uint64][]*ledger.Tx
 	return nil
 }
 
+// recoveryProgressInterval is the number of recommitted blocks after which the progress of the recovery is logged
+const recoveryProgressInterval = 1000
+
 //recommitLostBlocks retrieves blocks in specified range and commit the write set to either
 //state DB or history DB or both
 func (l *kvLedger) recommitLostBlocks(firstBlockNum uint64, lastBlockNum uint64, recoverables ...recoverable) error {
 	logger.Infof("Recommitting lost blocks - firstBlockNum=%d, lastBlockNum=%d, recoverables=%#v", firstBlockNum, lastBlockNum, recoverables)
 	var err error
 	var blockAndPvtdata *ledger.BlockAndPvtData
+	l.stats.updateRecoveryRemainingBlocks(lastBlockNum - firstBlockNum + 1)
 	for blockNumber := firstBlockNum; blockNumber <= lastBlockNum; blockNumber++ {
 		if blockAndPvtdata, err = l.GetPvtDataAndBlockByNum(blockNumber, nil); err != nil {
 			return err
 		}
+		purgedKeys, err := purgedPvtdataKeys(blockAndPvtdata.Block)
+		if err != nil {
+			return err
+		}
+		if err = l.purgePvtdataKeys(blockNumber, purgedKeys); err != nil {
+			return err
+		}
 		for _, r := range recoverables {
 			if err := r.CommitLostBlock(blockAndPvtdata); err != nil {
 				return err
 			}
 		}
+		l.stats.updateRecoveryRemainingBlocks(lastBlockNum - blockNumber)
+		l.stats.incrementRecoveryRecommittedBlocks()
+		// log the progress periodically so that it can be tracked while recovering (or rebuilding) large databases
+		if numRecommitted := blockNumber - firstBlockNum + 1; numRecommitted%recoveryProgressInterval == 0 {
+			logger.Infof("Recovery progress of the ledger [%s]: recommitted %d of %d blocks (%.1f%%)", l.ledgerID,
+				numRecommitted, lastBlockNum-firstBlockNum+1, float64(numRecommitted)*100/float64(lastBlockNum-firstBlockNum+1))
+		}
 	}
 	logger.Infof("Recommitted lost blocks - firstBlockNum=%d, lastBlockNum=%d, recoverables=%#v", firstBlockNum, lastBlockNum, recoverables)
 	return nil
@@ -336,9 +368,82 @@ func (l *kvLedger) GetTxValidationCodeByTxID(txID string) (peer.TxValidationCode
 	return txValidationCode, err
 }
 
-//Prune prunes the blocks/transactions that satisfy the given policy
+// Prune prunes the blocks/transactions that satisfy the given policy.
+// The block store retains the block file that is currently being appended to and hence,
+// the number of blocks retained may be higher than the number of blocks specified by the policy.
+// Irrespective of the policy, the last config block and the blocks after it are always retained,
+// as these are required for joining the channel and for generating a snapshot of the ledger
 func (l *kvLedger) Prune(policy commonledger.PrunePolicy) error {
-	return errors.New("not yet implemented")
+	l.blockAPIsRWLock.Lock()
+	defer l.blockAPIsRWLock.Unlock()
+	return l.prune(policy)
+}
+
+// prune prunes the blocks as per the given policy. The caller is expected to hold the blockAPIsRWLock
+func (l *kvLedger) prune(policy commonledger.PrunePolicy) error {
+	bcInfo, err := l.blockStore.GetBlockchainInfo()
+	if err != nil {
+		return err
+	}
+	if bcInfo.Height == 0 {
+		return nil
+	}
+	lastBlock, err := l.blockStore.RetrieveBlockByNumber(bcInfo.Height - 1)
+	if err != nil {
+		return err
+	}
+	lastConfigBlockNum, err := utils.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		return errors.WithMessage(err, "error retrieving the last config block index")
+	}
+
+	var pruneBeforeBlockNum uint64
+	var archiveDir string
+	switch p := policy.(type) {
+	case *ledger.KeepLastNBlocksPrunePolicy:
+		if bcInfo.Height <= p.NumBlocks {
+			logger.Debugf("[%s] Ledger height [%d] does not exceed the number of blocks to retain [%d]", l.ledgerID, bcInfo.Height, p.NumBlocks)
+			return nil
+		}
+		pruneBeforeBlockNum = bcInfo.Height - p.NumBlocks
+		if pruneBeforeBlockNum > lastConfigBlockNum {
+			logger.Debugf("[%s] Retaining the blocks from the last config block [%d]", l.ledgerID, lastConfigBlockNum)
+			pruneBeforeBlockNum = lastConfigBlockNum
+		}
+		archiveDir = p.ArchiveDir
+	case *ledger.ConfigBlockCheckpointPrunePolicy:
+		pruneBeforeBlockNum = lastConfigBlockNum
+		archiveDir = p.ArchiveDir
+	default:
+		return errors.Errorf("unsupported prune policy type [%T]", policy)
+	}
+
+	logger.Infof("[%s] Pruning blocks before block [%d]", l.ledgerID, pruneBeforeBlockNum)
+	return l.blockStore.PruneBlocksBefore(pruneBeforeBlockNum, archiveDir)
+}
+
+// retentionPolicy returns the policy configured for pruning the blocks of the given ledger after
+// they are committed, or nil if the blocks are never pruned. The pruned block files of each ledger
+// are archived in a subdirectory of the configured archive dir named after the ledger
+func retentionPolicy(ledgerID string) (commonledger.PrunePolicy, error) {
+	archiveDir := ledgerconfig.GetBlockRetentionArchiveDir()
+	if archiveDir != "" {
+		archiveDir = filepath.Join(archiveDir, ledgerID)
+	}
+	switch policy := ledgerconfig.GetBlockRetentionPolicy(); policy {
+	case "":
+		return nil, nil
+	case "keepLastNBlocks":
+		numBlocks := ledgerconfig.GetBlockRetentionNumBlocks()
+		if numBlocks == 0 {
+			return nil, errors.New("the block retention policy [keepLastNBlocks] requires a number of blocks greater than zero")
+		}
+		return &ledger.KeepLastNBlocksPrunePolicy{NumBlocks: numBlocks, ArchiveDir: archiveDir}, nil
+	case "configBlockCheckpoint":
+		return &ledger.ConfigBlockCheckpointPrunePolicy{ArchiveDir: archiveDir}, nil
+	default:
+		return nil, errors.Errorf("unsupported block retention policy [%s]", policy)
+	}
 }
 
 // NewTxSimulator returns new `ledger.TxSimulator`
@@ -404,12 +509,22 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData, co
 		l.addBlockCommitHash(pvtdataAndBlock.Block, updateBatchBytes)
 	}
 
+	purgedKeys, err := purgedPvtdataKeys(block)
+	if err != nil {
+		return err
+	}
+
 	logger.Debugf("[%s] Committing block [%d] to storage", l.ledgerID, blockNo)
 	l.blockAPIsRWLock.Lock()
 	defer l.blockAPIsRWLock.Unlock()
 	if err = l.blockStore.CommitWithPvtData(pvtdataAndBlock); err != nil {
 		return err
 	}
+	// if the purge fails, the state DB is left behind the block store and hence,
+	// the block along with its purges gets recommitted when the ledger is reopened
+	if err = l.purgePvtdataKeys(blockNo, purgedKeys); err != nil {
+		return errors.WithMessage(err, "error during purge of private data")
+	}
 	elapsedBlockstorageAndPvtdataCommit := time.Since(startBlockstorageAndPvtdataCommit)
 
 	startCommitState := time.Now()
@@ -428,6 +543,16 @@ func (l *kvLedger) CommitWithPvtData(pvtdataAndBlock *ledger.BlockAndPvtData, co
 		}
 	}
 
+	// The blocks are pruned after the state and history databases are committed, as they
+	// are recovered from the block store when found behind it. A failure to prune does not
+	// fail the commit, the blocks are pruned at one of the next intervals instead
+	if l.retentionPolicy != nil && (blockNo+1)%l.pruneInterval == 0 {
+		logger.Debugf("[%s] Pruning blocks after committing block [%d]", l.ledgerID, blockNo)
+		if err := l.prune(l.retentionPolicy); err != nil {
+			logger.Warningf("[%s] Failed pruning blocks after committing block [%d]: %s", l.ledgerID, blockNo, err)
+		}
+	}
+
 	logger.Infof("[%s] Committed block [%d] with %d transaction(s) in %dms (state_validation=%dms block_and_pvtdata_commit=%dms state_commit=%dms)"+
 		" commitHash=[%x]",
 		l.ledgerID, block.Header.Number, len(block.Data.Data),
@@ -479,17 +604,38 @@ func (l *kvLedger) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledge
 	return l.blockStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// ListMissingPvtData returns the details of the private data missing in the blocks within the range
+// [startBlock, endBlock], including the data of the collections the peer is not eligible for
+func (l *kvLedger) ListMissingPvtData(startBlock, endBlock uint64) ([]*ledger.MissingPvtDataDetails, error) {
+	if l.blockStore.IsPvtStoreAheadOfBlockStore() {
+		return nil, nil
+	}
+	return l.blockStore.ListMissingPvtData(startBlock, endBlock)
+}
+
+// MarkMissingPvtDataUnrecoverable marks the given eligible missing private data as unrecoverable
+// so that it is no longer returned by GetMissingPvtDataInfoForMostRecentBlocks
+func (l *kvLedger) MarkMissingPvtDataUnrecoverable(missingPvtDataInfo ledger.MissingPvtDataInfo) error {
+	return l.blockStore.MarkMissingPvtDataUnrecoverable(missingPvtDataInfo)
+}
+
 func (l *kvLedger) addBlockCommitHash(block *common.Block, updateBatchBytes []byte) {
+	l.commitHash = computeCommitHash(block, updateBatchBytes, l.commitHash)
+	block.Metadata.Metadata[common.BlockMetadataIndex_COMMIT_HASH] = utils.MarshalOrPanic(&common.Metadata{Value: l.commitHash})
+}
+
+// computeCommitHash computes the commit hash of a block from the validation codes of the transactions
+// in the block, the bytes of the resulting state updates, and the commit hash of the previous block
+func computeCommitHash(block *common.Block, updateBatchBytes []byte, prevCommitHash []byte) []byte {
 	var valueBytes []byte
 
 	txValidationCode := block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]
 	valueBytes = append(valueBytes, proto.EncodeVarint(uint64(len(txValidationCode)))...)
 	valueBytes = append(valueBytes, txValidationCode...)
 	valueBytes = append(valueBytes, updateBatchBytes...)
-	valueBytes = append(valueBytes, l.commitHash...)
+	valueBytes = append(valueBytes, prevCommitHash...)
 
-	l.commitHash = util.ComputeSHA256(valueBytes)
-	block.Metadata.Metadata[common.BlockMetadataIndex_COMMIT_HASH] = utils.MarshalOrPanic(&common.Metadata{Value: l.commitHash})
+	return util.ComputeSHA256(valueBytes)
 }
 
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
@@ -535,6 +681,11 @@ func (l *kvLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetriever, e
 	return l.configHistoryRetriever, nil
 }
 
+// GetStateDBIndexManager returns the StateDBIndexManager for managing the indexes of the state database
+func (l *kvLedger) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	return l.versionedDB.GetStateDBIndexManager()
+}
+
 func (l *kvLedger) CommitPvtDataOfOldBlocks(pvtData []*ledger.BlockPvtData) ([]*ledger.PvtdataHashMismatch, error) {
 	logger.Debugf("[%s:] Comparing pvtData of [%d] old blocks against the hashes in transaction's rwset to find valid and invalid data",
 		l.ledgerID, len(pvtData))
@@ -607,8 +758,14 @@ func (itr *blocksItr) Close() {
 	itr.blocksItr.Close()
 }
 
+// queryExecutorProvider is implemented by the ledger and, during the verification of a ledger,
+// by the transaction manager that replays the blocks
+type queryExecutorProvider interface {
+	NewQueryExecutor() (ledger.QueryExecutor, error)
+}
+
 type collectionInfoRetriever struct {
-	ledger       ledger.PeerLedger
+	ledger       queryExecutorProvider
 	infoProvider ledger.DeployedChaincodeInfoProvider
 }
 
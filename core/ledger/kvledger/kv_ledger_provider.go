@@ -7,7 +7,6 @@ SPDX-License-Identifier: Apache-2.0
 package kvledger
 
 import (
-	"bytes"
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
@@ -33,9 +32,16 @@ var (
 	ErrNonExistingLedgerID = errors.New("LedgerID does not exist")
 	// ErrLedgerNotOpened is thrown by a CloseLedger call if a ledger with the given id has not been opened
 	ErrLedgerNotOpened = errors.New("ledger is not opened yet")
+	// ErrPausedLedger is thrown by a OpenLedger call if the ledger with the given id has been paused
+	ErrPausedLedger = errors.New("ledger is paused")
 
 	underConstructionLedgerKey = []byte("underConstructionLedgerKey")
+	snapshotImportKey          = []byte("snapshotImportKey")
 	ledgerKeyPrefix            = []byte("l")
+	ledgerKeyStop              = []byte("m")
+	pausedLedgerKeyPrefix      = []byte("p")
+	pausedLedgerKeyStop        = []byte("q")
+	pausedLedgerMarker         = []byte{1}
 )
 
 // Provider implements interface ledger.PeerLedgerProvider
@@ -148,6 +154,13 @@ func (provider *Provider) Open(ledgerID string) (ledger.PeerLedger, error) {
 	if !exists {
 		return nil, ErrNonExistingLedgerID
 	}
+	paused, err := provider.idStore.ledgerIDPaused(ledgerID)
+	if err != nil {
+		return nil, err
+	}
+	if paused {
+		return nil, ErrPausedLedger
+	}
 	return provider.openInternal(ledgerID)
 }
 
@@ -192,7 +205,12 @@ func (provider *Provider) Exists(ledgerID string) (bool, error) {
 
 // List implements the corresponding method from interface ledger.PeerLedgerProvider
 func (provider *Provider) List() ([]string, error) {
-	return provider.idStore.getAllLedgerIds()
+	return provider.idStore.getActiveLedgerIDs()
+}
+
+// ListPaused implements the corresponding method from interface ledger.PeerLedgerProvider
+func (provider *Provider) ListPaused() ([]string, error) {
+	return provider.idStore.getPausedLedgerIDs()
 }
 
 // Close implements the corresponding method from interface ledger.PeerLedgerProvider
@@ -219,6 +237,12 @@ func (provider *Provider) recoverUnderConstructionLedger() {
 		return
 	}
 	logger.Infof("ledger [%s] found as under construction", ledgerID)
+	snapshotDir, err := provider.idStore.getSnapshotUnderImport()
+	panicOnErr(err, "Error while checking whether the ledger [%s] is being created from a snapshot", ledgerID)
+	if snapshotDir != "" {
+		provider.recoverLedgerUnderImport(ledgerID, snapshotDir)
+		return
+	}
 	ledger, err := provider.openInternal(ledgerID)
 	panicOnErr(err, "Error while opening under construction ledger [%s]", ledgerID)
 	bcInfo, err := ledger.GetBlockchainInfo()
@@ -279,8 +303,18 @@ func (s *idStore) setUnderConstructionFlag(ledgerID string) error {
 	return s.db.Put(underConstructionLedgerKey, []byte(ledgerID), true)
 }
 
+func (s *idStore) setUnderConstructionFlagForSnapshot(ledgerID, snapshotDir string) error {
+	batch := &leveldb.Batch{}
+	batch.Put(underConstructionLedgerKey, []byte(ledgerID))
+	batch.Put(snapshotImportKey, []byte(snapshotDir))
+	return s.db.WriteBatch(batch, true)
+}
+
 func (s *idStore) unsetUnderConstructionFlag() error {
-	return s.db.Delete(underConstructionLedgerKey, true)
+	batch := &leveldb.Batch{}
+	batch.Delete(underConstructionLedgerKey)
+	batch.Delete(snapshotImportKey)
+	return s.db.WriteBatch(batch, true)
 }
 
 func (s *idStore) getUnderConstructionFlag() (string, error) {
@@ -291,6 +325,14 @@ func (s *idStore) getUnderConstructionFlag() (string, error) {
 	return string(val), nil
 }
 
+func (s *idStore) getSnapshotUnderImport() (string, error) {
+	val, err := s.db.Get(snapshotImportKey)
+	if err != nil {
+		return "", err
+	}
+	return string(val), nil
+}
+
 func (s *idStore) createLedgerID(ledgerID string, gb *common.Block) error {
 	key := s.encodeLedgerKey(ledgerID)
 	var val []byte
@@ -307,6 +349,7 @@ func (s *idStore) createLedgerID(ledgerID string, gb *common.Block) error {
 	batch := &leveldb.Batch{}
 	batch.Put(key, val)
 	batch.Delete(underConstructionLedgerKey)
+	batch.Delete(snapshotImportKey)
 	return s.db.WriteBatch(batch, true)
 }
 
@@ -321,17 +364,71 @@ func (s *idStore) ledgerIDExists(ledgerID string) (bool, error) {
 }
 
 func (s *idStore) getAllLedgerIds() ([]string, error) {
+	return s.getLedgerIDsInRange(ledgerKeyPrefix, ledgerKeyStop)
+}
+
+// updateLedgerStatus marks the ledger as paused or removes the mark, if the ledger is to be resumed
+func (s *idStore) updateLedgerStatus(ledgerID string, paused bool) error {
+	exists, err := s.ledgerIDExists(ledgerID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNonExistingLedgerID
+	}
+	key := s.encodePausedLedgerKey(ledgerID)
+	if paused {
+		return s.db.Put(key, pausedLedgerMarker, true)
+	}
+	return s.db.Delete(key, true)
+}
+
+func (s *idStore) ledgerIDPaused(ledgerID string) (bool, error) {
+	val, err := s.db.Get(s.encodePausedLedgerKey(ledgerID))
+	if err != nil {
+		return false, err
+	}
+	return val != nil, nil
+}
+
+func (s *idStore) getPausedLedgerIDs() ([]string, error) {
+	return s.getLedgerIDsInRange(pausedLedgerKeyPrefix, pausedLedgerKeyStop)
+}
+
+func (s *idStore) getActiveLedgerIDs() ([]string, error) {
+	allIDs, err := s.getAllLedgerIds()
+	if err != nil {
+		return nil, err
+	}
+	pausedIDs, err := s.getPausedLedgerIDs()
+	if err != nil {
+		return nil, err
+	}
+	paused := map[string]bool{}
+	for _, id := range pausedIDs {
+		paused[id] = true
+	}
 	var ids []string
-	itr := s.db.GetIterator(nil, nil)
-	defer itr.Release()
-	itr.First()
-	for itr.Valid() {
-		if bytes.Equal(itr.Key(), underConstructionLedgerKey) {
-			continue
+	for _, id := range allIDs {
+		if !paused[id] {
+			ids = append(ids, id)
 		}
+	}
+	return ids, nil
+}
+
+// getLedgerIDsInRange returns the ledger ids encoded in the keys between the startKey and the endKey. The prefixes
+// of all the keys that encode a ledger id are of the same length and hence, the ledger id is decoded in the same way
+func (s *idStore) getLedgerIDsInRange(startKey, endKey []byte) ([]string, error) {
+	var ids []string
+	itr := s.db.GetIterator(startKey, endKey)
+	defer itr.Release()
+	for itr.Next() {
 		id := string(s.decodeLedgerID(itr.Key()))
 		ids = append(ids, id)
-		itr.Next()
+	}
+	if err := itr.Error(); err != nil {
+		return nil, errors.Wrap(err, "error while iterating over the ledger ids")
 	}
 	return ids, nil
 }
@@ -344,6 +441,10 @@ func (s *idStore) encodeLedgerKey(ledgerID string) []byte {
 	return append(ledgerKeyPrefix, []byte(ledgerID)...)
 }
 
+func (s *idStore) encodePausedLedgerKey(ledgerID string) []byte {
+	return append(pausedLedgerKeyPrefix, []byte(ledgerID)...)
+}
+
 func (s *idStore) decodeLedgerID(key []byte) string {
 	return string(key[len(ledgerKeyPrefix):])
 }
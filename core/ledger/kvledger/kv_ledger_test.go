@@ -8,6 +8,7 @@ package kvledger
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -171,6 +172,109 @@ func TestAddCommitHash(t *testing.T) {
 
 }
 
+func TestKVLedgerPrune(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+
+	bg, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	assert.NoError(t, err)
+	defer ledger.Close()
+
+	txid := util.GenerateUUID()
+	simulator, _ := ledger.NewTxSimulator(txid)
+	simulator.SetState("ns1", "key1", []byte("value1"))
+	simulator.Done()
+	simRes, _ := simulator.GetTxSimulationResults()
+	pubSimBytes, _ := simRes.GetPubSimulationBytes()
+	block1 := bg.NextBlock([][]byte{pubSimBytes})
+	block1.Metadata.Metadata[common.BlockMetadataIndex_LAST_CONFIG] = putils.MarshalOrPanic(&common.Metadata{
+		Value: putils.MarshalOrPanic(&common.LastConfig{Index: 0}),
+	})
+	assert.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block1}, &lgr.CommitOptions{}))
+
+	err = ledger.Prune("unknown-policy")
+	assert.EqualError(t, err, "unsupported prune policy type [string]")
+
+	// all the blocks reside in the block file that is currently being appended to and hence, none gets pruned
+	assert.NoError(t, ledger.Prune(&lgr.KeepLastNBlocksPrunePolicy{NumBlocks: 5}))
+	assert.NoError(t, ledger.Prune(&lgr.KeepLastNBlocksPrunePolicy{NumBlocks: 1}))
+	assert.NoError(t, ledger.Prune(&lgr.ConfigBlockCheckpointPrunePolicy{}))
+	b0, err := ledger.GetBlockByNumber(0)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(b0, gb), "proto messages are not equal")
+}
+
+func TestKVLedgerPruneOnCommit(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	defer func() {
+		viper.Set("ledger.blockchain.retention.policy", "")
+		viper.Set("ledger.blockchain.retention.numBlocks", 0)
+		viper.Set("ledger.blockchain.retention.archiveDir", "")
+		viper.Set("ledger.blockchain.retention.pruneInterval", 0)
+	}()
+
+	viper.Set("ledger.blockchain.retention.policy", "unknown-policy")
+	provider := testutilNewProvider(t)
+	bg, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	_, err := provider.Create(gb)
+	assert.EqualError(t, err, "unsupported block retention policy [unknown-policy]")
+	provider.Close()
+
+	viper.Set("ledger.blockchain.retention.policy", "keepLastNBlocks")
+	viper.Set("ledger.blockchain.retention.pruneInterval", 1)
+	env.cleanup()
+	provider = testutilNewProvider(t)
+	_, err = provider.Create(gb)
+	assert.EqualError(t, err, "the block retention policy [keepLastNBlocks] requires a number of blocks greater than zero")
+	provider.Close()
+
+	viper.Set("ledger.blockchain.retention.numBlocks", 1)
+	viper.Set("ledger.blockchain.retention.archiveDir", filepath.Join(env.path, "archive"))
+	env.cleanup()
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+	ledger, err := provider.Create(gb)
+	assert.NoError(t, err)
+	defer ledger.Close()
+	assert.Equal(t, &lgr.KeepLastNBlocksPrunePolicy{NumBlocks: 1, ArchiveDir: filepath.Join(env.path, "archive", "testLedger")},
+		ledger.(*kvLedger).retentionPolicy)
+	assert.Equal(t, uint64(1), ledger.(*kvLedger).pruneInterval)
+
+	// the blocks are pruned after every commit, but all the blocks reside in the block
+	// file that is currently being appended to and hence, none gets pruned
+	for i := 0; i < 3; i++ {
+		block := bg.NextBlock([][]byte{})
+		block.Metadata.Metadata[common.BlockMetadataIndex_LAST_CONFIG] = putils.MarshalOrPanic(&common.Metadata{
+			Value: putils.MarshalOrPanic(&common.LastConfig{Index: block.Header.Number}),
+		})
+		assert.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}, &lgr.CommitOptions{}))
+	}
+	b0, err := ledger.GetBlockByNumber(0)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(b0, gb), "proto messages are not equal")
+}
+
+func TestKVLedgerStateDBIndexManager(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+
+	_, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	assert.NoError(t, err)
+	defer ledger.Close()
+
+	// goleveldb does not support managing indexes
+	indexManager, err := ledger.GetStateDBIndexManager()
+	assert.EqualError(t, err, "the state database does not support managing indexes")
+	assert.Nil(t, indexManager)
+}
+
 func TestKVLedgerBlockStorageWithPvtdata(t *testing.T) {
 	t.Skip()
 	env := newTestEnv(t)
@@ -17,6 +17,8 @@ type stats struct {
 	blockAndPvtdataStoreCommitTime metrics.Histogram
 	statedbCommitTime              metrics.Histogram
 	transactionsCount              metrics.Counter
+	recoveryRemainingBlocks        metrics.Gauge
+	recoveryRecommittedBlocks      metrics.Counter
 }
 
 func newStats(metricsProvider metrics.Provider) *stats {
@@ -25,6 +27,8 @@ func newStats(metricsProvider metrics.Provider) *stats {
 	stats.blockAndPvtdataStoreCommitTime = metricsProvider.NewHistogram(blockAndPvtdataStoreCommitTimeOpts)
 	stats.statedbCommitTime = metricsProvider.NewHistogram(statedbCommitTimeOpts)
 	stats.transactionsCount = metricsProvider.NewCounter(transactionCountOpts)
+	stats.recoveryRemainingBlocks = metricsProvider.NewGauge(recoveryRemainingBlocksOpts)
+	stats.recoveryRecommittedBlocks = metricsProvider.NewCounter(recoveryRecommittedBlocksOpts)
 	return stats
 }
 
@@ -51,6 +55,14 @@ func (s *ledgerStats) updateStatedbCommitTime(timeTaken time.Duration) {
 	s.stats.statedbCommitTime.With("channel", s.ledgerid).Observe(timeTaken.Seconds())
 }
 
+func (s *ledgerStats) updateRecoveryRemainingBlocks(numBlocks uint64) {
+	s.stats.recoveryRemainingBlocks.With("channel", s.ledgerid).Set(float64(numBlocks))
+}
+
+func (s *ledgerStats) incrementRecoveryRecommittedBlocks() {
+	s.stats.recoveryRecommittedBlocks.With("channel", s.ledgerid).Add(1)
+}
+
 func (s *ledgerStats) updateTransactionsStats(
 	txstatsInfo []*txmgr.TxStatInfo,
 ) {
@@ -113,4 +125,22 @@ var (
 		LabelNames:   []string{"channel", "transaction_type", "chaincode", "validation_code"},
 		StatsdFormat: "%{#fqname}.%{channel}.%{transaction_type}.%{chaincode}.%{validation_code}",
 	}
+
+	recoveryRemainingBlocksOpts = metrics.GaugeOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "recovery_remaining_blocks",
+		Help:         "Number of blocks that remain to be recommitted while recovering or rebuilding the state and history databases.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	recoveryRecommittedBlocksOpts = metrics.CounterOpts{
+		Namespace:    "ledger",
+		Subsystem:    "",
+		Name:         "recovery_recommitted_blocks",
+		Help:         "Number of blocks recommitted while recovering or rebuilding the state and history databases.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
@@ -138,6 +138,8 @@ type testMetricProvider struct {
 	fakeBlockstorageCommitWithPvtDataTimeHist *metricsfakes.Histogram
 	fakeStatedbCommitTimeHist                 *metricsfakes.Histogram
 	fakeTransactionsCount                     *metricsfakes.Counter
+	fakeRecoveryRemainingBlocksGauge          *metricsfakes.Gauge
+	fakeRecoveryRecommittedBlocksCount        *metricsfakes.Counter
 }
 
 func testutilConstructMetricProvider() *testMetricProvider {
@@ -146,9 +148,16 @@ func testutilConstructMetricProvider() *testMetricProvider {
 	fakeBlockstorageCommitWithPvtDataTimeHist := testutilConstructHist()
 	fakeStatedbCommitTimeHist := testutilConstructHist()
 	fakeTransactionsCount := testutilConstructCounter()
+	fakeRecoveryRemainingBlocksGauge := testutilConstructGauge()
+	fakeRecoveryRecommittedBlocksCount := testutilConstructCounter()
 	fakeProvider.NewGaugeStub = func(opts metrics.GaugeOpts) metrics.Gauge {
-		// return a gauge for metrics in common/ledger
-		return testutilConstructGauge()
+		switch opts.Name {
+		case recoveryRemainingBlocksOpts.Name:
+			return fakeRecoveryRemainingBlocksGauge
+		default:
+			// return a gauge for metrics in common/ledger
+			return testutilConstructGauge()
+		}
 	}
 	fakeProvider.NewHistogramStub = func(opts metrics.HistogramOpts) metrics.Histogram {
 		switch opts.Name {
@@ -168,6 +177,8 @@ func testutilConstructMetricProvider() *testMetricProvider {
 		switch opts.Name {
 		case transactionCountOpts.Name:
 			return fakeTransactionsCount
+		case recoveryRecommittedBlocksOpts.Name:
+			return fakeRecoveryRecommittedBlocksCount
 		}
 		return nil
 	}
@@ -177,6 +188,8 @@ func testutilConstructMetricProvider() *testMetricProvider {
 		fakeBlockstorageCommitWithPvtDataTimeHist,
 		fakeStatedbCommitTimeHist,
 		fakeTransactionsCount,
+		fakeRecoveryRemainingBlocksGauge,
+		fakeRecoveryRecommittedBlocksCount,
 	}
 }
 
@@ -0,0 +1,50 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/pkg/errors"
+)
+
+// PauseChannel marks the ledger of the given channel as paused. A paused ledger is not opened when the peer starts
+// and hence, the peer does not participate in the channel (e.g., it neither pulls blocks nor serves the deliver
+// requests of the channel) while the other channels keep running. This function is expected to be invoked while
+// the peer is stopped
+func PauseChannel(ledgerID string) error {
+	if err := updateLedgerStatus(ledgerID, true); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("cannot pause the channel [%s]", ledgerID))
+	}
+	logger.Infof("The channel [%s] has been successfully paused", ledgerID)
+	return nil
+}
+
+// ResumeChannel removes the paused mark of the ledger of the given channel so that the ledger is opened again
+// when the peer starts. This function is expected to be invoked while the peer is stopped
+func ResumeChannel(ledgerID string) error {
+	if err := updateLedgerStatus(ledgerID, false); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("cannot resume the channel [%s]", ledgerID))
+	}
+	logger.Infof("The channel [%s] has been successfully resumed", ledgerID)
+	return nil
+}
+
+func updateLedgerStatus(ledgerID string, paused bool) error {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return errors.Wrap(err, "as another peer node command is executing,"+
+			" wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
+	defer idStore.close()
+	return idStore.updateLedgerStatus(ledgerID, paused)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"testing"
+
+	configtxtest "github.com/hyperledger/fabric/common/configtx/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseAndResumeChannel(t *testing.T) {
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	provider := testutilNewProvider(t)
+	numLedgers := 3
+	for i := 0; i < numLedgers; i++ {
+		genesisBlock, err := configtxtest.MakeGenesisBlock(constructTestLedgerID(i))
+		require.NoError(t, err)
+		l, err := provider.Create(genesisBlock)
+		require.NoError(t, err)
+		l.Close()
+	}
+
+	// the channels cannot be paused or resumed while the provider holds the file lock
+	err := PauseChannel(constructTestLedgerID(1))
+	assert.Contains(t, err.Error(), "as another peer node command is executing")
+	err = ResumeChannel(constructTestLedgerID(1))
+	assert.Contains(t, err.Error(), "as another peer node command is executing")
+	provider.Close()
+
+	assert.EqualError(t, PauseChannel("non-existing-ledger"), "cannot pause the channel [non-existing-ledger]: LedgerID does not exist")
+	assert.EqualError(t, ResumeChannel("non-existing-ledger"), "cannot resume the channel [non-existing-ledger]: LedgerID does not exist")
+
+	require.NoError(t, PauseChannel(constructTestLedgerID(1)))
+	// pausing an already paused channel is a no-op
+	require.NoError(t, PauseChannel(constructTestLedgerID(1)))
+	verifyLedgerStatus(t, []string{constructTestLedgerID(0), constructTestLedgerID(2)}, []string{constructTestLedgerID(1)})
+
+	require.NoError(t, ResumeChannel(constructTestLedgerID(1)))
+	// resuming an active channel is a no-op
+	require.NoError(t, ResumeChannel(constructTestLedgerID(1)))
+	verifyLedgerStatus(t, []string{constructTestLedgerID(0), constructTestLedgerID(1), constructTestLedgerID(2)}, nil)
+}
+
+func verifyLedgerStatus(t *testing.T, activeLedgerIDs, pausedLedgerIDs []string) {
+	provider := testutilNewProvider(t)
+	defer provider.Close()
+
+	ledgerIDs, err := provider.List()
+	require.NoError(t, err)
+	assert.Equal(t, activeLedgerIDs, ledgerIDs)
+	ledgerIDs, err = provider.ListPaused()
+	require.NoError(t, err)
+	assert.Equal(t, pausedLedgerIDs, ledgerIDs)
+
+	for _, ledgerID := range activeLedgerIDs {
+		l, err := provider.Open(ledgerID)
+		require.NoError(t, err)
+		l.Close()
+	}
+	for _, ledgerID := range pausedLedgerIDs {
+		exists, err := provider.Exists(ledgerID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		_, err = provider.Open(ledgerID)
+		assert.Equal(t, ErrPausedLedger, err)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	putils "github.com/hyperledger/fabric/protos/utils"
+)
+
+// purgePvtdataKeys erases from the pvtdata store the previous versions of the private data
+// of the keys purged by the valid transactions of the block. As the purge is idempotent, it
+// is performed again when the block is recommitted during the recovery of the state DB
+func (l *kvLedger) purgePvtdataKeys(blockNum uint64, purgedKeys []*ledger.PurgedPvtdataKey) error {
+	if len(purgedKeys) == 0 {
+		return nil
+	}
+	logger.Debugf("[%s] Purging [%d] private data keys at block [%d]", l.ledgerID, len(purgedKeys), blockNum)
+	return l.blockStore.PurgePvtDataKeys(purgedKeys)
+}
+
+// purgedPvtdataKeys returns the private data keys purged by the valid endorser transactions in the block
+func purgedPvtdataKeys(block *common.Block) ([]*ledger.PurgedPvtdataKey, error) {
+	var purgedKeys []*ledger.PurgedPvtdataKey
+	txsFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	for txNum, envBytes := range block.Data.Data {
+		if txsFilter.IsInvalid(txNum) {
+			continue
+		}
+
+		env, err := putils.GetEnvelopeFromBlock(envBytes)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := putils.GetPayload(env)
+		if err != nil {
+			return nil, err
+		}
+		chdr, err := putils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+		if err != nil {
+			return nil, err
+		}
+		if common.HeaderType(chdr.Type) != common.HeaderType_ENDORSER_TRANSACTION {
+			continue
+		}
+
+		respPayload, err := putils.GetActionFromEnvelope(envBytes)
+		if err != nil {
+			return nil, err
+		}
+		txRWSet := &rwsetutil.TxRwSet{}
+		if err = txRWSet.FromProtoBytes(respPayload.Results); err != nil {
+			return nil, err
+		}
+		purgedKeys = append(purgedKeys, txRWSet.PurgedKeys(block.Header.Number, uint64(txNum))...)
+	}
+	return purgedKeys, nil
+}
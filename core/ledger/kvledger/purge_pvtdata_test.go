@@ -0,0 +1,53 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgedPvtdataKeys(t *testing.T) {
+	simResults := func(purgedKeys ...string) []byte {
+		rwSetBuilder := rwsetutil.NewRWSetBuilder()
+		rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", []byte("value1"))
+		for _, key := range purgedKeys {
+			rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", key)
+		}
+		simRes, err := rwSetBuilder.GetTxSimulationResults()
+		assert.NoError(t, err)
+		pubSimBytes, err := simRes.GetPubSimulationBytes()
+		assert.NoError(t, err)
+		return pubSimBytes
+	}
+
+	bg, _ := testutil.NewBlockGenerator(t, "testLedger", false)
+	block := bg.NextBlock([][]byte{simResults(), simResults("key2"), simResults("key3"), simResults("key4", "key5")})
+	// the purges of invalid transactions are ignored
+	txsFilter := util.TxValidationFlags(block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER])
+	txsFilter.SetFlag(2, peer.TxValidationCode_MVCC_READ_CONFLICT)
+
+	purgedKeys, err := purgedPvtdataKeys(block)
+	assert.NoError(t, err)
+	assert.Equal(t, []*ledger.PurgedPvtdataKey{
+		{Namespace: "ns1", Collection: "coll1", KeyHash: util.ComputeStringHash("key2"), BlockNum: 1, TxNum: 1},
+		{Namespace: "ns1", Collection: "coll1", KeyHash: util.ComputeStringHash("key4"), BlockNum: 1, TxNum: 3},
+		{Namespace: "ns1", Collection: "coll1", KeyHash: util.ComputeStringHash("key5"), BlockNum: 1, TxNum: 3},
+	}, purgedKeys)
+
+	block = bg.NextBlock([][]byte{simResults()})
+	purgedKeys, err = purgedPvtdataKeys(block)
+	assert.NoError(t, err)
+	assert.Nil(t, purgedKeys)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/pkg/errors"
+)
+
+// RebuildDBs drops the stateDB, the configHistoryDB, the bookkeeperDB, and the historyDB of the given ledgers
+// (of all the ledgers, if no ledger is given) and rebuilds them by recommitting the blocks from the block store,
+// in the same way as the recovery performed while opening a ledger. The state is rebuilt in the state database
+// configured via 'ledger.state.stateDatabase', which may differ from the one in which the state was maintained
+// earlier (e.g., for moving from goleveldb to CouchDB). The progress of the rebuild is logged and reported via
+// the recovery metrics. The databases of a ledger cannot be rebuilt if its block store does not start with the
+// genesis block, i.e., if the ledger has been created from a snapshot or if its block store has been pruned.
+// This function is expected to be invoked while the peer is stopped
+func RebuildDBs(initializer *ledger.Initializer, ledgerIDs []string) error {
+	ledgerIDs, err := dropDBsForRebuild(initializer, ledgerIDs)
+	if err != nil {
+		return err
+	}
+
+	// The file lock is released after dropping the databases, as the provider acquires it again. If a peer
+	// starts in between, it acquires the lock and rebuilds the dropped databases itself, as a part of
+	// the regular recovery, and the provider here fails to acquire the lock
+	p, err := NewProvider()
+	if err != nil {
+		return err
+	}
+	provider := p.(*Provider)
+	defer provider.Close()
+	if err := provider.Initialize(initializer); err != nil {
+		return err
+	}
+	for _, ledgerID := range ledgerIDs {
+		logger.Infof("Rebuilding the databases of the ledger [%s]", ledgerID)
+		startTime := time.Now()
+		// the ledger is opened via the function openInternal, so that the databases of a paused ledger are rebuilt as well
+		l, err := provider.openInternal(ledgerID)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error while rebuilding the databases of the ledger [%s]", ledgerID))
+		}
+		l.Close()
+		logger.Infof("Rebuilt the databases of the ledger [%s] in %s", ledgerID, time.Since(startTime))
+	}
+	logger.Infof("The databases of the ledgers %s have been successfully rebuilt", ledgerIDs)
+	return nil
+}
+
+// dropDBsForRebuild drops the databases of the given ledgers, or of all the ledgers if no ledger is given,
+// and returns the ids of the ledgers whose databases are dropped
+func dropDBsForRebuild(initializer *ledger.Initializer, ledgerIDs []string) ([]string, error) {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return nil, errors.Wrap(err, "as another peer node command is executing,"+
+			" wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
+	allLedgerIDs, err := idStore.getAllLedgerIds()
+	idStore.close()
+	if err != nil {
+		return nil, err
+	}
+	rebuildAll := len(ledgerIDs) == 0
+	if rebuildAll {
+		ledgerIDs = allLedgerIDs
+	}
+	if err := validateRebuildParams(ledgerIDs, allLedgerIDs); err != nil {
+		return nil, err
+	}
+
+	if !rebuildAll {
+		if err := dropLedgerDBs(ledgerIDs, initializer.MetricsProvider); err != nil {
+			return nil, err
+		}
+		return ledgerIDs, nil
+	}
+	// as the state in CouchDB (or in an external state database) is not maintained under the ledger
+	// root directory, it is dropped ahead of the databases that dropDBs removes
+	if err := dropStateDBsIfNotLevelDB(ledgerIDs, initializer.MetricsProvider); err != nil {
+		return nil, err
+	}
+	if err := dropDBs(); err != nil {
+		return nil, err
+	}
+	return ledgerIDs, nil
+}
+
+// validateRebuildParams checks that the given ledgers exist and that their block stores start with the genesis block
+func validateRebuildParams(ledgerIDs, allLedgerIDs []string) error {
+	existing := map[string]bool{}
+	for _, ledgerID := range allLedgerIDs {
+		existing[ledgerID] = true
+	}
+	for _, ledgerID := range ledgerIDs {
+		if !existing[ledgerID] {
+			return errors.WithMessage(ErrNonExistingLedgerID, fmt.Sprintf("cannot rebuild the databases of the ledger [%s]", ledgerID))
+		}
+	}
+
+	ledgerStoreProvider := ledgerstorage.NewProvider(&disabled.Provider{})
+	defer ledgerStoreProvider.Close()
+	for _, ledgerID := range ledgerIDs {
+		blockStore, err := ledgerStoreProvider.Open(ledgerID)
+		if err != nil {
+			return err
+		}
+		firstBlockNum, err := firstAvailableBlockNum(blockStore)
+		blockStore.Shutdown()
+		if err != nil {
+			return err
+		}
+		if firstBlockNum != 0 {
+			return errors.Errorf("cannot rebuild the databases of the ledger [%s] as its block store starts at the block [%d]. "+
+				"This is the case when the ledger has been created from a snapshot or its block store has been pruned",
+				ledgerID, firstBlockNum)
+		}
+	}
+	return nil
+}
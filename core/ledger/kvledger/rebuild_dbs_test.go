@@ -0,0 +1,163 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/mock"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildDBs(t *testing.T) {
+	viper.Set("ledger.history.enableHistoryDatabase", true)
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	// create two ledgers, each with a few blocks
+	provider := testutilNewProvider(t)
+	ledgerIDs := []string{"ledger1", "ledger2"}
+	for _, ledgerID := range ledgerIDs {
+		bg, gb := testutil.NewBlockGenerator(t, ledgerID, false)
+		ledger, err := provider.Create(gb)
+		require.NoError(t, err)
+		for _, value := range []string{"value1", "value2", "value3"} {
+			simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+			require.NoError(t, err)
+			require.NoError(t, simulator.SetState("ns1", "key1", []byte(value)))
+			simulator.Done()
+			simRes, err := simulator.GetTxSimulationResults()
+			require.NoError(t, err)
+			pubSimBytes, err := simRes.GetPubSimulationBytes()
+			require.NoError(t, err)
+			require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimBytes})}, &lgr.CommitOptions{}))
+		}
+		ledger.Close()
+	}
+	provider.Close()
+
+	// tamperState overwrites the state of the given ledger in the goleveldb at its current savepoint
+	tamperState := func(ledgerID string) {
+		vdbProvider := stateleveldb.NewVersionedDBProvider()
+		defer vdbProvider.Close()
+		vdb, err := vdbProvider.GetDBHandle(ledgerID)
+		require.NoError(t, err)
+		savepoint, err := vdb.GetLatestSavePoint()
+		require.NoError(t, err)
+		batch := statedb.NewUpdateBatch()
+		batch.Put("ns1", "key1", []byte("tampered-value"), version.NewHeight(3, 0))
+		require.NoError(t, vdb.ApplyUpdates(batch, savepoint))
+	}
+
+	// verifyLedger checks the state and the history of the key in the given ledger
+	verifyLedger := func(ledgerID string, expectedValue string, expectedHistory []string) {
+		provider := testutilNewProvider(t)
+		defer provider.Close()
+		ledger, err := provider.Open(ledgerID)
+		require.NoError(t, err)
+		defer ledger.Close()
+		qe, err := ledger.NewQueryExecutor()
+		require.NoError(t, err)
+		val, err := qe.GetState("ns1", "key1")
+		qe.Done()
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expectedValue), val)
+
+		hqe, err := ledger.NewHistoryQueryExecutor()
+		require.NoError(t, err)
+		itr, err := hqe.GetHistoryForKey("ns1", "key1")
+		require.NoError(t, err)
+		defer itr.Close()
+		history := []string{}
+		for {
+			res, err := itr.Next()
+			require.NoError(t, err)
+			if res == nil {
+				break
+			}
+			history = append(history, string(res.(*queryresult.KeyModification).Value))
+		}
+		assert.Equal(t, expectedHistory, history)
+	}
+
+	newInitializer := func(metricsProvider *testMetricProvider) *lgr.Initializer {
+		return &lgr.Initializer{
+			DeployedChaincodeInfoProvider: &mock.DeployedChaincodeInfoProvider{},
+			MetricsProvider:               metricsProvider.fakeProvider,
+		}
+	}
+
+	t.Run("non-existing ledger", func(t *testing.T) {
+		err := RebuildDBs(newInitializer(testutilConstructMetricProvider()), []string{"ledger1", "non-existing-ledger"})
+		assert.EqualError(t, err, "cannot rebuild the databases of the ledger [non-existing-ledger]: LedgerID does not exist")
+	})
+
+	t.Run("selected ledgers", func(t *testing.T) {
+		tamperState("ledger1")
+		tamperState("ledger2")
+		metricsProvider := testutilConstructMetricProvider()
+		require.NoError(t, RebuildDBs(newInitializer(metricsProvider), []string{"ledger1"}))
+		verifyLedger("ledger1", "value3", []string{"value1", "value2", "value3"})
+		// the databases of the other ledger are not rebuilt
+		verifyLedger("ledger2", "tampered-value", []string{"value1", "value2", "value3"})
+
+		// the genesis block and the three blocks are recommitted
+		assert.Equal(t, 4, metricsProvider.fakeRecoveryRecommittedBlocksCount.AddCallCount())
+		assert.Equal(t, []string{"channel", "ledger1"}, metricsProvider.fakeRecoveryRecommittedBlocksCount.WithArgsForCall(0))
+		gaugeCalls := metricsProvider.fakeRecoveryRemainingBlocksGauge.SetCallCount()
+		require.True(t, gaugeCalls > 0)
+		assert.Equal(t, float64(0), metricsProvider.fakeRecoveryRemainingBlocksGauge.SetArgsForCall(gaugeCalls-1))
+	})
+
+	t.Run("all ledgers", func(t *testing.T) {
+		tamperState("ledger1")
+		require.NoError(t, RebuildDBs(newInitializer(testutilConstructMetricProvider()), nil))
+		verifyLedger("ledger1", "value3", []string{"value1", "value2", "value3"})
+		verifyLedger("ledger2", "value3", []string{"value1", "value2", "value3"})
+	})
+
+	t.Run("into a different state database", func(t *testing.T) {
+		// an external state database that maintains the state in a different goleveldb
+		externalDBPath := filepath.Join(ledgerconfig.GetRootPath(), "externalStateDB")
+		statedb.RegisterVersionedDBProviderFactory("testrebuilddb", func(config map[string]interface{}) (statedb.VersionedDBProvider, error) {
+			return stateleveldb.NewVersionedDBProviderWithPath(externalDBPath), nil
+		})
+		viper.Set("ledger.state.stateDatabase", "testrebuilddb")
+		defer viper.Set("ledger.state.stateDatabase", "")
+
+		require.NoError(t, RebuildDBs(newInitializer(testutilConstructMetricProvider()), []string{"ledger1"}))
+		verifyLedger("ledger1", "value3", []string{"value1", "value2", "value3"})
+
+		externalDBProvider := stateleveldb.NewVersionedDBProviderWithPath(externalDBPath)
+		vdb, err := externalDBProvider.GetDBHandle("ledger1")
+		require.NoError(t, err)
+		vv, err := vdb.GetState("ns1", "key1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value3"), vv.Value)
+		externalDBProvider.Close()
+
+		// the stale state is dropped from the goleveldb
+		vdbProvider := stateleveldb.NewVersionedDBProvider()
+		defer vdbProvider.Close()
+		vdb, err = vdbProvider.GetDBHandle("ledger1")
+		require.NoError(t, err)
+		savepoint, err := vdb.GetLatestSavePoint()
+		require.NoError(t, err)
+		assert.Nil(t, savepoint)
+	})
+}
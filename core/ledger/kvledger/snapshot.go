@@ -0,0 +1,743 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-lib-go/healthz"
+	fileutil "github.com/hyperledger/fabric/common/ledger/util"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/confighistory"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/pvtstatepurgemgmt"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	snapshotSignableMetadataFileName   = "_snapshot_signable_metadata.json"
+	snapshotAdditionalMetadataFileName = "_snapshot_additional_metadata.json"
+
+	publicStateFileName    = "public_state.data"
+	pvtStateHashesFileName = "private_state_hashes.data"
+	configHistoryFileName  = "confighistory.data"
+	pvtdataExpiryFileName  = "pvtdata_expiry.data"
+	blocksFileName         = "blocks.data"
+)
+
+var snapshotDataFileNames = []string{
+	publicStateFileName,
+	pvtStateHashesFileName,
+	configHistoryFileName,
+	pvtdataExpiryFileName,
+	blocksFileName,
+}
+
+// snapshotSignableMetadata is the part of the metadata of a snapshot that is expected to be identical across
+// the snapshots generated by the peers of a channel at the same height. The hashes of the data files are included
+// so that the administrators of different orgs can compare (or sign) this metadata before trusting a snapshot.
+// The hashes of the state files are computed over the canonical form of the state (see stateFileHasher) so that
+// these are identical across the peers irrespective of the type of their state database
+type snapshotSignableMetadata struct {
+	ChannelName            string            `json:"channel_name"`
+	LastBlockNumber        uint64            `json:"last_block_number"`
+	LastBlockHashInHex     string            `json:"last_block_hash"`
+	PreviousBlockHashInHex string            `json:"previous_block_hash"`
+	LastConfigBlockNumber  uint64            `json:"last_config_block_number"`
+	FilesAndHashes         map[string]string `json:"snapshot_files_hashes"`
+}
+
+// snapshotAdditionalMetadata contains the hash of the signable metadata file and the
+// commit hash of the last block
+type snapshotAdditionalMetadata struct {
+	SnapshotHashInHex        string `json:"snapshot_hash"`
+	LastBlockCommitHashInHex string `json:"last_block_commit_hash"`
+}
+
+// GenerateSnapshot exports the given ledger, as of its current height, into the given directory. The snapshot
+// contains the public state, the hashes of the private state, the collection config history (which governs the
+// eligibility of the peers for the private data of the collections), the expiry schedule of the hashed private data,
+// and the blocks starting from the last config block. The private data itself is not included in the snapshot.
+// This function is expected to be invoked while the peer is stopped
+func GenerateSnapshot(ledgerID string, snapshotDir string) error {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return errors.Wrap(err, "as another peer node command is executing,"+
+			" wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
+	exists, err := idStore.ledgerIDExists(ledgerID)
+	idStore.close()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNonExistingLedgerID
+	}
+
+	empty, err := fileutil.CreateDirIfMissing(snapshotDir)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.Errorf("the snapshot directory [%s] is not empty", snapshotDir)
+	}
+	if err := generateSnapshot(ledgerID, snapshotDir); err != nil {
+		// an incomplete snapshot is removed so that it is not mistaken for a complete one
+		if removeErr := os.RemoveAll(snapshotDir); removeErr != nil {
+			logger.Warningf("Error while removing the incomplete snapshot [%s]: %s", snapshotDir, removeErr)
+		}
+		return err
+	}
+	logger.Infof("Generated the snapshot of the ledger [%s] in the directory [%s]", ledgerID, snapshotDir)
+	return nil
+}
+
+func generateSnapshot(ledgerID string, snapshotDir string) error {
+	ledgerStoreProvider := ledgerstorage.NewProvider(&disabled.Provider{})
+	defer ledgerStoreProvider.Close()
+	blockStore, err := ledgerStoreProvider.Open(ledgerID)
+	if err != nil {
+		return err
+	}
+	bcInfo, err := blockStore.GetBlockchainInfo()
+	if err != nil {
+		return err
+	}
+	if bcInfo.Height == 0 {
+		return errors.Errorf("the ledger [%s] is empty", ledgerID)
+	}
+
+	bookkeepingProvider := bookkeeping.NewProvider()
+	defer bookkeepingProvider.Close()
+	vdbProvider, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeepingProvider, &disabled.Provider{}, &noopHealthCheckRegistry{})
+	if err != nil {
+		return err
+	}
+	defer vdbProvider.Close()
+	vdb, err := vdbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return err
+	}
+	savepoint, err := vdb.GetLatestSavePoint()
+	if err != nil {
+		return err
+	}
+	if savepoint == nil || savepoint.BlockNum != bcInfo.Height-1 {
+		return errors.Errorf("the state database of the ledger [%s] is not in sync with the block store [height=%d]."+
+			" Start the peer once so that the state database is brought in sync and retry after stopping the peer",
+			ledgerID, bcInfo.Height)
+	}
+
+	lastBlock, err := blockStore.RetrieveBlockByNumber(bcInfo.Height - 1)
+	if err != nil {
+		return err
+	}
+	lastConfigBlockNum, err := utils.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		return errors.WithMessage(err, "error retrieving the last config block index")
+	}
+	commitHash, err := commitHashFromBlock(lastBlock)
+	if err != nil {
+		return err
+	}
+
+	filesAndHashes := map[string]string{}
+	blocksHash, err := exportBlocks(snapshotDir, blockStore, lastConfigBlockNum, lastBlock.Header.Number)
+	if err != nil {
+		return err
+	}
+	filesAndHashes[blocksFileName] = hex.EncodeToString(blocksHash)
+
+	pubStateHash, pvtStateHashesHash, err := exportPubAndHashedState(snapshotDir, vdb)
+	if err != nil {
+		return err
+	}
+	filesAndHashes[publicStateFileName] = hex.EncodeToString(pubStateHash)
+	filesAndHashes[pvtStateHashesFileName] = hex.EncodeToString(pvtStateHashesHash)
+
+	configHistoryMgr := confighistory.NewMgr(nil)
+	defer configHistoryMgr.Close()
+	configHistoryHash, err := exportKVs(snapshotDir, configHistoryFileName, func(consume func(key, value []byte) error) error {
+		return configHistoryMgr.ExportConfigHistory(ledgerID, consume)
+	})
+	if err != nil {
+		return err
+	}
+	filesAndHashes[configHistoryFileName] = hex.EncodeToString(configHistoryHash)
+
+	expiryHash, err := exportKVs(snapshotDir, pvtdataExpiryFileName, func(consume func(key, value []byte) error) error {
+		return pvtstatepurgemgmt.ExportExpirySchedule(ledgerID, bookkeepingProvider, consume)
+	})
+	if err != nil {
+		return err
+	}
+	filesAndHashes[pvtdataExpiryFileName] = hex.EncodeToString(expiryHash)
+
+	return writeSnapshotMetadata(
+		snapshotDir,
+		&snapshotSignableMetadata{
+			ChannelName:            ledgerID,
+			LastBlockNumber:        lastBlock.Header.Number,
+			LastBlockHashInHex:     hex.EncodeToString(lastBlock.Header.Hash()),
+			PreviousBlockHashInHex: hex.EncodeToString(lastBlock.Header.PreviousHash),
+			LastConfigBlockNumber:  lastConfigBlockNum,
+			FilesAndHashes:         filesAndHashes,
+		},
+		commitHash,
+	)
+}
+
+func exportBlocks(snapshotDir string, blockStore *ledgerstorage.Store, firstBlockNum, lastBlockNum uint64) ([]byte, error) {
+	w, err := createSnapshotFile(snapshotDir, blocksFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer w.close()
+	for blockNum := firstBlockNum; blockNum <= lastBlockNum; blockNum++ {
+		block, err := blockStore.RetrieveBlockByNumber(blockNum)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("error retrieving the block [%d]", blockNum))
+		}
+		blockBytes, err := proto.Marshal(block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error marshaling the block [%d]", blockNum)
+		}
+		if err := w.addBytes(blockBytes); err != nil {
+			return nil, err
+		}
+	}
+	return w.done()
+}
+
+// exportPubAndHashedState writes the public state and the hashes of the private state in the canonical order,
+// i.e., ordered by namespace, then by collection, and then by key. The state db supplies the public keys in this
+// order, whereas the order of the hashed keys within a collection depends on the encoding of the keys in the state
+// db and hence, the hashed keys of a collection are sorted before writing
+func exportPubAndHashedState(snapshotDir string, db privacyenabledstate.DB) ([]byte, []byte, error) {
+	itr, err := db.GetPubAndHashedStateIterator()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer itr.Close()
+	return writePubAndHashedState(snapshotDir, itr.Next)
+}
+
+func writePubAndHashedState(snapshotDir string, nextRecord func() (*privacyenabledstate.StateRecord, error)) ([]byte, []byte, error) {
+	pubStateWriter, err := createSnapshotFile(snapshotDir, publicStateFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer pubStateWriter.close()
+	pvtStateHashesWriter, err := createSnapshotFile(snapshotDir, pvtStateHashesFileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer pvtStateHashesWriter.close()
+	pubStateHasher := newStateFileHasher(false)
+	pvtStateHashesHasher := newStateFileHasher(true)
+
+	var collRecords []*privacyenabledstate.StateRecord
+	writeCollRecords := func() error {
+		sort.Slice(collRecords, func(i, j int) bool {
+			return bytes.Compare(collRecords[i].Key, collRecords[j].Key) < 0
+		})
+		for _, record := range collRecords {
+			if err := pvtStateHashesHasher.add(record); err != nil {
+				return err
+			}
+			if err := writeStateRecord(pvtStateHashesWriter, record, true); err != nil {
+				return err
+			}
+		}
+		collRecords = nil
+		return nil
+	}
+
+	for {
+		record, err := nextRecord()
+		if err != nil {
+			return nil, nil, err
+		}
+		if record == nil {
+			break
+		}
+		if record.Collection == "" {
+			if err := pubStateHasher.add(record); err != nil {
+				return nil, nil, err
+			}
+			if err := writeStateRecord(pubStateWriter, record, false); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if len(collRecords) > 0 &&
+			(collRecords[0].Namespace != record.Namespace || collRecords[0].Collection != record.Collection) {
+			if err := writeCollRecords(); err != nil {
+				return nil, nil, err
+			}
+		}
+		collRecords = append(collRecords, record)
+	}
+	if err := writeCollRecords(); err != nil {
+		return nil, nil, err
+	}
+	if _, err := pubStateWriter.done(); err != nil {
+		return nil, nil, err
+	}
+	if _, err := pvtStateHashesWriter.done(); err != nil {
+		return nil, nil, err
+	}
+	return pubStateHasher.sum(), pvtStateHashesHasher.sum(), nil
+}
+
+func exportKVs(snapshotDir, fileName string, export func(consume func(key, value []byte) error) error) ([]byte, error) {
+	w, err := createSnapshotFile(snapshotDir, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer w.close()
+	if err := export(w.addKV); err != nil {
+		return nil, err
+	}
+	return w.done()
+}
+
+func writeStateRecord(w *snapshotFileWriter, record *privacyenabledstate.StateRecord, includeCollection bool) error {
+	fields := [][]byte{[]byte(record.Namespace)}
+	if includeCollection {
+		fields = append(fields, []byte(record.Collection))
+	}
+	fields = append(fields, record.Key, record.Value, record.Metadata, record.Version.ToBytes())
+	for _, f := range fields {
+		if err := w.addBytes(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStateRecord reads the next record written by the function writeStateRecord.
+// A nil record is returned when the end of the file is reached
+func readStateRecord(r *snapshotFileReader, includeCollection bool) (*privacyenabledstate.StateRecord, error) {
+	ns, err := r.readBytes()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	numRemainingFields := 4
+	if includeCollection {
+		numRemainingFields = 5
+	}
+	fields := make([][]byte, numRemainingFields)
+	for i := range fields {
+		if fields[i], err = r.readNonEOFBytes(); err != nil {
+			return nil, err
+		}
+	}
+	record := &privacyenabledstate.StateRecord{Namespace: string(ns)}
+	if includeCollection {
+		record.Collection = string(fields[0])
+		fields = fields[1:]
+	}
+	record.Key, record.Value = fields[0], fields[1]
+	if len(fields[2]) > 0 {
+		record.Metadata = fields[2]
+	}
+	if record.Version, _, err = version.NewHeightFromBytes(fields[3]); err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error decoding the version of the key in the namespace [%s]", ns))
+	}
+	return record, nil
+}
+
+// stateFileHasher computes the hash of a state file over the canonical form of its records, so that the hash
+// does not depend on the type of the state database of the peer that generated the file. The records are
+// required to be in the canonical order and, as CouchDB stores a value that is a JSON object as a document and
+// returns it re-encoded, such a value is hashed in the encoding of the standard json package
+type stateFileHasher struct {
+	hasher            hash.Hash
+	includeCollection bool
+	lastRecord        *privacyenabledstate.StateRecord
+}
+
+func newStateFileHasher(includeCollection bool) *stateFileHasher {
+	return &stateFileHasher{
+		hasher:            sha256.New(),
+		includeCollection: includeCollection,
+	}
+}
+
+func (h *stateFileHasher) add(record *privacyenabledstate.StateRecord) error {
+	if h.lastRecord != nil && compareStateRecords(h.lastRecord, record) >= 0 {
+		return errors.Errorf("the key [%x] in the namespace [%s] and collection [%s] is not in the canonical order of the state",
+			record.Key, record.Namespace, record.Collection)
+	}
+	h.lastRecord = record
+
+	fields := [][]byte{[]byte(record.Namespace)}
+	if h.includeCollection {
+		fields = append(fields, []byte(record.Collection))
+	}
+	value := record.Value
+	if !h.includeCollection {
+		value = canonicalValue(value)
+	}
+	fields = append(fields, record.Key, value, record.Metadata, record.Version.ToBytes())
+	sizeBuf := make([]byte, binary.MaxVarintLen64)
+	for _, f := range fields {
+		n := binary.PutUvarint(sizeBuf, uint64(len(f)))
+		h.hasher.Write(sizeBuf[:n])
+		h.hasher.Write(f)
+	}
+	return nil
+}
+
+func (h *stateFileHasher) sum() []byte {
+	return h.hasher.Sum(nil)
+}
+
+func compareStateRecords(r1, r2 *privacyenabledstate.StateRecord) int {
+	if r1.Namespace != r2.Namespace {
+		return strings.Compare(r1.Namespace, r2.Namespace)
+	}
+	if r1.Collection != r2.Collection {
+		return strings.Compare(r1.Collection, r2.Collection)
+	}
+	return bytes.Compare(r1.Key, r2.Key)
+}
+
+// canonicalValue returns the encoding of the given value that is used for computing the hash of the public state.
+// Same as CouchDB, a value that unmarshals into a JSON object is re-encoded and any other value is used as is
+func canonicalValue(value []byte) []byte {
+	jsonMap := make(map[string]interface{})
+	if err := json.Unmarshal(value, &jsonMap); err != nil || jsonMap == nil {
+		return value
+	}
+	canonical, err := json.Marshal(jsonMap)
+	if err != nil {
+		return value
+	}
+	return canonical
+}
+
+// computeStateFileHash computes the hash of the state file written by the function writePubAndHashedState
+func computeStateFileHash(snapshotDir, fileName string, includeCollection bool) ([]byte, error) {
+	r, err := openSnapshotFile(snapshotDir, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+	hasher := newStateFileHasher(includeCollection)
+	for {
+		record, err := readStateRecord(r, includeCollection)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			return hasher.sum(), nil
+		}
+		if err := hasher.add(record); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func writeSnapshotMetadata(snapshotDir string, signableMetadata *snapshotSignableMetadata, commitHash []byte) error {
+	signableMetadataBytes, err := json.Marshal(signableMetadata)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling the signable metadata of the snapshot")
+	}
+	snapshotHash := sha256.Sum256(signableMetadataBytes)
+	additionalMetadataBytes, err := json.Marshal(&snapshotAdditionalMetadata{
+		SnapshotHashInHex:        hex.EncodeToString(snapshotHash[:]),
+		LastBlockCommitHashInHex: hex.EncodeToString(commitHash),
+	})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling the additional metadata of the snapshot")
+	}
+	if err := writeFileAndSync(filepath.Join(snapshotDir, snapshotSignableMetadataFileName), signableMetadataBytes); err != nil {
+		return err
+	}
+	return writeFileAndSync(filepath.Join(snapshotDir, snapshotAdditionalMetadataFileName), additionalMetadataBytes)
+}
+
+func writeFileAndSync(filePath string, content []byte) error {
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "error creating the file [%s]", filePath)
+	}
+	defer file.Close()
+	if _, err := file.Write(content); err != nil {
+		return errors.Wrapf(err, "error writing to the file [%s]", filePath)
+	}
+	return errors.Wrapf(file.Sync(), "error syncing the file [%s]", filePath)
+}
+
+// loadSnapshotMetadata reads the metadata of the snapshot in the given directory
+// and verifies the metadata against the hashes of the data files
+func loadSnapshotMetadata(snapshotDir string) (*snapshotSignableMetadata, error) {
+	signableMetadataBytes, err := ioutil.ReadFile(filepath.Join(snapshotDir, snapshotSignableMetadataFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the signable metadata of the snapshot")
+	}
+	additionalMetadataBytes, err := ioutil.ReadFile(filepath.Join(snapshotDir, snapshotAdditionalMetadataFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the additional metadata of the snapshot")
+	}
+	signableMetadata := &snapshotSignableMetadata{}
+	if err := json.Unmarshal(signableMetadataBytes, signableMetadata); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling the signable metadata of the snapshot")
+	}
+	additionalMetadata := &snapshotAdditionalMetadata{}
+	if err := json.Unmarshal(additionalMetadataBytes, additionalMetadata); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling the additional metadata of the snapshot")
+	}
+
+	snapshotHash := sha256.Sum256(signableMetadataBytes)
+	if hex.EncodeToString(snapshotHash[:]) != additionalMetadata.SnapshotHashInHex {
+		return nil, errors.Errorf("the hash of the file [%s] does not match the snapshot hash [%s]",
+			snapshotSignableMetadataFileName, additionalMetadata.SnapshotHashInHex)
+	}
+	for _, fileName := range snapshotDataFileNames {
+		expectedHash, ok := signableMetadata.FilesAndHashes[fileName]
+		if !ok {
+			return nil, errors.Errorf("the hash of the file [%s] is missing from the snapshot metadata", fileName)
+		}
+		var hash []byte
+		switch fileName {
+		case publicStateFileName:
+			hash, err = computeStateFileHash(snapshotDir, fileName, false)
+		case pvtStateHashesFileName:
+			hash, err = computeStateFileHash(snapshotDir, fileName, true)
+		default:
+			hash, err = computeFileHash(snapshotDir, fileName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hex.EncodeToString(hash) != expectedHash {
+			return nil, errors.Errorf("the hash of the file [%s] does not match the hash [%s] in the snapshot metadata",
+				fileName, expectedHash)
+		}
+	}
+	return signableMetadata, nil
+}
+
+// CreateFromSnapshot implements the corresponding method from interface ledger.PeerLedgerProvider.
+// Similar to the function 'Create', this sets the under construction flag along with the path of the snapshot
+// before importing any data. If a crash happens in between, the function 'recoverUnderConstructionLedger'
+// resumes the import, as all the steps of the import are idempotent
+func (provider *Provider) CreateFromSnapshot(snapshotDir string) (ledger.PeerLedger, string, error) {
+	snapshotDir, err := filepath.Abs(snapshotDir)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "error while resolving the path of the snapshot [%s]", snapshotDir)
+	}
+	metadata, err := loadSnapshotMetadata(snapshotDir)
+	if err != nil {
+		return nil, "", err
+	}
+	ledgerID := metadata.ChannelName
+	exists, err := provider.idStore.ledgerIDExists(ledgerID)
+	if err != nil {
+		return nil, "", err
+	}
+	if exists {
+		return nil, "", ErrLedgerIDExists
+	}
+	if err = provider.idStore.setUnderConstructionFlagForSnapshot(ledgerID, snapshotDir); err != nil {
+		return nil, "", err
+	}
+	lgr, firstBlock, err := provider.importFromSnapshot(ledgerID, snapshotDir, metadata)
+	if err != nil {
+		logger.Errorf("Error creating the ledger [%s] from the snapshot [%s]. Unsetting under construction flag. Error: %+v",
+			ledgerID, snapshotDir, err)
+		panicOnErr(provider.runCleanup(ledgerID), "Error running cleanup for ledger id [%s]", ledgerID)
+		panicOnErr(provider.idStore.unsetUnderConstructionFlag(), "Error while unsetting under construction flag")
+		return nil, "", err
+	}
+	panicOnErr(provider.idStore.createLedgerID(ledgerID, firstBlock), "Error while marking ledger as created")
+	logger.Infof("Created the ledger [%s] from the snapshot [%s] at the height [%d]", ledgerID, snapshotDir, metadata.LastBlockNumber+1)
+	return lgr, ledgerID, nil
+}
+
+// recoverLedgerUnderImport completes the creation of a ledger from a snapshot
+// that could not be completed because of a crash
+func (provider *Provider) recoverLedgerUnderImport(ledgerID, snapshotDir string) {
+	logger.Infof("Resuming the creation of the ledger [%s] from the snapshot [%s]", ledgerID, snapshotDir)
+	metadata, err := loadSnapshotMetadata(snapshotDir)
+	panicOnErr(err, "Error while loading the snapshot [%s] for the under construction ledger [%s]", snapshotDir, ledgerID)
+	if metadata.ChannelName != ledgerID {
+		panic(errors.Errorf(
+			"data inconsistency: the snapshot [%s] belongs to the channel [%s] while the under construction ledger is [%s]",
+			snapshotDir, metadata.ChannelName, ledgerID))
+	}
+	lgr, firstBlock, err := provider.importFromSnapshot(ledgerID, snapshotDir, metadata)
+	panicOnErr(err, "Error while creating the ledger [%s] from the snapshot [%s]", ledgerID, snapshotDir)
+	lgr.Close()
+	panicOnErr(provider.idStore.createLedgerID(ledgerID, firstBlock), "Error while adding ledgerID [%s] to created list", ledgerID)
+}
+
+// importFromSnapshot loads the data from the snapshot into the stores of the given ledger and opens the ledger.
+// The blocks in the snapshot are committed to the history db so that the history db carries the savepoint at the
+// last block. The history of the keys prior to these blocks is not available in the created ledger. This function
+// returns the opened ledger and the first block of the snapshot, which is the last config block
+func (provider *Provider) importFromSnapshot(ledgerID, snapshotDir string, metadata *snapshotSignableMetadata) (ledger.PeerLedger, *common.Block, error) {
+	blocks, err := loadSnapshotBlocks(snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	firstBlock, lastBlock := blocks[0], blocks[len(blocks)-1]
+	if firstBlock.Header.Number != metadata.LastConfigBlockNumber ||
+		lastBlock.Header.Number != metadata.LastBlockNumber ||
+		hex.EncodeToString(lastBlock.Header.Hash()) != metadata.LastBlockHashInHex {
+		return nil, nil, errors.Errorf("the blocks in the snapshot [%s] do not match the snapshot metadata", snapshotDir)
+	}
+
+	if err := provider.ledgerStoreProvider.BootstrapFromBlocks(ledgerID, blocks); err != nil {
+		return nil, nil, err
+	}
+	if err := importKVs(snapshotDir, configHistoryFileName, func(nextEntry func() ([]byte, []byte, error)) error {
+		return provider.configHistoryMgr.ImportConfigHistory(ledgerID, nextEntry)
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := importKVs(snapshotDir, pvtdataExpiryFileName, func(nextEntry func() ([]byte, []byte, error)) error {
+		return pvtstatepurgemgmt.ImportExpirySchedule(ledgerID, provider.bookkeepingProvider, nextEntry)
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	historyDB, err := provider.historydbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, block := range blocks {
+		if err := historyDB.Commit(block); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	vdb, err := provider.vdbProvider.GetDBHandle(ledgerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	savepoint := version.NewHeight(lastBlock.Header.Number, uint64(len(lastBlock.Data.Data)-1))
+	if err := importPubAndHashedState(snapshotDir, vdb, savepoint); err != nil {
+		return nil, nil, err
+	}
+
+	lgr, err := provider.openInternal(ledgerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	bcInfo, err := lgr.GetBlockchainInfo()
+	if err != nil {
+		lgr.Close()
+		return nil, nil, err
+	}
+	if bcInfo.Height != lastBlock.Header.Number+1 || !bytes.Equal(bcInfo.CurrentBlockHash, lastBlock.Header.Hash()) {
+		lgr.Close()
+		return nil, nil, errors.Errorf("the ledger [%s] created from the snapshot has an unexpected blockchain info %#v", ledgerID, bcInfo)
+	}
+	return lgr, firstBlock, nil
+}
+
+func loadSnapshotBlocks(snapshotDir string) ([]*common.Block, error) {
+	r, err := openSnapshotFile(snapshotDir, blocksFileName)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+	var blocks []*common.Block
+	for {
+		blockBytes, err := r.readBytes()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		block, err := utils.GetBlockFromBlockBytes(blockBytes)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	if len(blocks) == 0 {
+		return nil, errors.Errorf("no blocks found in the snapshot [%s]", snapshotDir)
+	}
+	return blocks, nil
+}
+
+func importKVs(snapshotDir, fileName string, importFunc func(nextEntry func() ([]byte, []byte, error)) error) error {
+	r, err := openSnapshotFile(snapshotDir, fileName)
+	if err != nil {
+		return err
+	}
+	defer r.close()
+	return importFunc(r.nextKV)
+}
+
+func importPubAndHashedState(snapshotDir string, db privacyenabledstate.DB, savepoint *version.Height) error {
+	pubStateReader, err := openSnapshotFile(snapshotDir, publicStateFileName)
+	if err != nil {
+		return err
+	}
+	defer pubStateReader.close()
+	pvtStateHashesReader, err := openSnapshotFile(snapshotDir, pvtStateHashesFileName)
+	if err != nil {
+		return err
+	}
+	defer pvtStateHashesReader.close()
+
+	pubStateDone := false
+	return db.ImportPubAndHashedState(
+		func() (*privacyenabledstate.StateRecord, error) {
+			if !pubStateDone {
+				record, err := readStateRecord(pubStateReader, false)
+				if err != nil || record != nil {
+					return record, err
+				}
+				pubStateDone = true
+			}
+			return readStateRecord(pvtStateHashesReader, true)
+		},
+		savepoint,
+	)
+}
+
+// noopHealthCheckRegistry is used for opening the state database outside of a running peer
+type noopHealthCheckRegistry struct{}
+
+func (r *noopHealthCheckRegistry) RegisterChecker(string, healthz.HealthChecker) error {
+	return nil
+}
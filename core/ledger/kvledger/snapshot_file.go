@@ -0,0 +1,157 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// snapshotFileWriter writes a snapshot file as a sequence of length-prefixed byte arrays
+// and computes the hash of the contents of the file alongside
+type snapshotFileWriter struct {
+	file      *os.File
+	bufWriter *bufio.Writer
+	hasher    hash.Hash
+	writer    io.Writer
+}
+
+func createSnapshotFile(snapshotDir, fileName string) (*snapshotFileWriter, error) {
+	filePath := filepath.Join(snapshotDir, fileName)
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the snapshot file [%s]", filePath)
+	}
+	bufWriter := bufio.NewWriter(file)
+	hasher := sha256.New()
+	return &snapshotFileWriter{
+		file:      file,
+		bufWriter: bufWriter,
+		hasher:    hasher,
+		writer:    io.MultiWriter(bufWriter, hasher),
+	}, nil
+}
+
+func (w *snapshotFileWriter) addBytes(b []byte) error {
+	sizeBuf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(sizeBuf, uint64(len(b)))
+	if _, err := w.writer.Write(sizeBuf[:n]); err != nil {
+		return errors.Wrapf(err, "error writing to the snapshot file [%s]", w.file.Name())
+	}
+	if _, err := w.writer.Write(b); err != nil {
+		return errors.Wrapf(err, "error writing to the snapshot file [%s]", w.file.Name())
+	}
+	return nil
+}
+
+func (w *snapshotFileWriter) addKV(key, value []byte) error {
+	if err := w.addBytes(key); err != nil {
+		return err
+	}
+	return w.addBytes(value)
+}
+
+// done flushes and syncs the file and returns the hash of the contents of the file
+func (w *snapshotFileWriter) done() ([]byte, error) {
+	if err := w.bufWriter.Flush(); err != nil {
+		return nil, errors.Wrapf(err, "error flushing the snapshot file [%s]", w.file.Name())
+	}
+	if err := w.file.Sync(); err != nil {
+		return nil, errors.Wrapf(err, "error syncing the snapshot file [%s]", w.file.Name())
+	}
+	if err := w.file.Close(); err != nil {
+		return nil, errors.Wrapf(err, "error closing the snapshot file [%s]", w.file.Name())
+	}
+	return w.hasher.Sum(nil), nil
+}
+
+func (w *snapshotFileWriter) close() {
+	w.file.Close()
+}
+
+// snapshotFileReader reads the byte arrays written by the snapshotFileWriter
+type snapshotFileReader struct {
+	file      *os.File
+	bufReader *bufio.Reader
+}
+
+func openSnapshotFile(snapshotDir, fileName string) (*snapshotFileReader, error) {
+	filePath := filepath.Join(snapshotDir, fileName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the snapshot file [%s]", filePath)
+	}
+	return &snapshotFileReader{file: file, bufReader: bufio.NewReader(file)}, nil
+}
+
+// readBytes returns the next byte array from the file. The error io.EOF is returned
+// (unwrapped) only if the end of the file has been reached cleanly
+func (r *snapshotFileReader) readBytes() ([]byte, error) {
+	size, err := binary.ReadUvarint(r.bufReader)
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading from the snapshot file [%s]", r.file.Name())
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r.bufReader, b); err != nil {
+		return nil, errors.Wrapf(err, "error reading from the snapshot file [%s]", r.file.Name())
+	}
+	return b, nil
+}
+
+// readNonEOFBytes is same as readBytes except that it treats the end of the file as an error
+func (r *snapshotFileReader) readNonEOFBytes() ([]byte, error) {
+	b, err := r.readBytes()
+	if err == io.EOF {
+		return nil, errors.Errorf("unexpected end of the snapshot file [%s]", r.file.Name())
+	}
+	return b, err
+}
+
+// nextKV reads the next key-value pair from the file. A nil key is returned when the end of the file is reached
+func (r *snapshotFileReader) nextKV() ([]byte, []byte, error) {
+	key, err := r.readBytes()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := r.readNonEOFBytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+func (r *snapshotFileReader) close() {
+	r.file.Close()
+}
+
+// computeFileHash computes the hash of the contents of the given snapshot file
+func computeFileHash(snapshotDir, fileName string) ([]byte, error) {
+	filePath := filepath.Join(snapshotDir, fileName)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the snapshot file [%s]", filePath)
+	}
+	defer file.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return nil, errors.Wrapf(err, "error reading the snapshot file [%s]", filePath)
+	}
+	return hasher.Sum(nil), nil
+}
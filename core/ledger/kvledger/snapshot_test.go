@@ -0,0 +1,312 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	putils "github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSnapshotAndCreateFromSnapshot(t *testing.T) {
+	viper.Set("ledger.history.enableHistoryDatabase", true)
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	// create a ledger with a few blocks in the source environment
+	sourceEnv := newTestEnv(t)
+	defer sourceEnv.cleanup()
+	provider := testutilNewProvider(t)
+	bg, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+	blocks := []*common.Block{gb}
+	for i, value := range []string{"value1", "value2", "value3"} {
+		simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+		require.NoError(t, err)
+		require.NoError(t, simulator.SetState("ns1", "key1", []byte(value)))
+		require.NoError(t, simulator.SetState("ns2", "key2", []byte(value)))
+		simulator.Done()
+		simRes, err := simulator.GetTxSimulationResults()
+		require.NoError(t, err)
+		pubSimBytes, err := simRes.GetPubSimulationBytes()
+		require.NoError(t, err)
+		block := bg.NextBlock([][]byte{pubSimBytes})
+		// treat block 2 as the last config block
+		lastConfigIndex := uint64(0)
+		if i > 0 {
+			lastConfigIndex = 2
+		}
+		block.Metadata.Metadata[common.BlockMetadataIndex_LAST_CONFIG] = putils.MarshalOrPanic(&common.Metadata{
+			Value: putils.MarshalOrPanic(&common.LastConfig{Index: lastConfigIndex}),
+		})
+		require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}, &lgr.CommitOptions{}))
+		blocks = append(blocks, block)
+	}
+	ledger.Close()
+	provider.Close()
+
+	assert.Equal(t, ErrNonExistingLedgerID, GenerateSnapshot("non-existing-ledger", snapshotDir))
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+	err = GenerateSnapshot("testLedger", snapshotDir)
+	assert.EqualError(t, err, "the snapshot directory ["+snapshotDir+"] is not empty")
+
+	signableMetadataBytes, err := ioutil.ReadFile(filepath.Join(snapshotDir, snapshotSignableMetadataFileName))
+	require.NoError(t, err)
+	signableMetadata := &snapshotSignableMetadata{}
+	require.NoError(t, json.Unmarshal(signableMetadataBytes, signableMetadata))
+	assert.Equal(t, "testLedger", signableMetadata.ChannelName)
+	assert.Equal(t, uint64(3), signableMetadata.LastBlockNumber)
+	assert.Equal(t, hex.EncodeToString(blocks[3].Header.Hash()), signableMetadata.LastBlockHashInHex)
+	assert.Equal(t, hex.EncodeToString(blocks[2].Header.Hash()), signableMetadata.PreviousBlockHashInHex)
+	assert.Equal(t, uint64(2), signableMetadata.LastConfigBlockNumber)
+	assert.Len(t, signableMetadata.FilesAndHashes, len(snapshotDataFileNames))
+
+	// create the ledger from the snapshot in a different environment
+	targetEnv := newTestEnv(t)
+	defer targetEnv.cleanup()
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+	ledger, ledgerID, err := provider.CreateFromSnapshot(snapshotDir)
+	require.NoError(t, err)
+	defer ledger.Close()
+	assert.Equal(t, "testLedger", ledgerID)
+	ledgerIDs, err := provider.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"testLedger"}, ledgerIDs)
+
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	assert.Equal(t, ErrLedgerIDExists, err)
+
+	bcInfo, err := ledger.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, &common.BlockchainInfo{
+		Height: 4, CurrentBlockHash: blocks[3].Header.Hash(), PreviousBlockHash: blocks[2].Header.Hash(),
+	}, bcInfo)
+	_, err = ledger.GetBlockByNumber(1)
+	assert.IsType(t, &lgr.BlockPrunedErr{}, err)
+	b2, err := ledger.GetBlockByNumber(2)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(blocks[2], b2), "proto messages are not equal")
+
+	qe, err := ledger.NewQueryExecutor()
+	require.NoError(t, err)
+	val, err := qe.GetState("ns1", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value3"), val)
+	val, err = qe.GetState("ns2", "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value3"), val)
+	qe.Done()
+
+	// the history is available only for the blocks that are present in the snapshot
+	hqe, err := ledger.NewHistoryQueryExecutor()
+	require.NoError(t, err)
+	itr, err := hqe.GetHistoryForKey("ns1", "key1")
+	require.NoError(t, err)
+	var historyValues []string
+	for {
+		res, err := itr.Next()
+		require.NoError(t, err)
+		if res == nil {
+			break
+		}
+		historyValues = append(historyValues, string(res.(*queryresult.KeyModification).Value))
+	}
+	itr.Close()
+	assert.Equal(t, []string{"value2", "value3"}, historyValues)
+
+	// the created ledger continues to commit blocks
+	simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+	require.NoError(t, err)
+	require.NoError(t, simulator.SetState("ns1", "key1", []byte("value4")))
+	simulator.Done()
+	simRes, err := simulator.GetTxSimulationResults()
+	require.NoError(t, err)
+	pubSimBytes, err := simRes.GetPubSimulationBytes()
+	require.NoError(t, err)
+	block4 := bg.NextBlock([][]byte{pubSimBytes})
+	require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block4}, &lgr.CommitOptions{}))
+	qe, err = ledger.NewQueryExecutor()
+	require.NoError(t, err)
+	val, err = qe.GetState("ns1", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value4"), val)
+	qe.Done()
+}
+
+func TestCreateFromSnapshotRecovery(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	sourceEnv := newTestEnv(t)
+	defer sourceEnv.cleanup()
+	provider := testutilNewProvider(t)
+	_, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+	ledger.Close()
+	provider.Close()
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+	// simulate a crash after the under construction flag is set for the import
+	targetEnv := newTestEnv(t)
+	defer targetEnv.cleanup()
+	provider = testutilNewProvider(t)
+	require.NoError(t, provider.(*Provider).idStore.setUnderConstructionFlagForSnapshot("testLedger", snapshotDir))
+	ledgerIDs, err := provider.List()
+	assert.NoError(t, err)
+	assert.Len(t, ledgerIDs, 0)
+	provider.Close()
+
+	// the import is completed by the recovery
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+	ledgerIDs, err = provider.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"testLedger"}, ledgerIDs)
+	ledger, err = provider.Open("testLedger")
+	require.NoError(t, err)
+	defer ledger.Close()
+	bcInfo, err := ledger.GetBlockchainInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, &common.BlockchainInfo{Height: 1, CurrentBlockHash: gb.Header.Hash()}, bcInfo)
+	snapshotUnderImport, err := provider.(*Provider).idStore.getSnapshotUnderImport()
+	assert.NoError(t, err)
+	assert.Equal(t, "", snapshotUnderImport)
+}
+
+func TestCreateFromSnapshotTamperedFiles(t *testing.T) {
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	snapshotDir = filepath.Join(snapshotDir, "testLedger")
+
+	env := newTestEnv(t)
+	defer env.cleanup()
+	provider := testutilNewProvider(t)
+	_, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+	ledger.Close()
+	provider.Close()
+	require.NoError(t, GenerateSnapshot("testLedger", snapshotDir))
+
+	targetEnv := newTestEnv(t)
+	defer targetEnv.cleanup()
+	provider = testutilNewProvider(t)
+	defer provider.Close()
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(snapshotDir, publicStateFileName), []byte("tampered"), 0644))
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	assert.Error(t, err)
+
+	require.NoError(t, os.Remove(filepath.Join(snapshotDir, publicStateFileName)))
+	w, err := createSnapshotFile(snapshotDir, publicStateFileName)
+	require.NoError(t, err)
+	require.NoError(t, writeStateRecord(w, &privacyenabledstate.StateRecord{
+		Namespace: "ns1", Key: []byte("key1"), Value: []byte("tampered"), Version: version.NewHeight(1, 1),
+	}, false))
+	_, err = w.done()
+	require.NoError(t, err)
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	assert.Contains(t, err.Error(), "the hash of the file [public_state.data] does not match the hash")
+
+	additionalMetadataFile := filepath.Join(snapshotDir, snapshotAdditionalMetadataFileName)
+	require.NoError(t, ioutil.WriteFile(additionalMetadataFile, []byte(`{"snapshot_hash":"abcd"}`), 0644))
+	_, _, err = provider.CreateFromSnapshot(snapshotDir)
+	assert.EqualError(t, err, "the hash of the file [_snapshot_signable_metadata.json] does not match the snapshot hash [abcd]")
+
+	ledgerIDs, err := provider.List()
+	assert.NoError(t, err)
+	assert.Len(t, ledgerIDs, 0)
+}
+
+func TestStateFileHashesIndependentOfStateDB(t *testing.T) {
+	// the same state as supplied by goleveldb and by CouchDB, which re-encodes the JSON values
+	// and orders the hashed keys by their base64 encoding
+	levelDBRecords := []*privacyenabledstate.StateRecord{
+		{Namespace: "ns1", Key: []byte("key1"), Value: []byte(`{"b": 1.50, "a": "x"}`), Version: version.NewHeight(1, 1)},
+		{Namespace: "ns1", Key: []byte("key2"), Value: []byte("not-json"), Version: version.NewHeight(1, 2)},
+		{Namespace: "ns1", Collection: "coll1", Key: []byte{0x01}, Value: []byte("hash1"), Version: version.NewHeight(1, 3)},
+		{Namespace: "ns1", Collection: "coll1", Key: []byte{0xff}, Value: []byte("hash2"), Version: version.NewHeight(1, 4)},
+		{Namespace: "ns2", Key: []byte("key1"), Value: []byte("value1"), Metadata: []byte("metadata"), Version: version.NewHeight(2, 1)},
+	}
+	couchDBRecords := []*privacyenabledstate.StateRecord{
+		{Namespace: "ns1", Key: []byte("key1"), Value: []byte(`{"a":"x","b":1.5}`), Version: version.NewHeight(1, 1)},
+		{Namespace: "ns1", Key: []byte("key2"), Value: []byte("not-json"), Version: version.NewHeight(1, 2)},
+		{Namespace: "ns1", Collection: "coll1", Key: []byte{0xff}, Value: []byte("hash2"), Version: version.NewHeight(1, 4)},
+		{Namespace: "ns1", Collection: "coll1", Key: []byte{0x01}, Value: []byte("hash1"), Version: version.NewHeight(1, 3)},
+		{Namespace: "ns2", Key: []byte("key1"), Value: []byte("value1"), Metadata: []byte("metadata"), Version: version.NewHeight(2, 1)},
+	}
+
+	writeState := func(records []*privacyenabledstate.StateRecord) (string, []byte, []byte) {
+		snapshotDir, err := ioutil.TempDir("", "snapshot")
+		require.NoError(t, err)
+		i := 0
+		pubStateHash, pvtStateHashesHash, err := writePubAndHashedState(snapshotDir, func() (*privacyenabledstate.StateRecord, error) {
+			if i == len(records) {
+				return nil, nil
+			}
+			i++
+			return records[i-1], nil
+		})
+		require.NoError(t, err)
+		return snapshotDir, pubStateHash, pvtStateHashesHash
+	}
+
+	levelDBSnapshotDir, levelDBPubStateHash, levelDBPvtStateHashesHash := writeState(levelDBRecords)
+	defer os.RemoveAll(levelDBSnapshotDir)
+	couchDBSnapshotDir, couchDBPubStateHash, couchDBPvtStateHashesHash := writeState(couchDBRecords)
+	defer os.RemoveAll(couchDBSnapshotDir)
+	assert.Equal(t, levelDBPubStateHash, couchDBPubStateHash)
+	assert.Equal(t, levelDBPvtStateHashesHash, couchDBPvtStateHashesHash)
+
+	for _, snapshotDir := range []string{levelDBSnapshotDir, couchDBSnapshotDir} {
+		hash, err := computeStateFileHash(snapshotDir, publicStateFileName, false)
+		require.NoError(t, err)
+		assert.Equal(t, levelDBPubStateHash, hash)
+		hash, err = computeStateFileHash(snapshotDir, pvtStateHashesFileName, true)
+		require.NoError(t, err)
+		assert.Equal(t, levelDBPvtStateHashesHash, hash)
+	}
+
+	// the public keys are expected in the canonical order
+	snapshotDir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(snapshotDir)
+	unorderedRecords := []*privacyenabledstate.StateRecord{levelDBRecords[1], levelDBRecords[0]}
+	_, _, err = writePubAndHashedState(snapshotDir, func() (*privacyenabledstate.StateRecord, error) {
+		if len(unorderedRecords) == 0 {
+			return nil, nil
+		}
+		record := unorderedRecords[0]
+		unorderedRecords = unorderedRecords[1:]
+		return record, nil
+	})
+	assert.EqualError(t, err, "the key [6b657931] in the namespace [ns1] and collection [] is not in the canonical order of the state")
+}
@@ -8,6 +8,7 @@ package privacyenabledstate
 
 import (
 	"encoding/base64"
+	"fmt"
 	"strings"
 
 	"github.com/hyperledger/fabric-lib-go/healthz"
@@ -42,14 +43,9 @@ type CommonStorageDBProvider struct {
 
 // NewCommonStorageDBProvider constructs an instance of DBProvider
 func NewCommonStorageDBProvider(bookkeeperProvider bookkeeping.Provider, metricsProvider metrics.Provider, healthCheckRegistry ledger.HealthCheckRegistry) (DBProvider, error) {
-	var vdbProvider statedb.VersionedDBProvider
-	var err error
-	if ledgerconfig.IsCouchDBEnabled() {
-		if vdbProvider, err = statecouchdb.NewVersionedDBProvider(metricsProvider); err != nil {
-			return nil, err
-		}
-	} else {
-		vdbProvider = stateleveldb.NewVersionedDBProvider()
+	vdbProvider, err := newVersionedDBProvider(metricsProvider)
+	if err != nil {
+		return nil, err
 	}
 
 	dbProvider := &CommonStorageDBProvider{vdbProvider, healthCheckRegistry, bookkeeperProvider}
@@ -62,9 +58,79 @@ func NewCommonStorageDBProvider(bookkeeperProvider bookkeeping.Provider, metrics
 	return dbProvider, nil
 }
 
+// NewCommonStorageDBProviderWithVDBProvider constructs an instance of DBProvider on top of the supplied
+// VersionedDBProvider instead of the one configured for the peer. No health checker is registered for
+// the supplied VersionedDBProvider
+func NewCommonStorageDBProviderWithVDBProvider(vdbProvider statedb.VersionedDBProvider, bookkeeperProvider bookkeeping.Provider) DBProvider {
+	return &CommonStorageDBProvider{VersionedDBProvider: vdbProvider, bookkeepingProvider: bookkeeperProvider}
+}
+
+// newVersionedDBProvider constructs the VersionedDBProvider for the state database configured via
+// 'ledger.state.stateDatabase'. Other than the built-in goleveldb and CouchDB, the configured name
+// is looked up in the statedb registry and, if not found there, the factory is loaded from the
+// plugin library configured via 'ledger.state.stateDBPlugin.library'
+func newVersionedDBProvider(metricsProvider metrics.Provider) (statedb.VersionedDBProvider, error) {
+	stateDatabase := ledgerconfig.GetStateDatabase()
+	if !isExternalStateDatabase(stateDatabase) {
+		if ledgerconfig.IsCouchDBEnabled() {
+			return statecouchdb.NewVersionedDBProvider(metricsProvider)
+		}
+		return stateleveldb.NewVersionedDBProvider(), nil
+	}
+
+	factory, ok := statedb.GetVersionedDBProviderFactory(stateDatabase)
+	if !ok {
+		library := ledgerconfig.GetStateDBPluginLibrary()
+		if library == "" {
+			return nil, errors.Errorf("unknown state database [%s]: it is neither registered nor is a plugin library configured", stateDatabase)
+		}
+		var err error
+		if factory, err = statedb.LoadVersionedDBProviderFactory(library); err != nil {
+			return nil, err
+		}
+	}
+	logger.Infof("Using the external state database [%s]", stateDatabase)
+	vdbProvider, err := factory(ledgerconfig.GetStateDBPluginConfig())
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error while constructing the provider for the state database [%s]", stateDatabase))
+	}
+	return vdbProvider, nil
+}
+
+// DropStateDBs drops the state of the given ledgers from the state database configured via
+// 'ledger.state.stateDatabase'. This is used for rebuilding the state of a subset of the ledgers
+// and hence, the VersionedDBProvider is required to implement the interface statedb.DroppableDBProvider
+func DropStateDBs(ledgerIDs []string, metricsProvider metrics.Provider) error {
+	vdbProvider, err := newVersionedDBProvider(metricsProvider)
+	if err != nil {
+		return err
+	}
+	defer vdbProvider.Close()
+	droppable, ok := vdbProvider.(statedb.DroppableDBProvider)
+	if !ok {
+		return errors.Errorf("the state database [%s] does not support dropping the state of a ledger", ledgerconfig.GetStateDatabase())
+	}
+	for _, ledgerID := range ledgerIDs {
+		logger.Infof("Dropping the state of the ledger [%s] from the state database [%s]", ledgerID, ledgerconfig.GetStateDatabase())
+		if err := droppable.Drop(ledgerID); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error while dropping the state of the ledger [%s]", ledgerID))
+		}
+	}
+	return nil
+}
+
+// isExternalStateDatabase returns true if the configured state database is neither goleveldb nor CouchDB
+func isExternalStateDatabase(stateDatabase string) bool {
+	return stateDatabase != "" && stateDatabase != "goleveldb" && stateDatabase != "CouchDB"
+}
+
 func (p *CommonStorageDBProvider) RegisterHealthChecker() error {
 	if healthChecker, ok := p.VersionedDBProvider.(healthz.HealthChecker); ok {
-		return p.HealthCheckRegistry.RegisterChecker("couchdb", healthChecker)
+		component := "couchdb"
+		if stateDatabase := ledgerconfig.GetStateDatabase(); isExternalStateDatabase(stateDatabase) {
+			component = stateDatabase
+		}
+		return p.HealthCheckRegistry.RegisterChecker(component, healthChecker)
 	}
 	return nil
 }
@@ -7,13 +7,22 @@ SPDX-License-Identifier: Apache-2.0
 package privacyenabledstate_test
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
 	"testing"
 
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/statecouchdb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/mock"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 )
 
 func TestHealthCheckRegister(t *testing.T) {
@@ -38,3 +47,119 @@ func TestHealthCheckRegister(t *testing.T) {
 	gt.Expect(arg1).To(Equal("couchdb"))
 	gt.Expect(arg2).NotTo(Equal(nil))
 }
+
+func TestNewCommonStorageDBProviderExternalStateDB(t *testing.T) {
+	gt := NewGomegaWithT(t)
+	testPath, err := ioutil.TempDir("", "externalstatedb")
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Set("peer.fileSystemPath", "/tmp/fabric/ledgertests/kvledger/txmgmt/privacyenabledstate")
+	defer viper.Set("ledger.state.stateDatabase", "")
+	defer viper.Set("ledger.state.stateDBPlugin.config", nil)
+
+	bookkeeperTestEnv := bookkeeping.NewTestEnv(t)
+	defer bookkeeperTestEnv.Cleanup()
+
+	t.Run("unknown state database", func(t *testing.T) {
+		gt := NewGomegaWithT(t)
+		viper.Set("ledger.state.stateDatabase", "testunknowndb")
+		_, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeeperTestEnv.TestProvider, &disabled.Provider{}, &mock.HealthCheckRegistry{})
+		gt.Expect(err).To(MatchError("unknown state database [testunknowndb]: it is neither registered nor is a plugin library configured"))
+	})
+
+	t.Run("registered state database", func(t *testing.T) {
+		gt := NewGomegaWithT(t)
+		var suppliedConfig map[string]interface{}
+		statedb.RegisterVersionedDBProviderFactory("testexternaldb", func(config map[string]interface{}) (statedb.VersionedDBProvider, error) {
+			suppliedConfig = config
+			return &healthCheckingVDBProvider{stateleveldb.NewVersionedDBProvider()}, nil
+		})
+		viper.Set("ledger.state.stateDatabase", "testexternaldb")
+		viper.Set("ledger.state.stateDBPlugin.config", map[string]interface{}{"address": "127.0.0.1:7000"})
+
+		fakeHealthCheckRegistry := &mock.HealthCheckRegistry{}
+		dbProvider, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeeperTestEnv.TestProvider, &disabled.Provider{}, fakeHealthCheckRegistry)
+		gt.Expect(err).NotTo(HaveOccurred())
+		defer dbProvider.Close()
+		gt.Expect(suppliedConfig).To(Equal(map[string]interface{}{"address": "127.0.0.1:7000"}))
+		gt.Expect(fakeHealthCheckRegistry.RegisterCheckerCallCount()).To(Equal(1))
+		component, _ := fakeHealthCheckRegistry.RegisterCheckerArgsForCall(0)
+		gt.Expect(component).To(Equal("testexternaldb"))
+
+		db, err := dbProvider.GetDBHandle("testledger")
+		gt.Expect(err).NotTo(HaveOccurred())
+		batch := privacyenabledstate.NewUpdateBatch()
+		batch.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+		gt.Expect(db.ApplyPrivacyAwareUpdates(batch, version.NewHeight(1, 1))).To(Succeed())
+		vv, err := db.GetState("ns1", "key1")
+		gt.Expect(err).NotTo(HaveOccurred())
+		gt.Expect(vv.Value).To(Equal([]byte("value1")))
+	})
+
+	t.Run("state database factory returns error", func(t *testing.T) {
+		gt := NewGomegaWithT(t)
+		statedb.RegisterVersionedDBProviderFactory("testfailingdb", func(config map[string]interface{}) (statedb.VersionedDBProvider, error) {
+			return nil, errors.New("unreachable store")
+		})
+		viper.Set("ledger.state.stateDatabase", "testfailingdb")
+		_, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeeperTestEnv.TestProvider, &disabled.Provider{}, &mock.HealthCheckRegistry{})
+		gt.Expect(err).To(MatchError("error while constructing the provider for the state database [testfailingdb]: unreachable store"))
+	})
+}
+
+func TestDropStateDBs(t *testing.T) {
+	gt := NewGomegaWithT(t)
+	testPath, err := ioutil.TempDir("", "dropstatedbs")
+	gt.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Set("peer.fileSystemPath", "/tmp/fabric/ledgertests/kvledger/txmgmt/privacyenabledstate")
+	defer viper.Set("ledger.state.stateDatabase", "")
+
+	t.Run("droppable state database", func(t *testing.T) {
+		gt := NewGomegaWithT(t)
+		vdbProvider := stateleveldb.NewVersionedDBProvider()
+		for _, ledgerID := range []string{"ledger1", "ledger2"} {
+			db, err := vdbProvider.GetDBHandle(ledgerID)
+			gt.Expect(err).NotTo(HaveOccurred())
+			batch := statedb.NewUpdateBatch()
+			batch.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+			gt.Expect(db.ApplyUpdates(batch, version.NewHeight(1, 1))).To(Succeed())
+		}
+		vdbProvider.Close()
+
+		gt.Expect(privacyenabledstate.DropStateDBs([]string{"ledger1"}, &disabled.Provider{})).To(Succeed())
+
+		vdbProvider = stateleveldb.NewVersionedDBProvider()
+		defer vdbProvider.Close()
+		db, err := vdbProvider.GetDBHandle("ledger1")
+		gt.Expect(err).NotTo(HaveOccurred())
+		savepoint, err := db.GetLatestSavePoint()
+		gt.Expect(err).NotTo(HaveOccurred())
+		gt.Expect(savepoint).To(BeNil())
+		db, err = vdbProvider.GetDBHandle("ledger2")
+		gt.Expect(err).NotTo(HaveOccurred())
+		vv, err := db.GetState("ns1", "key1")
+		gt.Expect(err).NotTo(HaveOccurred())
+		gt.Expect(vv.Value).To(Equal([]byte("value1")))
+	})
+
+	t.Run("non-droppable state database", func(t *testing.T) {
+		gt := NewGomegaWithT(t)
+		statedb.RegisterVersionedDBProviderFactory("testnondroppabledb", func(config map[string]interface{}) (statedb.VersionedDBProvider, error) {
+			return &healthCheckingVDBProvider{stateleveldb.NewVersionedDBProvider()}, nil
+		})
+		viper.Set("ledger.state.stateDatabase", "testnondroppabledb")
+		err := privacyenabledstate.DropStateDBs([]string{"ledger1"}, &disabled.Provider{})
+		gt.Expect(err).To(MatchError("the state database [testnondroppabledb] does not support dropping the state of a ledger"))
+	})
+}
+
+type healthCheckingVDBProvider struct {
+	statedb.VersionedDBProvider
+}
+
+func (p *healthCheckingVDBProvider) HealthCheck(ctx context.Context) error {
+	return nil
+}
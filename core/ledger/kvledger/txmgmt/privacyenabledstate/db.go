@@ -9,6 +9,7 @@ package privacyenabledstate
 import (
 	"fmt"
 
+	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/cceventmgmt"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
@@ -39,6 +40,9 @@ type DB interface {
 	GetPrivateDataMetadataByHash(namespace, collection string, keyHash []byte) ([]byte, error)
 	ExecuteQueryOnPrivateData(namespace, collection, query string) (statedb.ResultsIterator, error)
 	ApplyPrivacyAwareUpdates(updates *UpdateBatch, height *version.Height) error
+	GetPubAndHashedStateIterator() (*StateIterator, error)
+	ImportPubAndHashedState(nextRecord func() (*StateRecord, error), savepoint *version.Height) error
+	GetStateDBIndexManager() (ledger.StateDBIndexManager, error)
 }
 
 // PvtdataCompositeKey encloses Namespace, CollectionName and Key components
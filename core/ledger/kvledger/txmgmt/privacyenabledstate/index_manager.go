@@ -0,0 +1,81 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privacyenabledstate
+
+import (
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/pkg/errors"
+)
+
+// indexManager implements the interface ledger.StateDBIndexManager on top of a state database that implements
+// the interface statedb.IndexManageable. The indexes of a collection are managed in the namespace that holds
+// the private data of the collection, i.e., the same namespace where the indexes packaged with the chaincode
+// for the collection are created
+type indexManager struct {
+	db statedb.IndexManageable
+}
+
+// GetStateDBIndexManager implements corresponding function in interface DB
+func (s *CommonStorageDB) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	indexManageable, ok := s.VersionedDB.(statedb.IndexManageable)
+	if !ok {
+		return nil, errors.New("the state database does not support managing indexes")
+	}
+	return &indexManager{db: indexManageable}, nil
+}
+
+// ListIndexes implements method in interface ledger.StateDBIndexManager
+func (m *indexManager) ListIndexes(namespace, collection string) ([]*ledger.StateDBIndex, error) {
+	indexInfos, err := m.db.ListIndexes(deriveIndexNs(namespace, collection))
+	if err != nil {
+		return nil, err
+	}
+	var indexes []*ledger.StateDBIndex
+	for _, indexInfo := range indexInfos {
+		indexes = append(indexes, toStateDBIndex(indexInfo))
+	}
+	return indexes, nil
+}
+
+// CreateIndex implements method in interface ledger.StateDBIndexManager
+func (m *indexManager) CreateIndex(namespace, collection, indexDefinition string) (*ledger.StateDBIndex, error) {
+	indexInfo, err := m.db.CreateIndex(deriveIndexNs(namespace, collection), indexDefinition)
+	if err != nil {
+		return nil, err
+	}
+	return toStateDBIndex(indexInfo), nil
+}
+
+// DropIndex implements method in interface ledger.StateDBIndexManager
+func (m *indexManager) DropIndex(namespace, collection, designDoc, indexName string) error {
+	return m.db.DropIndex(deriveIndexNs(namespace, collection), designDoc, indexName)
+}
+
+// ExplainQuery implements method in interface ledger.StateDBIndexManager
+func (m *indexManager) ExplainQuery(namespace, collection, query string) (*ledger.StateDBIndex, error) {
+	indexInfo, err := m.db.ExplainQuery(deriveIndexNs(namespace, collection), query)
+	if err != nil {
+		return nil, err
+	}
+	return toStateDBIndex(indexInfo), nil
+}
+
+func deriveIndexNs(namespace, collection string) string {
+	if collection == "" {
+		return namespace
+	}
+	return derivePvtDataNs(namespace, collection)
+}
+
+func toStateDBIndex(indexInfo *statedb.IndexInfo) *ledger.StateDBIndex {
+	return &ledger.StateDBIndex{
+		DesignDocument: indexInfo.DesignDocument,
+		Name:           indexInfo.Name,
+		Definition:     indexInfo.Definition,
+	}
+}
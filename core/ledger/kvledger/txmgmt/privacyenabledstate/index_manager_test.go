@@ -0,0 +1,88 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privacyenabledstate
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type indexManageableVersionedDB struct {
+	statedb.VersionedDB
+	namespaces []string
+	err        error
+}
+
+func (db *indexManageableVersionedDB) ListIndexes(namespace string) ([]*statedb.IndexInfo, error) {
+	db.namespaces = append(db.namespaces, namespace)
+	return []*statedb.IndexInfo{{DesignDocument: "ddoc1", Name: "index1", Definition: "def1"}}, db.err
+}
+
+func (db *indexManageableVersionedDB) CreateIndex(namespace string, indexDefinition string) (*statedb.IndexInfo, error) {
+	db.namespaces = append(db.namespaces, namespace)
+	return &statedb.IndexInfo{DesignDocument: "ddoc1", Name: "index1", Definition: indexDefinition}, db.err
+}
+
+func (db *indexManageableVersionedDB) DropIndex(namespace string, designDoc string, indexName string) error {
+	db.namespaces = append(db.namespaces, namespace)
+	return db.err
+}
+
+func (db *indexManageableVersionedDB) ExplainQuery(namespace string, query string) (*statedb.IndexInfo, error) {
+	db.namespaces = append(db.namespaces, namespace)
+	return &statedb.IndexInfo{DesignDocument: "ddoc1", Name: "index1", Definition: "def1"}, db.err
+}
+
+func TestGetStateDBIndexManagerUnsupported(t *testing.T) {
+	env := &LevelDBCommonStorageTestEnv{}
+	env.Init(t)
+	defer env.Cleanup()
+	db := env.GetDBHandle("test-ledger-id")
+
+	indexManager, err := db.GetStateDBIndexManager()
+	assert.EqualError(t, err, "the state database does not support managing indexes")
+	assert.Nil(t, indexManager)
+}
+
+func TestIndexManager(t *testing.T) {
+	vdb := &indexManageableVersionedDB{}
+	db, err := NewCommonStorageDB(vdb, "test-ledger-id", nil)
+	require.NoError(t, err)
+	indexManager, err := db.GetStateDBIndexManager()
+	require.NoError(t, err)
+
+	indexes, err := indexManager.ListIndexes("ns1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []*ledger.StateDBIndex{{DesignDocument: "ddoc1", Name: "index1", Definition: "def1"}}, indexes)
+
+	index, err := indexManager.CreateIndex("ns1", "coll1", "def2")
+	assert.NoError(t, err)
+	assert.Equal(t, &ledger.StateDBIndex{DesignDocument: "ddoc1", Name: "index1", Definition: "def2"}, index)
+
+	assert.NoError(t, indexManager.DropIndex("ns2", "coll2", "ddoc1", "index1"))
+
+	index, err = indexManager.ExplainQuery("ns2", "", `{"selector":{"owner":"tom"}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &ledger.StateDBIndex{DesignDocument: "ddoc1", Name: "index1", Definition: "def1"}, index)
+
+	// the indexes of a collection are managed in the namespace of the private data of the collection
+	assert.Equal(t, []string{"ns1", derivePvtDataNs("ns1", "coll1"), derivePvtDataNs("ns2", "coll2"), "ns2"}, vdb.namespaces)
+
+	vdb.err = errors.New("index-error")
+	_, err = indexManager.ListIndexes("ns1", "")
+	assert.EqualError(t, err, "index-error")
+	_, err = indexManager.CreateIndex("ns1", "", "def2")
+	assert.EqualError(t, err, "index-error")
+	assert.EqualError(t, indexManager.DropIndex("ns1", "", "ddoc1", "index1"), "index-error")
+	_, err = indexManager.ExplainQuery("ns1", "", "query")
+	assert.EqualError(t, err, "index-error")
+}
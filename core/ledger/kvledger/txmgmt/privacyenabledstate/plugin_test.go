@@ -0,0 +1,64 @@
+// +build go1.9,linux,cgo go1.10,darwin,cgo
+// +build !ppc64le
+
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privacyenabledstate_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/commontests"
+	"github.com/hyperledger/fabric/core/ledger/mock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// raceEnabled is set to true when the race build tag is enabled.
+// see race_test.go
+var raceEnabled bool
+
+func buildPlugin(t *testing.T, lib string) {
+	t.Helper()
+	cmd := exec.Command("go", "build", "-buildmode=plugin", "-o", lib)
+	if raceEnabled {
+		cmd.Args = append(cmd.Args, "-race")
+	}
+	cmd.Args = append(cmd.Args, "github.com/hyperledger/fabric/examples/plugins/statedb")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "Could not build plugin: %s", output)
+}
+
+func TestExternalStateDBPlugin(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "statedbplugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	lib := filepath.Join(testPath, "statedb.so")
+	buildPlugin(t, lib)
+
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Set("peer.fileSystemPath", "/tmp/fabric/ledgertests/kvledger/txmgmt/privacyenabledstate")
+	viper.Set("ledger.state.stateDatabase", "exampleplugindb")
+	defer viper.Set("ledger.state.stateDatabase", "")
+	viper.Set("ledger.state.stateDBPlugin.library", lib)
+	defer viper.Set("ledger.state.stateDBPlugin.library", "")
+
+	bookkeeperTestEnv := bookkeeping.NewTestEnv(t)
+	defer bookkeeperTestEnv.Cleanup()
+	dbProvider, err := privacyenabledstate.NewCommonStorageDBProvider(bookkeeperTestEnv.TestProvider, &disabled.Provider{}, &mock.HealthCheckRegistry{})
+	require.NoError(t, err)
+	defer dbProvider.Close()
+
+	commontests.TestConformance(t, dbProvider.(*privacyenabledstate.CommonStorageDBProvider).VersionedDBProvider)
+}
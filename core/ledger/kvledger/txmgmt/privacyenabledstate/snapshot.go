@@ -0,0 +1,127 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privacyenabledstate
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/pkg/errors"
+)
+
+// maxImportBatchSize is the maximum number of records that are applied to the state db in a single batch
+// while importing the state from a snapshot
+var maxImportBatchSize = 10000
+
+// StateRecord encloses a public key or a hash of a private key along with the corresponding value (or value hash),
+// metadata, and version. For a hash of a private key, the Collection is non-empty and the Key contains the key hash
+type StateRecord struct {
+	Namespace  string
+	Collection string
+	Key        []byte
+	Value      []byte
+	Metadata   []byte
+	Version    *version.Height
+}
+
+// StateIterator iterates over the public data and the hashes of the private data
+type StateIterator struct {
+	dbItr      statedb.ResultsIterator
+	base64Keys bool
+}
+
+// Next returns the next record or nil if the iterator is exhausted
+func (itr *StateIterator) Next() (*StateRecord, error) {
+	res, err := itr.dbItr.Next()
+	if err != nil || res == nil {
+		return nil, err
+	}
+	kv := res.(*statedb.VersionedKV)
+	record := &StateRecord{
+		Namespace: kv.Namespace,
+		Key:       []byte(kv.Key),
+		Value:     kv.Value,
+		Metadata:  kv.Metadata,
+		Version:   kv.Version,
+	}
+	if !isHashedDataNs(kv.Namespace) {
+		return record, nil
+	}
+	record.Namespace, record.Collection = splitHashedDataNs(kv.Namespace)
+	if itr.base64Keys {
+		if record.Key, err = base64.StdEncoding.DecodeString(kv.Key); err != nil {
+			return nil, errors.Wrapf(err, "error decoding the key hash [%s] in namespace [%s]", kv.Key, kv.Namespace)
+		}
+	}
+	return record, nil
+}
+
+// Close releases the resources held by the iterator
+func (itr *StateIterator) Close() {
+	itr.dbItr.Close()
+}
+
+// GetPubAndHashedStateIterator implements corresponding function in interface DB. The private data is
+// excluded as it is specific to the peer, whereas the public data and the hashes of the private data are
+// expected to be identical across the peers of a channel
+func (s *CommonStorageDB) GetPubAndHashedStateIterator() (*StateIterator, error) {
+	fullScanIterable, ok := s.VersionedDB.(statedb.FullScanIterable)
+	if !ok {
+		return nil, errors.New("the state database does not support iterating over the entire state")
+	}
+	dbItr, err := fullScanIterable.GetFullScanIterator(isPvtDataNs)
+	if err != nil {
+		return nil, err
+	}
+	return &StateIterator{dbItr: dbItr, base64Keys: !s.BytesKeySupported()}, nil
+}
+
+// ImportPubAndHashedState implements corresponding function in interface DB. The records supplied by
+// the function nextRecord are applied in batches and the savepoint is recorded along with the last batch.
+// The function nextRecord is expected to return nil when all the records have been supplied
+func (s *CommonStorageDB) ImportPubAndHashedState(nextRecord func() (*StateRecord, error), savepoint *version.Height) error {
+	batch := NewUpdateBatch()
+	numRecords := 0
+	for {
+		record, err := nextRecord()
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			break
+		}
+		if record.Collection == "" {
+			batch.PubUpdates.PutValAndMetadata(record.Namespace, string(record.Key), record.Value, record.Metadata, record.Version)
+		} else {
+			batch.HashUpdates.PutValHashAndMetadata(record.Namespace, record.Collection, record.Key, record.Value, record.Metadata, record.Version)
+		}
+		numRecords++
+		if numRecords%maxImportBatchSize == 0 {
+			if err := s.ApplyPrivacyAwareUpdates(batch, nil); err != nil {
+				return err
+			}
+			batch = NewUpdateBatch()
+		}
+	}
+	logger.Debugf("Imported [%d] records into the state db with the savepoint %#v", numRecords, savepoint)
+	return s.ApplyPrivacyAwareUpdates(batch, savepoint)
+}
+
+func isPvtDataNs(namespace string) bool {
+	return strings.Contains(namespace, nsJoiner+pvtDataPrefix)
+}
+
+func isHashedDataNs(namespace string) bool {
+	return strings.Contains(namespace, nsJoiner+hashDataPrefix)
+}
+
+func splitHashedDataNs(hashedDataNs string) (string, string) {
+	split := strings.SplitN(hashedDataNs, nsJoiner+hashDataPrefix, 2)
+	return split[0], split[1]
+}
@@ -0,0 +1,94 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package privacyenabledstate
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportPubAndHashedState(t *testing.T) {
+	for _, env := range testEnvs {
+		t.Run(env.GetName(), func(t *testing.T) {
+			testExportImportPubAndHashedState(t, env)
+		})
+	}
+}
+
+func testExportImportPubAndHashedState(t *testing.T, env TestEnv) {
+	env.Init(t)
+	defer env.Cleanup()
+	db := env.GetDBHandle("source-ledger")
+
+	updates := NewUpdateBatch()
+	updates.PubUpdates.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+	updates.PubUpdates.PutValAndMetadata("ns1", "key2", []byte("value2"), []byte("metadata2"), version.NewHeight(1, 2))
+	updates.PubUpdates.Put("ns2", "key3", []byte("value3"), version.NewHeight(1, 3))
+	putPvtUpdatesWithMetadata(t, updates, "ns1", "coll1", "key1", []byte("pvt_value1"), []byte("pvt_metadata1"), version.NewHeight(1, 4))
+	putPvtUpdates(t, updates, "ns2", "coll2", "key2", []byte("pvt_value2"), version.NewHeight(1, 5))
+	require.NoError(t, db.ApplyPrivacyAwareUpdates(updates, version.NewHeight(1, 5)))
+
+	itr, err := db.GetPubAndHashedStateIterator()
+	require.NoError(t, err)
+	var records []*StateRecord
+	for {
+		record, err := itr.Next()
+		require.NoError(t, err)
+		if record == nil {
+			break
+		}
+		records = append(records, record)
+	}
+	itr.Close()
+
+	expectedRecords := []*StateRecord{
+		{Namespace: "ns1", Key: []byte("key1"), Value: []byte("value1"), Version: version.NewHeight(1, 1)},
+		{Namespace: "ns1", Key: []byte("key2"), Value: []byte("value2"), Metadata: []byte("metadata2"), Version: version.NewHeight(1, 2)},
+		{Namespace: "ns1", Collection: "coll1", Key: util.ComputeStringHash("key1"), Value: util.ComputeStringHash("pvt_value1"),
+			Metadata: []byte("pvt_metadata1"), Version: version.NewHeight(1, 4)},
+		{Namespace: "ns2", Key: []byte("key3"), Value: []byte("value3"), Version: version.NewHeight(1, 3)},
+		{Namespace: "ns2", Collection: "coll2", Key: util.ComputeStringHash("key2"), Value: util.ComputeStringHash("pvt_value2"),
+			Version: version.NewHeight(1, 5)},
+	}
+	assert.Equal(t, expectedRecords, records)
+
+	importedDB := env.GetDBHandle("imported-ledger")
+	remaining := records
+	require.NoError(t, importedDB.ImportPubAndHashedState(
+		func() (*StateRecord, error) {
+			if len(remaining) == 0 {
+				return nil, nil
+			}
+			record := remaining[0]
+			remaining = remaining[1:]
+			return record, nil
+		},
+		version.NewHeight(1, 5),
+	))
+
+	savepoint, err := importedDB.GetLatestSavePoint()
+	assert.NoError(t, err)
+	assert.Equal(t, version.NewHeight(1, 5), savepoint)
+
+	vv, err := importedDB.GetState("ns1", "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), vv.Value)
+	metadata, err := importedDB.GetStateMetadata("ns1", "key2")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("metadata2"), metadata)
+	vv, err = importedDB.GetValueHash("ns1", "coll1", util.ComputeStringHash("key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, util.ComputeStringHash("pvt_value1"), vv.Value)
+	assert.Equal(t, version.NewHeight(1, 4), vv.Version)
+	vv, err = importedDB.GetPrivateData("ns1", "coll1", "key1")
+	assert.NoError(t, err)
+	assert.Nil(t, vv)
+}
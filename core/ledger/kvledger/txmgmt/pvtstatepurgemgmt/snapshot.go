@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pvtstatepurgemgmt
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/pkg/errors"
+)
+
+// ExportExpirySchedule supplies the expiry schedule of the hashed private data of the given ledger, in the
+// order of the keys, to the function consume. The raw private keys, that are present in the schedule only
+// if this peer has the private data, are excluded so that the exported schedule is identical across the peers
+func ExportExpirySchedule(ledgerid string, bookkeepingProvider bookkeeping.Provider, consume func(key, value []byte) error) error {
+	itr := bookkeepingProvider.GetDBHandle(ledgerid, bookkeeping.PvtdataExpiry).GetIterator(nil, nil)
+	defer itr.Release()
+	for itr.Next() {
+		expinfo, err := decodeExpiryInfo(itr.Key(), itr.Value())
+		if err != nil {
+			return err
+		}
+		for _, colls := range expinfo.pvtdataKeys.Map {
+			for _, keysAndHashes := range colls.Map {
+				for _, keyAndHash := range keysAndHashes.List {
+					keyAndHash.Key = ""
+				}
+			}
+		}
+		buf := proto.NewBuffer(nil)
+		buf.SetDeterministic(true)
+		if err := buf.Marshal(expinfo.pvtdataKeys); err != nil {
+			return errors.Wrap(err, "error marshaling the expiry info")
+		}
+		if err := consume(itr.Key(), buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(itr.Error(), "error while iterating over the expiry schedule")
+}
+
+// ImportExpirySchedule loads the entries supplied by the function nextEntry into the expiry schedule of
+// the given ledger. The function nextEntry is expected to return a nil key when all the entries have been supplied
+func ImportExpirySchedule(ledgerid string, bookkeepingProvider bookkeeping.Provider, nextEntry func() (key, value []byte, err error)) error {
+	batch := leveldbhelper.NewUpdateBatch()
+	for {
+		key, value, err := nextEntry()
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			break
+		}
+		if _, err := decodeExpiryInfo(key, value); err != nil {
+			return errors.WithMessage(err, "invalid entry for the expiry schedule")
+		}
+		batch.Put(key, value)
+	}
+	return bookkeepingProvider.GetDBHandle(ledgerid, bookkeeping.PvtdataExpiry).WriteBatch(batch, true)
+}
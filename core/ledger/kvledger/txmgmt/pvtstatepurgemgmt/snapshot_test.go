@@ -0,0 +1,68 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pvtstatepurgemgmt
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportExpirySchedule(t *testing.T) {
+	testenv := bookkeeping.NewTestEnv(t)
+	defer testenv.Cleanup()
+	expiryKeeper := newExpiryKeeper("source-ledger", testenv.TestProvider)
+
+	pvtdataKeys1 := newPvtdataKeys()
+	pvtdataKeys1.add("ns1", "coll-1", "key-1", []byte("key-1-hash"))
+	pvtdataKeys1.add("ns1", "coll-2", "", []byte("key-2-hash"))
+	pvtdataKeys2 := newPvtdataKeys()
+	pvtdataKeys2.add("ns2", "coll-1", "key-3", []byte("key-3-hash"))
+	require.NoError(t, expiryKeeper.updateBookkeeping([]*expiryInfo{
+		{&expiryInfoKey{committingBlk: 3, expiryBlk: 13}, pvtdataKeys1},
+		{&expiryInfoKey{committingBlk: 4, expiryBlk: 15}, pvtdataKeys2},
+	}, nil))
+
+	type kv struct{ key, value []byte }
+	var exported []*kv
+	require.NoError(t, ExportExpirySchedule("source-ledger", testenv.TestProvider, func(key, value []byte) error {
+		exported = append(exported, &kv{append([]byte{}, key...), append([]byte{}, value...)})
+		return nil
+	}))
+	assert.Len(t, exported, 2)
+
+	remaining := exported
+	require.NoError(t, ImportExpirySchedule("imported-ledger", testenv.TestProvider, func() ([]byte, []byte, error) {
+		if len(remaining) == 0 {
+			return nil, nil, nil
+		}
+		e := remaining[0]
+		remaining = remaining[1:]
+		return e.key, e.value, nil
+	}))
+
+	// the raw keys are not exported
+	expectedPvtdataKeys1 := newPvtdataKeys()
+	expectedPvtdataKeys1.add("ns1", "coll-1", "", []byte("key-1-hash"))
+	expectedPvtdataKeys1.add("ns1", "coll-2", "", []byte("key-2-hash"))
+	expectedPvtdataKeys2 := newPvtdataKeys()
+	expectedPvtdataKeys2.add("ns2", "coll-1", "", []byte("key-3-hash"))
+
+	importedExpiryKeeper := newExpiryKeeper("imported-ledger", testenv.TestProvider)
+	listExpinfo, err := importedExpiryKeeper.retrieve(13)
+	require.NoError(t, err)
+	require.Len(t, listExpinfo, 1)
+	assert.Equal(t, &expiryInfoKey{committingBlk: 3, expiryBlk: 13}, listExpinfo[0].expiryInfoKey)
+	assert.True(t, proto.Equal(expectedPvtdataKeys1, listExpinfo[0].pvtdataKeys))
+	listExpinfo, err = importedExpiryKeeper.retrieve(15)
+	require.NoError(t, err)
+	require.Len(t, listExpinfo, 1)
+	assert.True(t, proto.Equal(expectedPvtdataKeys2, listExpinfo[0].pvtdataKeys))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rwsetutil
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+)
+
+// PurgedKeys returns the private data keys purged by the transaction, given its block number
+// and its number in the block
+func (txRwSet *TxRwSet) PurgedKeys(blockNum, txNum uint64) []*ledger.PurgedPvtdataKey {
+	var purgedKeys []*ledger.PurgedPvtdataKey
+	for _, nsRwSet := range txRwSet.NsRwSets {
+		for _, collHashedRwSet := range nsRwSet.CollHashedRwSets {
+			for _, hashedWrite := range collHashedRwSet.HashedRwSet.HashedWrites {
+				if !hashedWrite.IsPurge {
+					continue
+				}
+				purgedKeys = append(purgedKeys, &ledger.PurgedPvtdataKey{
+					Namespace:  nsRwSet.NameSpace,
+					Collection: collHashedRwSet.CollectionName,
+					KeyHash:    hashedWrite.KeyHash,
+					BlockNum:   blockNum,
+					TxNum:      txNum,
+				})
+			}
+		}
+	}
+	return purgedKeys
+}
+
+// RemovePurgedKeys removes from the private write-set of a collection the writes and the metadata
+// writes of the keys whose hashes are present in keyHashes. It returns false if the write-set does
+// not contain any of these keys, in which case the write-set is left untouched.
+func RemovePurgedKeys(collPvtRwSet *rwset.CollectionPvtReadWriteSet, keyHashes map[string]bool) (bool, error) {
+	kvRwSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtRwSet.Rwset, kvRwSet); err != nil {
+		return false, err
+	}
+
+	removed := false
+	var writes []*kvrwset.KVWrite
+	for _, kvWrite := range kvRwSet.Writes {
+		if keyHashes[string(util.ComputeStringHash(kvWrite.Key))] {
+			removed = true
+			continue
+		}
+		writes = append(writes, kvWrite)
+	}
+	var metadataWrites []*kvrwset.KVMetadataWrite
+	for _, metadataWrite := range kvRwSet.MetadataWrites {
+		if keyHashes[string(util.ComputeStringHash(metadataWrite.Key))] {
+			removed = true
+			continue
+		}
+		metadataWrites = append(metadataWrites, metadataWrite)
+	}
+	if !removed {
+		return false, nil
+	}
+
+	kvRwSet.Writes = writes
+	kvRwSet.MetadataWrites = metadataWrites
+	rwsetBytes, err := proto.Marshal(kvRwSet)
+	if err != nil {
+		return false, err
+	}
+	collPvtRwSet.Rwset = rwsetBytes
+	return true, nil
+}
+
+// MatchesHashedRwSetWithoutPurgedKeys returns true if the private write-set of a collection, from which
+// `RemovePurgedKeys` has removed the keys whose hashes are present in keyHashes, matches the hashed
+// write-set of the collection once the writes and the metadata writes of these keys are left out of the
+// latter as well. It returns false if the hashed write-set does not contain any of these keys.
+func MatchesHashedRwSetWithoutPurgedKeys(collPvtRwSet *rwset.CollectionPvtReadWriteSet,
+	hashedRwSet *kvrwset.HashedRWSet, keyHashes map[string]bool) (bool, error) {
+	kvRwSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtRwSet.Rwset, kvRwSet); err != nil {
+		return false, err
+	}
+	if len(kvRwSet.Reads) != 0 || len(kvRwSet.RangeQueriesInfo) != 0 {
+		return false, nil
+	}
+
+	removed := false
+	var hashedWrites []*kvrwset.KVWriteHash
+	for _, hashedWrite := range hashedRwSet.HashedWrites {
+		if keyHashes[string(hashedWrite.KeyHash)] {
+			removed = true
+			continue
+		}
+		hashedWrites = append(hashedWrites, hashedWrite)
+	}
+	var metadataWrites []*kvrwset.KVMetadataWriteHash
+	for _, metadataWrite := range hashedRwSet.MetadataWrites {
+		if keyHashes[string(metadataWrite.KeyHash)] {
+			removed = true
+			continue
+		}
+		metadataWrites = append(metadataWrites, metadataWrite)
+	}
+	if !removed ||
+		len(kvRwSet.Writes) != len(hashedWrites) || len(kvRwSet.MetadataWrites) != len(metadataWrites) {
+		return false, nil
+	}
+
+	for i, kvWrite := range kvRwSet.Writes {
+		var valueHash []byte
+		if !kvWrite.IsDelete {
+			valueHash = util.ComputeHash(kvWrite.Value)
+		}
+		if !bytes.Equal(util.ComputeStringHash(kvWrite.Key), hashedWrites[i].KeyHash) ||
+			kvWrite.IsDelete != hashedWrites[i].IsDelete ||
+			!bytes.Equal(valueHash, hashedWrites[i].ValueHash) {
+			return false, nil
+		}
+	}
+	// the private metadata writes carry only the keys; the metadata is present in the hashed write-set alone
+	for i, metadataWrite := range kvRwSet.MetadataWrites {
+		if !bytes.Equal(util.ComputeStringHash(metadataWrite.Key), metadataWrites[i].KeyHash) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
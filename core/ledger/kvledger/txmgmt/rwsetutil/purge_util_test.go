@@ -0,0 +1,149 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rwsetutil
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/util"
+	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgedKeys(t *testing.T) {
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", []byte("value1"))
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns1", "coll1", "key2")
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns2", "coll2", "key3", nil)
+	rwSetBuilder.AddToPvtAndHashedWriteSetForPurge("ns2", "coll2", "key4")
+
+	txSimulationResults, err := rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+
+	// the private write-set contains a plain delete of the purged key
+	pvtRwSet, err := TxPvtRwSetFromProtoMsg(txSimulationResults.PvtSimulationResults)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		[]*kvrwset.KVWrite{{Key: "key1", Value: []byte("value1")}, {Key: "key2", IsDelete: true}},
+		pvtRwSet.NsPvtRwSet[0].CollPvtRwSets[0].KvRwSet.Writes,
+	)
+
+	txRwSet, err := TxRwSetFromProtoMsg(txSimulationResults.PubSimulationResults)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		[]*ledger.PurgedPvtdataKey{
+			{Namespace: "ns1", Collection: "coll1", KeyHash: util.ComputeStringHash("key2"), BlockNum: 5, TxNum: 3},
+			{Namespace: "ns2", Collection: "coll2", KeyHash: util.ComputeStringHash("key4"), BlockNum: 5, TxNum: 3},
+		},
+		txRwSet.PurgedKeys(5, 3),
+	)
+
+	// a delete is not a purge
+	rwSetBuilder = NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", nil)
+	assert.Nil(t, rwSetBuilder.GetTxReadWriteSet().PurgedKeys(5, 3))
+}
+
+func TestRemovePurgedKeys(t *testing.T) {
+	kvRwSet := &kvrwset.KVRWSet{
+		Writes: []*kvrwset.KVWrite{
+			{Key: "key1", Value: []byte("value1")},
+			{Key: "key2", Value: []byte("value2")},
+		},
+		MetadataWrites: []*kvrwset.KVMetadataWrite{
+			{Key: "key2"},
+			{Key: "key3"},
+		},
+	}
+	kvRwSetBytes, err := proto.Marshal(kvRwSet)
+	assert.NoError(t, err)
+	collPvtRwSet := &rwset.CollectionPvtReadWriteSet{CollectionName: "coll1", Rwset: kvRwSetBytes}
+
+	removed, err := RemovePurgedKeys(collPvtRwSet, map[string]bool{string(util.ComputeStringHash("key4")): true})
+	assert.NoError(t, err)
+	assert.False(t, removed)
+	assert.Equal(t, kvRwSetBytes, collPvtRwSet.Rwset)
+
+	removed, err = RemovePurgedKeys(collPvtRwSet, map[string]bool{string(util.ComputeStringHash("key2")): true})
+	assert.NoError(t, err)
+	assert.True(t, removed)
+	remainingKVRwSet := &kvrwset.KVRWSet{}
+	assert.NoError(t, proto.Unmarshal(collPvtRwSet.Rwset, remainingKVRwSet))
+	assert.True(t, proto.Equal(&kvrwset.KVRWSet{
+		Writes:         []*kvrwset.KVWrite{{Key: "key1", Value: []byte("value1")}},
+		MetadataWrites: []*kvrwset.KVMetadataWrite{{Key: "key3"}},
+	}, remainingKVRwSet))
+
+	_, err = RemovePurgedKeys(&rwset.CollectionPvtReadWriteSet{Rwset: []byte("garbage")}, nil)
+	assert.Error(t, err)
+}
+
+func TestMatchesHashedRwSetWithoutPurgedKeys(t *testing.T) {
+	rwSetBuilder := NewRWSetBuilder()
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key1", []byte("value1"))
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key2", []byte("value2"))
+	rwSetBuilder.AddToPvtAndHashedWriteSet("ns1", "coll1", "key3", nil)
+	rwSetBuilder.AddToHashedMetadataWriteSet("ns1", "coll1", "key4", map[string][]byte{"metadata": []byte("value")})
+	txSimulationResults, err := rwSetBuilder.GetTxSimulationResults()
+	assert.NoError(t, err)
+	collPvtRwSet := txSimulationResults.PvtSimulationResults.NsPvtRwset[0].CollectionPvtRwset[0]
+	txRwSet, err := TxRwSetFromProtoMsg(txSimulationResults.PubSimulationResults)
+	assert.NoError(t, err)
+	hashedRwSet := txRwSet.NsRwSets[0].CollHashedRwSets[0].HashedRwSet
+
+	key2Purged := map[string]bool{string(util.ComputeStringHash("key2")): true}
+	// the write-set has not been purged of the key
+	matches, err := MatchesHashedRwSetWithoutPurgedKeys(collPvtRwSet, hashedRwSet, key2Purged)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	// none of the purged keys is written by the transaction
+	purgedCollPvtRwSet := proto.Clone(collPvtRwSet).(*rwset.CollectionPvtReadWriteSet)
+	_, err = RemovePurgedKeys(purgedCollPvtRwSet, key2Purged)
+	assert.NoError(t, err)
+	matches, err = MatchesHashedRwSetWithoutPurgedKeys(purgedCollPvtRwSet, hashedRwSet,
+		map[string]bool{string(util.ComputeStringHash("key5")): true})
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	matches, err = MatchesHashedRwSetWithoutPurgedKeys(purgedCollPvtRwSet, hashedRwSet, key2Purged)
+	assert.NoError(t, err)
+	assert.True(t, matches)
+
+	// the metadata write of a purged key is left out as well
+	keys2And4Purged := map[string]bool{
+		string(util.ComputeStringHash("key2")): true,
+		string(util.ComputeStringHash("key4")): true,
+	}
+	purgedCollPvtRwSet = proto.Clone(collPvtRwSet).(*rwset.CollectionPvtReadWriteSet)
+	_, err = RemovePurgedKeys(purgedCollPvtRwSet, keys2And4Purged)
+	assert.NoError(t, err)
+	matches, err = MatchesHashedRwSetWithoutPurgedKeys(purgedCollPvtRwSet, hashedRwSet, keys2And4Purged)
+	assert.NoError(t, err)
+	assert.True(t, matches)
+
+	// the values of the remaining keys must match their hashes
+	tamperedKVRwSet := &kvrwset.KVRWSet{
+		Writes: []*kvrwset.KVWrite{
+			{Key: "key1", Value: []byte("tampered-value")},
+			{Key: "key3", IsDelete: true},
+		},
+		MetadataWrites: []*kvrwset.KVMetadataWrite{{Key: "key4"}},
+	}
+	tamperedKVRwSetBytes, err := proto.Marshal(tamperedKVRwSet)
+	assert.NoError(t, err)
+	matches, err = MatchesHashedRwSetWithoutPurgedKeys(
+		&rwset.CollectionPvtReadWriteSet{CollectionName: "coll1", Rwset: tamperedKVRwSetBytes}, hashedRwSet, key2Purged)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	_, err = MatchesHashedRwSetWithoutPurgedKeys(&rwset.CollectionPvtReadWriteSet{Rwset: []byte("garbage")}, hashedRwSet, key2Purged)
+	assert.Error(t, err)
+}
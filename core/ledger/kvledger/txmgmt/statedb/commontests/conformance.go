@@ -0,0 +1,157 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package commontests
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConformance runs against the given provider the tests that any implementation of the VersionedDB
+// is expected to pass, irrespective of the underlying store. This is intended to validate an external
+// state database (see statedb.RegisterVersionedDBProviderFactory) without changing the peer. The tests
+// for the optional interfaces (e.g., FullScanIterable and DroppableDBProvider) are run only if the VersionedDB
+// (or the VersionedDBProvider) implements them.
+// Each test uses a database with a distinct name and hence, the tests can share the supplied provider.
+// However, the databases are expected to be empty before the suite runs
+func TestConformance(t *testing.T, dbProvider statedb.VersionedDBProvider) {
+	tests := []struct {
+		name string
+		test func(*testing.T, statedb.VersionedDBProvider)
+	}{
+		{"BasicRW", TestBasicRW},
+		{"MultiDBBasicRW", TestMultiDBBasicRW},
+		{"GetStateMultipleKeys", TestGetStateMultipleKeys},
+		{"Deletes", TestDeletes},
+		{"Iterator", TestIterator},
+		{"GetVersion", TestGetVersion},
+		{"ValueAndMetadataWrites", TestValueAndMetadataWrites},
+		{"PaginatedRangeQuery", TestPaginatedRangeQuery},
+		{"ApplyUpdatesWithNilHeight", TestApplyUpdatesWithNilHeight},
+		{"FullScanIterator", TestFullScanIterator},
+		{"Drop", TestDrop},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, dbProvider)
+		})
+	}
+}
+
+// TestFullScanIterator tests the iterator returned by the function GetFullScanIterator.
+// The test is skipped if the VersionedDB does not implement the interface FullScanIterable
+func TestFullScanIterator(t *testing.T, dbProvider statedb.VersionedDBProvider) {
+	db, err := dbProvider.GetDBHandle("testfullscaniterator")
+	require.NoError(t, err)
+	fullScanIterable, ok := db.(statedb.FullScanIterable)
+	if !ok {
+		t.Skip("the VersionedDB does not implement the interface FullScanIterable")
+	}
+
+	batch := statedb.NewUpdateBatch()
+	batch.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+	batch.Put("ns1", "key2", []byte("value2"), version.NewHeight(1, 2))
+	batch.Put("ns2", "key3", []byte("value3"), version.NewHeight(1, 3))
+	batch.Put("ns3", "key4", []byte("value4"), version.NewHeight(1, 4))
+	batch.PutValAndMetadata("ns3", "key5", []byte("value5"), []byte("metadata5"), version.NewHeight(1, 5))
+	require.NoError(t, db.ApplyUpdates(batch, version.NewHeight(2, 5)))
+
+	verifyFullScan := func(skipNamespace func(string) bool, expected []*statedb.VersionedKV) {
+		itr, err := fullScanIterable.GetFullScanIterator(skipNamespace)
+		require.NoError(t, err)
+		defer itr.Close()
+		results := []*statedb.VersionedKV{}
+		for {
+			res, err := itr.Next()
+			require.NoError(t, err)
+			if res == nil {
+				break
+			}
+			results = append(results, res.(*statedb.VersionedKV))
+		}
+		assert.Equal(t, expected, results)
+	}
+
+	kv := func(ns, key, value, metadata string, txNum uint64) *statedb.VersionedKV {
+		vv := statedb.VersionedValue{Value: []byte(value), Version: version.NewHeight(1, txNum)}
+		if metadata != "" {
+			vv.Metadata = []byte(metadata)
+		}
+		return &statedb.VersionedKV{
+			CompositeKey:   statedb.CompositeKey{Namespace: ns, Key: key},
+			VersionedValue: vv,
+		}
+	}
+
+	verifyFullScan(
+		func(string) bool { return false },
+		[]*statedb.VersionedKV{
+			kv("ns1", "key1", "value1", "", 1),
+			kv("ns1", "key2", "value2", "", 2),
+			kv("ns2", "key3", "value3", "", 3),
+			kv("ns3", "key4", "value4", "", 4),
+			kv("ns3", "key5", "value5", "metadata5", 5),
+		},
+	)
+
+	verifyFullScan(
+		func(ns string) bool { return ns == "ns2" },
+		[]*statedb.VersionedKV{
+			kv("ns1", "key1", "value1", "", 1),
+			kv("ns1", "key2", "value2", "", 2),
+			kv("ns3", "key4", "value4", "", 4),
+			kv("ns3", "key5", "value5", "metadata5", 5),
+		},
+	)
+}
+
+// TestDrop tests the function Drop of the provider. The test is skipped if the
+// VersionedDBProvider does not implement the interface DroppableDBProvider
+func TestDrop(t *testing.T, dbProvider statedb.VersionedDBProvider) {
+	droppable, ok := dbProvider.(statedb.DroppableDBProvider)
+	if !ok {
+		t.Skip("the VersionedDBProvider does not implement the interface DroppableDBProvider")
+	}
+	db1, err := dbProvider.GetDBHandle("testdrop1")
+	require.NoError(t, err)
+	db2, err := dbProvider.GetDBHandle("testdrop2")
+	require.NoError(t, err)
+
+	for _, db := range []statedb.VersionedDB{db1, db2} {
+		batch := statedb.NewUpdateBatch()
+		batch.Put("ns1", "key1", []byte("value1"), version.NewHeight(1, 1))
+		batch.Put("ns2", "key2", []byte("value2"), version.NewHeight(1, 2))
+		require.NoError(t, db.ApplyUpdates(batch, version.NewHeight(1, 2)))
+	}
+
+	require.NoError(t, droppable.Drop("testdrop1"))
+	// dropping a non-existing database is not an error
+	require.NoError(t, droppable.Drop("testdrop3"))
+
+	db1, err = dbProvider.GetDBHandle("testdrop1")
+	require.NoError(t, err)
+	savepoint, err := db1.GetLatestSavePoint()
+	require.NoError(t, err)
+	assert.Nil(t, savepoint)
+	for _, nsKey := range [][]string{{"ns1", "key1"}, {"ns2", "key2"}} {
+		vv, err := db1.GetState(nsKey[0], nsKey[1])
+		require.NoError(t, err)
+		assert.Nil(t, vv)
+	}
+
+	// the other database remains intact
+	savepoint, err = db2.GetLatestSavePoint()
+	require.NoError(t, err)
+	assert.Equal(t, version.NewHeight(1, 2), savepoint)
+	vv, err := db2.GetState("ns1", "key1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value1"), vv.Value)
+}
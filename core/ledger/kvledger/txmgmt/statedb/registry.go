@@ -0,0 +1,77 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statedb
+
+import (
+	"os"
+	"plugin"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// PluginFactorySymbol is the name of the function that a go plugin implementing
+// an external state database is required to export. The function is expected
+// to have the signature of the type VersionedDBProviderFactory
+const PluginFactorySymbol = "NewVersionedDBProvider"
+
+// VersionedDBProviderFactory constructs a VersionedDBProvider using the supplied configuration
+type VersionedDBProviderFactory func(config map[string]interface{}) (VersionedDBProvider, error)
+
+var (
+	registryLock sync.RWMutex
+	factories    = make(map[string]VersionedDBProviderFactory)
+)
+
+// RegisterVersionedDBProviderFactory makes an external state database available under the given name so that
+// it can be selected via the configuration 'ledger.state.stateDatabase'. This is expected to be invoked
+// from the init function of the package that implements the state database. If this function is invoked
+// twice with the same name or with a nil factory, it panics
+func RegisterVersionedDBProviderFactory(name string, factory VersionedDBProviderFactory) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if factory == nil {
+		panic("statedb: nil VersionedDBProviderFactory registered for " + name)
+	}
+	if _, ok := factories[name]; ok {
+		panic("statedb: VersionedDBProviderFactory registered twice for " + name)
+	}
+	factories[name] = factory
+}
+
+// GetVersionedDBProviderFactory returns the factory registered under the given name
+func GetVersionedDBProviderFactory(name string) (VersionedDBProviderFactory, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	factory, ok := factories[name]
+	return factory, ok
+}
+
+// LoadVersionedDBProviderFactory loads the factory from the go plugin library at the given path.
+// The library is required to export the function named by PluginFactorySymbol
+func LoadVersionedDBProviderFactory(library string) (VersionedDBProviderFactory, error) {
+	if library == "" {
+		return nil, errors.New("no state database plugin library specified")
+	}
+	if _, err := os.Stat(library); err != nil {
+		return nil, errors.Wrapf(err, "could not find the state database plugin library [%s]", library)
+	}
+	plug, err := plugin.Open(library)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load the state database plugin library [%s]", library)
+	}
+	sym, err := plug.Lookup(PluginFactorySymbol)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not find the required symbol '%s' in the state database plugin library [%s]", PluginFactorySymbol, library)
+	}
+	factory, ok := sym.(func(map[string]interface{}) (VersionedDBProvider, error))
+	if !ok {
+		return nil, errors.Errorf("the symbol '%s' in the state database plugin library [%s] does not have the required signature",
+			PluginFactorySymbol, library)
+	}
+	return factory, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statedb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterVersionedDBProviderFactory(t *testing.T) {
+	_, ok := GetVersionedDBProviderFactory("testregistrydb")
+	assert.False(t, ok)
+
+	factory := func(config map[string]interface{}) (VersionedDBProvider, error) {
+		return nil, nil
+	}
+	RegisterVersionedDBProviderFactory("testregistrydb", factory)
+	registeredFactory, ok := GetVersionedDBProviderFactory("testregistrydb")
+	assert.True(t, ok)
+	assert.NotNil(t, registeredFactory)
+
+	assert.PanicsWithValue(
+		t,
+		"statedb: VersionedDBProviderFactory registered twice for testregistrydb",
+		func() { RegisterVersionedDBProviderFactory("testregistrydb", factory) },
+	)
+	assert.PanicsWithValue(
+		t,
+		"statedb: nil VersionedDBProviderFactory registered for testregistrynildb",
+		func() { RegisterVersionedDBProviderFactory("testregistrynildb", nil) },
+	)
+}
+
+func TestLoadVersionedDBProviderFactoryErrors(t *testing.T) {
+	_, err := LoadVersionedDBProviderFactory("")
+	assert.EqualError(t, err, "no state database plugin library specified")
+
+	testDir, err := ioutil.TempDir("", "statedbplugin")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+
+	missingLib := filepath.Join(testDir, "missing.so")
+	_, err = LoadVersionedDBProviderFactory(missingLib)
+	assert.Contains(t, err.Error(), "could not find the state database plugin library ["+missingLib+"]")
+
+	invalidLib := filepath.Join(testDir, "invalid.so")
+	require.NoError(t, ioutil.WriteFile(invalidLib, []byte("not a plugin"), 0644))
+	_, err = LoadVersionedDBProviderFactory(invalidLib)
+	assert.Contains(t, err.Error(), "failed to load the state database plugin library ["+invalidLib+"]")
+}
@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statecouchdb
+
+import (
+	"sort"
+
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/util/couchdb"
+)
+
+// GetFullScanIterator implements method in FullScanIterable interface. The namespaces are derived
+// from the names of the databases that belong to the channel and hence, this returns an error if
+// the name of any of these databases has been truncated
+func (vdb *VersionedDB) GetFullScanIterator(skipNamespace func(string) bool) (statedb.ResultsIterator, error) {
+	dbNames, err := vdb.couchInstance.RetrieveDatabaseNames()
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, dbName := range dbNames {
+		ns, belongsToChain, err := couchdb.NamespaceFromDBName(vdb.chainName, dbName)
+		if err != nil {
+			return nil, err
+		}
+		if !belongsToChain || (skipNamespace != nil && skipNamespace(ns)) {
+			continue
+		}
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	logger.Debugf("Namespaces included in the full scan of channel [%s] = %s", vdb.chainName, namespaces)
+	return &fullDBScanner{vdb: vdb, namespaces: namespaces}, nil
+}
+
+// fullDBScanner iterates over the namespaces one after another using the range scan
+// on each of the corresponding databases
+type fullDBScanner struct {
+	vdb        *VersionedDB
+	namespaces []string
+	currentItr statedb.ResultsIterator
+}
+
+func (s *fullDBScanner) Next() (statedb.QueryResult, error) {
+	for {
+		if s.currentItr == nil {
+			if len(s.namespaces) == 0 {
+				return nil, nil
+			}
+			itr, err := s.vdb.GetStateRangeScanIterator(s.namespaces[0], "", "")
+			if err != nil {
+				return nil, err
+			}
+			s.currentItr = itr
+			s.namespaces = s.namespaces[1:]
+		}
+		res, err := s.currentItr.Next()
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			s.currentItr.Close()
+			s.currentItr = nil
+			continue
+		}
+		kv := res.(*statedb.VersionedKV)
+		if kv.Namespace == "" && kv.Key == savepointDocID {
+			// the empty namespace shares the database with the metadata of the channel
+			continue
+		}
+		return kv, nil
+	}
+}
+
+func (s *fullDBScanner) Close() {
+	if s.currentItr != nil {
+		s.currentItr.Close()
+	}
+}
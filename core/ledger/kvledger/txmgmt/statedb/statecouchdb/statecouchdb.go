@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/flogging"
@@ -67,6 +68,42 @@ func (provider *VersionedDBProvider) GetDBHandle(dbName string) (statedb.Version
 	return vdb, nil
 }
 
+// Drop implements method in the interface statedb.DroppableDBProvider. It drops the metadata database
+// and all the namespace databases of the given channel. The metadata database is dropped first so
+// that a failure in between does not leave behind a savepoint for a partially dropped state
+func (provider *VersionedDBProvider) Drop(dbName string) error {
+	provider.mux.Lock()
+	defer provider.mux.Unlock()
+	// the metadata database is opened, rather than constructing its name, so that the name mapping
+	// done while creating a database is applied
+	vdb, err := newVersionedDB(provider.couchInstance, dbName)
+	if err != nil {
+		return err
+	}
+	dbNames, err := provider.couchInstance.RetrieveDatabaseNames()
+	if err != nil {
+		return err
+	}
+	if _, err := vdb.metadataDB.DropDatabase(); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("error while dropping the metadata database [%s]", vdb.metadataDB.DBName))
+	}
+	for _, name := range dbNames {
+		if name == vdb.metadataDB.DBName {
+			continue
+		}
+		// the error is returned only for a truncated namespace db name, which still belongs to the channel
+		if _, belongs, _ := couchdb.NamespaceFromDBName(dbName, name); !belongs {
+			continue
+		}
+		namespaceDB := &couchdb.CouchDatabase{CouchInstance: provider.couchInstance, DBName: name}
+		if _, err := namespaceDB.DropDatabase(); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("error while dropping the namespace database [%s]", name))
+		}
+	}
+	delete(provider.databases, dbName)
+	return nil
+}
+
 // Close closes the underlying db instance
 func (provider *VersionedDBProvider) Close() {
 	// No close needed on Couch
@@ -207,6 +244,80 @@ func (vdb *VersionedDB) GetDBType() string {
 	return "couchdb"
 }
 
+// ListIndexes implements method in the statedb.IndexManageable interface
+func (vdb *VersionedDB) ListIndexes(namespace string) ([]*statedb.IndexInfo, error) {
+	db, err := vdb.getNamespaceDBHandle(namespace)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := db.ListIndex()
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error listing indexes for namespace [%s]", namespace))
+	}
+	var indexInfos []*statedb.IndexInfo
+	for _, index := range indexes {
+		indexInfos = append(indexInfos, toIndexInfo(index))
+	}
+	return indexInfos, nil
+}
+
+// CreateIndex implements method in the statedb.IndexManageable interface
+func (vdb *VersionedDB) CreateIndex(namespace string, indexDefinition string) (*statedb.IndexInfo, error) {
+	db, err := vdb.getNamespaceDBHandle(namespace)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := db.CreateIndex(indexDefinition)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error creating index for namespace [%s]", namespace))
+	}
+	return &statedb.IndexInfo{
+		DesignDocument: strings.TrimPrefix(resp.ID, "_design/"),
+		Name:           resp.Name,
+		Definition:     indexDefinition,
+	}, nil
+}
+
+// DropIndex implements method in the statedb.IndexManageable interface
+func (vdb *VersionedDB) DropIndex(namespace string, designDoc string, indexName string) error {
+	db, err := vdb.getNamespaceDBHandle(namespace)
+	if err != nil {
+		return err
+	}
+	if err := db.DeleteIndex(designDoc, indexName); err != nil {
+		return errors.WithMessage(err, fmt.Sprintf(
+			"error deleting index [%s] of design document [%s] for namespace [%s]", indexName, designDoc, namespace))
+	}
+	return nil
+}
+
+// ExplainQuery implements method in the statedb.IndexManageable interface. The query is rewritten the same
+// way as for the execution of a query so that the returned index is the one that the execution would use
+func (vdb *VersionedDB) ExplainQuery(namespace string, query string) (*statedb.IndexInfo, error) {
+	db, err := vdb.getNamespaceDBHandle(namespace)
+	if err != nil {
+		return nil, err
+	}
+	queryString, err := applyAdditionalQueryOptions(query, int32(ledgerconfig.GetInternalQueryLimit()), "")
+	if err != nil {
+		logger.Errorf("Error calling applyAdditionalQueryOptions(): %s", err.Error())
+		return nil, err
+	}
+	index, err := db.ExplainQuery(queryString)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error explaining query for namespace [%s]", namespace))
+	}
+	return toIndexInfo(index), nil
+}
+
+func toIndexInfo(index *couchdb.IndexResult) *statedb.IndexInfo {
+	return &statedb.IndexInfo{
+		DesignDocument: index.DesignDocument,
+		Name:           index.Name,
+		Definition:     index.Definition,
+	}
+}
+
 // LoadCommittedVersions populates committedVersions and revisionNumbers into cache.
 // A bulk retrieve from couchdb is used to populate the cache.
 // committedVersions cache will be used for state validation of readsets
@@ -351,6 +351,78 @@ func TestHandleChaincodeDeploy(t *testing.T) {
 
 }
 
+func TestIndexManagement(t *testing.T) {
+	env := NewTestVDBEnv(t)
+	defer env.Cleanup()
+
+	db, err := env.DBProvider.GetDBHandle("testindexmanagement")
+	assert.NoError(t, err)
+	batch := statedb.NewUpdateBatch()
+	batch.Put("ns1", "key1", []byte(`{"asset_name": "marble1","color": "blue","size": 1,"owner": "tom"}`), version.NewHeight(1, 1))
+	batch.Put("ns1", "key2", []byte(`{"asset_name": "marble2","color": "red","size": 2,"owner": "fred"}`), version.NewHeight(1, 2))
+	assert.NoError(t, db.ApplyUpdates(batch, version.NewHeight(1, 2)))
+
+	indexManageable, ok := db.(statedb.IndexManageable)
+	if !ok {
+		t.Fatalf("Couchdb state impl is expected to implement interface `statedb.IndexManageable`")
+	}
+
+	indexes, err := indexManageable.ListIndexes("ns1")
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 0)
+
+	queryString := `{"selector":{"owner":"fred"}, "sort": [{"size": "desc"}]}`
+	_, err = db.ExecuteQuery("ns1", queryString)
+	assert.Error(t, err, "Error should have been thrown for a missing index")
+
+	indexDef := `{"index":{"fields":[{"size":"desc"}]},"ddoc":"indexSizeSortDoc","name":"indexSizeSortName","type":"json"}`
+	index, err := indexManageable.CreateIndex("ns1", indexDef)
+	assert.NoError(t, err)
+	assert.Equal(t, &statedb.IndexInfo{DesignDocument: "indexSizeSortDoc", Name: "indexSizeSortName", Definition: indexDef}, index)
+
+	_, err = indexManageable.CreateIndex("ns1", `{"index"{"fields":[{"size":"desc"}]}}`)
+	assert.Error(t, err)
+
+	//Sleep to allow time for index creation
+	time.Sleep(100 * time.Millisecond)
+	indexes, err = indexManageable.ListIndexes("ns1")
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 1)
+	assert.Equal(t, "indexSizeSortDoc", indexes[0].DesignDocument)
+	assert.Equal(t, "indexSizeSortName", indexes[0].Name)
+
+	// the index is created only in the given namespace
+	indexes, err = indexManageable.ListIndexes("ns2")
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 0)
+
+	_, err = db.ExecuteQuery("ns1", queryString)
+	assert.NoError(t, err)
+
+	index, err = indexManageable.ExplainQuery("ns1", queryString)
+	assert.NoError(t, err)
+	assert.Equal(t, "indexSizeSortDoc", index.DesignDocument)
+	assert.Equal(t, "indexSizeSortName", index.Name)
+
+	index, err = indexManageable.ExplainQuery("ns1", `{"selector":{"owner":"fred"}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", index.DesignDocument)
+	assert.Equal(t, "_all_docs", index.Name)
+
+	_, err = indexManageable.ExplainQuery("ns1", `{"selector"{"owner":"fred"}}`)
+	assert.Error(t, err)
+
+	assert.NoError(t, indexManageable.DropIndex("ns1", "indexSizeSortDoc", "indexSizeSortName"))
+	//Sleep to allow time for index deletion
+	time.Sleep(100 * time.Millisecond)
+	indexes, err = indexManageable.ListIndexes("ns1")
+	assert.NoError(t, err)
+	assert.Len(t, indexes, 0)
+
+	err = indexManageable.DropIndex("ns1", "indexSizeSortDoc", "indexSizeSortName")
+	assert.Error(t, err)
+}
+
 func TestTryCastingToJSON(t *testing.T) {
 	sampleJSON := []byte(`{"a":"A", "b":"B"}`)
 	isJSON, jsonVal := tryCastingToJSON(sampleJSON)
@@ -767,6 +839,12 @@ func TestApplyUpdatesWithNilHeight(t *testing.T) {
 	commontests.TestApplyUpdatesWithNilHeight(t, env.DBProvider)
 }
 
+func TestDrop(t *testing.T) {
+	env := NewTestVDBEnv(t)
+	defer env.Cleanup()
+	commontests.TestDrop(t, env.DBProvider)
+}
+
 func TestRangeScanWithCouchInternalDocsPresent(t *testing.T) {
 	env := NewTestVDBEnv(t)
 	defer env.Cleanup()
@@ -88,6 +88,43 @@ type IndexCapable interface {
 	ProcessIndexesForChaincodeDeploy(namespace string, fileEntries []*ccprovider.TarFileEntry) error
 }
 
+//IndexManageable interface provides additional functions for
+//databases that allow managing the indexes of a namespace at runtime
+type IndexManageable interface {
+	// ListIndexes returns the indexes defined in the given namespace
+	ListIndexes(namespace string) ([]*IndexInfo, error)
+	// CreateIndex creates (or updates) an index in the given namespace
+	CreateIndex(namespace string, indexDefinition string) (*IndexInfo, error)
+	// DropIndex deletes the index with the given design document and name from the given namespace
+	DropIndex(namespace string, designDoc string, indexName string) error
+	// ExplainQuery returns the index that would be used for executing the given query in the given namespace
+	ExplainQuery(namespace string, query string) (*IndexInfo, error)
+}
+
+// IndexInfo describes an index of a namespace
+type IndexInfo struct {
+	DesignDocument string
+	Name           string
+	Definition     string
+}
+
+//FullScanIterable interface provides additional functions for
+//databases capable of iterating over the entire state (e.g., for exporting a snapshot)
+type FullScanIterable interface {
+	// GetFullScanIterator returns an iterator over all the keys of all the namespaces, ordered by namespace
+	// and then by key. The namespaces for which skipNamespace returns true are not included in the results.
+	// The returned ResultsIterator contains results of type *VersionedKV
+	GetFullScanIterator(skipNamespace func(namespace string) bool) (ResultsIterator, error)
+}
+
+//DroppableDBProvider interface provides additional functions for
+//providers capable of dropping the database of a single ledger (e.g., for rebuilding the state of a ledger)
+type DroppableDBProvider interface {
+	// Drop drops the named database, i.e., all the state and the savepoint maintained for the ledger.
+	// Dropping a non-existing database is not an error
+	Drop(dbName string) error
+}
+
 // CompositeKey encloses Namespace and Key components
 type CompositeKey struct {
 	Namespace string
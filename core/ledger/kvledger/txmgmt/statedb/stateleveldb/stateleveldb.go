@@ -7,6 +7,7 @@ package stateleveldb
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
@@ -30,7 +31,13 @@ type VersionedDBProvider struct {
 
 // NewVersionedDBProvider instantiates VersionedDBProvider
 func NewVersionedDBProvider() *VersionedDBProvider {
-	dbPath := ledgerconfig.GetStateLevelDBPath()
+	return NewVersionedDBProviderWithPath(ledgerconfig.GetStateLevelDBPath())
+}
+
+// NewVersionedDBProviderWithPath instantiates VersionedDBProvider that maintains the state at the given path
+// instead of the path configured for the peer. This is used for building a scratch state, e.g., while
+// verifying a ledger
+func NewVersionedDBProviderWithPath(dbPath string) *VersionedDBProvider {
 	logger.Debugf("constructing VersionedDBProvider dbPath=%s", dbPath)
 	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: dbPath})
 	return &VersionedDBProvider{dbProvider}
@@ -41,6 +48,12 @@ func (provider *VersionedDBProvider) GetDBHandle(dbName string) (statedb.Version
 	return newVersionedDB(provider.dbProvider.GetDBHandle(dbName), dbName), nil
 }
 
+// Drop implements method in the interface statedb.DroppableDBProvider
+func (provider *VersionedDBProvider) Drop(dbName string) error {
+	return errors.WithMessage(provider.dbProvider.GetDBHandle(dbName).DeleteAll(),
+		fmt.Sprintf("error while dropping the state of the database [%s]", dbName))
+}
+
 // Close closes the underlying db
 func (provider *VersionedDBProvider) Close() {
 	provider.dbProvider.Close()
@@ -154,6 +167,11 @@ func (vdb *versionedDB) GetStateRangeScanIteratorWithMetadata(namespace string,
 
 }
 
+// GetFullScanIterator implements method in FullScanIterable interface
+func (vdb *versionedDB) GetFullScanIterator(skipNamespace func(string) bool) (statedb.ResultsIterator, error) {
+	return newFullDBScanner(vdb.db.GetIterator(nil, nil), skipNamespace), nil
+}
+
 // ExecuteQuery implements method in VersionedDB interface
 func (vdb *versionedDB) ExecuteQuery(namespace, query string) (statedb.ResultsIterator, error) {
 	return nil, errors.New("ExecuteQuery not supported for leveldb")
@@ -278,3 +296,42 @@ func (scanner *kvScanner) GetBookmarkAndClose() string {
 	scanner.Close()
 	return retval
 }
+
+type fullDBScanner struct {
+	dbItr         iterator.Iterator
+	skipNamespace func(string) bool
+}
+
+func newFullDBScanner(dbItr iterator.Iterator, skipNamespace func(string) bool) *fullDBScanner {
+	return &fullDBScanner{dbItr, skipNamespace}
+}
+
+// Next returns the key-values in the lexical order of <Namespace, key>
+func (s *fullDBScanner) Next() (statedb.QueryResult, error) {
+	for s.dbItr.Next() {
+		dbKey := s.dbItr.Key()
+		if bytes.Equal(dbKey, savePointKey) {
+			continue
+		}
+		ns, key := splitCompositeKey(dbKey)
+		if s.skipNamespace != nil && s.skipNamespace(ns) {
+			continue
+		}
+		dbVal := s.dbItr.Value()
+		dbValCopy := make([]byte, len(dbVal))
+		copy(dbValCopy, dbVal)
+		vv, err := decodeValue(dbValCopy)
+		if err != nil {
+			return nil, err
+		}
+		return &statedb.VersionedKV{
+			CompositeKey:   statedb.CompositeKey{Namespace: ns, Key: key},
+			VersionedValue: *vv,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (s *fullDBScanner) Close() {
+	s.dbItr.Release()
+}
@@ -6,6 +6,7 @@ SPDX-License-Identifier: Apache-2.0
 package lockbasedtxmgr
 
 import (
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/common"
 )
@@ -23,6 +24,9 @@ func newCollNameValidator(ccInfoProvider ledger.DeployedChaincodeInfoProvider, q
 }
 
 func (v *collNameValidator) validateCollName(ns, coll string) error {
+	if isImplicit, _ := privdata.MSPIDIfImplicitCollection(coll); isImplicit && !v.cache.containsCollName(ns, coll) {
+		return v.validateImplicitCollName(ns, coll)
+	}
 	if !v.cache.isPopulatedFor(ns) {
 		conf, err := v.retrieveCollConfigFromStateDB(ns)
 		if err != nil {
@@ -39,6 +43,23 @@ func (v *collNameValidator) validateCollName(ns, coll string) error {
 	return nil
 }
 
+// validateImplicitCollName validates the presence of an implicit collection of an organization,
+// which does not require the collections of the namespace to be defined explicitly
+func (v *collNameValidator) validateImplicitCollName(ns, coll string) error {
+	conf, err := v.ccInfoProvider.CollectionInfo(ns, coll, v.queryExecutor)
+	if err != nil {
+		return err
+	}
+	if conf == nil {
+		return &ledger.InvalidCollNameError{
+			Ns:   ns,
+			Coll: coll,
+		}
+	}
+	v.cache[collConfigkey{ns, coll}] = true
+	return nil
+}
+
 func (v *collNameValidator) retrieveCollConfigFromStateDB(ns string) (*common.CollectionConfigPackage, error) {
 	logger.Debugf("retrieveCollConfigFromStateDB() begin - ns=[%s]", ns)
 	ccInfo, err := v.ccInfoProvider.ChaincodeInfo(ns, v.queryExecutor)
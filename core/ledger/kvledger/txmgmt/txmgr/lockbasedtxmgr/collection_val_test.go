@@ -10,6 +10,8 @@ import (
 
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/mock"
+	"github.com/hyperledger/fabric/protos/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -51,6 +53,39 @@ func TestCollectionValidation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestImplicitCollectionValidation(t *testing.T) {
+	testEnv := testEnvsMap[levelDBtestEnvName]
+	testEnv.init(t, "testLedger", nil)
+	defer testEnv.cleanup()
+	txMgr := testEnv.getTxMgr().(*LockBasedTxMgr)
+	populateCollConfigForTest(t, txMgr, []collConfigkey{{"ns1", "coll1"}}, version.NewHeight(1, 1))
+	ccInfoProvider := txMgr.ccInfoProvider.(*mock.DeployedChaincodeInfoProvider)
+	ccInfoProvider.CollectionInfoStub = func(ccName, collName string, qe ledger.SimpleQueryExecutor) (*common.StaticCollectionConfig, error) {
+		if collName == "_implicit_org_Org1MSP" {
+			return &common.StaticCollectionConfig{Name: collName}, nil
+		}
+		return nil, nil
+	}
+
+	sim, err := txMgr.NewTxSimulator("tx-id1")
+	assert.NoError(t, err)
+
+	// the implicit collections do not require the collections of the namespace to be defined explicitly
+	err = sim.SetPrivateData("ns2", "_implicit_org_Org1MSP", "key1", []byte("val1"))
+	assert.NoError(t, err)
+	err = sim.SetPrivateData("ns1", "_implicit_org_Org1MSP", "key1", []byte("val1"))
+	assert.NoError(t, err)
+	_, err = sim.GetPrivateData("ns1", "_implicit_org_Org1MSP", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ccInfoProvider.CollectionInfoCallCount())
+
+	err = sim.SetPrivateData("ns1", "_implicit_org_Org2MSP", "key1", []byte("val1"))
+	assert.IsType(t, &ledger.InvalidCollNameError{}, err)
+
+	err = sim.SetPrivateData("ns1", "coll1", "key1", []byte("val1"))
+	assert.NoError(t, err)
+}
+
 func TestPvtGetNoCollection(t *testing.T) {
 	testEnv := testEnvs[0]
 	testEnv.init(t, "test-pvtdata-get-no-collection", nil)
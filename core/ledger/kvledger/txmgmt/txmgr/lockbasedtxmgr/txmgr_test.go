@@ -1556,6 +1556,61 @@ func testTxWithPvtdataMetadata(t *testing.T, env testEnv, ns, coll string) {
 	qe.Done()
 }
 
+func TestTxWithPvtdataPurge(t *testing.T) {
+	ledgerid, ns, coll := "testtxwithpvtdatapurge", "ns", "coll"
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns", "coll"}: 1000,
+		},
+	)
+	testEnv := testEnvs[0]
+	testEnv.init(t, ledgerid, btlPolicy)
+	defer testEnv.cleanup()
+	txMgr := testEnv.getTxMgr()
+	bg, _ := testutil.NewBlockGenerator(t, ledgerid, false)
+	populateCollConfigForTest(t, txMgr.(*LockBasedTxMgr), []collConfigkey{{"ns", "coll"}}, version.NewHeight(1, 1))
+
+	// Simulate and commit tx1 - set val for key1 and key2
+	s1, _ := txMgr.NewTxSimulator("test_tx1")
+	s1.SetPrivateData(ns, coll, "key1", []byte("value1"))
+	s1.SetPrivateData(ns, coll, "key2", []byte("value2"))
+	s1.Done()
+	blkAndPvtdata1 := prepareNextBlockForTestFromSimulator(t, bg, s1)
+	_, _, err := txMgr.ValidateAndPrepare(blkAndPvtdata1, true)
+	assert.NoError(t, err)
+	assert.NoError(t, txMgr.Commit())
+
+	// Simulate tx2 - purge key1. The purge of a private key of an unknown collection fails
+	s2, _ := txMgr.NewTxSimulator("test_tx2")
+	assert.Error(t, s2.PurgePrivateData(ns, "unknown-coll", "key1"))
+	assert.NoError(t, s2.PurgePrivateData(ns, coll, "key1"))
+	s2.Done()
+	simRes, err := s2.GetTxSimulationResults()
+	assert.NoError(t, err)
+	txRWSet, err := rwsetutil.TxRwSetFromProtoMsg(simRes.PubSimulationResults)
+	assert.NoError(t, err)
+	hashedWrites := txRWSet.NsRwSets[0].CollHashedRwSets[0].HashedRwSet.HashedWrites
+	assert.Len(t, hashedWrites, 1)
+	assert.True(t, hashedWrites[0].IsDelete)
+	assert.True(t, hashedWrites[0].IsPurge)
+	assert.Len(t, txRWSet.PurgedKeys(2, 0), 1)
+
+	// Commit tx2 - the purge deletes key1 like a delete does
+	pubSimBytes, err := simRes.GetPubSimulationBytes()
+	assert.NoError(t, err)
+	blkAndPvtdata2 := &ledger.BlockAndPvtData{Block: bg.NextBlock([][]byte{pubSimBytes}),
+		PvtData: ledger.TxPvtDataMap{0: {SeqInBlock: 0, WriteSet: simRes.PvtSimulationResults}},
+	}
+	_, _, err = txMgr.ValidateAndPrepare(blkAndPvtdata2, true)
+	assert.NoError(t, err)
+	assert.NoError(t, txMgr.Commit())
+
+	qe, _ := txMgr.NewQueryExecutor("test_tx3")
+	checkPvtdataTestQueryResults(t, qe, ns, coll, "key1", nil, nil)
+	checkPvtdataTestQueryResults(t, qe, ns, coll, "key2", []byte("value2"), nil)
+	qe.Done()
+}
+
 func prepareNextBlockForTest(t *testing.T, txMgr txmgr.TxMgr, bg *testutil.BlockGenerator,
 	txid string, pubKVs map[string]string, pvtKVs map[string]string, isMissing bool) *ledger.BlockAndPvtData {
 	simulator, _ := txMgr.NewTxSimulator(txid)
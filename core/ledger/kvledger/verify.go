@@ -0,0 +1,478 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/bookkeeping"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/history/historydb/historyleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/privacyenabledstate"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/txmgr/lockbasedtxmgr"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
+	"github.com/hyperledger/fabric/core/ledger/ledgerstorage"
+	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// scratchDirName is the directory, under the ledgers root, that holds the state rebuilt while verifying a ledger
+const scratchDirName = "verifyLedgerScratch"
+
+// LedgerVerificationResult contains the outcome of the verification of a ledger
+type LedgerVerificationResult struct {
+	LedgerID string
+	// FirstBlockNum is the lowest block number available in the block store. This is non-zero
+	// if the block store has been pruned or if the ledger has been created from a snapshot
+	FirstBlockNum uint64
+	// LastBlockNum is the highest block number available in the block store
+	LastBlockNum uint64
+	// NumVerifiedCommitHashes is the number of blocks for which the recomputed commit hash
+	// matched the commit hash stored in the block
+	NumVerifiedCommitHashes uint64
+	// NumComparedReferenceCommitHashes is the number of blocks for which the commit hash
+	// has been compared with the commit hash in a reference block supplied by the caller
+	NumComparedReferenceCommitHashes uint64
+	// Notes lists the checks that could not be performed along with the reason
+	Notes []string
+	// Divergence is nil if the ledger is found consistent
+	Divergence *LedgerDivergence
+}
+
+// LedgerDivergence describes the first inconsistency found while verifying a ledger
+type LedgerDivergence struct {
+	BlockNum uint64
+	Reason   string
+}
+
+func (r *LedgerVerificationResult) addNote(format string, args ...interface{}) {
+	r.Notes = append(r.Notes, fmt.Sprintf(format, args...))
+}
+
+func (r *LedgerVerificationResult) diverged(blockNum uint64, format string, args ...interface{}) {
+	r.Divergence = &LedgerDivergence{BlockNum: blockNum, Reason: fmt.Sprintf(format, args...)}
+}
+
+// VerifyLedger verifies the integrity of a ledger. The blocks are read from the block files and the chain
+// of the block hashes and the data hashes of the blocks are recomputed. If the block store starts with the
+// genesis block, the blocks are replayed into a scratch state database and the commit hash of each block
+// is recomputed and matched against the one stored in the block metadata. The state database is compared
+// with the scratch state at the height of the state database and the history database is checked for the
+// records of the blocks it has committed. Finally, the commit hashes are matched against the ones present
+// in the optional referenceCommitHashes, which is expected to be extracted from the blocks of another peer
+// (e.g., via the function GetBlockByNumber of qscc). The verification stops at the first divergence found.
+// The custom transaction processors, if any, are expected to be initialized by the caller in the same way
+// as for the peer and this function is expected to be invoked while the peer is stopped
+func VerifyLedger(ledgerID string, ccInfoProvider ledger.DeployedChaincodeInfoProvider,
+	referenceCommitHashes map[uint64][]byte) (*LedgerVerificationResult, error) {
+	fileLock := leveldbhelper.NewFileLock(ledgerconfig.GetFileLockPath())
+	if err := fileLock.Lock(); err != nil {
+		return nil, errors.Wrap(err, "as another peer node command is executing,"+
+			" wait for that command to complete its execution or terminate it before retrying")
+	}
+	defer fileLock.Unlock()
+
+	idStore := openIDStore(ledgerconfig.GetLedgerProviderPath())
+	exists, err := idStore.ledgerIDExists(ledgerID)
+	idStore.close()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNonExistingLedgerID
+	}
+
+	scratchDir := filepath.Join(ledgerconfig.GetRootPath(), scratchDirName)
+	if err := os.RemoveAll(scratchDir); err != nil {
+		return nil, errors.Wrapf(err, "error removing the scratch directory [%s]", scratchDir)
+	}
+	defer os.RemoveAll(scratchDir)
+
+	v, err := newLedgerVerifier(ledgerID, scratchDir, ccInfoProvider, referenceCommitHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer v.close()
+	return v.verify()
+}
+
+type ledgerVerifier struct {
+	ledgerID              string
+	referenceCommitHashes map[uint64][]byte
+
+	ledgerStoreProvider *ledgerstorage.Provider
+	blockStore          *ledgerstorage.Store
+	bookkeepingProvider bookkeeping.Provider
+	vdbProvider         privacyenabledstate.DBProvider
+	vdb                 privacyenabledstate.DB
+	historyDBProvider   *historyleveldb.HistoryDBProvider
+	historyDB           historydb.HistoryDB
+
+	scratchDir                 string
+	ccInfoProvider             ledger.DeployedChaincodeInfoProvider
+	scratchBookkeepingProvider bookkeeping.Provider
+	scratchVDBProvider         privacyenabledstate.DBProvider
+	scratchVDB                 privacyenabledstate.DB
+	scratchTxMgr               txmgr.TxMgr
+}
+
+func newLedgerVerifier(ledgerID, scratchDir string, ccInfoProvider ledger.DeployedChaincodeInfoProvider,
+	referenceCommitHashes map[uint64][]byte) (*ledgerVerifier, error) {
+	v := &ledgerVerifier{
+		ledgerID:              ledgerID,
+		referenceCommitHashes: referenceCommitHashes,
+		scratchDir:            scratchDir,
+		ccInfoProvider:        ccInfoProvider,
+	}
+	var err error
+	v.ledgerStoreProvider = ledgerstorage.NewProvider(&disabled.Provider{})
+	if v.blockStore, err = v.ledgerStoreProvider.Open(ledgerID); err != nil {
+		v.close()
+		return nil, err
+	}
+	v.bookkeepingProvider = bookkeeping.NewProvider()
+	if v.vdbProvider, err = privacyenabledstate.NewCommonStorageDBProvider(v.bookkeepingProvider, &disabled.Provider{}, &noopHealthCheckRegistry{}); err != nil {
+		v.close()
+		return nil, err
+	}
+	if v.vdb, err = v.vdbProvider.GetDBHandle(ledgerID); err != nil {
+		v.close()
+		return nil, err
+	}
+	if ledgerconfig.IsHistoryDBEnabled() {
+		v.historyDBProvider = historyleveldb.NewHistoryDBProvider()
+		if v.historyDB, err = v.historyDBProvider.GetDBHandle(ledgerID); err != nil {
+			v.close()
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// initScratchState sets up a transaction manager on top of an empty state database that is used for replaying the blocks
+func (v *ledgerVerifier) initScratchState() error {
+	v.scratchBookkeepingProvider = bookkeeping.NewProviderWithPath(filepath.Join(v.scratchDir, "bookkeeper"))
+	v.scratchVDBProvider = privacyenabledstate.NewCommonStorageDBProviderWithVDBProvider(
+		stateleveldb.NewVersionedDBProviderWithPath(filepath.Join(v.scratchDir, "stateLeveldb")),
+		v.scratchBookkeepingProvider,
+	)
+	var err error
+	if v.scratchVDB, err = v.scratchVDBProvider.GetDBHandle(v.ledgerID); err != nil {
+		return err
+	}
+	qeProvider := &txMgrQueryExecutorProvider{}
+	btlPolicy := pvtdatapolicy.ConstructBTLPolicy(&collectionInfoRetriever{qeProvider, v.ccInfoProvider})
+	if v.scratchTxMgr, err = lockbasedtxmgr.NewLockBasedTxMgr(v.ledgerID, v.scratchVDB, nil, btlPolicy, v.scratchBookkeepingProvider, v.ccInfoProvider); err != nil {
+		return err
+	}
+	qeProvider.txMgr = v.scratchTxMgr
+	return nil
+}
+
+func (v *ledgerVerifier) close() {
+	if v.scratchTxMgr != nil {
+		v.scratchTxMgr.Shutdown()
+	}
+	if v.scratchVDBProvider != nil {
+		v.scratchVDBProvider.Close()
+	}
+	if v.scratchBookkeepingProvider != nil {
+		v.scratchBookkeepingProvider.Close()
+	}
+	if v.historyDBProvider != nil {
+		v.historyDBProvider.Close()
+	}
+	if v.vdbProvider != nil {
+		v.vdbProvider.Close()
+	}
+	if v.bookkeepingProvider != nil {
+		v.bookkeepingProvider.Close()
+	}
+	if v.blockStore != nil {
+		v.blockStore.Shutdown()
+	}
+	if v.ledgerStoreProvider != nil {
+		v.ledgerStoreProvider.Close()
+	}
+}
+
+func (v *ledgerVerifier) verify() (*LedgerVerificationResult, error) {
+	result := &LedgerVerificationResult{LedgerID: v.ledgerID}
+	bcInfo, err := v.blockStore.GetBlockchainInfo()
+	if err != nil {
+		return nil, err
+	}
+	if bcInfo.Height == 0 {
+		return nil, errors.Errorf("the ledger [%s] is empty", v.ledgerID)
+	}
+	result.LastBlockNum = bcInfo.Height - 1
+	if result.FirstBlockNum, err = firstAvailableBlockNum(v.blockStore); err != nil {
+		return nil, err
+	}
+
+	stateSavepoint, err := v.vdb.GetLatestSavePoint()
+	if err != nil {
+		return nil, err
+	}
+	if stateSavepoint != nil && stateSavepoint.BlockNum > result.LastBlockNum {
+		result.diverged(stateSavepoint.BlockNum, "the state database [height=%d] is ahead of the block store [height=%d]",
+			stateSavepoint.BlockNum+1, bcInfo.Height)
+		return result, nil
+	}
+	historySavepoint, err := v.historySavepoint(result)
+	if err != nil {
+		return nil, err
+	}
+	if historySavepoint != nil && historySavepoint.BlockNum > result.LastBlockNum {
+		result.diverged(historySavepoint.BlockNum, "the history database [height=%d] is ahead of the block store [height=%d]",
+			historySavepoint.BlockNum+1, bcInfo.Height)
+		return result, nil
+	}
+
+	replay := result.FirstBlockNum == 0
+	if replay {
+		if err := v.initScratchState(); err != nil {
+			return nil, err
+		}
+		if stateSavepoint == nil {
+			result.addNote("the state database is empty, hence it is not compared with the replayed state")
+		}
+	} else {
+		result.addNote("the block store starts at the block [%d], hence the commit hashes are not recomputed"+
+			" and the state database is not verified", result.FirstBlockNum)
+	}
+
+	itr, err := v.blockStore.RetrieveBlocks(result.FirstBlockNum)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var prevBlock *common.Block
+	var commitHash []byte
+	for blockNum := result.FirstBlockNum; blockNum <= result.LastBlockNum; blockNum++ {
+		res, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		block := res.(*common.Block)
+		if diverged := v.verifyBlockHashes(result, blockNum, block, prevBlock); diverged {
+			return result, nil
+		}
+		// the block is retained before the replay as the validation of the block updates its metadata
+		prevBlock = block
+
+		storedCommitHash, err := commitHashFromBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		if replay {
+			if commitHash, err = v.replayBlock(block, commitHash); err != nil {
+				return nil, err
+			}
+			if storedCommitHash != nil {
+				if !bytes.Equal(commitHash, storedCommitHash) {
+					result.diverged(blockNum, "the recomputed commit hash [%x] does not match the commit hash [%x] stored in the block",
+						commitHash, storedCommitHash)
+					return result, nil
+				}
+				result.NumVerifiedCommitHashes++
+			}
+		} else {
+			commitHash = storedCommitHash
+		}
+
+		if referenceCommitHash, ok := v.referenceCommitHashes[blockNum]; ok {
+			if !bytes.Equal(commitHash, referenceCommitHash) {
+				result.diverged(blockNum, "the commit hash [%x] does not match the commit hash [%x] in the reference block",
+					commitHash, referenceCommitHash)
+				return result, nil
+			}
+			result.NumComparedReferenceCommitHashes++
+		}
+
+		if historySavepoint != nil && blockNum <= historySavepoint.BlockNum {
+			if err := v.historyDB.VerifyBlock(block); err != nil {
+				result.diverged(blockNum, "the history database is not consistent with the block: %s", err)
+				return result, nil
+			}
+		}
+
+		if replay && stateSavepoint != nil && blockNum == stateSavepoint.BlockNum {
+			reason, err := v.compareStateWithScratchState()
+			if err != nil {
+				return nil, err
+			}
+			if reason != "" {
+				result.diverged(blockNum, "the state database does not match the state rebuilt from the blocks: %s", reason)
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+// firstAvailableBlockNum returns the lowest block number that is present in the block store
+func firstAvailableBlockNum(blockStore *ledgerstorage.Store) (uint64, error) {
+	_, err := blockStore.RetrieveBlockByNumber(0)
+	if err == nil {
+		return 0, nil
+	}
+	if prunedErr, ok := err.(*ledger.BlockPrunedErr); ok {
+		return prunedErr.FirstAvailableBlockNum, nil
+	}
+	return 0, err
+}
+
+func (v *ledgerVerifier) historySavepoint(result *LedgerVerificationResult) (*version.Height, error) {
+	if v.historyDB == nil {
+		result.addNote("the history database is disabled, hence it is not verified")
+		return nil, nil
+	}
+	savepoint, err := v.historyDB.GetLastSavepoint()
+	if err != nil {
+		return nil, err
+	}
+	if savepoint == nil {
+		result.addNote("the history database is empty, hence it is not verified")
+	}
+	return savepoint, nil
+}
+
+// verifyBlockHashes matches the data hash present in the header of the block with the hash of the data
+// of the block and the previous hash present in the header with the hash of the header of the previous block
+func (v *ledgerVerifier) verifyBlockHashes(result *LedgerVerificationResult, blockNum uint64, block, prevBlock *common.Block) bool {
+	if block.Header == nil || block.Data == nil {
+		result.diverged(blockNum, "the block does not contain the header or the data")
+		return true
+	}
+	if block.Header.Number != blockNum {
+		result.diverged(blockNum, "unexpected block number [%d] found in the block header", block.Header.Number)
+		return true
+	}
+	if dataHash := block.Data.Hash(); !bytes.Equal(dataHash, block.Header.DataHash) {
+		result.diverged(blockNum, "the hash of the block data [%x] does not match the data hash [%x] in the block header",
+			dataHash, block.Header.DataHash)
+		return true
+	}
+	if prevBlock == nil {
+		return false
+	}
+	if prevHash := prevBlock.Header.Hash(); !bytes.Equal(prevHash, block.Header.PreviousHash) {
+		result.diverged(blockNum, "the hash of the previous block header [%x] does not match the previous hash [%x] in the block header",
+			prevHash, block.Header.PreviousHash)
+		return true
+	}
+	return false
+}
+
+// replayBlock applies the valid transactions of the block to the scratch state, in the same way as the block
+// is recommitted to the state database during the recovery of a ledger, and returns the commit hash of the block
+func (v *ledgerVerifier) replayBlock(block *common.Block, prevCommitHash []byte) ([]byte, error) {
+	// the metadata of the block is modified during the validation and hence, a copy is validated
+	blockCopy := &common.Block{
+		Header:   block.Header,
+		Data:     block.Data,
+		Metadata: &common.BlockMetadata{Metadata: append([][]byte{}, block.Metadata.Metadata...)},
+	}
+	_, updateBatchBytes, err := v.scratchTxMgr.ValidateAndPrepare(&ledger.BlockAndPvtData{Block: blockCopy}, false)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error while replaying the block [%d]", block.Header.Number))
+	}
+	if err := v.scratchTxMgr.Commit(); err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("error while replaying the block [%d]", block.Header.Number))
+	}
+	// the commit hash is computed starting from the block 1, see function CommitWithPvtData in kv_ledger.go
+	if block.Header.Number != 1 && prevCommitHash == nil {
+		return nil, nil
+	}
+	return computeCommitHash(blockCopy, updateBatchBytes, prevCommitHash), nil
+}
+
+// compareStateWithScratchState matches the public state and the hashes of the private state present in the state
+// database with the scratch state. A non-empty string describing the first mismatch is returned if the two differ
+func (v *ledgerVerifier) compareStateWithScratchState() (string, error) {
+	itr, err := v.vdb.GetPubAndHashedStateIterator()
+	if err != nil {
+		return "", err
+	}
+	defer itr.Close()
+	numRecords := 0
+	for {
+		record, err := itr.Next()
+		if err != nil {
+			return "", err
+		}
+		if record == nil {
+			break
+		}
+		numRecords++
+		var vv *statedb.VersionedValue
+		if record.Collection == "" {
+			vv, err = v.scratchVDB.GetState(record.Namespace, string(record.Key))
+		} else {
+			vv, err = v.scratchVDB.GetValueHash(record.Namespace, record.Collection, record.Key)
+		}
+		if err != nil {
+			return "", err
+		}
+		if vv == nil || !bytes.Equal(vv.Value, record.Value) || !bytes.Equal(vv.Metadata, record.Metadata) ||
+			vv.Version.Compare(record.Version) != 0 {
+			return fmt.Sprintf("mismatch found for the %s", describeStateRecord(record)), nil
+		}
+	}
+
+	scratchItr, err := v.scratchVDB.GetPubAndHashedStateIterator()
+	if err != nil {
+		return "", err
+	}
+	defer scratchItr.Close()
+	numScratchRecords := 0
+	for {
+		record, err := scratchItr.Next()
+		if err != nil {
+			return "", err
+		}
+		if record == nil {
+			break
+		}
+		numScratchRecords++
+	}
+	if numRecords != numScratchRecords {
+		return fmt.Sprintf("the state database contains [%d] entries whereas [%d] entries are expected", numRecords, numScratchRecords), nil
+	}
+	return "", nil
+}
+
+func describeStateRecord(record *privacyenabledstate.StateRecord) string {
+	if record.Collection == "" {
+		return fmt.Sprintf("key [%s] in the namespace [%s]", record.Key, record.Namespace)
+	}
+	return fmt.Sprintf("key hash [%x] in the collection [%s] of the namespace [%s]", record.Key, record.Collection, record.Namespace)
+}
+
+// txMgrQueryExecutorProvider supplies the query executors over the scratch state
+// for retrieving the collection configurations while replaying the blocks
+type txMgrQueryExecutorProvider struct {
+	txMgr txmgr.TxMgr
+}
+
+func (p *txMgrQueryExecutorProvider) NewQueryExecutor() (ledger.QueryExecutor, error) {
+	return p.txMgr.NewQueryExecutor(util.GenerateUUID())
+}
@@ -0,0 +1,122 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kvledger
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric/common/ledger/testutil"
+	"github.com/hyperledger/fabric/common/util"
+	lgr "github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLedger(t *testing.T) {
+	viper.Set("ledger.history.enableHistoryDatabase", true)
+	env := newTestEnv(t)
+	defer env.cleanup()
+
+	// create a ledger with a few blocks, including the hashes of private data that expire
+	provider := testutilNewProviderWithCollectionConfig(t, "ns1", map[string]uint64{"coll1": 1})
+	ccInfoProvider := provider.(*Provider).initializer.DeployedChaincodeInfoProvider
+	bg, gb := testutil.NewBlockGenerator(t, "testLedger", false)
+	ledger, err := provider.Create(gb)
+	require.NoError(t, err)
+	blocks := []*common.Block{gb}
+	for _, value := range []string{"value1", "value2", "value3", "value4"} {
+		simulator, err := ledger.NewTxSimulator(util.GenerateUUID())
+		require.NoError(t, err)
+		require.NoError(t, simulator.SetState("ns1", "key1", []byte(value)))
+		require.NoError(t, simulator.SetStateMetadata("ns1", "key1", map[string][]byte{"metadata": []byte(value)}))
+		require.NoError(t, simulator.SetPrivateData("ns1", "coll1", "key-"+value, []byte(value)))
+		simulator.Done()
+		simRes, err := simulator.GetTxSimulationResults()
+		require.NoError(t, err)
+		pubSimBytes, err := simRes.GetPubSimulationBytes()
+		require.NoError(t, err)
+		block := bg.NextBlock([][]byte{pubSimBytes})
+		require.NoError(t, ledger.CommitWithPvtData(&lgr.BlockAndPvtData{Block: block}, &lgr.CommitOptions{}))
+		blocks = append(blocks, block)
+	}
+	ledger.Close()
+	provider.Close()
+
+	referenceCommitHashes := map[uint64][]byte{}
+	for _, block := range blocks[1:] {
+		commitHash, err := commitHashFromBlock(block)
+		require.NoError(t, err)
+		require.NotNil(t, commitHash)
+		referenceCommitHashes[block.Header.Number] = commitHash
+	}
+
+	t.Run("non-existing ledger", func(t *testing.T) {
+		_, err := VerifyLedger("non-existing-ledger", ccInfoProvider, nil)
+		assert.Equal(t, ErrNonExistingLedgerID, err)
+	})
+
+	t.Run("consistent ledger", func(t *testing.T) {
+		result, err := VerifyLedger("testLedger", ccInfoProvider, referenceCommitHashes)
+		require.NoError(t, err)
+		assert.Nil(t, result.Divergence)
+		assert.Equal(t, uint64(0), result.FirstBlockNum)
+		assert.Equal(t, uint64(4), result.LastBlockNum)
+		assert.Equal(t, uint64(4), result.NumVerifiedCommitHashes)
+		assert.Equal(t, uint64(4), result.NumComparedReferenceCommitHashes)
+		assert.Empty(t, result.Notes)
+	})
+
+	t.Run("reference commit hash differs", func(t *testing.T) {
+		result, err := VerifyLedger("testLedger", ccInfoProvider, map[uint64][]byte{
+			1: referenceCommitHashes[1],
+			3: []byte("another-commit-hash"),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.Divergence)
+		assert.Equal(t, uint64(3), result.Divergence.BlockNum)
+		assert.Contains(t, result.Divergence.Reason, "does not match the commit hash [616e6f746865722d636f6d6d69742d68617368] in the reference block")
+		assert.Equal(t, uint64(1), result.NumComparedReferenceCommitHashes)
+	})
+
+	t.Run("state database differs", func(t *testing.T) {
+		vdbProvider := stateleveldb.NewVersionedDBProvider()
+		vdb, err := vdbProvider.GetDBHandle("testLedger")
+		require.NoError(t, err)
+		savepoint, err := vdb.GetLatestSavePoint()
+		require.NoError(t, err)
+		batch := statedb.NewUpdateBatch()
+		batch.Put("ns1", "key1", []byte("tampered-value"), version.NewHeight(4, 0))
+		require.NoError(t, vdb.ApplyUpdates(batch, savepoint))
+		vdbProvider.Close()
+
+		result, err := VerifyLedger("testLedger", ccInfoProvider, nil)
+		require.NoError(t, err)
+		require.NotNil(t, result.Divergence)
+		assert.Equal(t, uint64(4), result.Divergence.BlockNum)
+		assert.Equal(t, "the state database does not match the state rebuilt from the blocks: mismatch found for the key [key1] in the namespace [ns1]",
+			result.Divergence.Reason)
+	})
+
+	t.Run("state database ahead of the block store", func(t *testing.T) {
+		vdbProvider := stateleveldb.NewVersionedDBProvider()
+		vdb, err := vdbProvider.GetDBHandle("testLedger")
+		require.NoError(t, err)
+		require.NoError(t, vdb.ApplyUpdates(statedb.NewUpdateBatch(), version.NewHeight(6, 0)))
+		vdbProvider.Close()
+
+		result, err := VerifyLedger("testLedger", ccInfoProvider, nil)
+		require.NoError(t, err)
+		require.NotNil(t, result.Divergence)
+		assert.Equal(t, uint64(6), result.Divergence.BlockNum)
+		assert.Equal(t, "the state database [height=7] is ahead of the block store [height=5]", result.Divergence.Reason)
+	})
+}
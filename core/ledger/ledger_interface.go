@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hyperledger/fabric-lib-go/healthz"
 	commonledger "github.com/hyperledger/fabric/common/ledger"
 	"github.com/hyperledger/fabric/common/metrics"
@@ -35,12 +36,19 @@ type PeerLedgerProvider interface {
 	// This function guarantees that the creation of ledger and committing the genesis block would an atomic action
 	// The chain id retrieved from the genesis block is treated as a ledger id
 	Create(genesisBlock *common.Block) (PeerLedger, error)
+	// CreateFromSnapshot creates a new ledger from the snapshot in the given directory. The snapshot is expected
+	// to have been generated by a peer of the channel. The created ledger starts at the height of the snapshot and
+	// does not contain the blocks prior to the last config block in the snapshot. This function returns the created
+	// ledger along with the ledger id, which is the name of the channel recorded in the snapshot
+	CreateFromSnapshot(snapshotDir string) (PeerLedger, string, error)
 	// Open opens an already created ledger
 	Open(ledgerID string) (PeerLedger, error)
 	// Exists tells whether the ledger with given id exists
 	Exists(ledgerID string) (bool, error)
-	// List lists the ids of the existing ledgers
+	// List lists the ids of the existing ledgers that are active, i.e., the ledgers that have not been paused
 	List() ([]string, error)
+	// ListPaused lists the ids of the existing ledgers that have been paused
+	ListPaused() ([]string, error)
 	// Close closes the PeerLedgerProvider
 	Close()
 }
@@ -85,10 +93,15 @@ type PeerLedger interface {
 	PurgePrivateData(maxBlockNumToRetain uint64) error
 	// PrivateDataMinBlockNum returns the lowest retained endorsement block height
 	PrivateDataMinBlockNum() (uint64, error)
-	//Prune prunes the blocks/transactions that satisfy the given policy
+	// Prune prunes the blocks/transactions that satisfy the given policy. The supported policies
+	// are `KeepLastNBlocksPrunePolicy` and `ConfigBlockCheckpointPrunePolicy`. Retrieving a pruned
+	// block or a transaction contained in a pruned block returns a `BlockPrunedErr`
 	Prune(policy commonledger.PrunePolicy) error
 	// GetConfigHistoryRetriever returns the ConfigHistoryRetriever
 	GetConfigHistoryRetriever() (ConfigHistoryRetriever, error)
+	// GetStateDBIndexManager returns the StateDBIndexManager. An error is returned if the
+	// state database does not support managing indexes at runtime (e.g., goleveldb)
+	GetStateDBIndexManager() (StateDBIndexManager, error)
 	// CommitPvtDataOfOldBlocks commits the private data corresponding to already committed block
 	// If hashes for some of the private data supplied in this function does not match
 	// the corresponding hash present in the block, the unmatched private data is not
@@ -178,6 +191,32 @@ type HistoryQueryExecutor interface {
 	// GetHistoryForKey retrieves the history of values for a key.
 	// The returned ResultsIterator contains results of type *KeyModification which is defined in protos/ledger/queryresult.
 	GetHistoryForKey(namespace string, key string) (commonledger.ResultsIterator, error)
+	// GetHistoryForKeyWithOptions retrieves the history of values for a key, bounded and ordered as per the given options.
+	// The returned QueryResultsIterator contains results of type *KeyModification which is defined in protos/ledger/queryresult.
+	// The function GetBookmarkAndClose of the returned iterator returns a bookmark that, when set in the options, resumes the
+	// history from the first result that has not been consumed from the iterator
+	GetHistoryForKeyWithOptions(namespace, key string, options *HistoryQueryOptions) (QueryResultsIterator, error)
+	// GetPrivateDataHashHistoryWithOptions retrieves the history of the hashes of the values for a private data key,
+	// identified by the hash of the key, in the same way as the function GetHistoryForKeyWithOptions. The field Value
+	// of each KeyModification contains the hash of the value. The history of the hashes is maintained only for the
+	// blocks committed since the peer started maintaining it
+	GetPrivateDataHashHistoryWithOptions(namespace, collection string, keyHash []byte, options *HistoryQueryOptions) (QueryResultsIterator, error)
+}
+
+// HistoryQueryOptions bounds, orders, and positions the results of a history query
+type HistoryQueryOptions struct {
+	// StartBlock is the number of the first block (inclusive) whose writes are included in the history
+	StartBlock uint64
+	// EndBlock is the number of the last block (inclusive) whose writes are included in the history. A value of 0 denotes no upper bound
+	EndBlock uint64
+	// StartTime, if set, excludes the writes of the transactions whose timestamp is before the StartTime
+	StartTime *timestamp.Timestamp
+	// EndTime, if set, excludes the writes of the transactions whose timestamp is not before the EndTime
+	EndTime *timestamp.Timestamp
+	// Descending returns the history from the newest to the oldest write, instead of the default order from the oldest to the newest
+	Descending bool
+	// Bookmark is the bookmark returned by a prior history query with the same options
+	Bookmark string
 }
 
 // TxSimulator simulates a transaction on a consistent snapshot of the 'as recent state as possible'
@@ -202,6 +241,9 @@ type TxSimulator interface {
 	SetPrivateDataMultipleKeys(namespace, collection string, kvs map[string][]byte) error
 	// DeletePrivateData deletes the given tuple <namespace, collection, key> from private data
 	DeletePrivateData(namespace, collection, key string) error
+	// PurgePrivateData deletes the given tuple <namespace, collection, key> from private data and,
+	// once the transaction is committed, erases all the previous versions of the private data of the key
+	PurgePrivateData(namespace, collection, key string) error
 	// SetPrivateDataMetadata sets the metadata associated with an existing key-tuple <namespace, collection, key>
 	SetPrivateDataMetadata(namespace, collection, key string, metadata map[string][]byte) error
 	// DeletePrivateDataMetadata deletes the metadata associated with an existing key-tuple <namespace, collection, key>
@@ -249,6 +291,16 @@ type MissingPvtData struct {
 // missing pvtData
 type TxMissingPvtDataMap map[uint64][]*MissingPvtData
 
+// PurgedPvtdataKey identifies, by its hash, a private data key that is
+// purged by the valid transaction TxNum of the block BlockNum
+type PurgedPvtdataKey struct {
+	Namespace  string
+	Collection string
+	KeyHash    []byte
+	BlockNum   uint64
+	TxNum      uint64
+}
+
 // BlockAndPvtData encapsulates the block and a map that contains the tuples <seqInBlock, *TxPvtData>
 // The map is expected to contain the entries only for the transactions that has associated pvt data
 type BlockAndPvtData struct {
@@ -381,9 +433,43 @@ type ConfigHistoryRetriever interface {
 	MostRecentCollectionConfigBelow(blockNum uint64, chaincodeName string) (*CollectionConfigInfo, error)
 }
 
+// StateDBIndexManager allows managing the indexes of the state database at runtime. An empty collection
+// refers to the public state of the namespace, otherwise, to the private data of the given collection
+type StateDBIndexManager interface {
+	// ListIndexes returns the indexes defined for the namespace (or the collection)
+	ListIndexes(namespace, collection string) ([]*StateDBIndex, error)
+	// CreateIndex creates (or updates) an index for the namespace (or the collection)
+	CreateIndex(namespace, collection, indexDefinition string) (*StateDBIndex, error)
+	// DropIndex deletes an index of the namespace (or the collection)
+	DropIndex(namespace, collection, designDoc, indexName string) error
+	// ExplainQuery returns the index that the state database would use for executing the given rich query
+	ExplainQuery(namespace, collection, query string) (*StateDBIndex, error)
+}
+
+// StateDBIndex describes an index of the state database
+type StateDBIndex struct {
+	DesignDocument string
+	Name           string
+	Definition     string
+}
+
 // MissingPvtDataTracker allows getting information about the private data that is not missing on the peer
 type MissingPvtDataTracker interface {
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlocks int) (MissingPvtDataInfo, error)
+	// ListMissingPvtData returns the details of the private data missing in the blocks within the
+	// range [startBlock, endBlock], including the data of the collections the peer is not eligible for
+	ListMissingPvtData(startBlock, endBlock uint64) ([]*MissingPvtDataDetails, error)
+	// MarkMissingPvtDataUnrecoverable marks the given eligible missing private data as unrecoverable so
+	// that it is no longer returned for reconciliation. The data is still listed until it expires
+	MarkMissingPvtDataUnrecoverable(missingPvtDataInfo MissingPvtDataInfo) error
+}
+
+// MissingPvtDataDetails describes the private data of a collection that is missing for a transaction
+type MissingPvtDataDetails struct {
+	BlockNum, TxNum       uint64
+	Namespace, Collection string
+	IsEligible            bool
+	IsUnrecoverable       bool
 }
 
 // MissingPvtDataInfo is a map of block number to MissingBlockPvtdataInfo
@@ -440,6 +526,32 @@ func (NotFoundInIndexErr) Error() string {
 	return "Entry not found in index"
 }
 
+// BlockPrunedErr is used to indicate that the requested block (or a transaction
+// contained in it) belongs to a range of the block store that has been pruned
+type BlockPrunedErr struct {
+	FirstAvailableBlockNum uint64
+	Msg                    string
+}
+
+func (e *BlockPrunedErr) Error() string {
+	return fmt.Sprintf("block pruned: %s, the lowest available block is [%d]", e.Msg, e.FirstAvailableBlockNum)
+}
+
+// KeepLastNBlocksPrunePolicy is a `PrunePolicy` that retains (at least) the most recent
+// `NumBlocks` blocks of the ledger and prunes the older ones. The pruned block files are
+// moved to `ArchiveDir` or deleted, if `ArchiveDir` is empty
+type KeepLastNBlocksPrunePolicy struct {
+	NumBlocks  uint64
+	ArchiveDir string
+}
+
+// ConfigBlockCheckpointPrunePolicy is a `PrunePolicy` that prunes the blocks older
+// than the most recent config block of the ledger. The pruned block files are moved
+// to `ArchiveDir` or deleted, if `ArchiveDir` is empty
+type ConfigBlockCheckpointPrunePolicy struct {
+	ArchiveDir string
+}
+
 // CollConfigNotDefinedError is returned whenever an operation
 // is requested on a collection whose config has not been defined
 type CollConfigNotDefinedError struct {
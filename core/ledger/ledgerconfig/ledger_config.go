@@ -22,6 +22,23 @@ func IsCouchDBEnabled() bool {
 	return false
 }
 
+// GetStateDatabase returns the name of the state database configured for the peer.
+// Other than the built-in "goleveldb" and "CouchDB", this may be the name of an
+// external state database that is registered with the statedb package or loaded from a plugin
+func GetStateDatabase() string {
+	return viper.GetString(confStateDatabase)
+}
+
+// GetStateDBPluginLibrary returns the path to the go plugin library that implements the external state database
+func GetStateDBPluginLibrary() string {
+	return config.GetPath(confStateDBPluginLibrary)
+}
+
+// GetStateDBPluginConfig returns the configuration that is passed to the external state database plugin
+func GetStateDBPluginConfig() map[string]interface{} {
+	return viper.GetStringMap(confStateDBPluginConfig)
+}
+
 const confPeerFileSystemPath = "peer.fileSystemPath"
 const confLedgersData = "ledgersData"
 const confLedgerProvider = "ledgerProvider"
@@ -32,12 +49,19 @@ const confConfigHistory = "configHistory"
 const confChains = "chains"
 const confPvtdataStore = "pvtdataStore"
 const fileLockPath = "fileLock"
+const confStateDatabase = "ledger.state.stateDatabase"
+const confStateDBPluginLibrary = "ledger.state.stateDBPlugin.library"
+const confStateDBPluginConfig = "ledger.state.stateDBPlugin.config"
 const confTotalQueryLimit = "ledger.state.totalQueryLimit"
 const confInternalQueryLimit = "ledger.state.couchDBConfig.internalQueryLimit"
 const confEnableHistoryDatabase = "ledger.history.enableHistoryDatabase"
 const confMaxBatchSize = "ledger.state.couchDBConfig.maxBatchUpdateSize"
 const confAutoWarmIndexes = "ledger.state.couchDBConfig.autoWarmIndexes"
 const confWarmIndexesAfterNBlocks = "ledger.state.couchDBConfig.warmIndexesAfterNBlocks"
+const confBlockRetentionPolicy = "ledger.blockchain.retention.policy"
+const confBlockRetentionNumBlocks = "ledger.blockchain.retention.numBlocks"
+const confBlockRetentionArchiveDir = "ledger.blockchain.retention.archiveDir"
+const confBlockRetentionPruneInterval = "ledger.blockchain.retention.pruneInterval"
 
 var confCollElgProcMaxDbBatchSize = &conf{"ledger.pvtdataStore.collElgProcMaxDbBatchSize", 5000}
 var confCollElgProcDbBatchesInterval = &conf{"ledger.pvtdataStore.collElgProcDbBatchesInterval", 1000}
@@ -134,6 +158,37 @@ func GetPvtdataStorePurgeInterval() uint64 {
 	return uint64(purgeInterval)
 }
 
+// GetBlockRetentionPolicy returns the name of the policy used for pruning the blocks of the ledgers
+// after they are committed. An empty name means that the blocks are never pruned
+func GetBlockRetentionPolicy() string {
+	return viper.GetString(confBlockRetentionPolicy)
+}
+
+// GetBlockRetentionNumBlocks returns the number of most recent blocks retained by the "keepLastNBlocks" policy
+func GetBlockRetentionNumBlocks() uint64 {
+	numBlocks := viper.GetInt(confBlockRetentionNumBlocks)
+	if numBlocks < 0 {
+		numBlocks = 0
+	}
+	return uint64(numBlocks)
+}
+
+// GetBlockRetentionArchiveDir returns the directory the pruned block files are moved to.
+// The pruned block files are deleted if it is empty
+func GetBlockRetentionArchiveDir() string {
+	return config.GetPath(confBlockRetentionArchiveDir)
+}
+
+// GetBlockRetentionPruneInterval returns the interval in the terms of number of blocks
+// when the blocks are pruned as per the retention policy
+func GetBlockRetentionPruneInterval() uint64 {
+	pruneInterval := viper.GetInt(confBlockRetentionPruneInterval)
+	if pruneInterval <= 0 {
+		pruneInterval = 100
+	}
+	return uint64(pruneInterval)
+}
+
 // GetPvtdataStoreCollElgProcMaxDbBatchSize returns the maximum db batch size for converting
 // the ineligible missing data entries to eligible missing data entries
 func GetPvtdataStoreCollElgProcMaxDbBatchSize() int {
@@ -46,6 +46,20 @@ func TestIsCouchDBEnabled(t *testing.T) {
 	assert.True(t, updatedValue) //test config returns true
 }
 
+func TestStateDBPluginConfig(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defer ledgertestutil.ResetConfigToDefaultValues()
+	assert.Equal(t, "goleveldb", GetStateDatabase())
+	assert.Equal(t, "", GetStateDBPluginLibrary())
+
+	viper.Set("ledger.state.stateDatabase", "mystore")
+	viper.Set("ledger.state.stateDBPlugin.library", "/opt/plugins/mystore.so")
+	viper.Set("ledger.state.stateDBPlugin.config", map[string]interface{}{"address": "127.0.0.1:7000"})
+	assert.Equal(t, "mystore", GetStateDatabase())
+	assert.Equal(t, "/opt/plugins/mystore.so", GetStateDBPluginLibrary())
+	assert.Equal(t, map[string]interface{}{"address": "127.0.0.1:7000"}, GetStateDBPluginConfig())
+}
+
 func TestLedgerConfigPathDefault(t *testing.T) {
 	setUpCoreYAMLConfig()
 	assert.Equal(t, "/var/hyperledger/production/ledgersData", GetRootPath())
@@ -144,6 +158,27 @@ func TestPvtdataStorePurgeIntervalUnset(t *testing.T) {
 	assert.Equal(t, uint64(100), defaultValue) // 100 if purgeInterval is not set
 }
 
+func TestBlockRetentionDefault(t *testing.T) {
+	setUpCoreYAMLConfig()
+	assert.Equal(t, "", GetBlockRetentionPolicy())
+	assert.Equal(t, uint64(0), GetBlockRetentionNumBlocks())
+	assert.Equal(t, "", GetBlockRetentionArchiveDir())
+	assert.Equal(t, uint64(100), GetBlockRetentionPruneInterval())
+}
+
+func TestBlockRetention(t *testing.T) {
+	setUpCoreYAMLConfig()
+	defer ledgertestutil.ResetConfigToDefaultValues()
+	viper.Set("ledger.blockchain.retention.policy", "keepLastNBlocks")
+	viper.Set("ledger.blockchain.retention.numBlocks", 1000)
+	viper.Set("ledger.blockchain.retention.archiveDir", "/tmp/hyperledger/archive")
+	viper.Set("ledger.blockchain.retention.pruneInterval", 10)
+	assert.Equal(t, "keepLastNBlocks", GetBlockRetentionPolicy())
+	assert.Equal(t, uint64(1000), GetBlockRetentionNumBlocks())
+	assert.Equal(t, "/tmp/hyperledger/archive", GetBlockRetentionArchiveDir())
+	assert.Equal(t, uint64(10), GetBlockRetentionPruneInterval())
+}
+
 func TestIsQueryReadHasingEnabled(t *testing.T) {
 	assert.True(t, IsQueryReadsHashingEnabled())
 }
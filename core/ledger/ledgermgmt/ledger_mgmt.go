@@ -84,6 +84,31 @@ func initialize(initializer *Initializer) {
 	logger.Info("ledger mgmt initialized")
 }
 
+// RebuildDBs drops and rebuilds the state, history, config history, and bookkeeper databases of the
+// given ledgers (of all the ledgers, if none is given) from the block store, as described in the function
+// kvledger.RebuildDBs. The ledgers are set up with the same dependencies as in the function Initialize so
+// that the blocks are recommitted in the same way as by a running peer. This is expected to be invoked
+// by a peer node command, in place of the function Initialize, while the peer is stopped
+func RebuildDBs(initializer *Initializer, ledgerIDs []string) error {
+	logger.Info("Rebuilding the databases of the ledgers")
+	customtx.Initialize(initializer.CustomTxProcessors)
+	cceventmgmt.Initialize(&chaincodeInfoProviderImpl{
+		initializer.PlatformRegistry,
+		initializer.DeployedChaincodeInfoProvider,
+	})
+	finalStateListeners := addListenerForCCEventsHandler(initializer.DeployedChaincodeInfoProvider, []ledger.StateListener{})
+	return kvledger.RebuildDBs(
+		&ledger.Initializer{
+			StateListeners:                finalStateListeners,
+			DeployedChaincodeInfoProvider: initializer.DeployedChaincodeInfoProvider,
+			MembershipInfoProvider:        initializer.MembershipInfoProvider,
+			MetricsProvider:               initializer.MetricsProvider,
+			HealthCheckRegistry:           initializer.HealthCheckRegistry,
+		},
+		ledgerIDs,
+	)
+}
+
 // CreateLedger creates a new ledger with the given genesis block.
 // This function guarantees that the creation of ledger and committing the genesis block would an atomic action
 // The chain id retrieved from the genesis block is treated as a ledger id
@@ -109,6 +134,26 @@ func CreateLedger(genesisBlock *common.Block) (ledger.PeerLedger, error) {
 	return l, nil
 }
 
+// CreateLedgerFromSnapshot creates a new ledger from the snapshot in the given directory.
+// The channel name recorded in the snapshot is treated as a ledger id and is returned along with the ledger
+func CreateLedgerFromSnapshot(snapshotDir string) (ledger.PeerLedger, string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !initialized {
+		return nil, "", ErrLedgerMgmtNotInitialized
+	}
+
+	logger.Infof("Creating ledger from snapshot [%s]", snapshotDir)
+	l, id, err := ledgerProvider.CreateFromSnapshot(snapshotDir)
+	if err != nil {
+		return nil, "", err
+	}
+	l = wrapLedger(id, l)
+	openedLedgers[id] = l
+	logger.Infof("Created ledger [%s] from snapshot [%s]", id, snapshotDir)
+	return l, id, nil
+}
+
 // OpenLedger returns a ledger for the given id
 func OpenLedger(id string) (ledger.PeerLedger, error) {
 	logger.Infof("Opening ledger with id = %s", id)
@@ -131,7 +176,7 @@ func OpenLedger(id string) (ledger.PeerLedger, error) {
 	return l, nil
 }
 
-// GetLedgerIDs returns the ids of the ledgers created
+// GetLedgerIDs returns the ids of the ledgers created, excluding the ledgers that have been paused
 func GetLedgerIDs() ([]string, error) {
 	lock.Lock()
 	defer lock.Unlock()
@@ -141,6 +186,16 @@ func GetLedgerIDs() ([]string, error) {
 	return ledgerProvider.List()
 }
 
+// GetPausedLedgerIDs returns the ids of the ledgers that have been paused
+func GetPausedLedgerIDs() ([]string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if !initialized {
+		return nil, ErrLedgerMgmtNotInitialized
+	}
+	return ledgerProvider.ListPaused()
+}
+
 // Close closes all the opened ledgers and any resources held for ledger management
 func Close() {
 	logger.Infof("Closing ledger mgmt")
@@ -54,6 +54,10 @@ func TestLedgerMgmt(t *testing.T) {
 	assert.Nil(t, ids)
 	assert.Equal(t, ErrLedgerMgmtNotInitialized, err)
 
+	ids, err = GetPausedLedgerIDs()
+	assert.Nil(t, ids)
+	assert.Equal(t, ErrLedgerMgmtNotInitialized, err)
+
 	Close()
 
 	InitializeTestEnv()
@@ -72,6 +76,9 @@ func TestLedgerMgmt(t *testing.T) {
 	for i := 0; i < numLedgers; i++ {
 		assert.Equal(t, constructTestLedgerID(i), ids[i])
 	}
+	ids, err = GetPausedLedgerIDs()
+	assert.NoError(t, err)
+	assert.Len(t, ids, 0)
 
 	ledgerID = constructTestLedgerID(2)
 	t.Logf("Ledger selected for test = %s", ledgerID)
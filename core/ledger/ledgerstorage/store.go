@@ -15,6 +15,7 @@ import (
 	"github.com/hyperledger/fabric/common/ledger/blkstorage/fsblkstorage"
 	"github.com/hyperledger/fabric/common/metrics"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatastorage"
@@ -93,6 +94,12 @@ func (p *Provider) Open(ledgerid string) (*Store, error) {
 	return store, nil
 }
 
+// BootstrapFromBlocks prepares the block store for the given ledger such that it contains only the given
+// blocks. The pvt data store gets initialized to the last of these blocks when the store is opened
+func (p *Provider) BootstrapFromBlocks(ledgerid string, blocks []*common.Block) error {
+	return p.blkStoreProvider.BootstrapFromBlocks(ledgerid, blocks)
+}
+
 // Close closes the provider
 func (p *Provider) Close() {
 	p.blkStoreProvider.Close()
@@ -192,6 +199,16 @@ func (s *Store) CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPv
 	return nil
 }
 
+// PurgePvtDataKeys invokes the function `PurgeKeys` on underlying pvtdata store
+func (s *Store) PurgePvtDataKeys(purgedKeys []*ledger.PurgedPvtdataKey) error {
+	return s.pvtdataStore.PurgeKeys(purgedKeys)
+}
+
+// GetPvtDataPurgeHeight invokes the function `GetPurgeHeight` on underlying pvtdata store
+func (s *Store) GetPvtDataPurgeHeight(ns, coll string, keyHash []byte) (*version.Height, error) {
+	return s.pvtdataStore.GetPurgeHeight(ns, coll, keyHash)
+}
+
 // GetPvtDataAndBlockByNum returns the block and the corresponding pvt data.
 // The pvt data is filtered by the list of 'collections' supplied
 func (s *Store) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
@@ -253,6 +270,16 @@ func (s *Store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 	return s.pvtdataStore.GetMissingPvtDataInfoForMostRecentBlocks(maxBlock)
 }
 
+// ListMissingPvtData invokes the function on underlying pvtdata store
+func (s *Store) ListMissingPvtData(startBlock, endBlock uint64) ([]*ledger.MissingPvtDataDetails, error) {
+	return s.pvtdataStore.ListMissingPvtData(startBlock, endBlock)
+}
+
+// MarkMissingPvtDataUnrecoverable invokes the function on underlying pvtdata store
+func (s *Store) MarkMissingPvtDataUnrecoverable(missingPvtDataInfo ledger.MissingPvtDataInfo) error {
+	return s.pvtdataStore.MarkMissingPvtDataUnrecoverable(missingPvtDataInfo)
+}
+
 // ProcessCollsEligibilityEnabled invokes the function on underlying pvtdata store
 func (s *Store) ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap map[string][]string) error {
 	return s.pvtdataStore.ProcessCollsEligibilityEnabled(committingBlk, nsCollMap)
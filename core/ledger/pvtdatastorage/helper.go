@@ -9,9 +9,14 @@ package pvtdatastorage
 import (
 	"math"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
+	"github.com/hyperledger/fabric/protos/ledger/rwset/kvrwset"
 	"github.com/willf/bitset"
 )
 
@@ -158,6 +163,51 @@ func deriveKeys(expiryEntry *expiryEntry) (dataKeys []*dataKey, missingDataKeys
 	return
 }
 
+// keyHashesOfCollPvtdata returns the hashes of the keys written by the private write set of a collection
+func keyHashesOfCollPvtdata(collPvtdata *rwset.CollectionPvtReadWriteSet) ([][]byte, error) {
+	kvRwSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(collPvtdata.Rwset, kvRwSet); err != nil {
+		return nil, err
+	}
+	var keyHashes [][]byte
+	for _, kvWrite := range kvRwSet.Writes {
+		keyHashes = append(keyHashes, util.ComputeStringHash(kvWrite.Key))
+	}
+	for _, metadataWrite := range kvRwSet.MetadataWrites {
+		keyHashes = append(keyHashes, util.ComputeStringHash(metadataWrite.Key))
+	}
+	return keyHashes, nil
+}
+
+// addKeyHashIndexEntries adds to the batch the index entries of the keys written by the data entry
+func addKeyHashIndexEntries(batch *leveldbhelper.UpdateBatch, key *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet) {
+	height := version.NewHeight(key.blkNum, key.txNum)
+	for _, keyHash := range indexedKeyHashes(key, collPvtdata) {
+		batch.Put(encodeKeyHashIndexKey(key.ns, key.coll, keyHash, height), emptyValue)
+	}
+}
+
+// deleteKeyHashIndexEntries adds to the batch the deletion of the index entries of the keys written by the data entry
+func deleteKeyHashIndexEntries(batch *leveldbhelper.UpdateBatch, key *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet) {
+	height := version.NewHeight(key.blkNum, key.txNum)
+	for _, keyHash := range indexedKeyHashes(key, collPvtdata) {
+		batch.Delete(encodeKeyHashIndexKey(key.ns, key.coll, keyHash, height))
+	}
+}
+
+// indexedKeyHashes returns the hashes of the keys under which the data entry is indexed. The private
+// write sets of invalid transactions are stored as well and may not be well formed, in which case
+// they are not indexed as no key can be purged from them
+func indexedKeyHashes(key *dataKey, collPvtdata *rwset.CollectionPvtReadWriteSet) [][]byte {
+	keyHashes, err := keyHashesOfCollPvtdata(collPvtdata)
+	if err != nil {
+		logger.Debugf("Not indexing the malformed private write set of [%s:%s] at block [%d] tx [%d]: %s",
+			key.ns, key.coll, key.blkNum, key.txNum, err)
+		return nil
+	}
+	return keyHashes
+}
+
 func passesFilter(dataKey *dataKey, filter ledger.PvtNsCollFilter) bool {
 	return filter == nil || filter.Has(dataKey.ns, dataKey.coll)
 }
@@ -19,14 +19,18 @@ import (
 )
 
 var (
-	pendingCommitKey               = []byte{0}
-	lastCommittedBlkkey            = []byte{1}
-	pvtDataKeyPrefix               = []byte{2}
-	expiryKeyPrefix                = []byte{3}
-	eligibleMissingDataKeyPrefix   = []byte{4}
-	ineligibleMissingDataKeyPrefix = []byte{5}
-	collElgKeyPrefix               = []byte{6}
-	lastUpdatedOldBlocksKey        = []byte{7}
+	pendingCommitKey                  = []byte{0}
+	lastCommittedBlkkey               = []byte{1}
+	pvtDataKeyPrefix                  = []byte{2}
+	expiryKeyPrefix                   = []byte{3}
+	eligibleMissingDataKeyPrefix      = []byte{4}
+	ineligibleMissingDataKeyPrefix    = []byte{5}
+	collElgKeyPrefix                  = []byte{6}
+	lastUpdatedOldBlocksKey           = []byte{7}
+	purgedKeyPrefix                   = []byte{8}
+	unrecoverableMissingDataKeyPrefix = []byte{9}
+	keyHashIndexKeyPrefix             = []byte{10}
+	keyHashIndexBuiltKey              = []byte{11}
 
 	nilByte    = byte(0)
 	emptyValue = []byte{}
@@ -38,6 +42,12 @@ func getDataKeysForRangeScanByBlockNum(blockNum uint64) (startKey, endKey []byte
 	return
 }
 
+func getDataKeysForRangeScanTillBlockNum(maxBlkNum uint64) (startKey, endKey []byte) {
+	startKey = append(pvtDataKeyPrefix, version.NewHeight(0, 0).ToBytes()...)
+	endKey = append(pvtDataKeyPrefix, version.NewHeight(maxBlkNum+1, 0).ToBytes()...)
+	return
+}
+
 func getExpiryKeysForRangeScan(minBlkNum, maxBlkNum uint64) (startKey, endKey []byte) {
 	startKey = append(expiryKeyPrefix, version.NewHeight(minBlkNum, 0).ToBytes()...)
 	endKey = append(expiryKeyPrefix, version.NewHeight(maxBlkNum+1, 0).ToBytes()...)
@@ -107,6 +117,41 @@ func decodeDataValue(datavalueBytes []byte) (*rwset.CollectionPvtReadWriteSet, e
 	return collPvtdata, err
 }
 
+func encodePurgedKeyKey(ns, coll string, keyHash []byte) []byte {
+	purgedKeyBytes := append(purgedKeyPrefix, []byte(ns)...)
+	purgedKeyBytes = append(purgedKeyBytes, nilByte)
+	purgedKeyBytes = append(purgedKeyBytes, []byte(coll)...)
+	purgedKeyBytes = append(purgedKeyBytes, nilByte)
+	return append(purgedKeyBytes, keyHash...)
+}
+
+func encodePurgedKeyValue(purgeHeight *version.Height) []byte {
+	return purgeHeight.ToBytes()
+}
+
+func decodePurgedKeyValue(purgedKeyValueBytes []byte) (*version.Height, error) {
+	purgeHeight, _, err := version.NewHeightFromBytes(purgedKeyValueBytes)
+	return purgeHeight, err
+}
+
+func encodeKeyHashIndexKey(ns, coll string, keyHash []byte, height *version.Height) []byte {
+	return append(encodeKeyHashIndexKeyPrefix(ns, coll, keyHash), height.ToBytes()...)
+}
+
+func encodeKeyHashIndexKeyPrefix(ns, coll string, keyHash []byte) []byte {
+	keyHashIndexKeyBytes := append(keyHashIndexKeyPrefix, []byte(ns)...)
+	keyHashIndexKeyBytes = append(keyHashIndexKeyBytes, nilByte)
+	keyHashIndexKeyBytes = append(keyHashIndexKeyBytes, []byte(coll)...)
+	keyHashIndexKeyBytes = append(keyHashIndexKeyBytes, nilByte)
+	return append(keyHashIndexKeyBytes, keyHash...)
+}
+
+func decodeKeyHashIndexKey(keyHashIndexKeyBytes []byte, ns, coll string, keyHash []byte) (*version.Height, error) {
+	prefixLen := len(encodeKeyHashIndexKeyPrefix(ns, coll, keyHash))
+	height, _, err := version.NewHeightFromBytes(keyHashIndexKeyBytes[prefixLen:])
+	return height, err
+}
+
 func encodeMissingDataKey(key *missingDataKey) []byte {
 	if key.isEligible {
 		keyBytes := append(eligibleMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(key.blkNum)...)
@@ -124,7 +169,7 @@ func encodeMissingDataKey(key *missingDataKey) []byte {
 
 func decodeMissingDataKey(keyBytes []byte) *missingDataKey {
 	key := &missingDataKey{nsCollBlk: nsCollBlk{}}
-	if keyBytes[0] == eligibleMissingDataKeyPrefix[0] {
+	if keyBytes[0] == eligibleMissingDataKeyPrefix[0] || keyBytes[0] == unrecoverableMissingDataKeyPrefix[0] {
 		blkNum, numBytesConsumed := util.DecodeReverseOrderVarUint64(keyBytes[1:])
 
 		splittedKey := bytes.Split(keyBytes[numBytesConsumed+1:], []byte{nilByte})
@@ -143,6 +188,15 @@ func decodeMissingDataKey(keyBytes []byte) *missingDataKey {
 	return key
 }
 
+// encodeUnrecoverableMissingDataKey encodes the key of the eligible missing data that is no longer
+// reconciled. The encoding is the same as the one of the eligible missing data except for the prefix
+func encodeUnrecoverableMissingDataKey(key *nsCollBlk) []byte {
+	keyBytes := append(unrecoverableMissingDataKeyPrefix, util.EncodeReverseOrderVarUint64(key.blkNum)...)
+	keyBytes = append(keyBytes, []byte(key.ns)...)
+	keyBytes = append(keyBytes, nilByte)
+	return append(keyBytes, []byte(key.coll)...)
+}
+
 func encodeMissingDataValue(bitmap *bitset.BitSet) ([]byte, error) {
 	return bitmap.MarshalBinary()
 }
@@ -183,6 +237,18 @@ func createRangeScanKeysForEligibleMissingDataEntries(blkNum uint64) (startKey,
 	return startKey, endKey
 }
 
+// createRangeScanKeysForMissingDataInBlockRange returns the keys for scanning the missing data entries of the blocks
+// within [minBlkNum, maxBlkNum] that are stored with the given prefix, i.e., either eligible or unrecoverable
+func createRangeScanKeysForMissingDataInBlockRange(prefix []byte, minBlkNum, maxBlkNum uint64) (startKey, endKey []byte) {
+	startKey = append(prefix, util.EncodeReverseOrderVarUint64(maxBlkNum)...)
+	if minBlkNum == 0 {
+		endKey = []byte{prefix[0] + 1}
+		return
+	}
+	endKey = append(prefix, util.EncodeReverseOrderVarUint64(minBlkNum-1)...)
+	return
+}
+
 func createRangeScanKeysForIneligibleMissingData(maxBlkNum uint64, ns, coll string) (startKey, endKey []byte) {
 	startKey = encodeMissingDataKey(
 		&missingDataKey{
@@ -199,6 +265,18 @@ func createRangeScanKeysForIneligibleMissingData(maxBlkNum uint64, ns, coll stri
 	return
 }
 
+// createRangeScanKeysForKeyHashIndex returns the range of the index entries of the given key hash
+// which point to the data entries at a height lower than the given height
+func createRangeScanKeysForKeyHashIndex(ns, coll string, keyHash []byte, maxHeight *version.Height) (startKey, endKey []byte) {
+	startKey = encodeKeyHashIndexKey(ns, coll, keyHash, version.NewHeight(0, 0))
+	endKey = encodeKeyHashIndexKey(ns, coll, keyHash, maxHeight)
+	return
+}
+
+func createRangeScanKeysForAllDataEntries() (startKey, endKey []byte) {
+	return pvtDataKeyPrefix, []byte{pvtDataKeyPrefix[0] + 1}
+}
+
 func createRangeScanKeysForCollElg() (startKey, endKey []byte) {
 	return encodeCollElgKey(math.MaxUint64),
 		encodeCollElgKey(0)
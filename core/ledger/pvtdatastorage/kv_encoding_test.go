@@ -81,6 +81,24 @@ func TestEligibleMissingdataRange(t *testing.T) {
 	}
 }
 
+func TestMissingdataInBlockRange(t *testing.T) {
+	keyOfBlock := func(blkNum uint64) []byte {
+		return encodeUnrecoverableMissingDataKey(&nsCollBlk{ns: "ns", coll: "coll", blkNum: blkNum})
+	}
+	startKey, endKey := createRangeScanKeysForMissingDataInBlockRange(unrecoverableMissingDataKeyPrefix, 10, 20)
+	assert.Equal(t, -1, bytes.Compare(keyOfBlock(21), startKey))
+	assert.Equal(t, 1, bytes.Compare(keyOfBlock(20), startKey))
+	assert.Equal(t, -1, bytes.Compare(keyOfBlock(10), endKey))
+	assert.Equal(t, 1, bytes.Compare(keyOfBlock(9), endKey))
+
+	startKey, endKey = createRangeScanKeysForMissingDataInBlockRange(unrecoverableMissingDataKeyPrefix, 0, 20)
+	assert.Equal(t, 1, bytes.Compare(keyOfBlock(0), startKey))
+	assert.Equal(t, -1, bytes.Compare(keyOfBlock(0), endKey))
+
+	decodedKey := decodeMissingDataKey(keyOfBlock(15))
+	assert.Equal(t, &missingDataKey{nsCollBlk: nsCollBlk{ns: "ns", coll: "coll", blkNum: 15}, isEligible: true}, decodedKey)
+}
+
 func TestEncodeDecodeMissingdataKey(t *testing.T) {
 	for i := 0; i < 1000; i++ {
 		testEncodeDecodeMissingdataKey(t, uint64(i))
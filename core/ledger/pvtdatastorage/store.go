@@ -8,6 +8,7 @@ package pvtdatastorage
 
 import (
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
 )
 
@@ -41,6 +42,13 @@ type Store interface {
 	// GetMissingPvtDataInfoForMostRecentBlocks returns the missing private data information for the
 	// most recent `maxBlock` blocks which miss at least a private data of a eligible collection.
 	GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.MissingPvtDataInfo, error)
+	// ListMissingPvtData returns the details of the eligible, ineligible, and unrecoverable missing private
+	// data of the blocks within the range [startBlock, endBlock]. The expired missing data is not included
+	ListMissingPvtData(startBlock, endBlock uint64) ([]*ledger.MissingPvtDataDetails, error)
+	// MarkMissingPvtDataUnrecoverable moves the given eligible missing private data to the unrecoverable
+	// missing data so that it is no longer returned by `GetMissingPvtDataInfoForMostRecentBlocks`.
+	// The missing data that is not eligible (or that is not missing anymore) is ignored
+	MarkMissingPvtDataUnrecoverable(missingPvtDataInfo ledger.MissingPvtDataInfo) error
 	// Prepare prepares the Store for commiting the pvt data and storing both eligible and ineligible
 	// missing private data --- `eligible` denotes that the missing private data belongs to a collection
 	// for which this peer is a member; `ineligible` denotes that the missing private data belong to a
@@ -65,6 +73,17 @@ type Store interface {
 	// these pvtData, the `lastUpdatedOldBlocksList` must be removed. During the peer startup,
 	// if the `lastUpdatedOldBlocksList` exists, stateDB needs to be updated with the appropriate pvtData.
 	CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPvtData) error
+	// PurgeKeys erases the private data of the given purged keys that was written by the transactions
+	// preceding the purging transactions. The purges are recorded as well so that the pvtData of old
+	// blocks committed afterwards via `CommitPvtDataOfOldBlocks` cannot bring back a purged key.
+	// The data entries are indexed by the hashes of the keys they write, so that only the entries
+	// which write the purged keys are read and rewritten. As the rewritten entries no longer match the
+	// hashes in their blocks, a peer which pulls them validates them against these hashes once the
+	// writes of the keys purged after the entries are left out (see `GetPurgeHeight`)
+	PurgeKeys(purgedKeys []*ledger.PurgedPvtdataKey) error
+	// GetPurgeHeight returns the height of the most recent transaction that purged the key of the given
+	// hash in the given collection, or nil if the key has not been purged
+	GetPurgeHeight(ns, coll string, keyHash []byte) (*version.Height, error)
 	// GetLastUpdatedOldBlocksPvtData returns the pvtdata of blocks listed in `lastUpdatedOldBlocksList`
 	GetLastUpdatedOldBlocksPvtData() (map[uint64][]*ledger.TxPvtData, error)
 	// ResetLastUpdatedOldBlocksList removes the `lastUpdatedOldBlocksList` entry from the store
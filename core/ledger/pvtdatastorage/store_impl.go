@@ -17,6 +17,8 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
 	"github.com/hyperledger/fabric/core/ledger"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	"github.com/hyperledger/fabric/core/ledger/pvtdatapolicy"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
@@ -25,6 +27,10 @@ import (
 
 var logger = flogging.MustGetLogger("pvtdatastorage")
 
+// keyHashIndexBuildBatchSize is the number of index entries written at once when the data
+// entries committed before the store maintained the key hash index get indexed
+const keyHashIndexBuildBatchSize = 1000
+
 type provider struct {
 	dbProvider *leveldbhelper.Provider
 }
@@ -78,6 +84,10 @@ type dataKey struct {
 	txNum uint64
 }
 
+type nsColl struct {
+	ns, coll string
+}
+
 type missingDataKey struct {
 	nsCollBlk
 	isEligible bool
@@ -100,6 +110,9 @@ type entriesForPvtDataOfOldBlocks struct {
 	expiryEntries map[expiryKey]*ExpiryData
 	// for each <ns, coll, blkNum>, store the retrieved (& updated) bitmap in the missingDataEntries
 	missingDataEntries map[nsCollBlk]*bitset.BitSet
+	// for each <ns, coll, blkNum>, store the retrieved (& updated) bitmap of the missing data
+	// marked as unrecoverable in the unrecoverableMissingDataEntries
+	unrecoverableMissingDataEntries map[nsCollBlk]*bitset.BitSet
 }
 
 //////// Provider functions  /////////////
@@ -124,6 +137,9 @@ func (p *provider) OpenStore(ledgerid string) (Store, error) {
 	if err := s.initState(); err != nil {
 		return nil, err
 	}
+	if err := s.buildKeyHashIndex(); err != nil {
+		return nil, err
+	}
 	s.launchCollElgProc()
 	logger.Debugf("Pvtdata store opened. Initial state: isEmpty [%t], lastCommittedBlock [%d], batchPending [%t]",
 		s.isEmpty, s.lastCommittedBlock, s.batchPending)
@@ -187,6 +203,7 @@ func (s *store) Prepare(blockNum uint64, pvtData []*ledger.TxPvtData, missingPvt
 			return err
 		}
 		batch.Put(keyBytes, valBytes)
+		addKeyHashIndexEntries(batch, dataEntry.key, dataEntry.value)
 	}
 
 	for _, expiryEntry := range storeEntries.expiryEntries {
@@ -252,6 +269,10 @@ func (s *store) CommitPvtDataOfOldBlocks(blocksPvtData map[uint64][]*ledger.TxPv
 
 	// (1) construct dataEntries for all pvtData
 	dataEntries := constructDataEntriesFromBlocksPvtData(blocksPvtData)
+	// the private data of the keys purged after the transactions of the old blocks must not be stored again
+	if err := s.removePurgedKeysFromDataEntries(dataEntries); err != nil {
+		return err
+	}
 
 	// (2) construct update entries (i.e., dataEntries, expiryEntries, missingDataEntries) from the above created data entries
 	logger.Debugf("Constructing pvtdatastore entries for pvtData of [%d] old blocks", len(blocksPvtData))
@@ -287,11 +308,40 @@ func constructDataEntriesFromBlocksPvtData(blocksPvtData map[uint64][]*ledger.Tx
 	return dataEntries
 }
 
+func (s *store) removePurgedKeysFromDataEntries(dataEntries []*dataEntry) error {
+	for _, dataEntry := range dataEntries {
+		writtenKeyHashes, err := keyHashesOfCollPvtdata(dataEntry.value)
+		if err != nil {
+			return err
+		}
+
+		entryHeight := version.NewHeight(dataEntry.key.blkNum, dataEntry.key.txNum)
+		keyHashes := make(map[string]bool)
+		for _, keyHash := range writtenKeyHashes {
+			purgeHeight, err := s.GetPurgeHeight(dataEntry.key.ns, dataEntry.key.coll, keyHash)
+			if err != nil {
+				return err
+			}
+			if purgeHeight != nil && entryHeight.Compare(purgeHeight) < 0 {
+				keyHashes[string(keyHash)] = true
+			}
+		}
+		if len(keyHashes) == 0 {
+			continue
+		}
+		if _, err := rwsetutil.RemovePurgedKeys(dataEntry.value, keyHashes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *store) constructUpdateEntriesFromDataEntries(dataEntries []*dataEntry) (*entriesForPvtDataOfOldBlocks, error) {
 	updateEntries := &entriesForPvtDataOfOldBlocks{
-		dataEntries:        make(map[dataKey]*rwset.CollectionPvtReadWriteSet),
-		expiryEntries:      make(map[expiryKey]*ExpiryData),
-		missingDataEntries: make(map[nsCollBlk]*bitset.BitSet)}
+		dataEntries:                     make(map[dataKey]*rwset.CollectionPvtReadWriteSet),
+		expiryEntries:                   make(map[expiryKey]*ExpiryData),
+		missingDataEntries:              make(map[nsCollBlk]*bitset.BitSet),
+		unrecoverableMissingDataEntries: make(map[nsCollBlk]*bitset.BitSet)}
 
 	// for each data entry, first, get the expiryData and missingData from the pvtStore.
 	// Second, update the expiryData and missingData as per the data entry. Finally, add
@@ -322,7 +372,13 @@ func (s *store) constructUpdateEntriesFromDataEntries(dataEntries []*dataEntry)
 		if missingData, err = s.getMissingDataFromUpdateEntriesOrStore(updateEntries, nsCollBlk); err != nil {
 			return nil, err
 		}
-		if missingData == nil {
+		// the missing data marked as unrecoverable is no longer reconciled but it is stored
+		// nonetheless if it is delivered, e.g., by a reconciliation started before the marking
+		var unrecoverableMissingData *bitset.BitSet
+		if unrecoverableMissingData, err = s.getUnrecoverableMissingDataFromUpdateEntriesOrStore(updateEntries, nsCollBlk); err != nil {
+			return nil, err
+		}
+		if missingData == nil && unrecoverableMissingData == nil {
 			// data entry is already expired
 			// and purged (a rare scenario)
 			continue
@@ -333,7 +389,12 @@ func (s *store) constructUpdateEntriesFromDataEntries(dataEntries []*dataEntry)
 			expiryEntry := &expiryEntry{&expiryKey, expiryData}
 			updateEntries.updateAndAddExpiryEntry(expiryEntry, dataEntry.key)
 		}
-		updateEntries.updateAndAddMissingDataEntry(missingData, dataEntry.key)
+		if missingData != nil {
+			updateEntries.updateAndAddMissingDataEntry(missingData, dataEntry.key)
+		}
+		if unrecoverableMissingData != nil {
+			updateEntries.updateAndAddUnrecoverableMissingDataEntry(unrecoverableMissingData, dataEntry.key)
+		}
 	}
 	return updateEntries, nil
 }
@@ -373,6 +434,18 @@ func (s *store) getMissingDataFromUpdateEntriesOrStore(updateEntries *entriesFor
 	return missingData, nil
 }
 
+func (s *store) getUnrecoverableMissingDataFromUpdateEntriesOrStore(updateEntries *entriesForPvtDataOfOldBlocks, nsCollBlk nsCollBlk) (*bitset.BitSet, error) {
+	unrecoverableMissingData, ok := updateEntries.unrecoverableMissingDataEntries[nsCollBlk]
+	if !ok {
+		var err error
+		unrecoverableMissingData, err = s.getBitmapOfUnrecoverableMissingData(&nsCollBlk)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return unrecoverableMissingData, nil
+}
+
 func (updateEntries *entriesForPvtDataOfOldBlocks) addDataEntry(dataEntry *dataEntry) {
 	dataKey := dataKey{dataEntry.key.nsCollBlk, dataEntry.key.txNum}
 	updateEntries.dataEntries[dataKey] = dataEntry.value
@@ -402,12 +475,19 @@ func (updateEntries *entriesForPvtDataOfOldBlocks) updateAndAddMissingDataEntry(
 	updateEntries.missingDataEntries[nsCollBlk] = missingData
 }
 
+func (updateEntries *entriesForPvtDataOfOldBlocks) updateAndAddUnrecoverableMissingDataEntry(unrecoverableMissingData *bitset.BitSet, dataKey *dataKey) {
+	// update
+	unrecoverableMissingData.Clear(uint(dataKey.txNum))
+	// add
+	updateEntries.unrecoverableMissingDataEntries[dataKey.nsCollBlk] = unrecoverableMissingData
+}
+
 func constructUpdateBatchFromUpdateEntries(updateEntries *entriesForPvtDataOfOldBlocks) (*leveldbhelper.UpdateBatch, error) {
 	batch := leveldbhelper.NewUpdateBatch()
 
 	// add the following four types of entries to the update batch: (1) new data entries
-	// (i.e., pvtData), (2) updated expiry entries, (3) updated missing data entries, and
-	// (4) updated block list
+	// (i.e., pvtData), (2) updated expiry entries, (3) updated missing data entries, including
+	// the ones marked as unrecoverable, and (4) updated block list
 
 	// (1) add new data entries to the batch
 	if err := addNewDataEntriesToUpdateBatch(batch, updateEntries); err != nil {
@@ -439,6 +519,7 @@ func addNewDataEntriesToUpdateBatch(batch *leveldbhelper.UpdateBatch, entries *e
 			return err
 		}
 		batch.Put(keyBytes, valBytes)
+		addKeyHashIndexEntries(batch, &dataKey, pvtData)
 	}
 	return nil
 }
@@ -471,6 +552,17 @@ func addUpdatedMissingDataEntriesToUpdateBatch(batch *leveldbhelper.UpdateBatch,
 		}
 		batch.Put(keyBytes, valBytes)
 	}
+	for nsCollBlk, unrecoverableMissingData := range entries.unrecoverableMissingDataEntries {
+		keyBytes = encodeUnrecoverableMissingDataKey(&nsCollBlk)
+		if unrecoverableMissingData.None() {
+			batch.Delete(keyBytes)
+			continue
+		}
+		if valBytes, err = encodeMissingDataValue(unrecoverableMissingData); err != nil {
+			return err
+		}
+		batch.Put(keyBytes, valBytes)
+	}
 	return nil
 }
 
@@ -513,6 +605,168 @@ func (s *store) commitBatch(batch *leveldbhelper.UpdateBatch) error {
 	return nil
 }
 
+// PurgeKeys implements the function in the interface `Store`
+func (s *store) PurgeKeys(purgedKeys []*ledger.PurgedPvtdataKey) error {
+	if len(purgedKeys) == 0 {
+		return nil
+	}
+	// the data entries are rewritten below and hence, the purger of the expired data must not run concurrently
+	s.purgerLock.Lock()
+	defer s.purgerLock.Unlock()
+
+	// for each collection, the hashes of the purged keys along with the height of their most recent purge
+	purgeHeights := make(map[nsColl]map[string]*version.Height)
+	maxBlkNum := uint64(0)
+	for _, purgedKey := range purgedKeys {
+		nsColl := nsColl{purgedKey.Namespace, purgedKey.Collection}
+		if purgeHeights[nsColl] == nil {
+			purgeHeights[nsColl] = make(map[string]*version.Height)
+		}
+		purgeHeight := version.NewHeight(purgedKey.BlockNum, purgedKey.TxNum)
+		if h, ok := purgeHeights[nsColl][string(purgedKey.KeyHash)]; !ok || h.Compare(purgeHeight) < 0 {
+			purgeHeights[nsColl][string(purgedKey.KeyHash)] = purgeHeight
+		}
+		if purgedKey.BlockNum > maxBlkNum {
+			maxBlkNum = purgedKey.BlockNum
+		}
+	}
+
+	batch := leveldbhelper.NewUpdateBatch()
+	// for each data entry written before the purge of some of its keys, the hashes of these keys
+	purgedEntries := make(map[dataKey]map[string]bool)
+	for nsColl, keyHeights := range purgeHeights {
+		for keyHash, purgeHeight := range keyHeights {
+			batch.Put(encodePurgedKeyKey(nsColl.ns, nsColl.coll, []byte(keyHash)), encodePurgedKeyValue(purgeHeight))
+			entryHeights, err := s.retrieveKeyHashIndexEntries(nsColl.ns, nsColl.coll, []byte(keyHash), purgeHeight)
+			if err != nil {
+				return err
+			}
+			for _, entryHeight := range entryHeights {
+				batch.Delete(encodeKeyHashIndexKey(nsColl.ns, nsColl.coll, []byte(keyHash), entryHeight))
+				dataKey := dataKey{nsCollBlk{nsColl.ns, nsColl.coll, entryHeight.BlockNum}, entryHeight.TxNum}
+				if purgedEntries[dataKey] == nil {
+					purgedEntries[dataKey] = make(map[string]bool)
+				}
+				purgedEntries[dataKey][keyHash] = true
+			}
+		}
+	}
+
+	numUpdatedEntries := 0
+	for dataKey, keyHashes := range purgedEntries {
+		dataKeyBytes := encodeDataKey(&dataKey)
+		dataValueBytes, err := s.db.Get(dataKeyBytes)
+		if err != nil {
+			return err
+		}
+		if dataValueBytes == nil {
+			// the data entry has expired
+			continue
+		}
+		collPvtdata, err := decodeDataValue(dataValueBytes)
+		if err != nil {
+			return err
+		}
+		removed, err := rwsetutil.RemovePurgedKeys(collPvtdata, keyHashes)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			continue
+		}
+		if dataValueBytes, err = encodeDataValue(collPvtdata); err != nil {
+			return err
+		}
+		batch.Put(dataKeyBytes, dataValueBytes)
+		numUpdatedEntries++
+	}
+
+	if err := s.commitBatch(batch); err != nil {
+		return err
+	}
+	logger.Infof("[%s] - [%d] keys purged from [%d] entries of private data storage till block number [%d]",
+		s.ledgerid, len(purgedKeys), numUpdatedEntries, maxBlkNum)
+	return nil
+}
+
+// GetPurgeHeight implements the function in the interface `Store`
+func (s *store) GetPurgeHeight(ns, coll string, keyHash []byte) (*version.Height, error) {
+	purgeHeightBytes, err := s.db.Get(encodePurgedKeyKey(ns, coll, keyHash))
+	if err != nil || purgeHeightBytes == nil {
+		return nil, err
+	}
+	return decodePurgedKeyValue(purgeHeightBytes)
+}
+
+// retrieveKeyHashIndexEntries returns the heights of the data entries lower than the given height
+// which write the key of the given hash
+func (s *store) retrieveKeyHashIndexEntries(ns, coll string, keyHash []byte, maxHeight *version.Height) ([]*version.Height, error) {
+	startKey, endKey := createRangeScanKeysForKeyHashIndex(ns, coll, keyHash, maxHeight)
+	itr := s.db.GetIterator(startKey, endKey)
+	defer itr.Release()
+
+	var heights []*version.Height
+	for itr.Next() {
+		height, err := decodeKeyHashIndexKey(itr.Key(), ns, coll, keyHash)
+		if err != nil {
+			return nil, err
+		}
+		heights = append(heights, height)
+	}
+	return heights, nil
+}
+
+// buildKeyHashIndex indexes by the hashes of their keys the data entries committed
+// before the store maintained such an index, so that PurgeKeys finds them as well
+func (s *store) buildKeyHashIndex() error {
+	built, err := s.db.Get(keyHashIndexBuiltKey)
+	if err != nil || built != nil {
+		return err
+	}
+
+	startKey, endKey := createRangeScanKeysForAllDataEntries()
+	itr := s.db.GetIterator(startKey, endKey)
+	defer itr.Release()
+
+	numIndexedEntries := 0
+	batch := leveldbhelper.NewUpdateBatch()
+	for itr.Next() {
+		v11Fmt, err := v11Format(itr.Key())
+		if err != nil {
+			return err
+		}
+		if v11Fmt {
+			// the entries of the v1.1 format hold the pvtdata of whole transactions and are not indexed
+			continue
+		}
+		dataKey, err := decodeDatakey(itr.Key())
+		if err != nil {
+			return err
+		}
+		collPvtdata, err := decodeDataValue(itr.Value())
+		if err != nil {
+			return err
+		}
+		addKeyHashIndexEntries(batch, dataKey, collPvtdata)
+		numIndexedEntries++
+		if batch.Len() >= keyHashIndexBuildBatchSize {
+			if err := s.db.WriteBatch(batch, false); err != nil {
+				return err
+			}
+			batch = leveldbhelper.NewUpdateBatch()
+		}
+	}
+
+	batch.Put(keyHashIndexBuiltKey, emptyValue)
+	if err := s.db.WriteBatch(batch, true); err != nil {
+		return err
+	}
+	if numIndexedEntries > 0 {
+		logger.Infof("[%s] - Indexed [%d] entries of private data storage by key hash", s.ledgerid, numIndexedEntries)
+	}
+	return nil
+}
+
 // GetLastUpdatedOldBlocksPvtData implements the function in the interface `Store`
 func (s *store) GetLastUpdatedOldBlocksPvtData() (map[uint64][]*ledger.TxPvtData, error) {
 	if !s.isLastUpdatedOldBlocksSet {
@@ -730,6 +984,156 @@ func (s *store) GetMissingPvtDataInfoForMostRecentBlocks(maxBlock int) (ledger.M
 	return missingPvtDataInfo, nil
 }
 
+// ListMissingPvtData implements the function in the interface `Store`
+func (s *store) ListMissingPvtData(startBlock, endBlock uint64) ([]*ledger.MissingPvtDataDetails, error) {
+	lastCommittedBlock := atomic.LoadUint64(&s.lastCommittedBlock)
+	if endBlock > lastCommittedBlock {
+		endBlock = lastCommittedBlock
+	}
+	if startBlock > endBlock {
+		return nil, nil
+	}
+
+	startKey, endKey := createRangeScanKeysForMissingDataInBlockRange(eligibleMissingDataKeyPrefix, startBlock, endBlock)
+	missingPvtData, err := s.getMissingDataDetails(startKey, endKey, startBlock, endBlock, lastCommittedBlock, false)
+	if err != nil {
+		return nil, err
+	}
+	startKey, endKey = createRangeScanKeysForMissingDataInBlockRange(unrecoverableMissingDataKeyPrefix, startBlock, endBlock)
+	unrecoverableMissingPvtData, err := s.getMissingDataDetails(startKey, endKey, startBlock, endBlock, lastCommittedBlock, true)
+	if err != nil {
+		return nil, err
+	}
+	// the ineligible missing data entries are ordered by namespace and collection first and
+	// hence, all of them are scanned and filtered by block number
+	startKey, endKey = ineligibleMissingDataKeyPrefix, []byte{ineligibleMissingDataKeyPrefix[0] + 1}
+	ineligibleMissingPvtData, err := s.getMissingDataDetails(startKey, endKey, startBlock, endBlock, lastCommittedBlock, false)
+	if err != nil {
+		return nil, err
+	}
+	missingPvtData = append(missingPvtData, unrecoverableMissingPvtData...)
+	missingPvtData = append(missingPvtData, ineligibleMissingPvtData...)
+
+	sort.Slice(missingPvtData, func(i, j int) bool {
+		a, b := missingPvtData[i], missingPvtData[j]
+		if a.BlockNum != b.BlockNum {
+			return a.BlockNum < b.BlockNum
+		}
+		if a.TxNum != b.TxNum {
+			return a.TxNum < b.TxNum
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Collection < b.Collection
+	})
+	return missingPvtData, nil
+}
+
+// getMissingDataDetails returns the details of the missing data entries within the given range of keys
+// that belong to the blocks within [startBlock, endBlock] and that have not expired yet
+func (s *store) getMissingDataDetails(startKey, endKey []byte, startBlock, endBlock, lastCommittedBlock uint64,
+	isUnrecoverable bool) ([]*ledger.MissingPvtDataDetails, error) {
+	dbItr := s.db.GetIterator(startKey, endKey)
+	defer dbItr.Release()
+
+	var missingPvtData []*ledger.MissingPvtDataDetails
+	for dbItr.Next() {
+		missingDataKey := decodeMissingDataKey(dbItr.Key())
+		if missingDataKey.blkNum < startBlock || missingDataKey.blkNum > endBlock {
+			continue
+		}
+		expired, err := isExpired(missingDataKey.nsCollBlk, s.btlPolicy, lastCommittedBlock)
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			continue
+		}
+		bitmap, err := decodeMissingDataValue(dbItr.Value())
+		if err != nil {
+			return nil, err
+		}
+		for index, isSet := bitmap.NextSet(0); isSet; index, isSet = bitmap.NextSet(index + 1) {
+			missingPvtData = append(missingPvtData, &ledger.MissingPvtDataDetails{
+				BlockNum:        missingDataKey.blkNum,
+				TxNum:           uint64(index),
+				Namespace:       missingDataKey.ns,
+				Collection:      missingDataKey.coll,
+				IsEligible:      missingDataKey.isEligible,
+				IsUnrecoverable: isUnrecoverable,
+			})
+		}
+	}
+	return missingPvtData, nil
+}
+
+// MarkMissingPvtDataUnrecoverable implements the function in the interface `Store`
+func (s *store) MarkMissingPvtDataUnrecoverable(missingPvtDataInfo ledger.MissingPvtDataInfo) error {
+	// the eligible missing data entries are updated by the processing of the
+	// collection eligibility events as well and hence, the same lock is acquired
+	s.purgerLock.Lock()
+	defer s.purgerLock.Unlock()
+
+	eligibleMissingData := make(map[nsCollBlk]*bitset.BitSet)
+	unrecoverableMissingData := make(map[nsCollBlk]*bitset.BitSet)
+	for blkNum, missingBlockPvtDataInfo := range missingPvtDataInfo {
+		for txNum, missingCollsPvtDataInfo := range missingBlockPvtDataInfo {
+			for _, missingCollPvtDataInfo := range missingCollsPvtDataInfo {
+				key := nsCollBlk{missingCollPvtDataInfo.Namespace, missingCollPvtDataInfo.Collection, blkNum}
+				eligible, ok := eligibleMissingData[key]
+				if !ok {
+					var err error
+					if eligible, err = s.getBitmapOfMissingDataKey(&missingDataKey{key, true}); err != nil {
+						return err
+					}
+					eligibleMissingData[key] = eligible
+				}
+				if eligible == nil || !eligible.Test(uint(txNum)) {
+					continue
+				}
+				unrecoverable, ok := unrecoverableMissingData[key]
+				if !ok {
+					var err error
+					if unrecoverable, err = s.getBitmapOfUnrecoverableMissingData(&key); err != nil {
+						return err
+					}
+					if unrecoverable == nil {
+						unrecoverable = &bitset.BitSet{}
+					}
+					unrecoverableMissingData[key] = unrecoverable
+				}
+				eligible.Clear(uint(txNum))
+				unrecoverable.Set(uint(txNum))
+			}
+		}
+	}
+
+	batch := leveldbhelper.NewUpdateBatch()
+	for key, unrecoverable := range unrecoverableMissingData {
+		eligibleKeyBytes := encodeMissingDataKey(&missingDataKey{key, true})
+		if eligible := eligibleMissingData[key]; eligible.None() {
+			batch.Delete(eligibleKeyBytes)
+		} else {
+			valBytes, err := encodeMissingDataValue(eligible)
+			if err != nil {
+				return err
+			}
+			batch.Put(eligibleKeyBytes, valBytes)
+		}
+		valBytes, err := encodeMissingDataValue(unrecoverable)
+		if err != nil {
+			return err
+		}
+		batch.Put(encodeUnrecoverableMissingDataKey(&key), valBytes)
+	}
+	if err := s.db.WriteBatch(batch, true); err != nil {
+		return err
+	}
+	logger.Infof("[%s] - Private data of [%d] blocks has been marked as unrecoverable", s.ledgerid, len(missingPvtDataInfo))
+	return nil
+}
+
 // ProcessCollsEligibilityEnabled implements the function in the interface `Store`
 func (s *store) ProcessCollsEligibilityEnabled(committingBlk uint64, nsCollMap map[string][]string) error {
 	key := encodeCollElgKey(committingBlk)
@@ -775,10 +1179,25 @@ func (s *store) purgeExpiredData(minBlkNum, maxBlkNum uint64) error {
 		batch.Delete(encodeExpiryKey(expiryEntry.key))
 		dataKeys, missingDataKeys := deriveKeys(expiryEntry)
 		for _, dataKey := range dataKeys {
-			batch.Delete(encodeDataKey(dataKey))
+			dataKeyBytes := encodeDataKey(dataKey)
+			dataValueBytes, err := s.db.Get(dataKeyBytes)
+			if err != nil {
+				return err
+			}
+			if dataValueBytes != nil {
+				collPvtdata, err := decodeDataValue(dataValueBytes)
+				if err != nil {
+					return err
+				}
+				deleteKeyHashIndexEntries(batch, dataKey, collPvtdata)
+			}
+			batch.Delete(dataKeyBytes)
 		}
 		for _, missingDataKey := range missingDataKeys {
 			batch.Delete(encodeMissingDataKey(missingDataKey))
+			if missingDataKey.isEligible {
+				batch.Delete(encodeUnrecoverableMissingDataKey(&missingDataKey.nsCollBlk))
+			}
 		}
 		s.db.WriteBatch(batch, false)
 	}
@@ -971,6 +1390,17 @@ func (s *store) getBitmapOfMissingDataKey(missingDataKey *missingDataKey) (*bits
 	return decodeMissingDataValue(v)
 }
 
+func (s *store) getBitmapOfUnrecoverableMissingData(key *nsCollBlk) (*bitset.BitSet, error) {
+	v, err := s.db.Get(encodeUnrecoverableMissingDataKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return decodeMissingDataValue(v)
+}
+
 func (s *store) getExpiryDataOfExpiryKey(expiryKey *expiryKey) (*ExpiryData, error) {
 	var v []byte
 	var err error
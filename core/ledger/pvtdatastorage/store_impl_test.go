@@ -17,8 +17,10 @@ import (
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/version"
 	"github.com/hyperledger/fabric/core/ledger/ledgerconfig"
 	btltestutil "github.com/hyperledger/fabric/core/ledger/pvtdatapolicy/testutil"
+	"github.com/hyperledger/fabric/core/ledger/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
@@ -596,6 +598,9 @@ func TestStorePurge(t *testing.T) {
 	testWaitForPurgerRoutineToFinish(s)
 	assert.False(testDataKeyExists(t, s, ns1Coll1))
 	assert.True(testDataKeyExists(t, s, ns2Coll2))
+	// the index entries of the expired data should have been purged along with it
+	assert.False(testKeyHashIndexEntryExists(t, s, ns1Coll1, "key-ns-1-coll-1"))
+	assert.True(testKeyHashIndexEntryExists(t, s, ns2Coll2, "key-ns-2-coll-2"))
 	// eligible missingData entries for ns-1:coll-1 should have expired and ns-1:coll-2 (neverExpires) should exist in store
 	assert.False(testMissingDataKeyExists(t, s, ns1Coll1elgMD))
 	assert.True(testMissingDataKeyExists(t, s, ns1Coll2elgMD))
@@ -623,6 +628,183 @@ func TestStorePurge(t *testing.T) {
 	assert.True(testDataKeyExists(t, s, &dataKey{nsCollBlk: nsCollBlk{ns: "ns-1", coll: "coll-2", blkNum: 1}, txNum: 2}))
 }
 
+func TestPurgeKeys(t *testing.T) {
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns-1", "coll-1"}: 0,
+			{"ns-1", "coll-2"}: 0,
+		},
+	)
+	env := NewTestStoreEnv(t, "TestPurgeKeys", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	store := env.TestStore
+
+	blk1MissingData := make(ledger.TxMissingPvtDataMap)
+	blk1MissingData.Add(1, "ns-1", "coll-1", true)
+	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Commit())
+	assert.NoError(store.Prepare(1, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 2, []string{"ns-1:coll-1", "ns-1:coll-2"}),
+		produceSamplePvtdata(t, 4, []string{"ns-1:coll-1"}),
+	}, blk1MissingData))
+	assert.NoError(store.Commit())
+	assert.NoError(store.Prepare(2, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 1, []string{"ns-1:coll-1"}),
+	}, nil))
+	assert.NoError(store.Commit())
+
+	assert.NoError(store.PurgeKeys(nil))
+	// the key is purged by the transaction 0 of the block 2
+	assert.NoError(store.PurgeKeys([]*ledger.PurgedPvtdataKey{
+		{Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-ns-1-coll-1"), BlockNum: 2, TxNum: 0},
+	}))
+	assert.Equal([]string{"2/ns-1/coll-2/key-ns-1-coll-2"}, testWrittenKeys(t, store, 1))
+	assert.Equal([]string{"1/ns-1/coll-1/key-ns-1-coll-1"}, testWrittenKeys(t, store, 2))
+	// only the index entries of the purged key at the heights lower than the purge are consumed
+	assert.False(testKeyHashIndexEntryExists(t, store, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 2}, "key-ns-1-coll-1"))
+	assert.False(testKeyHashIndexEntryExists(t, store, &dataKey{nsCollBlk{"ns-1", "coll-1", 1}, 4}, "key-ns-1-coll-1"))
+	assert.True(testKeyHashIndexEntryExists(t, store, &dataKey{nsCollBlk{"ns-1", "coll-2", 1}, 2}, "key-ns-1-coll-2"))
+	assert.True(testKeyHashIndexEntryExists(t, store, &dataKey{nsCollBlk{"ns-1", "coll-1", 2}, 1}, "key-ns-1-coll-1"))
+	purgeHeight, err := store.GetPurgeHeight("ns-1", "coll-1", util.ComputeStringHash("key-ns-1-coll-1"))
+	assert.NoError(err)
+	assert.Equal(version.NewHeight(2, 0), purgeHeight)
+	purgeHeight, err = store.GetPurgeHeight("ns-1", "coll-2", util.ComputeStringHash("key-ns-1-coll-2"))
+	assert.NoError(err)
+	assert.Nil(purgeHeight)
+
+	// the pvtdata of old blocks committed afterwards must not bring back the purged key
+	assert.NoError(store.CommitPvtDataOfOldBlocks(map[uint64][]*ledger.TxPvtData{
+		1: {produceSamplePvtdata(t, 1, []string{"ns-1:coll-1"})},
+	}))
+	assert.Equal([]string{"2/ns-1/coll-2/key-ns-1-coll-2"}, testWrittenKeys(t, store, 1))
+	missingPvtDataInfo, err := store.GetMissingPvtDataInfoForMostRecentBlocks(10)
+	assert.NoError(err)
+	assert.Empty(missingPvtDataInfo)
+}
+
+func TestPurgeKeysOfDataCommittedBeforeKeyHashIndex(t *testing.T) {
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns-1", "coll-1"}: 0,
+		},
+	)
+	env := NewTestStoreEnv(t, "TestPurgeKeysOfDataCommittedBeforeKeyHashIndex", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	assert.NoError(env.TestStore.Prepare(0, []*ledger.TxPvtData{
+		produceSamplePvtdata(t, 1, []string{"ns-1:coll-1"}),
+	}, nil))
+	assert.NoError(env.TestStore.Commit())
+
+	// drop the index as if the data had been committed by a version of the store without the index
+	blk0Tx1 := &dataKey{nsCollBlk{"ns-1", "coll-1", 0}, 1}
+	db := env.TestStore.(*store).db
+	assert.NoError(db.Delete(encodeKeyHashIndexKey("ns-1", "coll-1", util.ComputeStringHash("key-ns-1-coll-1"), version.NewHeight(0, 1)), true))
+	assert.NoError(db.Delete(keyHashIndexBuiltKey, true))
+	assert.False(testKeyHashIndexEntryExists(t, env.TestStore, blk0Tx1, "key-ns-1-coll-1"))
+
+	// the index is built when the store is opened
+	env.CloseAndReopen()
+	assert.True(testKeyHashIndexEntryExists(t, env.TestStore, blk0Tx1, "key-ns-1-coll-1"))
+
+	assert.NoError(env.TestStore.PurgeKeys([]*ledger.PurgedPvtdataKey{
+		{Namespace: "ns-1", Collection: "coll-1", KeyHash: util.ComputeStringHash("key-ns-1-coll-1"), BlockNum: 1, TxNum: 0},
+	}))
+	assert.Empty(testWrittenKeys(t, env.TestStore, 0))
+	assert.False(testKeyHashIndexEntryExists(t, env.TestStore, blk0Tx1, "key-ns-1-coll-1"))
+}
+
+func TestListAndMarkMissingPvtDataUnrecoverable(t *testing.T) {
+	btlPolicy := btltestutil.SampleBTLPolicy(
+		map[[2]string]uint64{
+			{"ns-1", "coll-1"}: 0,
+			{"ns-1", "coll-2"}: 0,
+			{"ns-2", "coll-1"}: 1,
+		},
+	)
+	env := NewTestStoreEnv(t, "TestListAndMarkMissingPvtDataUnrecoverable", btlPolicy)
+	defer env.Cleanup()
+	assert := assert.New(t)
+	store := env.TestStore
+
+	blk1MissingData := make(ledger.TxMissingPvtDataMap)
+	blk1MissingData.Add(1, "ns-1", "coll-1", true)
+	blk1MissingData.Add(2, "ns-1", "coll-2", false)
+	blk2MissingData := make(ledger.TxMissingPvtDataMap)
+	blk2MissingData.Add(3, "ns-2", "coll-1", true)
+	blk2MissingData.Add(1, "ns-1", "coll-1", true)
+	assert.NoError(store.Prepare(0, nil, nil))
+	assert.NoError(store.Commit())
+	assert.NoError(store.Prepare(1, nil, blk1MissingData))
+	assert.NoError(store.Commit())
+	assert.NoError(store.Prepare(2, nil, blk2MissingData))
+	assert.NoError(store.Commit())
+
+	missingPvtData, err := store.ListMissingPvtData(0, 10)
+	assert.NoError(err)
+	assert.Equal([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 1, TxNum: 1, Namespace: "ns-1", Collection: "coll-1", IsEligible: true},
+		{BlockNum: 1, TxNum: 2, Namespace: "ns-1", Collection: "coll-2"},
+		{BlockNum: 2, TxNum: 1, Namespace: "ns-1", Collection: "coll-1", IsEligible: true},
+		{BlockNum: 2, TxNum: 3, Namespace: "ns-2", Collection: "coll-1", IsEligible: true},
+	}, missingPvtData)
+	missingPvtData, err = store.ListMissingPvtData(2, 2)
+	assert.NoError(err)
+	assert.Len(missingPvtData, 2)
+	missingPvtData, err = store.ListMissingPvtData(3, 10)
+	assert.NoError(err)
+	assert.Empty(missingPvtData)
+
+	// the ineligible and the not missing data are ignored
+	toMark := make(ledger.MissingPvtDataInfo)
+	toMark.Add(1, 1, "ns-1", "coll-1")
+	toMark.Add(1, 2, "ns-1", "coll-2")
+	toMark.Add(2, 1, "ns-1", "coll-1")
+	toMark.Add(2, 5, "ns-1", "coll-1")
+	assert.NoError(store.MarkMissingPvtDataUnrecoverable(toMark))
+
+	expectedMissingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	expectedMissingPvtDataInfo.Add(2, 3, "ns-2", "coll-1")
+	missingPvtDataInfo, err := store.GetMissingPvtDataInfoForMostRecentBlocks(10)
+	assert.NoError(err)
+	assert.Equal(expectedMissingPvtDataInfo, missingPvtDataInfo)
+	missingPvtData, err = store.ListMissingPvtData(0, 10)
+	assert.NoError(err)
+	assert.Equal([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 1, TxNum: 1, Namespace: "ns-1", Collection: "coll-1", IsEligible: true, IsUnrecoverable: true},
+		{BlockNum: 1, TxNum: 2, Namespace: "ns-1", Collection: "coll-2"},
+		{BlockNum: 2, TxNum: 1, Namespace: "ns-1", Collection: "coll-1", IsEligible: true, IsUnrecoverable: true},
+		{BlockNum: 2, TxNum: 3, Namespace: "ns-2", Collection: "coll-1", IsEligible: true},
+	}, missingPvtData)
+
+	// the unrecoverable data that becomes available later is stored and no longer listed as missing
+	assert.True(testUnrecoverableMissingDataEntryExists(t, store, &nsCollBlk{"ns-1", "coll-1", 1}))
+	assert.NoError(store.CommitPvtDataOfOldBlocks(map[uint64][]*ledger.TxPvtData{
+		1: {produceSamplePvtdata(t, 1, []string{"ns-1:coll-1"})},
+	}))
+	assert.NotEmpty(testWrittenKeys(t, store, 1))
+	missingPvtData, err = store.ListMissingPvtData(1, 1)
+	assert.NoError(err)
+	assert.Equal([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 1, TxNum: 2, Namespace: "ns-1", Collection: "coll-2"},
+	}, missingPvtData)
+	assert.False(testUnrecoverableMissingDataEntryExists(t, store, &nsCollBlk{"ns-1", "coll-1", 1}))
+
+	// the expired missing data is not listed
+	assert.NoError(store.ResetLastUpdatedOldBlocksList())
+	assert.NoError(store.Prepare(3, nil, nil))
+	assert.NoError(store.Commit())
+	assert.NoError(store.Prepare(4, nil, nil))
+	assert.NoError(store.Commit())
+	missingPvtData, err = store.ListMissingPvtData(2, 10)
+	assert.NoError(err)
+	assert.Equal([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "ns-1", Collection: "coll-1", IsEligible: true, IsUnrecoverable: true},
+	}, missingPvtData)
+}
+
 func TestStoreState(t *testing.T) {
 	btlPolicy := btltestutil.SampleBTLPolicy(
 		map[[2]string]uint64{
@@ -803,12 +985,45 @@ func testMissingDataKeyExists(t *testing.T, s Store, missingDataKey *missingData
 	return len(val) != 0
 }
 
+func testKeyHashIndexEntryExists(t *testing.T, s Store, dataKey *dataKey, key string) bool {
+	keyHashIndexKeyBytes := encodeKeyHashIndexKey(dataKey.ns, dataKey.coll, util.ComputeStringHash(key),
+		version.NewHeight(dataKey.blkNum, dataKey.txNum))
+	val, err := s.(*store).db.Get(keyHashIndexKeyBytes)
+	assert.NoError(t, err)
+	return val != nil
+}
+
+func testUnrecoverableMissingDataEntryExists(t *testing.T, s Store, key *nsCollBlk) bool {
+	val, err := s.(*store).db.Get(encodeUnrecoverableMissingDataKey(key))
+	assert.NoError(t, err)
+	return val != nil
+}
+
 func testWaitForPurgerRoutineToFinish(s Store) {
 	time.Sleep(1 * time.Second)
 	s.(*store).purgerLock.Lock()
 	s.(*store).purgerLock.Unlock()
 }
 
+// testWrittenKeys returns the keys written by the pvtdata of a block in the form txNum/ns/coll/key
+func testWrittenKeys(t *testing.T, s Store, blkNum uint64) []string {
+	pvtData, err := s.GetPvtDataByBlockNum(blkNum, nil)
+	assert.NoError(t, err)
+	var keys []string
+	for _, txPvtData := range pvtData {
+		txPvtRwSet, err := rwsetutil.TxPvtRwSetFromProtoMsg(txPvtData.WriteSet)
+		assert.NoError(t, err)
+		for _, nsPvtRwSet := range txPvtRwSet.NsPvtRwSet {
+			for _, collPvtRwSet := range nsPvtRwSet.CollPvtRwSets {
+				for _, kvWrite := range collPvtRwSet.KvRwSet.Writes {
+					keys = append(keys, fmt.Sprintf("%d/%s/%s/%s", txPvtData.SeqInBlock, nsPvtRwSet.NameSpace, collPvtRwSet.CollectionName, kvWrite.Key))
+				}
+			}
+		}
+	}
+	return keys
+}
+
 func testutilWaitForCollElgProcToFinish(s Store) {
 	s.(*store).collElgProcSync.waitForDone()
 }
@@ -451,6 +451,32 @@ func (couchInstance *CouchInstance) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
+//RetrieveDatabaseNames method provides function to list the names of all the databases
+//hosted by the couch instance, including the system databases
+func (couchInstance *CouchInstance) RetrieveDatabaseNames() ([]string, error) {
+	connectURL, err := url.Parse(couchInstance.conf.URL)
+	if err != nil {
+		logger.Errorf("URL parse error: %s", err)
+		return nil, errors.Wrapf(err, "error parsing couch instance URL: %s", couchInstance.conf.URL)
+	}
+	connectURL.Path = "/_all_dbs"
+
+	maxRetries := couchInstance.conf.MaxRetries
+	resp, _, err := couchInstance.handleRequest(context.Background(), http.MethodGet, "", "RetrieveDatabaseNames", connectURL, nil,
+		couchInstance.conf.Username, couchInstance.conf.Password, maxRetries, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+
+	var dbNames []string
+	if err := json.NewDecoder(resp.Body).Decode(&dbNames); err != nil {
+		return nil, errors.Wrap(err, "error decoding response body")
+	}
+	logger.Debugw("RetrieveDatabaseNames()", "dbNames", dbNames)
+	return dbNames, nil
+}
+
 //DropDatabase provides method to drop an existing database
 func (dbclient *CouchDatabase) DropDatabase() (*DBOperationResponse, error) {
 	dbName := dbclient.DBName
@@ -1275,6 +1301,75 @@ func (dbclient *CouchDatabase) DeleteIndex(designdoc, indexname string) error {
 
 }
 
+// ExplainQuery method provides a function returning the index that CouchDB would use for
+// executing the given query. When no user defined index can serve the query, the returned
+// IndexResult has an empty design document and the name of the system index (e.g., _all_docs)
+func (dbclient *CouchDatabase) ExplainQuery(query string) (*IndexResult, error) {
+
+	//explainIndex contains the definition of the index selected by CouchDB for a query
+	type explainIndex struct {
+		DesignDocument string          `json:"ddoc"`
+		Name           string          `json:"name"`
+		Type           string          `json:"type"`
+		Definition     json.RawMessage `json:"def"`
+	}
+
+	//explainResponse contains the definition for the response of the couchdb _explain endpoint
+	type explainResponse struct {
+		DBName string       `json:"dbname"`
+		Index  explainIndex `json:"index"`
+	}
+
+	dbName := dbclient.DBName
+
+	logger.Debugf("[%s] Entering ExplainQuery()  query=%s", dbName, query)
+
+	//Test to see if this is a valid JSON
+	if IsJSON(query) != true {
+		return nil, errors.New("JSON format is not valid")
+	}
+
+	explainURL, err := url.Parse(dbclient.CouchInstance.conf.URL)
+	if err != nil {
+		logger.Errorf("URL parse error: %s", err)
+		return nil, errors.Wrapf(err, "error parsing CouchDB URL: %s", dbclient.CouchInstance.conf.URL)
+	}
+
+	//get the number of retries
+	maxRetries := dbclient.CouchInstance.conf.MaxRetries
+
+	resp, _, err := dbclient.handleRequest(http.MethodPost, "ExplainQuery", explainURL, []byte(query), "", "", maxRetries, true, nil, "_explain")
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+
+	//handle as JSON document
+	jsonResponseRaw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading response body")
+	}
+
+	var jsonResponse = &explainResponse{}
+
+	err2 := json.Unmarshal(jsonResponseRaw, jsonResponse)
+	if err2 != nil {
+		return nil, errors.Wrap(err2, "error unmarshalling json data")
+	}
+
+	//system level indexes (e.g., _all_docs) do not belong to a design document
+	designDoc := jsonResponse.Index.DesignDocument
+	s := strings.SplitAfterN(designDoc, "_design/", 2)
+	if len(s) > 1 {
+		designDoc = s[1]
+	}
+
+	logger.Debugf("[%s] Exiting ExplainQuery()", dbclient.DBName)
+
+	return &IndexResult{DesignDocument: designDoc, Name: jsonResponse.Index.Name, Definition: fmt.Sprintf("%s", jsonResponse.Index.Definition)}, nil
+
+}
+
 //WarmIndex method provides a function for warming a single index
 func (dbclient *CouchDatabase) WarmIndex(designdoc, indexname string) error {
 	dbName := dbclient.DBName
@@ -996,6 +996,23 @@ func TestIndexOperations(t *testing.T) {
 	_, _, err = db.QueryDocuments(queryString)
 	assert.NoError(t, err, "Error thrown while querying with an index")
 
+	//Explain the query, the index should be selected by CouchDB
+	explainResult, err := db.ExplainQuery(queryString)
+	assert.NoError(t, err, "Error thrown while explaining a query")
+	assert.Equal(t, "indexSizeSortDoc", explainResult.DesignDocument)
+	assert.Equal(t, "indexSizeSortName", explainResult.Name)
+	assert.True(t, strings.Contains(explainResult.Definition, `"fields":[{"size":"desc"}]`))
+
+	//Explain a query that cannot use any user defined index
+	explainResult, err = db.ExplainQuery(`{"selector":{"owner":"tom"}}`)
+	assert.NoError(t, err, "Error thrown while explaining a query")
+	assert.Equal(t, "", explainResult.DesignDocument)
+	assert.Equal(t, "_all_docs", explainResult.Name)
+
+	//Explain an invalid JSON query
+	_, err = db.ExplainQuery(`{"selector"{"owner":"tom"}}`)
+	assert.Error(t, err, "Error should have been thrown for an invalid query JSON")
+
 	//Create another index definition
 	indexDefSize = `{"index":{"fields":[{"data.size":"desc"},{"data.owner":"desc"}]},"ddoc":"indexSizeOwnerSortDoc", "name":"indexSizeOwnerSortName","type":"json"}`
 
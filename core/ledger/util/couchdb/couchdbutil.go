@@ -245,6 +245,25 @@ func ConstructNamespaceDBName(chainName, namespace string) string {
 	return namespaceDBName
 }
 
+// NamespaceFromDBName derives the namespace from a db name constructed by the function ConstructNamespaceDBName.
+// The returned bool is false if the db name does not belong to the given chain. As the truncation of a db name
+// is not reversible, an error is returned for a truncated db name
+func NamespaceFromDBName(chainName, dbName string) (string, bool, error) {
+	mappedChainName := strings.Replace(chainName, ".", "$", -1)
+	if len(mappedChainName) > chainNameAllowedLength {
+		mappedChainName = mappedChainName[0:chainNameAllowedLength]
+	}
+	prefix := mappedChainName + "_"
+	if !strings.HasPrefix(dbName, prefix) {
+		return "", false, nil
+	}
+	escapedNamespace := strings.TrimPrefix(dbName, prefix)
+	if strings.HasSuffix(escapedNamespace, ")") && strings.Contains(escapedNamespace, "(") {
+		return "", true, errors.Errorf("the namespace cannot be derived from the truncated database name [%s]", dbName)
+	}
+	return unescapeUpperCase(escapedNamespace), true, nil
+}
+
 //mapAndValidateDatabaseName checks to see if the database name contains illegal characters
 //CouchDB Rules: Only lowercase characters (a-z), digits (0-9), and any of the characters
 //_, $, (, ), +, -, and / are allowed. Must begin with a letter.
@@ -284,3 +303,22 @@ func escapeUpperCase(dbName string) string {
 	dbName = re.ReplaceAllString(dbName, "$$"+"$1")
 	return strings.ToLower(dbName)
 }
+
+// unescapeUpperCase reverses the function escapeUpperCase. The sequence "$$" is retained
+// as it is used as a joiner between the namespace and the collection name
+func unescapeUpperCase(dbName string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(dbName); i++ {
+		switch {
+		case dbName[i] == '$' && i+1 < len(dbName) && dbName[i+1] == '$':
+			buf.WriteString("$$")
+			i++
+		case dbName[i] == '$' && i+1 < len(dbName):
+			buf.WriteString(strings.ToUpper(dbName[i+1 : i+2]))
+			i++
+		default:
+			buf.WriteByte(dbName[i])
+		}
+	}
+	return buf.String()
+}
@@ -188,3 +188,23 @@ func TestConstructedNamespaceDBName(t *testing.T) {
 	assert.Equal(t, expectedDBNameLength, len(constructedDBName))
 	assert.Equal(t, expectedDBName, constructedDBName)
 }
+
+func TestNamespaceFromDBName(t *testing.T) {
+	for _, ns := range []string{"", "lscc", "myCC", "my_CC-1$$hMyColl", "mycc$$pcoll"} {
+		dbName, err := mapAndValidateDatabaseName(ConstructNamespaceDBName("my.chain", ns))
+		assert.NoError(t, err)
+		derivedNs, belongsToChain, err := NamespaceFromDBName("my.chain", dbName)
+		assert.NoError(t, err)
+		assert.True(t, belongsToChain)
+		assert.Equal(t, ns, derivedNs)
+	}
+
+	_, belongsToChain, err := NamespaceFromDBName("my.chain", "otherchain_mycc")
+	assert.NoError(t, err)
+	assert.False(t, belongsToChain)
+
+	longNs := "wMCnSXiV9YoIqNQyNvFVTdM8XnUtvrOFFIWsKelmP5NEszmNLl8YhtOKbFu3P_NgwgsYF8PsfwjYCD8f1XRpANQLoErDHwLlweryqXeJ6vzT2x0pS_GwSx0m6tBI0zOmHQOq_2De8A87x6zUOPwufC2T6dkidFxiuq8Sey2-5vUo_iNKCij3WTeCnKx78PUIg_U1gp4_0KTvYVtRBRvH0kz5usizBxPaiFu3TPhB9XLviScvdUVSbSYJ0Z"
+	_, belongsToChain, err = NamespaceFromDBName("mychain", ConstructNamespaceDBName("mychain", longNs))
+	assert.True(t, belongsToChain)
+	assert.EqualError(t, err, "the namespace cannot be derived from the truncated database name ["+ConstructNamespaceDBName("mychain", longNs)+"]")
+}
@@ -144,6 +144,12 @@ func (s *System) RegisterChecker(component string, checker healthz.HealthChecker
 	return s.healthHandler.RegisterChecker(component, checker)
 }
 
+// RegisterHandler registers the handler for requests to the given path. When TLS is
+// enabled, requests must be authenticated with a client certificate.
+func (s *System) RegisterHandler(path string, handler http.Handler) {
+	s.mux.Handle(path, s.handlerChain(handler, s.options.TLS.Enabled))
+}
+
 func (s *System) initializeServer() {
 	s.mux = http.NewServeMux()
 	s.httpServer = &http.Server{
@@ -115,9 +115,16 @@ var TransientStoreFactory = &storeProvider{stores: make(map[string]transientstor
 type storeProvider struct {
 	stores map[string]transientstore.Store
 	transientstore.StoreProvider
+	metricsProvider metrics.Provider
 	sync.RWMutex
 }
 
+func (sp *storeProvider) setMetricsProvider(metricsProvider metrics.Provider) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.metricsProvider = metricsProvider
+}
+
 func (sp *storeProvider) StoreForChannel(channel string) transientstore.Store {
 	sp.RLock()
 	defer sp.RUnlock()
@@ -128,7 +135,12 @@ func (sp *storeProvider) OpenStore(ledgerID string) (transientstore.Store, error
 	sp.Lock()
 	defer sp.Unlock()
 	if sp.StoreProvider == nil {
-		sp.StoreProvider = transientstore.NewStoreProvider()
+		sp.StoreProvider = transientstore.NewStoreProvider(&transientstore.Config{
+			EncryptionEnabled: viper.GetBool("peer.gossip.pvtData.transientstoreEncryptionEnabled"),
+			MaxAge:            viper.GetDuration("peer.gossip.pvtData.transientstoreMaxAge"),
+			PurgeInterval:     viper.GetDuration("peer.gossip.pvtData.transientstorePurgeInterval"),
+			MetricsProvider:   sp.metricsProvider,
+		})
 	}
 	store, err := sp.StoreProvider.OpenStore(ledgerID)
 	if err == nil {
@@ -249,6 +261,7 @@ func Initialize(init func(string), ccp ccprovider.ChaincodeProvider, sccp sysccp
 
 	pluginMapper = pm
 	chainInitializer = init
+	TransientStoreFactory.setMetricsProvider(metricsProvider)
 
 	var cb *common.Block
 	var ledger ledger.PeerLedger
@@ -487,6 +500,7 @@ func createChain(
 		AddressesByOrg:   ordererAddressesByOrg,
 		Organizations:    ordererOrganizations,
 		AddressOverrides: ordererAddressOverrides,
+		ConsensusType:    oc.ConsensusType(),
 	}
 	service.GetGossipService().InitializeChannel(bundle.ConfigtxValidator().ChainID(), oac, service.Support{
 		Validator:            validator,
@@ -495,6 +509,7 @@ func createChain(
 		Cs:                   simpleCollectionStore,
 		IdDeserializeFactory: csStoreSupport,
 		CapabilityProvider:   cp,
+		ImplicitCollections:  csStoreSupport,
 	})
 
 	chains.Lock()
@@ -523,6 +538,21 @@ func CreateChainFromBlock(cb *common.Block, ccp ccprovider.ChaincodeProvider, sc
 	return createChain(cid, l, cb, ccp, sccp, pluginMapper)
 }
 
+// CreateChainFromSnapshot creates a new chain from the ledger snapshot in the given directory
+// and returns the chain ID recorded in the snapshot
+func CreateChainFromSnapshot(snapshotDir string, ccp ccprovider.ChaincodeProvider, sccp sysccprovider.SystemChaincodeProvider) (string, error) {
+	l, cid, err := ledgermgmt.CreateLedgerFromSnapshot(snapshotDir)
+	if err != nil {
+		return "", errors.WithMessage(err, "cannot create ledger from snapshot")
+	}
+
+	cb, err := getCurrConfigBlockFromLedger(l)
+	if err != nil {
+		return "", err
+	}
+	return cid, createChain(cid, l, cb, ccp, sccp, pluginMapper)
+}
+
 // GetLedger returns the ledger of the chain with chain ID. Note that this
 // call returns nil if chain cid has not been created.
 func GetLedger(cid string) ledger.PeerLedger {
@@ -746,7 +776,7 @@ func GetLocalIP() string {
 }
 
 // GetChannelsInfo returns an array with information about all channels for
-// this peer
+// this peer, including the channels that have been paused
 func GetChannelsInfo() []*pb.ChannelInfo {
 	// array to store metadata for all channels
 	var channelInfoArray []*pb.ChannelInfo
@@ -754,12 +784,22 @@ func GetChannelsInfo() []*pb.ChannelInfo {
 	chains.RLock()
 	defer chains.RUnlock()
 	for key := range chains.list {
-		channelInfo := &pb.ChannelInfo{ChannelId: key}
+		channelInfo := &pb.ChannelInfo{ChannelId: key, Status: pb.ChannelInfo_ACTIVE}
 
 		// add this specific chaincode's metadata to the array of all chaincodes
 		channelInfoArray = append(channelInfoArray, channelInfo)
 	}
 
+	// the paused channels are not loaded by the peer and hence, they are retrieved from the ledger mgmt
+	pausedChannelIDs, err := ledgermgmt.GetPausedLedgerIDs()
+	if err != nil {
+		peerLogger.Errorf("Failed to retrieve the paused channels: %s", err)
+		return channelInfoArray
+	}
+	for _, cid := range pausedChannelIDs {
+		channelInfoArray = append(channelInfoArray, &pb.ChannelInfo{ChannelId: cid, Status: pb.ChannelInfo_INACTIVE})
+	}
+
 	return channelInfoArray
 }
 
@@ -808,6 +848,17 @@ func (*CollectionSupport) GetIdentityDeserializer(chainID string) msp.IdentityDe
 	return mspmgmt.GetManagerForChain(chainID)
 }
 
+// RetrieveImplicitCollection returns the configuration of the implicit collection with the given name
+// of the given chaincode, according to the state of the ledger
+func (cs *CollectionSupport) RetrieveImplicitCollection(chaincodeName, collectionName string) (*common.StaticCollectionConfig, error) {
+	qe, err := cs.NewQueryExecutor()
+	if err != nil {
+		return nil, err
+	}
+	defer qe.Done()
+	return privdata.RetrieveImplicitCollectionFromState(chaincodeName, collectionName, qe)
+}
+
 //
 //  Deliver service support structs for the peer
 //
@@ -838,6 +889,15 @@ func (flbs fileLedgerBlockStore) RetrieveBlocks(startBlockNumber uint64) (common
 	return flbs.GetBlocksIterator(startBlockNumber)
 }
 
+func (flbs fileLedgerBlockStore) RetrieveBlockByNumber(blockNum uint64) (*common.Block, error) {
+	return flbs.GetBlockByNumber(blockNum)
+}
+
+// PruneBlocksBefore does nothing, as the blocks of the peer ledger are pruned by its Prune policies
+func (flbs fileLedgerBlockStore) PruneBlocksBefore(uint64, string) error {
+	return nil
+}
+
 // NewConfigSupport returns
 func NewConfigSupport() cc.Manager {
 	return &configSupport{}
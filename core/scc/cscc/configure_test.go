@@ -199,6 +199,28 @@ func TestConfigerInvokeJoinChainWrongParams(t *testing.T) {
 	}
 }
 
+func TestConfigerInvokeJoinChainBySnapshotWrongParams(t *testing.T) {
+	e := New(nil, nil, mockAclProvider)
+	stub := shim.NewMockStub("PeerConfiger", e)
+
+	if res := stub.MockInit("1", nil); res.Status != shim.OK {
+		fmt.Println("Init failed", string(res.Message))
+		t.FailNow()
+	}
+
+	// Failed path: empty snapshot directory
+	args := [][]byte{[]byte("JoinChainBySnapshot"), []byte("")}
+	res := stub.MockInvoke("2", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Equal(t, "Cannot join the channel, no snapshot directory provided", res.Message)
+
+	// Failed path: no signed proposal
+	args = [][]byte{[]byte("JoinChainBySnapshot"), []byte("/tmp/snapshot")}
+	res = stub.MockInvoke("3", args)
+	assert.Equal(t, int32(shim.ERROR), res.Status)
+	assert.Contains(t, res.Message, "access denied for [JoinChainBySnapshot]")
+}
+
 func TestConfigerInvokeJoinChainCorrectParams(t *testing.T) {
 	mp := (&scc.MocksccProviderFactory{}).NewSystemChaincodeProvider()
 	ccp := &ccprovidermocks.MockCcProviderImpl{}
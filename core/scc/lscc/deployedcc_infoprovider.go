@@ -79,19 +79,21 @@ func (p *DeployedCCInfoProvider) ChaincodeInfo(chaincodeName string, qe ledger.S
 	}, nil
 }
 
-// CollectionInfo implements function in interface ledger.DeployedChaincodeInfoProvider
+// CollectionInfo implements function in interface ledger.DeployedChaincodeInfoProvider.
+// Besides the collections defined explicitly, the implicit collections of the organizations
+// of the channel are returned
 func (p *DeployedCCInfoProvider) CollectionInfo(chaincodeName, collectionName string, qe ledger.SimpleQueryExecutor) (*common.StaticCollectionConfig, error) {
 	collConfigPkg, err := fetchCollConfigPkg(chaincodeName, qe)
-	if err != nil || collConfigPkg == nil {
+	if err != nil {
 		return nil, err
 	}
-	for _, conf := range collConfigPkg.Config {
+	for _, conf := range collConfigPkg.GetConfig() {
 		staticCollConfig := conf.GetStaticCollectionConfig()
 		if staticCollConfig != nil && staticCollConfig.Name == collectionName {
 			return staticCollConfig, nil
 		}
 	}
-	return nil, nil
+	return privdata.RetrieveImplicitCollectionFromState(chaincodeName, collectionName, qe)
 }
 
 func fetchCollConfigPkg(chaincodeName string, qe ledger.SimpleQueryExecutor) (*common.CollectionConfigPackage, error) {
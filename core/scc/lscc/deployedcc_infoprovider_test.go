@@ -16,6 +16,8 @@ import (
 	"github.com/hyperledger/fabric/core/scc/lscc"
 	"github.com/hyperledger/fabric/core/scc/lscc/mock"
 	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,6 +89,48 @@ func TestCollectionInfo(t *testing.T) {
 	assert.Nil(t, collInfo3)
 }
 
+func TestImplicitCollectionInfo(t *testing.T) {
+	cc1 := &ledger.DeployedChaincodeInfo{
+		Name:    "cc1",
+		Version: "cc1_version",
+		Hash:    []byte("cc1_hash"),
+	}
+
+	mockQE := prepareMockQE(t, []*ledger.DeployedChaincodeInfo{cc1})
+	lsccStateStub := mockQE.GetStateStub
+	mspConfig := &msp.MSPConfig{Config: utils.MarshalOrPanic(&msp.FabricMSPConfig{Name: "Org1MSP"})}
+	channelConfig := &common.Config{
+		ChannelGroup: &common.ConfigGroup{
+			Groups: map[string]*common.ConfigGroup{
+				"Application": {
+					Groups: map[string]*common.ConfigGroup{
+						"Org1": {Values: map[string]*common.ConfigValue{"MSP": {Value: utils.MarshalOrPanic(mspConfig)}}},
+					},
+				},
+			},
+		},
+	}
+	mockQE.GetStateStub = func(ns, key string) ([]byte, error) {
+		if ns == "" && key == "resourcesconfigtx.CHANNEL_CONFIG_KEY" {
+			return utils.MarshalOrPanic(channelConfig), nil
+		}
+		return lsccStateStub(ns, key)
+	}
+	ccInfoProvdier := &lscc.DeployedCCInfoProvider{}
+
+	collInfo1, err := ccInfoProvdier.CollectionInfo("cc1", "_implicit_org_Org1MSP", mockQE)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(privdata.GenerateImplicitCollectionForOrg("Org1MSP"), collInfo1))
+
+	collInfo2, err := ccInfoProvdier.CollectionInfo("cc1", "_implicit_org_Org2MSP", mockQE)
+	assert.NoError(t, err)
+	assert.Nil(t, collInfo2)
+
+	collInfo3, err := ccInfoProvdier.CollectionInfo("cc2", "_implicit_org_Org1MSP", mockQE)
+	assert.NoError(t, err)
+	assert.Nil(t, collInfo3)
+}
+
 func prepareMockQE(t *testing.T, deployedChaincodes []*ledger.DeployedChaincodeInfo) *mock.QueryExecutor {
 	mockQE := &mock.QueryExecutor{}
 	lsccTable := map[string][]byte{}
@@ -333,6 +333,10 @@ func (lscc *LifeCycleSysCC) putChaincodeCollectionData(stub shim.ChaincodeStubIn
 		return fmt.Errorf("could not get MSP manager for channel %s", stub.GetChannelID())
 	}
 	for _, collectionConfig := range collections.Config {
+		collectionName := collectionConfig.GetStaticCollectionConfig().GetName()
+		if isImplicit, _ := privdata.MSPIDIfImplicitCollection(collectionName); isImplicit {
+			return errors.Errorf("collection-name: %s -- the name is reserved for the implicit collection of an organization", collectionName)
+		}
 		err = checkCollectionMemberPolicy(collectionConfig, mspmgr)
 		if err != nil {
 			return errors.Wrapf(err, "collection member policy check failed")
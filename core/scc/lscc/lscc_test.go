@@ -888,6 +888,16 @@ func TestPutChaincodeCollectionData(t *testing.T) {
 	err = scc.putChaincodeCollectionData(stub, cd, ccpBytes)
 	assert.NoError(t, err)
 	stub.MockTransactionEnd("foo")
+
+	implicitColl := createCollectionConfig("_implicit_org_Org1MSP", testPolicyEnvelope, 1, 2)
+	ccp = &common.CollectionConfigPackage{Config: []*common.CollectionConfig{coll1, implicitColl}}
+	ccpBytes, err = proto.Marshal(ccp)
+	assert.NoError(t, err)
+
+	stub.MockTransactionStart("foo")
+	err = scc.putChaincodeCollectionData(stub, cd, ccpBytes)
+	assert.EqualError(t, err, "collection-name: _implicit_org_Org1MSP -- the name is reserved for the implicit collection of an organization")
+	stub.MockTransactionEnd("foo")
 }
 
 func TestGetChaincodeCollectionData(t *testing.T) {
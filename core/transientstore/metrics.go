@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transientstore
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+)
+
+type stats struct {
+	entries        metrics.Gauge
+	oldestEntryAge metrics.Gauge
+}
+
+func newStats(metricsProvider metrics.Provider) *stats {
+	stats := &stats{}
+	stats.entries = metricsProvider.NewGauge(entriesOpts)
+	stats.oldestEntryAge = metricsProvider.NewGauge(oldestEntryAgeOpts)
+	return stats
+}
+
+type ledgerStats struct {
+	stats    *stats
+	ledgerid string
+}
+
+func (s *stats) ledgerStats(ledgerid string) *ledgerStats {
+	return &ledgerStats{
+		s, ledgerid,
+	}
+}
+
+func (s *ledgerStats) updateEntries(numEntries int) {
+	s.stats.entries.With("channel", s.ledgerid).Set(float64(numEntries))
+}
+
+func (s *ledgerStats) updateOldestEntryAge(age time.Duration) {
+	s.stats.oldestEntryAge.With("channel", s.ledgerid).Set(age.Seconds())
+}
+
+var (
+	entriesOpts = metrics.GaugeOpts{
+		Namespace:    "transientstore",
+		Subsystem:    "",
+		Name:         "entries",
+		Help:         "Number of private write sets in the transient store.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+
+	oldestEntryAgeOpts = metrics.GaugeOpts{
+		Namespace:    "transientstore",
+		Subsystem:    "",
+		Name:         "oldest_entry_age",
+		Help:         "Time in seconds since the oldest private write set in the transient store was persisted.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+)
@@ -0,0 +1,115 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package transientstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	removeStorePath(t)
+	defer removeStorePath(t)
+	assert := assert.New(t)
+
+	fakeEntriesGauge := &metricsfakes.Gauge{}
+	fakeEntriesGauge.WithReturns(fakeEntriesGauge)
+	fakeOldestEntryAgeGauge := &metricsfakes.Gauge{}
+	fakeOldestEntryAgeGauge.WithReturns(fakeOldestEntryAgeGauge)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewGaugeStub = func(opts metrics.GaugeOpts) metrics.Gauge {
+		switch opts.Name {
+		case entriesOpts.Name:
+			return fakeEntriesGauge
+		case oldestEntryAgeOpts.Name:
+			return fakeOldestEntryAgeGauge
+		}
+		return nil
+	}
+
+	provider := NewStoreProvider(&Config{PurgeInterval: time.Hour, MetricsProvider: fakeProvider})
+	defer provider.Close()
+	testStore, err := provider.OpenStore("TestStore")
+	assert.NoError(err)
+	s := testStore.(*store)
+
+	// the metrics are updated when the store is opened
+	deadline := time.Now().Add(time.Minute)
+	for fakeOldestEntryAgeGauge.SetCallCount() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the metrics of the transient store were not updated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal([]string{"channel", "TestStore"}, fakeEntriesGauge.WithArgsForCall(0))
+	assert.Equal(float64(0), fakeEntriesGauge.SetArgsForCall(0))
+	assert.Equal([]string{"channel", "TestStore"}, fakeOldestEntryAgeGauge.WithArgsForCall(0))
+	assert.Equal(float64(0), fakeOldestEntryAgeGauge.SetArgsForCall(0))
+
+	assert.NoError(testStore.PersistWithConfig("txid-1", 5, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(testStore.Persist("txid-2", 6, samplePvtData(t)))
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(s.updateStats())
+	assert.Equal(float64(2), fakeEntriesGauge.SetArgsForCall(1))
+	assert.True(fakeOldestEntryAgeGauge.SetArgsForCall(1) >= (10 * time.Millisecond).Seconds())
+
+	assert.NoError(testStore.PurgeByTxids([]string{"txid-1", "txid-1"}))
+	assert.NoError(s.updateStats())
+	assert.Equal(float64(1), fakeEntriesGauge.SetArgsForCall(2))
+
+	assert.NoError(testStore.PurgeByTxids([]string{"txid-2"}))
+	assert.NoError(s.updateStats())
+	assert.Equal(float64(0), fakeEntriesGauge.SetArgsForCall(3))
+	assert.Equal(float64(0), fakeOldestEntryAgeGauge.SetArgsForCall(3))
+
+	// the private write sets are counted when the store is opened, including the ones persisted
+	// by previous versions of the peer which are not taken into account for the age
+	assert.NoError(testStore.Persist("txid-3", 7, samplePvtData(t)))
+	assert.NoError(s.db.Put(createCompositeKeyForPurgeIndexByHeight(4, "txid-4", "uuid"), emptyValue, true))
+	provider.Close()
+	provider = NewStoreProvider(&Config{PurgeInterval: time.Hour, MetricsProvider: fakeProvider})
+	defer provider.Close()
+	testStore, err = provider.OpenStore("TestStore")
+	assert.NoError(err)
+	s = testStore.(*store)
+	assert.NoError(s.updateStats())
+	assert.Equal(float64(2), fakeEntriesGauge.SetArgsForCall(fakeEntriesGauge.SetCallCount()-1))
+	assert.True(fakeOldestEntryAgeGauge.SetArgsForCall(fakeOldestEntryAgeGauge.SetCallCount()-1) > 0)
+}
+
+func TestStatsWithoutMaxAge(t *testing.T) {
+	removeStorePath(t)
+	defer removeStorePath(t)
+
+	fakeEntriesGauge := &metricsfakes.Gauge{}
+	fakeEntriesGauge.WithReturns(fakeEntriesGauge)
+	fakeProvider := &metricsfakes.Provider{}
+	fakeProvider.NewGaugeReturns(fakeEntriesGauge)
+
+	// the private write sets are not purged based on time when the max age is zero,
+	// while the metrics keep being updated at every interval
+	provider := NewStoreProvider(&Config{PurgeInterval: 10 * time.Millisecond, MetricsProvider: fakeProvider})
+	defer provider.Close()
+	testStore, err := provider.OpenStore("TestStore")
+	assert.NoError(t, err)
+	assert.NoError(t, testStore.Persist("txid-1", 5, samplePvtData(t)))
+
+	deadline := time.Now().Add(time.Minute)
+	for fakeEntriesGauge.SetCallCount() < 10 {
+		if time.Now().After(deadline) {
+			t.Fatal("the metrics of the transient store were not updated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	minBlkHt, err := testStore.GetMinTransientBlkHt()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), minBlkHt)
+}
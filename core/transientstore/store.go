@@ -7,15 +7,23 @@ SPDX-License-Identifier: Apache-2.0
 package transientstore
 
 import (
-	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp"
+	"github.com/hyperledger/fabric/bccsp/factory"
 	"github.com/hyperledger/fabric/common/flogging"
 	"github.com/hyperledger/fabric/common/ledger/util/leveldbhelper"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/util"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/hyperledger/fabric/protos/transientstore"
+	"github.com/pkg/errors"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
 )
 
@@ -24,6 +32,14 @@ var logger = flogging.MustGetLogger("transientstore")
 var emptyValue = []byte{}
 var nilByte = byte('\x00')
 
+// encryptedByte is prepended to the encrypted private write sets. As for the nil byte, a marshaled
+// message can never start with it, hence it differentiates encrypted values from plain ones.
+var encryptedByte = byte('\x01')
+
+// defaultPurgeInterval is the interval at which the private write sets are purged based on the
+// time they were persisted, and the metrics are updated, if no interval is configured
+const defaultPurgeInterval = time.Minute
+
 // ErrStoreEmpty is used to indicate that there are no entries in transient store
 var ErrStoreEmpty = errors.New("Transient store is empty")
 
@@ -31,6 +47,27 @@ var ErrStoreEmpty = errors.New("Transient store is empty")
 // Interfaces and data types
 /////////////////////////////////////////////
 
+// Config contains the configuration of the transient store
+type Config struct {
+	// EncryptionEnabled indicates whether the private write sets are encrypted before being
+	// written to the store. The key of each ledger is generated by the CSP and its SKI is
+	// kept in the store; the private write sets encrypted earlier can still be read once
+	// the encryption is disabled
+	EncryptionEnabled bool
+	// CSP is used to generate, retrieve and use the encryption keys. The default BCCSP
+	// is used if it is nil
+	CSP bccsp.BCCSP
+	// MaxAge is the maximum time a private write set resides in the store before being
+	// purged, regardless of the block height it was received at. Zero disables the purge
+	// based on time
+	MaxAge time.Duration
+	// PurgeInterval is the interval at which the private write sets older than MaxAge are
+	// purged and the metrics of the store are updated
+	PurgeInterval time.Duration
+	// MetricsProvider is used to create the metrics of the store
+	MetricsProvider metrics.Provider
+}
+
 // StoreProvider provides an instance of a TransientStore
 type StoreProvider interface {
 	OpenStore(ledgerID string) (Store, error)
@@ -78,6 +115,11 @@ type Store interface {
 	// after successful block commit, PurgeByHeight() is still required to remove orphan entries (as
 	// transaction that gets endorsed may not be submitted by the client for commit)
 	PurgeByHeight(maxBlockNumToRetain uint64) error
+	// PurgeByTime removes private write sets that were persisted before the given time.
+	// Like PurgeByHeight(), it removes orphan entries, but it does not depend on blocks
+	// being committed. Private write sets persisted by previous versions of the peer
+	// are not removed by it as their persist time is unknown
+	PurgeByTime(persistedBefore time.Time) error
 	// GetMinTransientBlkHt returns the lowest block height remaining in transient store
 	GetMinTransientBlkHt() (uint64, error)
 	Shutdown()
@@ -105,37 +147,154 @@ type EndorserPvtSimulationResultsWithConfig struct {
 // interface.
 type storeProvider struct {
 	dbProvider *leveldbhelper.Provider
+	conf       Config
+	stats      *stats
+	stopChan   chan struct{}
+	stopOnce   sync.Once
+	wg         sync.WaitGroup
 }
 
 // store holds an instance of a levelDB.
 type store struct {
-	db       *leveldbhelper.DBHandle
-	ledgerID string
+	// numEntries is the number of private write sets in the store. It is counted when the store
+	// is opened and kept up to date as private write sets get persisted and purged
+	numEntries int64
+	db         *leveldbhelper.DBHandle
+	ledgerID   string
+	csp        bccsp.BCCSP
+	// encryptionKey is nil if the store does not contain any encrypted private write set
+	// and the encryption is not enabled
+	encryptionKey     bccsp.Key
+	encryptionEnabled bool
+	stats             *ledgerStats
+	// purgeLock serializes the purges, so that a private write set is not counted as
+	// removed twice
+	purgeLock sync.Mutex
 }
 
 type RwsetScanner struct {
 	txid   string
 	dbItr  iterator.Iterator
 	filter ledger.PvtNsCollFilter
+	store  *store
 }
 
 // NewStoreProvider instantiates TransientStoreProvider
-func NewStoreProvider() StoreProvider {
+func NewStoreProvider(conf *Config) StoreProvider {
 	dbProvider := leveldbhelper.NewProvider(&leveldbhelper.Conf{DBPath: GetTransientStorePath()})
-	return &storeProvider{dbProvider: dbProvider}
+	provider := &storeProvider{
+		dbProvider: dbProvider,
+		conf:       *conf,
+		stopChan:   make(chan struct{}),
+	}
+	if provider.conf.CSP == nil {
+		provider.conf.CSP = factory.GetDefault()
+	}
+	if provider.conf.PurgeInterval <= 0 {
+		provider.conf.PurgeInterval = defaultPurgeInterval
+	}
+	if provider.conf.MetricsProvider == nil {
+		provider.conf.MetricsProvider = &disabled.Provider{}
+	}
+	provider.stats = newStats(provider.conf.MetricsProvider)
+	return provider
 }
 
 // OpenStore returns a handle to a ledgerId in Store
 func (provider *storeProvider) OpenStore(ledgerID string) (Store, error) {
 	dbHandle := provider.dbProvider.GetDBHandle(ledgerID)
-	return &store{db: dbHandle, ledgerID: ledgerID}, nil
+	s := &store{
+		db:                dbHandle,
+		ledgerID:          ledgerID,
+		csp:               provider.conf.CSP,
+		encryptionEnabled: provider.conf.EncryptionEnabled,
+		stats:             provider.stats.ledgerStats(ledgerID),
+	}
+	if err := s.loadEncryptionKey(); err != nil {
+		return nil, errors.WithMessage(err, "failed loading the encryption key of the transient store for ledger "+ledgerID)
+	}
+	if err := s.buildPurgeIndexByTime(); err != nil {
+		return nil, errors.WithMessage(err, "failed indexing the private write sets of the transient store for ledger "+ledgerID)
+	}
+	if err := s.loadNumEntries(); err != nil {
+		return nil, errors.WithMessage(err, "failed counting the private write sets of the transient store for ledger "+ledgerID)
+	}
+
+	provider.wg.Add(1)
+	go func() {
+		defer provider.wg.Done()
+		s.purgeAndUpdateStatsPeriodically(provider.conf.MaxAge, provider.conf.PurgeInterval, provider.stopChan)
+	}()
+	return s, nil
 }
 
 // Close closes the TransientStoreProvider
 func (provider *storeProvider) Close() {
+	provider.stopOnce.Do(func() {
+		close(provider.stopChan)
+	})
+	provider.wg.Wait()
 	provider.dbProvider.Close()
 }
 
+// loadEncryptionKey retrieves from the CSP the key whose SKI is kept in the store. If the store does
+// not reference any key and the encryption is enabled, a key is generated and its SKI is kept in the
+// store, so that the private write sets remain readable after a restart of the peer
+func (s *store) loadEncryptionKey() error {
+	ski, err := s.db.Get(encryptionKeySKIKey)
+	if err != nil {
+		return err
+	}
+	if ski != nil {
+		s.encryptionKey, err = s.csp.GetKey(ski)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("failed retrieving the encryption key with SKI [%x] from the BCCSP:"+
+				" the private write sets of the ledger were encrypted with this key, which must remain available"+
+				" in the keystore of the BCCSP of the peer (peer.BCCSP) even after disabling the encryption", ski))
+		}
+		return nil
+	}
+	if !s.encryptionEnabled {
+		return nil
+	}
+	key, err := s.csp.KeyGen(&bccsp.AES256KeyGenOpts{Temporary: false})
+	if err != nil {
+		return errors.WithMessage(err, "failed generating the encryption key")
+	}
+	if err := s.db.Put(encryptionKeySKIKey, key.SKI(), true); err != nil {
+		return err
+	}
+	s.encryptionKey = key
+	return nil
+}
+
+// encodeValue encrypts the given marshaled private write set if the encryption is enabled
+func (s *store) encodeValue(value []byte) ([]byte, error) {
+	if !s.encryptionEnabled {
+		return value, nil
+	}
+	ciphertext, err := s.csp.Encrypt(s.encryptionKey, value, &bccsp.AESCBCPKCS7ModeOpts{})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed encrypting private write set")
+	}
+	return append([]byte{encryptedByte}, ciphertext...), nil
+}
+
+// decodeValue returns the marshaled private write set stored in the given value, decrypting it if needed
+func (s *store) decodeValue(value []byte) ([]byte, error) {
+	if len(value) == 0 || value[0] != encryptedByte {
+		return value, nil
+	}
+	if s.encryptionKey == nil {
+		return nil, errors.New("found encrypted private write set but no encryption key is available")
+	}
+	plaintext, err := s.csp.Decrypt(s.encryptionKey, value[1:], &bccsp.AESCBCPKCS7ModeOpts{})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed decrypting private write set")
+	}
+	return plaintext, nil
+}
+
 // Persist stores the private write set of a transaction in the transient store
 // based on txid and the block height the private data was received at
 // TODO: Once the related gossip changes are made as per FAB-5096, remove this function.
@@ -155,17 +314,30 @@ func (s *store) Persist(txid string, blockHeight uint64,
 	if err != nil {
 		return err
 	}
-	dbBatch.Put(compositeKeyPvtRWSet, privateSimulationResultsBytes)
+	value, err := s.encodeValue(privateSimulationResultsBytes)
+	if err != nil {
+		return err
+	}
+	dbBatch.Put(compositeKeyPvtRWSet, value)
 
-	// Create two index: (i) by txid, and (ii) by height
+	// Create three index: (i) by txid, (ii) by height, and (iii) by time
 
 	// Create compositeKey for purge index by height with appropriate prefix, blockHeight,
-	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that
-	// the purge index is used to remove orphan entries in the transient store (which are not removed
-	// by PurgeTxids()) using BTL policy by PurgeByHeight(). Note that orphan entries are due to transaction
-	// that gets endorsed but not submitted by the client for commit)
+	// txid, uuid and store the compositeKey (purge index) with the current time as value, so that
+	// the purge index by time can be found from it. Note that the purge index is used to remove orphan
+	// entries in the transient store (which are not removed by PurgeTxids()) using BTL policy by
+	// PurgeByHeight(). Note that orphan entries are due to transaction that gets endorsed but not
+	// submitted by the client for commit)
+	persistTime := time.Now()
 	compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
-	dbBatch.Put(compositeKeyPurgeIndexByHeight, emptyValue)
+	dbBatch.Put(compositeKeyPurgeIndexByHeight, encodePersistTime(persistTime))
+
+	// Create compositeKey for purge index by time with appropriate prefix, current time, blockHeight,
+	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that this purge
+	// index is used to remove orphan entries using their age by PurgeByTime() and to find the oldest
+	// entry when updating the metrics of the store, without scanning all the entries
+	compositeKeyPurgeIndexByTime := createCompositeKeyForPurgeIndexByTime(persistTime, blockHeight, txid, uuid)
+	dbBatch.Put(compositeKeyPurgeIndexByTime, emptyValue)
 
 	// Create compositeKey for purge index by txid with appropriate prefix, txid, uuid,
 	// blockHeight and store the compositeKey (purge index) with a nil byte as value.
@@ -180,7 +352,11 @@ func (s *store) Persist(txid string, blockHeight uint64,
 	compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
 	dbBatch.Put(compositeKeyPurgeIndexByTxid, emptyValue)
 
-	return s.db.WriteBatch(dbBatch, true)
+	if err := s.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	atomic.AddInt64(&s.numEntries, 1)
+	return nil
 }
 
 // PersistWithConfig stores the private write set of a transaction along with the collection config
@@ -209,18 +385,30 @@ func (s *store) PersistWithConfig(txid string, blockHeight uint64,
 	// retrieving, a nil byte is prepended to the new proto, i.e., privateSimulationResultsWithConfigBytes,
 	// as a marshaled message can never start with a nil byte. In v1.3, we can avoid prepending the
 	// nil byte.
-	value := append([]byte{nilByte}, privateSimulationResultsWithConfigBytes...)
+	value, err := s.encodeValue(append([]byte{nilByte}, privateSimulationResultsWithConfigBytes...))
+	if err != nil {
+		return err
+	}
 	dbBatch.Put(compositeKeyPvtRWSet, value)
 
-	// Create two index: (i) by txid, and (ii) by height
+	// Create three index: (i) by txid, (ii) by height, and (iii) by time
 
 	// Create compositeKey for purge index by height with appropriate prefix, blockHeight,
-	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that
-	// the purge index is used to remove orphan entries in the transient store (which are not removed
-	// by PurgeTxids()) using BTL policy by PurgeByHeight(). Note that orphan entries are due to transaction
-	// that gets endorsed but not submitted by the client for commit)
+	// txid, uuid and store the compositeKey (purge index) with the current time as value, so that
+	// the purge index by time can be found from it. Note that the purge index is used to remove orphan
+	// entries in the transient store (which are not removed by PurgeTxids()) using BTL policy by
+	// PurgeByHeight(). Note that orphan entries are due to transaction that gets endorsed but not
+	// submitted by the client for commit)
+	persistTime := time.Now()
 	compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
-	dbBatch.Put(compositeKeyPurgeIndexByHeight, emptyValue)
+	dbBatch.Put(compositeKeyPurgeIndexByHeight, encodePersistTime(persistTime))
+
+	// Create compositeKey for purge index by time with appropriate prefix, current time, blockHeight,
+	// txid, uuid and store the compositeKey (purge index) with a nil byte as value. Note that this purge
+	// index is used to remove orphan entries using their age by PurgeByTime() and to find the oldest
+	// entry when updating the metrics of the store, without scanning all the entries
+	compositeKeyPurgeIndexByTime := createCompositeKeyForPurgeIndexByTime(persistTime, blockHeight, txid, uuid)
+	dbBatch.Put(compositeKeyPurgeIndexByTime, emptyValue)
 
 	// Create compositeKey for purge index by txid with appropriate prefix, txid, uuid,
 	// blockHeight and store the compositeKey (purge index) with a nil byte as value.
@@ -235,7 +423,11 @@ func (s *store) PersistWithConfig(txid string, blockHeight uint64,
 	compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
 	dbBatch.Put(compositeKeyPurgeIndexByTxid, emptyValue)
 
-	return s.db.WriteBatch(dbBatch, true)
+	if err := s.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	atomic.AddInt64(&s.numEntries, 1)
+	return nil
 }
 
 // GetTxPvtRWSetByTxid returns an iterator due to the fact that the txid may have multiple private
@@ -249,7 +441,7 @@ func (s *store) GetTxPvtRWSetByTxid(txid string, filter ledger.PvtNsCollFilter)
 	endKey := createTxidRangeEndKey(txid)
 
 	iter := s.db.GetIterator(startKey, endKey)
-	return &RwsetScanner{txid, iter, filter}, nil
+	return &RwsetScanner{txid, iter, filter, s}, nil
 }
 
 // PurgeByTxids removes private write sets of a given set of transactions from the
@@ -259,9 +451,19 @@ func (s *store) PurgeByTxids(txids []string) error {
 
 	logger.Debug("Purging private data from transient store for committed txids")
 
+	s.purgeLock.Lock()
+	defer s.purgeLock.Unlock()
+
 	dbBatch := leveldbhelper.NewUpdateBatch()
+	numPurged := 0
 
+	purgedTxids := make(map[string]struct{})
 	for _, txid := range txids {
+		if _, ok := purgedTxids[txid]; ok {
+			continue
+		}
+		purgedTxids[txid] = struct{}{}
+
 		// Construct startKey and endKey to do an range query
 		startKey := createPurgeIndexByTxidRangeStartKey(txid)
 		endKey := createPurgeIndexByTxidRangeEndKey(txid)
@@ -279,23 +481,31 @@ func (s *store) PurgeByTxids(txids []string) error {
 			// with  prwsetPrefix. For code readability and to be expressive, we split and create again.
 			uuid, blockHeight, err := splitCompositeKeyOfPurgeIndexByTxid(compositeKeyPurgeIndexByTxid)
 			if err != nil {
+				iter.Release()
 				return err
 			}
 			compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
 			dbBatch.Delete(compositeKeyPvtRWSet)
 
-			// Remove purge index -- purgeIndexByHeight
+			// Remove purge index -- purgeIndexByTime
 			compositeKeyPurgeIndexByHeight := createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid)
+			if err := s.deletePurgeIndexByTimeOfHeight(dbBatch, compositeKeyPurgeIndexByHeight, blockHeight, txid, uuid); err != nil {
+				iter.Release()
+				return err
+			}
+
+			// Remove purge index -- purgeIndexByHeight
 			dbBatch.Delete(compositeKeyPurgeIndexByHeight)
 
 			// Remove purge index -- purgeIndexByTxid
 			dbBatch.Delete(compositeKeyPurgeIndexByTxid)
+			numPurged++
 		}
 		iter.Release()
 	}
 	// If peer fails before/while writing the batch to golevelDB, these entries will be
 	// removed as per BTL policy later by PurgeByHeight()
-	return s.db.WriteBatch(dbBatch, true)
+	return s.writePurgeBatch(dbBatch, numPurged)
 }
 
 // PurgeByHeight removes private write sets at block height lesser than
@@ -308,12 +518,17 @@ func (s *store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 
 	logger.Debugf("Purging orphaned private data from transient store received prior to block [%d]", maxBlockNumToRetain)
 
+	s.purgeLock.Lock()
+	defer s.purgeLock.Unlock()
+
 	// Do a range query with 0 as startKey and maxBlockNumToRetain-1 as endKey
 	startKey := createPurgeIndexByHeightRangeStartKey(0)
 	endKey := createPurgeIndexByHeightRangeEndKey(maxBlockNumToRetain - 1)
 	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
 
 	dbBatch := leveldbhelper.NewUpdateBatch()
+	numPurged := 0
 
 	// Get all txid and uuid from above result and remove it from transient store (both
 	// write set and the corresponding index.
@@ -331,26 +546,163 @@ func (s *store) PurgeByHeight(maxBlockNumToRetain uint64) error {
 		compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
 		dbBatch.Delete(compositeKeyPvtRWSet)
 
+		// Remove purge index -- purgeIndexByTime
+		if err := deletePurgeIndexByTime(dbBatch, iter.Value(), blockHeight, txid, uuid); err != nil {
+			return err
+		}
+
 		// Remove purge index -- purgeIndexByTxid
 		compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
 		dbBatch.Delete(compositeKeyPurgeIndexByTxid)
 
 		// Remove purge index -- purgeIndexByHeight
 		dbBatch.Delete(compositeKeyPurgeIndexByHeight)
+		numPurged++
+	}
+
+	return s.writePurgeBatch(dbBatch, numPurged)
+}
+
+// PurgeByTime removes private write sets that were persisted before the given time. Though orphan
+// entries are removed by PurgeByHeight(), it runs only when blocks get committed. PurgeByTime() is
+// expected to be called periodically so that orphan entries do not linger in the transient store.
+func (s *store) PurgeByTime(persistedBefore time.Time) error {
+
+	logger.Debugf("Purging private data from transient store persisted before [%s]", persistedBefore)
+
+	s.purgeLock.Lock()
+	defer s.purgeLock.Unlock()
+
+	// Do a range query on the purge index by time up to persistedBefore, which does not
+	// include the private write sets persisted by previous versions of the peer
+	startKey, _ := createPurgeIndexByTimeFullRangeKeys()
+	endKey := createPurgeIndexByTimeRangeEndKey(persistedBefore)
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	numPurged := 0
+
+	for iter.Next() {
+		compositeKeyPurgeIndexByTime := iter.Key()
+		persistTime, blockHeight, txid, uuid, err := splitCompositeKeyOfPurgeIndexByTime(compositeKeyPurgeIndexByTime)
+		if err != nil {
+			return err
+		}
+		logger.Debugf("Purging from transient store private data persisted at [%s]: txid [%s] uuid [%s]", persistTime, txid, uuid)
+
+		// Remove private write set
+		compositeKeyPvtRWSet := createCompositeKeyForPvtRWSet(txid, uuid, blockHeight)
+		dbBatch.Delete(compositeKeyPvtRWSet)
+
+		// Remove purge index -- purgeIndexByTxid
+		compositeKeyPurgeIndexByTxid := createCompositeKeyForPurgeIndexByTxid(txid, uuid, blockHeight)
+		dbBatch.Delete(compositeKeyPurgeIndexByTxid)
+
+		// Remove purge index -- purgeIndexByHeight
+		dbBatch.Delete(createCompositeKeyForPurgeIndexByHeight(blockHeight, txid, uuid))
+
+		// Remove purge index -- purgeIndexByTime
+		dbBatch.Delete(compositeKeyPurgeIndexByTime)
+		numPurged++
+	}
+
+	return s.writePurgeBatch(dbBatch, numPurged)
+}
+
+// deletePurgeIndexByTime adds to the batch the removal of the purge index by time of a private
+// write set, given the value of its purge index by height. The private write sets persisted by
+// previous versions of the peer are not indexed by time
+func deletePurgeIndexByTime(dbBatch *leveldbhelper.UpdateBatch, purgeIndexByHeightValue []byte, blockHeight uint64, txid, uuid string) error {
+	persistTime, known, err := decodePersistTime(purgeIndexByHeightValue)
+	if err != nil || !known {
+		return err
+	}
+	dbBatch.Delete(createCompositeKeyForPurgeIndexByTime(persistTime, blockHeight, txid, uuid))
+	return nil
+}
+
+// deletePurgeIndexByTimeOfHeight adds to the batch the removal of the purge index by time of a
+// private write set, given the key of its purge index by height
+func (s *store) deletePurgeIndexByTimeOfHeight(dbBatch *leveldbhelper.UpdateBatch, compositeKeyPurgeIndexByHeight []byte, blockHeight uint64, txid, uuid string) error {
+	purgeIndexByHeightValue, err := s.db.Get(compositeKeyPurgeIndexByHeight)
+	if err != nil {
+		return err
+	}
+	return deletePurgeIndexByTime(dbBatch, purgeIndexByHeightValue, blockHeight, txid, uuid)
+}
+
+// writePurgeBatch writes the batch removing the given number of private write sets and updates
+// the number of private write sets in the store accordingly
+func (s *store) writePurgeBatch(dbBatch *leveldbhelper.UpdateBatch, numPurged int) error {
+	if err := s.db.WriteBatch(dbBatch, true); err != nil {
+		return err
+	}
+	atomic.AddInt64(&s.numEntries, -int64(numPurged))
+	return nil
+}
+
+// buildPurgeIndexByTime indexes based on the time they were persisted at the private write sets
+// persisted before the purge index by time was introduced, so that PurgeByTime() finds them as well
+func (s *store) buildPurgeIndexByTime() error {
+	built, err := s.db.Get(purgeIndexByTimeKey)
+	if err != nil || built != nil {
+		return err
+	}
+
+	startKey, endKey := createPurgeIndexByHeightFullRangeKeys()
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+
+	dbBatch := leveldbhelper.NewUpdateBatch()
+	for iter.Next() {
+		persistTime, known, err := decodePersistTime(iter.Value())
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+		txid, uuid, blockHeight, err := splitCompositeKeyOfPurgeIndexByHeight(iter.Key())
+		if err != nil {
+			return err
+		}
+		dbBatch.Put(createCompositeKeyForPurgeIndexByTime(persistTime, blockHeight, txid, uuid), emptyValue)
+	}
+	if err := iter.Error(); err != nil {
+		return err
 	}
-	iter.Release()
+	dbBatch.Put(purgeIndexByTimeKey, emptyValue)
 
 	return s.db.WriteBatch(dbBatch, true)
 }
 
+// loadNumEntries counts the private write sets in the store. It is called once, when the store is
+// opened, and the count is kept up to date afterwards by the functions persisting and purging them
+func (s *store) loadNumEntries() error {
+	startKey, endKey := createPurgeIndexByHeightFullRangeKeys()
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+
+	var numEntries int64
+	for iter.Next() {
+		numEntries++
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&s.numEntries, numEntries)
+	return nil
+}
+
 // GetMinTransientBlkHt returns the lowest block height remaining in transient store
 func (s *store) GetMinTransientBlkHt() (uint64, error) {
 	// Current approach performs a range query on purgeIndex with startKey
 	// as 0 (i.e., blockHeight) and returns the first key which denotes
 	// the lowest block height remaining in transient store. An alternative approach
 	// is to explicitly store the minBlockHeight in the transientStore.
-	startKey := createPurgeIndexByHeightRangeStartKey(0)
-	iter := s.db.GetIterator(startKey, nil)
+	startKey, endKey := createPurgeIndexByHeightFullRangeKeys()
+	iter := s.db.GetIterator(startKey, endKey)
 	defer iter.Release()
 	// Fetch the minimum transient block height
 	if iter.Next() {
@@ -368,6 +720,58 @@ func (s *store) Shutdown() {
 	// do nothing because shared db is used
 }
 
+// purgeAndUpdateStatsPeriodically purges the private write sets older than maxAge, if maxAge is
+// not zero, and updates the metrics of the store at every interval until stopChan is closed
+func (s *store) purgeAndUpdateStatsPeriodically(maxAge, interval time.Duration, stopChan <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if maxAge > 0 {
+			if err := s.PurgeByTime(time.Now().Add(-maxAge)); err != nil {
+				logger.Errorf("[%s] Failed purging data from transient store older than %s: %s", s.ledgerID, maxAge, err)
+			}
+		}
+		if err := s.updateStats(); err != nil {
+			logger.Errorf("[%s] Failed updating transient store metrics: %s", s.ledgerID, err)
+		}
+		select {
+		case <-ticker.C:
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// updateStats updates the number of private write sets in the store and the age of the
+// oldest one, which is the first one of the purge index by time. Private write sets persisted
+// by previous versions of the peer are counted, but not taken into account for the age as
+// their persist time is unknown
+func (s *store) updateStats() error {
+	startKey, endKey := createPurgeIndexByTimeFullRangeKeys()
+	iter := s.db.GetIterator(startKey, endKey)
+	defer iter.Release()
+
+	var oldest time.Time
+	if iter.Next() {
+		persistTime, _, _, _, err := splitCompositeKeyOfPurgeIndexByTime(iter.Key())
+		if err != nil {
+			return err
+		}
+		oldest = persistTime
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	s.stats.updateEntries(int(atomic.LoadInt64(&s.numEntries)))
+	if oldest.IsZero() {
+		s.stats.updateOldestEntryAge(0)
+	} else {
+		s.stats.updateOldestEntryAge(time.Since(oldest))
+	}
+	return nil
+}
+
 // Next moves the iterator to the next key/value pair.
 // It returns whether the iterator is exhausted.
 // TODO: Once the related gossip changes are made as per FAB-5096, remove this function
@@ -381,6 +785,9 @@ func (scanner *RwsetScanner) Next() (*EndorserPvtSimulationResults, error) {
 	if err != nil {
 		return nil, err
 	}
+	if dbVal, err = scanner.store.decodeValue(dbVal); err != nil {
+		return nil, err
+	}
 
 	txPvtRWSet := &rwset.TxPvtReadWriteSet{}
 	if err := proto.Unmarshal(dbVal, txPvtRWSet); err != nil {
@@ -407,12 +814,15 @@ func (scanner *RwsetScanner) NextWithConfig() (*EndorserPvtSimulationResultsWith
 	if err != nil {
 		return nil, err
 	}
+	if dbVal, err = scanner.store.decodeValue(dbVal); err != nil {
+		return nil, err
+	}
 
 	txPvtRWSet := &rwset.TxPvtReadWriteSet{}
 	filteredTxPvtRWSet := &rwset.TxPvtReadWriteSet{}
 	txPvtRWSetWithConfig := &transientstore.TxPvtReadWriteSetWithConfigInfo{}
 
-	if dbVal[0] == nilByte {
+	if len(dbVal) > 0 && dbVal[0] == nilByte {
 		// new proto, i.e., TxPvtReadWriteSetWithConfigInfo
 		if err := proto.Unmarshal(dbVal[1:], txPvtRWSetWithConfig); err != nil {
 			return nil, err
@@ -9,7 +9,9 @@ package transientstore
 import (
 	"bytes"
 	"errors"
+	"math"
 	"path/filepath"
+	"time"
 
 	"github.com/hyperledger/fabric/common/ledger/util"
 	"github.com/hyperledger/fabric/core/config"
@@ -22,7 +24,10 @@ var (
 	prwsetPrefix             = []byte("P")[0] // key prefix for storing private write set in transient store.
 	purgeIndexByHeightPrefix = []byte("H")[0] // key prefix for storing index on private write set using received at block height.
 	purgeIndexByTxidPrefix   = []byte("T")[0] // key prefix for storing index on private write set using txid
+	purgeIndexByTimePrefix   = []byte("A")[0] // key prefix for storing index on private write set using the time it was persisted at
 	compositeKeySep          = byte(0x00)
+	encryptionKeySKIKey      = []byte("E") // key for storing the SKI of the key used to encrypt private write sets.
+	purgeIndexByTimeKey      = []byte("B") // key marking that the private write sets are indexed using the time they were persisted at.
 )
 
 // createCompositeKeyForPvtRWSet creates a key for storing private write set
@@ -157,6 +162,80 @@ func createPurgeIndexByHeightRangeEndKey(blockHeight uint64) []byte {
 	return endKey
 }
 
+// createPurgeIndexByHeightFullRangeKeys returns a startKey and an endKey to do a range query on all
+// the entries of the index stored in transient store using blockHeight
+func createPurgeIndexByHeightFullRangeKeys() ([]byte, []byte) {
+	return createPurgeIndexByHeightRangeStartKey(0), createPurgeIndexByHeightRangeEndKey(math.MaxUint64)
+}
+
+// encodePersistTime encodes the time at which a private write set is persisted. The encoded time is
+// stored as the value of the purge index by height such that the purge index by time of a private write
+// set can be found when it is removed.
+func encodePersistTime(persistTime time.Time) []byte {
+	return util.EncodeOrderPreservingVarUint64(uint64(persistTime.UnixNano()))
+}
+
+// decodePersistTime decodes the time at which a private write set was persisted from the value of the
+// purge index by height. The value is empty for the private write sets persisted by previous versions
+// of the peer, in which case false is returned.
+func decodePersistTime(value []byte) (time.Time, bool, error) {
+	if len(value) == 0 {
+		return time.Time{}, false, nil
+	}
+	nanos, _, err := util.DecodeOrderPreservingVarUint64(value)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(0, int64(nanos)), true, nil
+}
+
+// createCompositeKeyForPurgeIndexByTime creates a key for storing index on private write set using
+// the time it was persisted at. The structure of the key is <purgeIndexByTimePrefix>~persistTime blockHeight~txid~uuid.
+func createCompositeKeyForPurgeIndexByTime(persistTime time.Time, blockHeight uint64, txid string, uuid string) []byte {
+	var compositeKey []byte
+	compositeKey = append(compositeKey, createPurgeIndexByTimeRangeEndKey(persistTime)...)
+	compositeKey = append(compositeKey, util.EncodeOrderPreservingVarUint64(blockHeight)...)
+	compositeKey = append(compositeKey, compositeKeySep)
+	compositeKey = append(compositeKey, []byte(txid)...)
+	compositeKey = append(compositeKey, compositeKeySep)
+	compositeKey = append(compositeKey, []byte(uuid)...)
+	return compositeKey
+}
+
+// splitCompositeKeyOfPurgeIndexByTime splits the compositeKey (<purgeIndexByTimePrefix>~persistTime blockHeight~txid~uuid)
+// into persistTime, blockHeight, txid and uuid.
+func splitCompositeKeyOfPurgeIndexByTime(compositeKey []byte) (persistTime time.Time, blockHeight uint64, txid string, uuid string, err error) {
+	nanos, timeBytesConsumed, err := util.DecodeOrderPreservingVarUint64(compositeKey[2:])
+	if err != nil {
+		return time.Time{}, 0, "", "", err
+	}
+	blockHeight, blockHeightBytesConsumed, err := util.DecodeOrderPreservingVarUint64(compositeKey[2+timeBytesConsumed:])
+	if err != nil {
+		return time.Time{}, 0, "", "", err
+	}
+	splits := bytes.Split(compositeKey[2+timeBytesConsumed+blockHeightBytesConsumed+1:], []byte{compositeKeySep})
+	if len(splits) != 2 {
+		return time.Time{}, 0, "", "", errors.New("invalid purge index by time key")
+	}
+	return time.Unix(0, int64(nanos)), blockHeight, string(splits[0]), string(splits[1]), nil
+}
+
+// createPurgeIndexByTimeRangeEndKey returns a endKey to do a range query on index stored in transient store
+// using the time the private write sets were persisted at, up to the private write sets persisted before the given time
+func createPurgeIndexByTimeRangeEndKey(persistedBefore time.Time) []byte {
+	var endKey []byte
+	endKey = append(endKey, purgeIndexByTimePrefix)
+	endKey = append(endKey, compositeKeySep)
+	endKey = append(endKey, encodePersistTime(persistedBefore)...)
+	return endKey
+}
+
+// createPurgeIndexByTimeFullRangeKeys returns a startKey and an endKey to do a range query on all
+// the entries of the index stored in transient store using the time the private write sets were persisted at
+func createPurgeIndexByTimeFullRangeKeys() ([]byte, []byte) {
+	return []byte{purgeIndexByTimePrefix, compositeKeySep}, []byte{purgeIndexByTimePrefix, byte(0xff)}
+}
+
 // createPurgeIndexByTxidRangeStartKey returns a startKey to do a range query on index stored in transient store
 // using txid
 func createPurgeIndexByTxidRangeStartKey(txid string) []byte {
@@ -7,12 +7,15 @@ SPDX-License-Identifier: Apache-2.0
 package transientstore
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/bccsp/sw"
 	"github.com/hyperledger/fabric/common/cauthdsl"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/util"
@@ -457,6 +460,205 @@ func TestTransientStorePurgeByHeight(t *testing.T) {
 	env.Cleanup()
 }
 
+func TestTransientStorePurgeByTime(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	assert.NoError(env.TestStore.PersistWithConfig("txid-1", 5, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(env.TestStore.Persist("txid-2", 5, samplePvtData(t)))
+	time.Sleep(10 * time.Millisecond)
+	purgeTime := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(env.TestStore.PersistWithConfig("txid-3", 6, samplePvtDataWithConfigInfo(t)))
+
+	// private data persisted by previous versions of the peer does not have a persist time
+	s := env.TestStore.(*store)
+	pvtRWSetBytes, err := proto.Marshal(samplePvtData(t))
+	assert.NoError(err)
+	assert.NoError(s.db.Put(createCompositeKeyForPvtRWSet("txid-4", "uuid", 4), pvtRWSetBytes, true))
+	assert.NoError(s.db.Put(createCompositeKeyForPurgeIndexByHeight(4, "txid-4", "uuid"), emptyValue, true))
+	assert.NoError(s.db.Put(createCompositeKeyForPurgeIndexByTxid("txid-4", "uuid", 4), emptyValue, true))
+
+	assert.NoError(env.TestStore.PurgeByTime(purgeTime))
+
+	numEntries := func(txid string) int {
+		iter, err := env.TestStore.GetTxPvtRWSetByTxid(txid, nil)
+		assert.NoError(err)
+		defer iter.Close()
+		n := 0
+		for {
+			result, err := iter.NextWithConfig()
+			assert.NoError(err)
+			if result == nil {
+				return n
+			}
+			n++
+		}
+	}
+	assert.Equal(0, numEntries("txid-1"))
+	assert.Equal(0, numEntries("txid-2"))
+	assert.Equal(1, numEntries("txid-3"))
+	assert.Equal(1, numEntries("txid-4"))
+
+	// the indexes of the purged private data are removed as well
+	minBlkHt, err := env.TestStore.GetMinTransientBlkHt()
+	assert.NoError(err)
+	assert.Equal(uint64(4), minBlkHt)
+	itr := s.db.GetIterator(createPurgeIndexByTxidRangeStartKey("txid-1"), createPurgeIndexByTxidRangeEndKey("txid-1"))
+	assert.False(itr.Next())
+	itr.Release()
+
+	assert.NoError(env.TestStore.PurgeByTime(time.Now()))
+	assert.Equal(0, numEntries("txid-3"))
+	assert.Equal(1, numEntries("txid-4"))
+	assert.Empty(testPurgeIndexByTime(t, s))
+}
+
+func TestTransientStorePurgeIndexByTime(t *testing.T) {
+	env := NewTestStoreEnv(t)
+	defer env.Cleanup()
+	assert := assert.New(t)
+
+	assert.NoError(env.TestStore.PersistWithConfig("txid-1", 5, samplePvtDataWithConfigInfo(t)))
+	assert.NoError(env.TestStore.Persist("txid-2", 6, samplePvtData(t)))
+	assert.NoError(env.TestStore.Persist("txid-3", 7, samplePvtData(t)))
+	s := env.TestStore.(*store)
+	assert.Equal([]string{"txid-1", "txid-2", "txid-3"}, testPurgeIndexByTime(t, s))
+
+	// the purge index by time is removed along with the private write sets
+	assert.NoError(env.TestStore.PurgeByTxids([]string{"txid-1"}))
+	assert.Equal([]string{"txid-2", "txid-3"}, testPurgeIndexByTime(t, s))
+	assert.NoError(env.TestStore.PurgeByHeight(7))
+	assert.Equal([]string{"txid-3"}, testPurgeIndexByTime(t, s))
+
+	// drop the purge index by time as if the private write set had been persisted before it was introduced
+	startKey, endKey := createPurgeIndexByTimeFullRangeKeys()
+	itr := s.db.GetIterator(startKey, endKey)
+	for itr.Next() {
+		assert.NoError(s.db.Delete(itr.Key(), true))
+	}
+	itr.Release()
+	assert.NoError(s.db.Delete(purgeIndexByTimeKey, true))
+
+	// the private write set is indexed when the store is opened
+	env.TestStoreProvider.Close()
+	env.TestStoreProvider = NewStoreProvider(&Config{})
+	var err error
+	env.TestStore, err = env.TestStoreProvider.OpenStore("TestStore")
+	assert.NoError(err)
+	s = env.TestStore.(*store)
+	assert.Equal([]string{"txid-3"}, testPurgeIndexByTime(t, s))
+	assert.NoError(env.TestStore.PurgeByTime(time.Now()))
+	_, err = env.TestStore.GetMinTransientBlkHt()
+	assert.Equal(ErrStoreEmpty, err)
+}
+
+// testPurgeIndexByTime returns the txids of the private write sets in the purge index by time, oldest first
+func testPurgeIndexByTime(t *testing.T, s *store) []string {
+	startKey, endKey := createPurgeIndexByTimeFullRangeKeys()
+	itr := s.db.GetIterator(startKey, endKey)
+	defer itr.Release()
+	var txids []string
+	for itr.Next() {
+		_, _, txid, _, err := splitCompositeKeyOfPurgeIndexByTime(itr.Key())
+		assert.NoError(t, err)
+		txids = append(txids, txid)
+	}
+	return txids
+}
+
+func TestTransientStorePurgeByTimePeriodically(t *testing.T) {
+	removeStorePath(t)
+	defer removeStorePath(t)
+	provider := NewStoreProvider(&Config{MaxAge: 10 * time.Millisecond, PurgeInterval: 10 * time.Millisecond})
+	defer provider.Close()
+	testStore, err := provider.OpenStore("TestStore")
+	assert.NoError(t, err)
+
+	assert.NoError(t, testStore.PersistWithConfig("txid-1", 5, samplePvtDataWithConfigInfo(t)))
+	deadline := time.Now().Add(time.Minute)
+	for {
+		_, err := testStore.GetMinTransientBlkHt()
+		if err == ErrStoreEmpty {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("private data was not purged from the transient store")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestTransientStoreEncryption(t *testing.T) {
+	removeStorePath(t)
+	defer removeStorePath(t)
+	assert := assert.New(t)
+	csp, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewInMemoryKeyStore())
+	assert.NoError(err)
+
+	provider := NewStoreProvider(&Config{EncryptionEnabled: true, CSP: csp})
+	testStore, err := provider.OpenStore("TestStore")
+	assert.NoError(err)
+
+	pvtRWSetWithConfig := samplePvtDataWithConfigInfo(t)
+	assert.NoError(testStore.PersistWithConfig("txid-1", 5, pvtRWSetWithConfig))
+	assert.NoError(testStore.Persist("txid-2", 5, samplePvtData(t)))
+
+	// the private write sets are not stored in plain text
+	s := testStore.(*store)
+	itr := s.db.GetIterator(createTxidRangeStartKey("txid-1"), createTxidRangeEndKey("txid-1"))
+	assert.True(itr.Next())
+	assert.Equal(encryptedByte, itr.Value()[0])
+	assert.False(bytes.Contains(itr.Value(), []byte("RandomBytes-PvtRWSet-ns1-coll1")))
+	itr.Release()
+
+	retrieve := func(testStore Store, txid string) *EndorserPvtSimulationResultsWithConfig {
+		iter, err := testStore.GetTxPvtRWSetByTxid(txid, nil)
+		assert.NoError(err)
+		defer iter.Close()
+		result, err := iter.NextWithConfig()
+		assert.NoError(err)
+		return result
+	}
+	result := retrieve(testStore, "txid-1")
+	assert.True(proto.Equal(pvtRWSetWithConfig, result.PvtSimulationResultsWithConfig))
+	assert.True(proto.Equal(samplePvtData(t), retrieve(testStore, "txid-2").PvtSimulationResultsWithConfig.PvtRwset))
+
+	// the encrypted private write sets remain readable after disabling the encryption,
+	// and the new ones are stored in plain text
+	provider.Close()
+	provider = NewStoreProvider(&Config{CSP: csp})
+	testStore, err = provider.OpenStore("TestStore")
+	assert.NoError(err)
+	result = retrieve(testStore, "txid-1")
+	assert.True(proto.Equal(pvtRWSetWithConfig, result.PvtSimulationResultsWithConfig))
+	assert.NoError(testStore.PersistWithConfig("txid-3", 6, pvtRWSetWithConfig))
+	s = testStore.(*store)
+	itr = s.db.GetIterator(createTxidRangeStartKey("txid-3"), createTxidRangeEndKey("txid-3"))
+	assert.True(itr.Next())
+	assert.Equal(nilByte, itr.Value()[0])
+	itr.Release()
+	provider.Close()
+
+	// the store cannot be opened if the CSP does not have the encryption key
+	otherCSP, err := sw.NewDefaultSecurityLevelWithKeystore(sw.NewInMemoryKeyStore())
+	assert.NoError(err)
+	provider = NewStoreProvider(&Config{EncryptionEnabled: true, CSP: otherCSP})
+	defer provider.Close()
+	_, err = provider.OpenStore("TestStore")
+	assert.Error(err)
+	assert.Contains(err.Error(), "failed loading the encryption key of the transient store for ledger TestStore: failed retrieving the encryption key with SKI [")
+	assert.Contains(err.Error(), "must remain available in the keystore of the BCCSP of the peer (peer.BCCSP)")
+
+	// the encryption key of a ledger is not used by other ledgers
+	otherStore, err := provider.OpenStore("OtherStore")
+	assert.NoError(err)
+	assert.NoError(otherStore.PersistWithConfig("txid-1", 5, pvtRWSetWithConfig))
+	result = retrieve(otherStore, "txid-1")
+	assert.True(proto.Equal(pvtRWSetWithConfig, result.PvtSimulationResultsWithConfig))
+}
+
 func TestTransientStoreRetrievalWithFilter(t *testing.T) {
 	env := NewTestStoreEnv(t)
 	store := env.TestStore
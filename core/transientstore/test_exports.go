@@ -34,7 +34,7 @@ type StoreEnv struct {
 func NewTestStoreEnv(t *testing.T) *StoreEnv {
 	removeStorePath(t)
 	assert := assert.New(t)
-	testStoreProvider := NewStoreProvider()
+	testStoreProvider := NewStoreProvider(&Config{})
 	testStore, err := testStoreProvider.OpenStore("TestStore")
 	assert.NoError(err)
 	return &StoreEnv{t, testStoreProvider, testStore}
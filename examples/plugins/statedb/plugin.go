@@ -0,0 +1,19 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+package main
+
+import (
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb"
+	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/statedb/stateleveldb"
+)
+
+// NewVersionedDBProvider returns a new instance of the VersionedDBProvider. This example
+// simply delegates to the goleveldb based state database that is built into the peer
+func NewVersionedDBProvider(config map[string]interface{}) (statedb.VersionedDBProvider, error) {
+	return stateleveldb.NewVersionedDBProvider(), nil
+}
+
+func main() {}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/hyperledger/fabric/gossip/common"
 	"github.com/hyperledger/fabric/gossip/metrics"
@@ -211,7 +212,6 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 	ss proto.Gossip_GossipStreamServer, metrics *metrics.CommMetrics, config ConnConfig) *connection {
 	connection := &connection{
 		metrics:      metrics,
-		outBuff:      make(chan *msgSending, config.SendBuffSize),
 		cl:           cl,
 		conn:         c,
 		clientStream: cs,
@@ -220,13 +220,16 @@ func newConnection(cl proto.GossipClient, c *grpc.ClientConn, cs proto.Gossip_Go
 		stopChan:     make(chan struct{}),
 		recvBuffSize: config.RecvBuffSize,
 	}
+	for class := range connection.outBuffs {
+		connection.outBuffs[class] = make(chan *msgSending, config.SendBuffSize)
+	}
 	return connection
 }
 
 // ConnConfig is the configuration required to initialize a new conn
 type ConnConfig struct {
 	RecvBuffSize int
-	SendBuffSize int
+	SendBuffSize int // size of the outgoing queue of each message class
 }
 
 type connection struct {
@@ -234,7 +237,8 @@ type connection struct {
 	metrics      *metrics.CommMetrics
 	cancel       context.CancelFunc
 	info         *proto.ConnectionInfo
-	outBuff      chan *msgSending
+	outBuffs     [numOfMessageClasses]chan *msgSending
+	passedOver   [numOfMessageClasses]int        // times each class was passed over while having messages waiting, only accessed by writeToStream
 	logger       util.Logger                     // logger
 	pkiID        common.PKIidType                // pkiID of the remote endpoint
 	handler      handler                         // function to invoke upon a message reception
@@ -286,19 +290,26 @@ func (conn *connection) send(msg *proto.SignedGossipMessage, onErr func(error),
 	}
 
 	m := &msgSending{
-		envelope: msg.Envelope,
-		onErr:    onErr,
+		envelope:    msg.Envelope,
+		onErr:       onErr,
+		class:       classOf(msg),
+		enqueueTime: time.Now(),
 	}
 
+	queueLength := conn.metrics.QueueLength.With("class", m.class.String())
+	queueLength.Add(1)
+	outBuff := conn.outBuffs[m.class]
 	select {
-	case conn.outBuff <- m:
+	case outBuff <- m:
 		// room in channel, successfully sent message, nothing to do
 	default: // did not send
 		if shouldBlock {
-			conn.outBuff <- m // try again, and wait to send
+			outBuff <- m // try again, and wait to send
 		} else {
+			queueLength.Add(-1)
 			conn.metrics.BufferOverflow.Add(1)
-			conn.logger.Debugf("Buffer to %s overflowed, dropping message %s", conn.info.Endpoint, msg)
+			conn.metrics.QueueOverflow.With("class", m.class.String()).Add(1)
+			conn.logger.Debugf("Buffer of %s messages to %s overflowed, dropping message %s", m.class, conn.info.Endpoint, msg)
 		}
 	}
 }
@@ -335,29 +346,82 @@ func (conn *connection) writeToStream() {
 			conn.logger.Error(conn.pkiID, "Stream is nil, aborting!")
 			return
 		}
-		select {
-		case m := <-conn.outBuff:
-			err := stream.Send(m.envelope)
-			if err != nil {
-				go m.onErr(err)
-				return
-			}
-			conn.metrics.SentMessages.Add(1)
-		case <-conn.stopChan:
+		m := conn.nextMessage()
+		if m == nil {
 			conn.logger.Debug("Closing writing to stream")
 			return
 		}
+		conn.metrics.QueueLength.With("class", m.class.String()).Add(-1)
+		conn.metrics.QueueLatency.With("class", m.class.String()).Observe(time.Since(m.enqueueTime).Seconds())
+		err := stream.Send(m.envelope)
+		if err != nil {
+			go m.onErr(err)
+			return
+		}
+		conn.metrics.SentMessages.Add(1)
 	}
 }
 
-func (conn *connection) drainOutputBuffer() {
-	// Read from the buffer until it is empty.
-	// There may be multiple concurrent readers.
-	for {
+// nextMessage returns the next message to be sent, taken from the outgoing
+// queue of the first message class that was passed over maxPassedOver times,
+// or else of the first message class that has a message waiting.
+// It blocks until a message is enqueued, and returns nil if the
+// connection is stopped in the meantime.
+func (conn *connection) nextMessage() *msgSending {
+	for class, outBuff := range conn.outBuffs {
+		if conn.passedOver[class] < maxPassedOver {
+			continue
+		}
+		conn.passedOver[class] = 0
 		select {
-		case <-conn.outBuff:
+		case m := <-outBuff:
+			conn.passOverFollowingClasses(messageClass(class))
+			return m
 		default:
-			return
+		}
+	}
+	for class, outBuff := range conn.outBuffs {
+		select {
+		case m := <-outBuff:
+			conn.passOverFollowingClasses(messageClass(class))
+			return m
+		default:
+		}
+	}
+	select {
+	case m := <-conn.outBuffs[membershipClass]:
+		return m
+	case m := <-conn.outBuffs[defaultClass]:
+		return m
+	case m := <-conn.outBuffs[blocksClass]:
+		return m
+	case <-conn.stopChan:
+		return nil
+	}
+}
+
+// passOverFollowingClasses counts the message classes following the given
+// class, whose message is about to be sent, that have messages waiting
+func (conn *connection) passOverFollowingClasses(class messageClass) {
+	for following := class + 1; following < numOfMessageClasses; following++ {
+		if len(conn.outBuffs[following]) > 0 {
+			conn.passedOver[following]++
+		}
+	}
+}
+
+func (conn *connection) drainOutputBuffer() {
+	// Read from the buffers until they are empty.
+	// There may be multiple concurrent readers.
+	for _, outBuff := range conn.outBuffs {
+		drained := false
+		for !drained {
+			select {
+			case m := <-outBuff:
+				conn.metrics.QueueLength.With("class", m.class.String()).Add(-1)
+			default:
+				drained = true
+			}
 		}
 	}
 }
@@ -417,8 +481,10 @@ func (conn *connection) getStream() stream {
 }
 
 type msgSending struct {
-	envelope *proto.Envelope
-	onErr    func(error)
+	envelope    *proto.Envelope
+	onErr       func(error)
+	class       messageClass
+	enqueueTime time.Time
 }
 
 //go:generate mockery -dir . -name MockStream -case underscore -output mocks/
@@ -0,0 +1,56 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	proto "github.com/hyperledger/fabric/protos/gossip"
+)
+
+// messageClass is the class of an outgoing message.
+// Each connection has an outgoing queue per message class,
+// and the queues are served in the order of the classes,
+// such that membership and leadership messages are not
+// delayed behind large blocks and state transfer payloads.
+// A class whose queue was passed over maxPassedOver times
+// in a row is served next, so that a steady flow of messages
+// of the preceding classes does not starve it.
+type messageClass int
+
+// maxPassedOver is the number of messages of preceding classes that may be
+// sent while a message of a class is waiting, before that class is served
+const maxPassedOver = 10
+
+const (
+	membershipClass messageClass = iota // alive, membership and leadership messages
+	defaultClass                        // messages of no other class, such as private data
+	blocksClass                         // blocks disseminated, pulled or transferred by the state
+	numOfMessageClasses
+)
+
+var messageClassNames = [numOfMessageClasses]string{
+	membershipClass: "membership",
+	defaultClass:    "default",
+	blocksClass:     "blocks",
+}
+
+func (c messageClass) String() string {
+	return messageClassNames[c]
+}
+
+// classOf returns the class of the given message
+func classOf(msg *proto.SignedGossipMessage) messageClass {
+	switch {
+	case msg.IsAliveMsg() || msg.GetMemReq() != nil || msg.GetMemRes() != nil || msg.IsLeadershipMsg():
+		return membershipClass
+	case msg.IsDataMsg() || msg.GetStateResponse() != nil:
+		return blocksClass
+	case msg.IsDataUpdate() && msg.GetPullMsgType() == proto.PullMsgType_BLOCK_MSG:
+		return blocksClass
+	default:
+		return defaultClass
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package comm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	gmocks "github.com/hyperledger/fabric/gossip/comm/mocks"
+	"github.com/hyperledger/fabric/gossip/metrics"
+	"github.com/hyperledger/fabric/gossip/metrics/mocks"
+	proto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClassOf(t *testing.T) {
+	for _, testCase := range []struct {
+		msg           *proto.GossipMessage
+		expectedClass messageClass
+	}{
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}}}, expectedClass: membershipClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_MemReq{MemReq: &proto.MembershipRequest{}}}, expectedClass: membershipClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_MemRes{MemRes: &proto.MembershipResponse{}}}, expectedClass: membershipClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_LeadershipMsg{LeadershipMsg: &proto.LeadershipMessage{}}}, expectedClass: membershipClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}}, expectedClass: blocksClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_DataUpdate{DataUpdate: &proto.DataUpdate{MsgType: proto.PullMsgType_BLOCK_MSG}}}, expectedClass: blocksClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_DataUpdate{DataUpdate: &proto.DataUpdate{MsgType: proto.PullMsgType_IDENTITY_MSG}}}, expectedClass: defaultClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_StateResponse{StateResponse: &proto.RemoteStateResponse{}}}, expectedClass: blocksClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_StateRequest{StateRequest: &proto.RemoteStateRequest{}}}, expectedClass: defaultClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_PrivateData{PrivateData: &proto.PrivateDataMessage{}}}, expectedClass: defaultClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_PrivateRes{PrivateRes: &proto.RemotePvtDataResponse{}}}, expectedClass: defaultClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_StateInfo{StateInfo: &proto.StateInfo{}}}, expectedClass: defaultClass},
+		{msg: &proto.GossipMessage{Content: &proto.GossipMessage_Ack{Ack: &proto.Acknowledgement{}}}, expectedClass: defaultClass},
+	} {
+		msg := &proto.SignedGossipMessage{GossipMessage: testCase.msg}
+		assert.Equal(t, testCase.expectedClass, classOf(msg), "%T", testCase.msg.Content)
+	}
+}
+
+func TestConnectionPrioritizesMessageClasses(t *testing.T) {
+	testMetricProvider := mocks.TestUtilConstructMetricProvider()
+	commMetrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).CommMetrics
+
+	sent := make(chan uint64, 10)
+	stream := &gmocks.MockStream{}
+	stream.On("CloseSend").Return(nil)
+	stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		msg, err := args.Get(0).(*proto.Envelope).ToGossipMessage()
+		assert.NoError(t, err)
+		sent <- msg.Nonce
+	}).Return(nil)
+
+	conn := newConnection(nil, nil, stream, nil, commMetrics, ConnConfig{1, 2})
+	conn.logger = flogging.MustGetLogger("test")
+	conn.info = &proto.ConnectionInfo{Endpoint: "localhost:7051"}
+	defer conn.close()
+
+	signedMsg := func(msg *proto.GossipMessage) *proto.SignedGossipMessage {
+		sMsg, _ := msg.NoopSign()
+		return sMsg
+	}
+	dataMsg := func(nonce uint64) *proto.SignedGossipMessage {
+		return signedMsg(&proto.GossipMessage{Nonce: nonce, Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}})
+	}
+	onErr := func(error) {}
+
+	// the messages are enqueued before the connection starts writing to the stream
+	conn.send(dataMsg(1), onErr, nonBlockingSend)
+	conn.send(dataMsg(2), onErr, nonBlockingSend)
+	// the outgoing queue of the blocks is full
+	conn.send(dataMsg(3), onErr, nonBlockingSend)
+	conn.send(signedMsg(&proto.GossipMessage{Nonce: 4, Content: &proto.GossipMessage_PrivateData{PrivateData: &proto.PrivateDataMessage{}}}), onErr, nonBlockingSend)
+	conn.send(signedMsg(&proto.GossipMessage{Nonce: 5, Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}}}), onErr, nonBlockingSend)
+
+	assert.Equal(t, 1, testMetricProvider.FakeBufferOverflow.AddCallCount())
+	assert.Equal(t, 1, testMetricProvider.FakeQueueOverflow.AddCallCount())
+	assert.Equal(t, []string{"class", "blocks"}, testMetricProvider.FakeQueueOverflow.WithArgsForCall(0))
+
+	go conn.writeToStream()
+
+	var order []uint64
+	for len(order) < 4 {
+		select {
+		case nonce := <-sent:
+			order = append(order, nonce)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Didn't send the messages in a timely manner, sent %v", order)
+		}
+	}
+	// the membership messages are sent first, and the blocks last
+	assert.Equal(t, []uint64{5, 4, 1, 2}, order)
+
+	assert.Equal(t, 4, testMetricProvider.FakeQueueLatency.ObserveCallCount())
+	assert.Equal(t, []string{"class", "membership"}, testMetricProvider.FakeQueueLatency.WithArgsForCall(0))
+	// each message enqueued is counted, and uncounted once dequeued or dropped
+	var queueLength float64
+	for i := 0; i < testMetricProvider.FakeQueueLength.AddCallCount(); i++ {
+		queueLength += testMetricProvider.FakeQueueLength.AddArgsForCall(i)
+	}
+	assert.Equal(t, float64(0), queueLength)
+}
+
+func TestConnectionDoesNotStarveMessageClasses(t *testing.T) {
+	testMetricProvider := mocks.TestUtilConstructMetricProvider()
+	commMetrics := metrics.NewGossipMetrics(testMetricProvider.FakeProvider).CommMetrics
+
+	sent := make(chan uint64, 3*maxPassedOver)
+	stream := &gmocks.MockStream{}
+	stream.On("CloseSend").Return(nil)
+	stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		msg, err := args.Get(0).(*proto.Envelope).ToGossipMessage()
+		assert.NoError(t, err)
+		sent <- msg.Nonce
+	}).Return(nil)
+
+	conn := newConnection(nil, nil, stream, nil, commMetrics, ConnConfig{1, 3 * maxPassedOver})
+	conn.logger = flogging.MustGetLogger("test")
+	conn.info = &proto.ConnectionInfo{Endpoint: "localhost:7051"}
+	defer conn.close()
+
+	signedMsg := func(msg *proto.GossipMessage) *proto.SignedGossipMessage {
+		sMsg, _ := msg.NoopSign()
+		return sMsg
+	}
+	onErr := func(error) {}
+
+	// a block and a private data message wait behind more membership messages than can be passed over
+	conn.send(signedMsg(&proto.GossipMessage{Nonce: 0, Content: &proto.GossipMessage_DataMsg{DataMsg: &proto.DataMessage{}}}), onErr, nonBlockingSend)
+	conn.send(signedMsg(&proto.GossipMessage{Nonce: 1, Content: &proto.GossipMessage_PrivateData{PrivateData: &proto.PrivateDataMessage{}}}), onErr, nonBlockingSend)
+	for nonce := uint64(2); nonce < 2*maxPassedOver+2; nonce++ {
+		conn.send(signedMsg(&proto.GossipMessage{Nonce: nonce, Content: &proto.GossipMessage_AliveMsg{AliveMsg: &proto.AliveMessage{}}}), onErr, nonBlockingSend)
+	}
+
+	go conn.writeToStream()
+
+	var order []uint64
+	for len(order) < 2*maxPassedOver+2 {
+		select {
+		case nonce := <-sent:
+			order = append(order, nonce)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Didn't send the messages in a timely manner, sent %v", order)
+		}
+	}
+	// the private data message and the block are sent once passed over maxPassedOver times,
+	// and the block is passed over by the private data message as well
+	var expectedOrder []uint64
+	for nonce := uint64(2); nonce < maxPassedOver+2; nonce++ {
+		expectedOrder = append(expectedOrder, nonce)
+	}
+	expectedOrder = append(expectedOrder, 1, 0)
+	for nonce := uint64(maxPassedOver + 2); nonce < 2*maxPassedOver+2; nonce++ {
+		expectedOrder = append(expectedOrder, nonce)
+	}
+	assert.Equal(t, expectedOrder, order)
+}
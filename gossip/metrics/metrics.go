@@ -98,6 +98,9 @@ type CommMetrics struct {
 	SentMessages     metrics.Counter
 	BufferOverflow   metrics.Counter
 	ReceivedMessages metrics.Counter
+	QueueLength      metrics.Gauge
+	QueueLatency     metrics.Histogram
+	QueueOverflow    metrics.Counter
 }
 
 func newCommMetrics(p metrics.Provider) *CommMetrics {
@@ -105,6 +108,9 @@ func newCommMetrics(p metrics.Provider) *CommMetrics {
 		SentMessages:     p.NewCounter(SentMessagesOpts),
 		BufferOverflow:   p.NewCounter(BufferOverflowOpts),
 		ReceivedMessages: p.NewCounter(ReceivedMessagesOpts),
+		QueueLength:      p.NewGauge(QueueLengthOpts),
+		QueueLatency:     p.NewHistogram(QueueLatencyOpts),
+		QueueOverflow:    p.NewCounter(QueueOverflowOpts),
 	}
 }
 
@@ -132,6 +138,33 @@ var (
 		Help:         "Number of messages received",
 		StatsdFormat: "%{#fqname}",
 	}
+
+	QueueLengthOpts = metrics.GaugeOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "queue_length",
+		Help:         "Number of messages waiting in the outgoing queues of the message class",
+		LabelNames:   []string{"class"},
+		StatsdFormat: "%{#fqname}.%{class}",
+	}
+
+	QueueLatencyOpts = metrics.HistogramOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "queue_latency",
+		Help:         "Time in seconds messages of the message class wait in the outgoing queues before being sent",
+		LabelNames:   []string{"class"},
+		StatsdFormat: "%{#fqname}.%{class}",
+	}
+
+	QueueOverflowOpts = metrics.CounterOpts{
+		Namespace:    "gossip",
+		Subsystem:    "comm",
+		Name:         "queue_overflow_count",
+		Help:         "Number of outgoing queue buffer overflows of the message class",
+		LabelNames:   []string{"class"},
+		StatsdFormat: "%{#fqname}.%{class}",
+	}
 )
 
 // MembershipMetrics encapsulates gossip channel membership related metrics
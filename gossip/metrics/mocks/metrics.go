@@ -24,6 +24,9 @@ type TestMetricProvider struct {
 	FakeSentMessages     *metricsfakes.Counter
 	FakeBufferOverflow   *metricsfakes.Counter
 	FakeReceivedMessages *metricsfakes.Counter
+	FakeQueueLength      *metricsfakes.Gauge
+	FakeQueueLatency     *metricsfakes.Histogram
+	FakeQueueOverflow    *metricsfakes.Counter
 
 	FakeTotalGauge *metricsfakes.Gauge
 
@@ -50,6 +53,9 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 	fakeSentMessages := testUtilConstructCounter()
 	fakeBufferOverflow := testUtilConstructCounter()
 	fakeReceivedMessages := testUtilConstructCounter()
+	fakeQueueLength := testUtilConstructGauge()
+	fakeQueueLatency := testUtilConstructHist()
+	fakeQueueOverflow := testUtilConstructCounter()
 
 	fakeTotalGauge := testUtilConstructGauge()
 
@@ -71,6 +77,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 			return fakeSentMessages
 		case gmetrics.ReceivedMessagesOpts.Name:
 			return fakeReceivedMessages
+		case gmetrics.QueueOverflowOpts.Name:
+			return fakeQueueOverflow
 		}
 		return nil
 	}
@@ -96,6 +104,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 			return fakePullDuration
 		case gmetrics.RetrieveDurationOpts.Name:
 			return fakeRetrieveDuration
+		case gmetrics.QueueLatencyOpts.Name:
+			return fakeQueueLatency
 		}
 		return nil
 	}
@@ -109,6 +119,8 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 			return fakeDeclarationGauge
 		case gmetrics.TotalOpts.Name:
 			return fakeTotalGauge
+		case gmetrics.QueueLengthOpts.Name:
+			return fakeQueueLength
 		}
 		return nil
 	}
@@ -122,6 +134,9 @@ func TestUtilConstructMetricProvider() *TestMetricProvider {
 		fakeSentMessages,
 		fakeBufferOverflow,
 		fakeReceivedMessages,
+		fakeQueueLength,
+		fakeQueueLatency,
+		fakeQueueOverflow,
 		fakeTotalGauge,
 		fakeValidationDuration,
 		fakeListMissingPrivateDataDuration,
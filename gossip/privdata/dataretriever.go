@@ -7,10 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package privdata
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/transientstore"
 	"github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/gossip/util"
+	fcommon "github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
 	"github.com/pkg/errors"
@@ -24,6 +27,7 @@ type StorageDataRetriever interface {
 }
 
 //go:generate mockery -dir . -name DataStore -case underscore -output mocks/
+//go:generate mockery -dir . -name ImplicitCollectionRetriever -case underscore -output mocks/
 //go:generate mockery -dir ../../core/transientstore/ -name RWSetScanner -case underscore -output mocks/
 //go:generate mockery -dir ../../core/ledger/ -name ConfigHistoryRetriever -case underscore -output mocks/
 
@@ -44,6 +48,16 @@ type DataStore interface {
 
 	// Get recent block sequence number
 	LedgerHeight() (uint64, error)
+
+	ImplicitCollectionRetriever
+}
+
+// ImplicitCollectionRetriever retrieves the configuration of the implicit collections of the organizations
+type ImplicitCollectionRetriever interface {
+	// RetrieveImplicitCollection returns the configuration of the implicit collection with the given name of
+	// the given chaincode. It returns nil if the chaincode is not deployed, or if the name is not the name of
+	// the implicit collection of one of the organizations of the channel, according to the state of the ledger
+	RetrieveImplicitCollection(chaincodeName, collectionName string) (*fcommon.StaticCollectionConfig, error)
 }
 
 type dataRetriever struct {
@@ -145,11 +159,19 @@ func (dr *dataRetriever) fromLedger(digests []*gossip2.PvtDataDigest, blockNum u
 				" collection name = <%s> for chaincode <%s>", dig.BlockSeq, dig.Collection, dig.Namespace)
 		}
 
-		if configInfo == nil {
+		var configPackage *fcommon.CollectionConfigPackage
+		if configInfo != nil {
+			configPackage = configInfo.CollectionConfig
+		}
+		configs, err := extractCollectionConfigOrImplicit(configPackage, dig.Namespace, dig.Collection, dr.store)
+		if err != nil {
+			return nil, errors.WithMessage(err, fmt.Sprintf("cannot retrieve the implicit collection <%s>"+
+				" namespace <%s> txID <%s>", dig.Collection, dig.Namespace, dig.TxId))
+		}
+		if configs == nil && configInfo == nil {
 			return nil, errors.Errorf("no collection config update below block sequence = <%d>"+
 				" collection name = <%s> for chaincode <%s> is available ", dig.BlockSeq, dig.Collection, dig.Namespace)
 		}
-		configs := extractCollectionConfig(configInfo.CollectionConfig, dig.Collection)
 		if configs == nil {
 			return nil, errors.Errorf("no collection config was found for collection <%s>"+
 				" namespace <%s> txID <%s>", dig.Collection, dig.Namespace, dig.TxId)
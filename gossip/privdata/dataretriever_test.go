@@ -10,10 +10,13 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/transientstore"
 	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
 	"github.com/hyperledger/fabric/gossip/privdata/mocks"
+	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/protos/common"
 	gossip2 "github.com/hyperledger/fabric/protos/gossip"
 	"github.com/hyperledger/fabric/protos/ledger/rwset"
@@ -167,6 +170,116 @@ func TestNewDataRetriever_GetDataFromLedger(t *testing.T) {
 	assertion.Equal([]byte{1, 2, 3, 4}, mergedRWSet)
 }
 
+func TestNewDataRetriever_GetImplicitCollectionDataFromLedger(t *testing.T) {
+	t.Parallel()
+	dataStore := &mocks.DataStore{}
+
+	namespace := "testChaincodeName1"
+	collectionName := "_implicit_org_Org1MSP"
+
+	result := []*ledger.TxPvtData{{
+		WriteSet: &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				pvtReadWriteSet(namespace, collectionName, []byte{1, 2}),
+			},
+		},
+		SeqInBlock: 1,
+	}}
+
+	dataStore.On("LedgerHeight").Return(uint64(10), nil)
+	dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).Return(result, nil)
+
+	// the chaincode does not define any collection explicitly
+	historyRetreiver := &mocks.ConfigHistoryRetriever{}
+	historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, namespace).Return(nil, nil)
+	dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
+	// the implicit collection is the one of an organization of the channel
+	dataStore.On("RetrieveImplicitCollection", namespace, collectionName).Return(privdata.GenerateImplicitCollectionForOrg("Org1MSP"), nil)
+
+	retriever := NewDataRetriever(dataStore)
+
+	dig := &gossip2.PvtDataDigest{
+		Namespace:  namespace,
+		Collection: collectionName,
+		BlockSeq:   uint64(5),
+		TxId:       "testTxID",
+		SeqInBlock: 1,
+	}
+	rwSets, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{dig}, uint64(5))
+
+	assertion := assert.New(t)
+	assertion.NoError(err)
+	pvtRWSet := rwSets[privdatacommon.DigKey{
+		Namespace:  namespace,
+		Collection: collectionName,
+		BlockSeq:   5,
+		TxId:       "testTxID",
+		SeqInBlock: 1,
+	}]
+	assertion.NotNil(pvtRWSet)
+	assertion.Equal([]util.PrivateRWSet{{1, 2}}, pvtRWSet.RWSet)
+	assertion.True(proto.Equal(privdata.GenerateImplicitCollectionForOrg("Org1MSP"), pvtRWSet.CollectionConfig.GetStaticCollectionConfig()))
+}
+
+func TestNewDataRetriever_FailGetUnknownImplicitCollectionDataFromLedger(t *testing.T) {
+	t.Parallel()
+	namespace := "testChaincodeName1"
+
+	for _, test := range []struct {
+		name           string
+		collectionName string
+		implicitConfig *common.StaticCollectionConfig
+		implicitErr    error
+		expectedErr    string
+	}{
+		{
+			// the organization is not in the channel, or the chaincode is not deployed
+			name:           "unknown implicit collection",
+			collectionName: "_implicit_org_Org9MSP",
+			expectedErr:    "no collection config was found for collection <_implicit_org_Org9MSP> namespace <testChaincodeName1> txID <testTxID>",
+		},
+		{
+			name:           "state unavailable",
+			collectionName: "_implicit_org_Org1MSP",
+			implicitErr:    errors.New("state unavailable"),
+			expectedErr:    "cannot retrieve the implicit collection <_implicit_org_Org1MSP> namespace <testChaincodeName1> txID <testTxID>: state unavailable",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			dataStore := &mocks.DataStore{}
+			dataStore.On("LedgerHeight").Return(uint64(10), nil)
+			dataStore.On("GetPvtDataByNum", uint64(5), mock.Anything).Return([]*ledger.TxPvtData{{
+				WriteSet: &rwset.TxPvtReadWriteSet{
+					DataModel:  rwset.TxReadWriteSet_KV,
+					NsPvtRwset: []*rwset.NsPvtReadWriteSet{pvtReadWriteSet(namespace, test.collectionName, []byte{1, 2})},
+				},
+				SeqInBlock: 1,
+			}}, nil)
+			historyRetreiver := &mocks.ConfigHistoryRetriever{}
+			historyRetreiver.On("MostRecentCollectionConfigBelow", mock.Anything, namespace).Return(&ledger.CollectionConfigInfo{
+				CollectionConfig:   &common.CollectionConfigPackage{},
+				CommittingBlockNum: 1,
+			}, nil)
+			dataStore.On("GetConfigHistoryRetriever").Return(historyRetreiver, nil)
+			dataStore.On("RetrieveImplicitCollection", namespace, test.collectionName).Return(test.implicitConfig, test.implicitErr)
+
+			retriever := NewDataRetriever(dataStore)
+			dig := &gossip2.PvtDataDigest{
+				Namespace:  namespace,
+				Collection: test.collectionName,
+				BlockSeq:   uint64(5),
+				TxId:       "testTxID",
+				SeqInBlock: 1,
+			}
+			rwSets, _, err := retriever.CollectionRWSet([]*gossip2.PvtDataDigest{dig}, uint64(5))
+			assert.EqualError(t, err, test.expectedErr)
+			assert.Nil(t, rwSets)
+		})
+	}
+}
+
 func TestNewDataRetriever_FailGetPvtDataFromLedger(t *testing.T) {
 	t.Parallel()
 	dataStore := &mocks.DataStore{}
@@ -2,6 +2,7 @@
 
 package mocks
 
+import common "github.com/hyperledger/fabric/protos/common"
 import ledger "github.com/hyperledger/fabric/core/ledger"
 import mock "github.com/stretchr/testify/mock"
 
@@ -101,3 +102,26 @@ func (_m *DataStore) LedgerHeight() (uint64, error) {
 
 	return r0, r1
 }
+
+// RetrieveImplicitCollection provides a mock function with given fields: chaincodeName, collectionName
+func (_m *DataStore) RetrieveImplicitCollection(chaincodeName string, collectionName string) (*common.StaticCollectionConfig, error) {
+	ret := _m.Called(chaincodeName, collectionName)
+
+	var r0 *common.StaticCollectionConfig
+	if rf, ok := ret.Get(0).(func(string, string) *common.StaticCollectionConfig); ok {
+		r0 = rf(chaincodeName, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.StaticCollectionConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(chaincodeName, collectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -32,3 +32,40 @@ func (_m *MissingPvtDataTracker) GetMissingPvtDataInfoForMostRecentBlocks(maxBlo
 
 	return r0, r1
 }
+
+// ListMissingPvtData provides a mock function with given fields: startBlock, endBlock
+func (_m *MissingPvtDataTracker) ListMissingPvtData(startBlock uint64, endBlock uint64) ([]*ledger.MissingPvtDataDetails, error) {
+	ret := _m.Called(startBlock, endBlock)
+
+	var r0 []*ledger.MissingPvtDataDetails
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []*ledger.MissingPvtDataDetails); ok {
+		r0 = rf(startBlock, endBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledger.MissingPvtDataDetails)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(startBlock, endBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkMissingPvtDataUnrecoverable provides a mock function with given fields: missingPvtDataInfo
+func (_m *MissingPvtDataTracker) MarkMissingPvtDataUnrecoverable(missingPvtDataInfo ledger.MissingPvtDataInfo) error {
+	ret := _m.Called(missingPvtDataInfo)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(ledger.MissingPvtDataInfo) error); ok {
+		r0 = rf(missingPvtDataInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -40,6 +40,31 @@ type PvtDataReconciler interface {
 	Start()
 	// Stop function stops reconciler
 	Stop()
+	// Status returns the outcome of the most recent reconciliation attempt, or nil if there was none
+	Status() *ReconciliationStatus
+	// ReconcileBlockRange reconciles immediately the eligible missing private data of the blocks
+	// within the range [startBlock, endBlock] and returns the number of reconciled items. The
+	// progress of the reconciliation is reported by Status while it is running
+	ReconcileBlockRange(startBlock, endBlock uint64) (int, error)
+	// MarkUnrecoverable marks the eligible missing private data of the blocks within the range
+	// [startBlock, endBlock] as unrecoverable so that it is no longer reconciled. An empty namespace
+	// (or collection) matches all the namespaces (or collections). The marked data is returned
+	MarkUnrecoverable(startBlock, endBlock uint64, namespace, collection string) ([]*ledger.MissingPvtDataDetails, error)
+}
+
+// ReconciliationStatus describes the outcome of a reconciliation attempt
+type ReconciliationStatus struct {
+	// StartTime is the time the attempt started at
+	StartTime time.Time
+	// Duration is the time it took to complete the attempt
+	Duration time.Duration
+	// Reconciled is the number of private data items that were reconciled
+	Reconciled int
+	// InProgress tells whether the attempt is still running, in which case Duration and
+	// Reconciled report its progress so far
+	InProgress bool
+	// Err is the error the attempt failed with, if any
+	Err error
 }
 
 type Reconciler struct {
@@ -48,9 +73,15 @@ type Reconciler struct {
 	config  *ReconcilerConfig
 	ReconciliationFetcher
 	committer.Committer
+	ImplicitCollectionRetriever
 	stopChan  chan struct{}
 	startOnce sync.Once
 	stopOnce  sync.Once
+	// lock serializes the reconciliation of the batches of missing private data, whether
+	// scheduled or requested explicitly, and the marking of missing private data as unrecoverable
+	lock       sync.Mutex
+	statusLock sync.RWMutex
+	status     *ReconciliationStatus
 }
 
 // NoOpReconciler non functional reconciler to be used
@@ -67,6 +98,18 @@ func (*NoOpReconciler) Stop() {
 	// do nothing
 }
 
+func (*NoOpReconciler) Status() *ReconciliationStatus {
+	return nil
+}
+
+func (*NoOpReconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	return 0, errors.New("private data reconciliation has been disabled")
+}
+
+func (*NoOpReconciler) MarkUnrecoverable(startBlock, endBlock uint64, namespace, collection string) ([]*ledger.MissingPvtDataDetails, error) {
+	return nil, errors.New("private data reconciliation has been disabled")
+}
+
 // ReconcilerConfig holds config flags that are read from core.yaml
 type ReconcilerConfig struct {
 	SleepInterval time.Duration
@@ -76,15 +119,16 @@ type ReconcilerConfig struct {
 
 // NewReconciler creates a new instance of reconciler
 func NewReconciler(channel string, metrics *metrics.PrivdataMetrics, c committer.Committer,
-	fetcher ReconciliationFetcher, config *ReconcilerConfig) *Reconciler {
+	fetcher ReconciliationFetcher, icr ImplicitCollectionRetriever, config *ReconcilerConfig) *Reconciler {
 	logger.Debug("Private data reconciliation is enabled")
 	return &Reconciler{
-		channel:               channel,
-		metrics:               metrics,
-		config:                config,
-		Committer:             c,
-		ReconciliationFetcher: fetcher,
-		stopChan:              make(chan struct{}),
+		channel:                     channel,
+		metrics:                     metrics,
+		config:                      config,
+		Committer:                   c,
+		ReconciliationFetcher:       fetcher,
+		ImplicitCollectionRetriever: icr,
+		stopChan:                    make(chan struct{}),
 	}
 }
 
@@ -115,16 +159,144 @@ func (r *Reconciler) run() {
 	}
 }
 
-// returns the number of items that were reconciled , minBlock, maxBlock (blocks range) and an error
+// Status returns the outcome of the most recent reconciliation attempt, or nil if there was none
+func (r *Reconciler) Status() *ReconciliationStatus {
+	r.statusLock.RLock()
+	defer r.statusLock.RUnlock()
+	if r.status == nil {
+		return nil
+	}
+	status := *r.status
+	if status.InProgress {
+		status.Duration = time.Since(status.StartTime)
+	}
+	return &status
+}
+
+func (r *Reconciler) setStatus(startTime time.Time, reconciled int, inProgress bool, err error) {
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	r.status = &ReconciliationStatus{
+		StartTime:  startTime,
+		Duration:   time.Since(startTime),
+		Reconciled: reconciled,
+		InProgress: inProgress,
+		Err:        err,
+	}
+}
+
+// startBlockRangeReconciliation reports that the reconciliation of a blocks range has started,
+// unless another one is still in progress
+func (r *Reconciler) startBlockRangeReconciliation(startTime time.Time) bool {
+	r.statusLock.Lock()
+	defer r.statusLock.Unlock()
+	if r.status != nil && r.status.InProgress {
+		return false
+	}
+	r.status = &ReconciliationStatus{StartTime: startTime, InProgress: true}
+	return true
+}
+
 func (r *Reconciler) reconcile() error {
+	// the progress of a blocks range reconciliation is not to be overwritten by the scheduled
+	// reconciliation, which resumes once the blocks range has been reconciled
+	if status := r.Status(); status != nil && status.InProgress {
+		logger.Debug("Skipping the scheduled reconciliation while a blocks range is being reconciled")
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	startTime := time.Now()
+	totalReconciled, err := r.reconcileMostRecentBlocks()
+	r.setStatus(startTime, totalReconciled, false, err)
+	return err
+}
+
+// ReconcileBlockRange reconciles immediately the eligible missing private data of the blocks
+// within the range [startBlock, endBlock] and returns the number of reconciled items. The lock
+// is held for one batch at a time, so that the scheduled reconciliation and the marking of
+// missing private data as unrecoverable are not blocked for the whole range
+func (r *Reconciler) ReconcileBlockRange(startBlock, endBlock uint64) (int, error) {
+	if startBlock > endBlock {
+		return 0, errors.Errorf("invalid block range [%d - %d]", startBlock, endBlock)
+	}
+	startTime := time.Now()
+	if !r.startBlockRangeReconciliation(startTime) {
+		return 0, errors.New("the reconciliation of another blocks range is in progress")
+	}
+	totalReconciled, err := r.reconcileBlockRange(startBlock, endBlock, func(reconciled int) {
+		r.setStatus(startTime, reconciled, true, nil)
+	})
+	r.setStatus(startTime, totalReconciled, false, err)
+	return totalReconciled, err
+}
+
+// MarkUnrecoverable marks the eligible missing private data of the blocks within the range
+// [startBlock, endBlock] as unrecoverable so that it is no longer reconciled
+func (r *Reconciler) MarkUnrecoverable(startBlock, endBlock uint64, namespace, collection string) ([]*ledger.MissingPvtDataDetails, error) {
+	if startBlock > endBlock {
+		return nil, errors.Errorf("invalid block range [%d - %d]", startBlock, endBlock)
+	}
+	// the reconciliation in progress must complete before the missing data is updated
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	missingPvtDataTracker, err := r.getMissingPvtDataTracker()
+	if err != nil {
+		return nil, err
+	}
+	missingPvtData, err := missingPvtDataTracker.ListMissingPvtData(startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	var marked []*ledger.MissingPvtDataDetails
+	missingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	for _, missing := range missingPvtData {
+		if !missing.IsEligible || missing.IsUnrecoverable {
+			continue
+		}
+		if (namespace != "" && missing.Namespace != namespace) || (collection != "" && missing.Collection != collection) {
+			continue
+		}
+		missingPvtDataInfo.Add(missing.BlockNum, missing.TxNum, missing.Namespace, missing.Collection)
+		marked = append(marked, &ledger.MissingPvtDataDetails{
+			BlockNum:        missing.BlockNum,
+			TxNum:           missing.TxNum,
+			Namespace:       missing.Namespace,
+			Collection:      missing.Collection,
+			IsEligible:      true,
+			IsUnrecoverable: true,
+		})
+	}
+	if len(marked) == 0 {
+		return nil, nil
+	}
+	if err := missingPvtDataTracker.MarkMissingPvtDataUnrecoverable(missingPvtDataInfo); err != nil {
+		return nil, err
+	}
+	logger.Infof("Marked %d missing private data items from blocks range [%d - %d] of channel %s as unrecoverable",
+		len(marked), startBlock, endBlock, r.channel)
+	return marked, nil
+}
+
+func (r *Reconciler) getMissingPvtDataTracker() (ledger.MissingPvtDataTracker, error) {
 	missingPvtDataTracker, err := r.GetMissingPvtDataTracker()
 	if err != nil {
 		logger.Error("reconciliation error when trying to get missingPvtDataTracker:", err)
-		return err
+		return nil, err
 	}
 	if missingPvtDataTracker == nil {
 		logger.Error("got nil as MissingPvtDataTracker, exiting...")
-		return errors.New("got nil as MissingPvtDataTracker, exiting...")
+		return nil, errors.New("got nil as MissingPvtDataTracker, exiting...")
+	}
+	return missingPvtDataTracker, nil
+}
+
+// returns the number of items that were reconciled and an error
+func (r *Reconciler) reconcileMostRecentBlocks() (int, error) {
+	missingPvtDataTracker, err := r.getMissingPvtDataTracker()
+	if err != nil {
+		return 0, err
 	}
 	totalReconciled, minBlock, maxBlock := 0, uint64(math.MaxUint64), uint64(0)
 
@@ -134,7 +306,7 @@ func (r *Reconciler) reconcile() error {
 		missingPvtDataInfo, err := missingPvtDataTracker.GetMissingPvtDataInfoForMostRecentBlocks(r.config.BatchSize)
 		if err != nil {
 			logger.Error("reconciliation error when trying to get missing pvt data info recent blocks:", err)
-			return err
+			return totalReconciled, err
 		}
 		// if missingPvtDataInfo is nil, len will return 0
 		if len(missingPvtDataInfo) == 0 {
@@ -143,37 +315,100 @@ func (r *Reconciler) reconcile() error {
 			} else {
 				logger.Debug("Reconciliation cycle finished successfully. no items to reconcile")
 			}
-			return nil
+			return totalReconciled, nil
 		}
 
 		logger.Debug("got from ledger", len(missingPvtDataInfo), "blocks with missing private data, trying to reconcile...")
 
-		dig2collectionCfg, minB, maxB := r.getDig2CollectionConfig(missingPvtDataInfo)
-		fetchedData, err := r.FetchReconciledItems(dig2collectionCfg)
+		reconciled, minB, maxB, err := r.reconcileMissingPvtData(missingPvtDataInfo)
 		if err != nil {
-			logger.Error("reconciliation error when trying to fetch missing items from different peers:", err)
-			return err
-		}
-		if len(fetchedData.AvailableElements) == 0 {
-			logger.Warning("missing private data is not available on other peers")
-			return nil
+			return totalReconciled, err
 		}
-
-		pvtDataToCommit := r.preparePvtDataToCommit(fetchedData.AvailableElements)
-		// commit missing private data that was reconciled and log mismatched
-		pvtdataHashMismatch, err := r.CommitPvtDataOfOldBlocks(pvtDataToCommit)
-		if err != nil {
-			return errors.Wrap(err, "failed to commit private data")
+		if reconciled == 0 {
+			return totalReconciled, nil
 		}
-		r.logMismatched(pvtdataHashMismatch)
 		if minB < minBlock {
 			minBlock = minB
 		}
 		if maxB > maxBlock {
 			maxBlock = maxB
 		}
-		totalReconciled += len(fetchedData.AvailableElements)
+		totalReconciled += reconciled
+	}
+}
+
+// reconcileBlockRange reconciles the eligible missing private data of the blocks within the
+// range [startBlock, endBlock], at most BatchSize blocks at a time, and returns the number of
+// items that were reconciled and an error. The number of items reconciled so far is passed to
+// reportProgress after every batch
+func (r *Reconciler) reconcileBlockRange(startBlock, endBlock uint64, reportProgress func(reconciled int)) (int, error) {
+	missingPvtDataTracker, err := r.getMissingPvtDataTracker()
+	if err != nil {
+		return 0, err
+	}
+	missingPvtData, err := missingPvtDataTracker.ListMissingPvtData(startBlock, endBlock)
+	if err != nil {
+		logger.Error("reconciliation error when trying to list missing pvt data of blocks range:", err)
+		return 0, err
+	}
+
+	defer r.reportReconciliationDuration(time.Now())
+
+	// the missing data is sorted by block number
+	var batches []ledger.MissingPvtDataInfo
+	for _, missing := range missingPvtData {
+		if !missing.IsEligible || missing.IsUnrecoverable {
+			continue
+		}
+		if len(batches) == 0 || (len(batches[len(batches)-1]) == r.config.BatchSize && batches[len(batches)-1][missing.BlockNum] == nil) {
+			batches = append(batches, make(ledger.MissingPvtDataInfo))
+		}
+		batches[len(batches)-1].Add(missing.BlockNum, missing.TxNum, missing.Namespace, missing.Collection)
 	}
+
+	totalReconciled := 0
+	for _, missingPvtDataInfo := range batches {
+		reconciled, err := r.reconcileBatch(missingPvtDataInfo)
+		if err != nil {
+			return totalReconciled, err
+		}
+		totalReconciled += reconciled
+		reportProgress(totalReconciled)
+	}
+	logger.Infof("Reconciliation of blocks range [%d - %d] finished successfully. reconciled %d private data keys", startBlock, endBlock, totalReconciled)
+	return totalReconciled, nil
+}
+
+// reconcileBatch reconciles a batch of the missing private data of a blocks range while holding the lock
+func (r *Reconciler) reconcileBatch(missingPvtDataInfo ledger.MissingPvtDataInfo) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	reconciled, _, _, err := r.reconcileMissingPvtData(missingPvtDataInfo)
+	return reconciled, err
+}
+
+// reconcileMissingPvtData fetches the given missing private data from the other peers and commits it.
+// It returns the number of items that were reconciled, minBlock, maxBlock (blocks range) and an error
+func (r *Reconciler) reconcileMissingPvtData(missingPvtDataInfo ledger.MissingPvtDataInfo) (int, uint64, uint64, error) {
+	dig2collectionCfg, minB, maxB := r.getDig2CollectionConfig(missingPvtDataInfo)
+	fetchedData, err := r.FetchReconciledItems(dig2collectionCfg)
+	if err != nil {
+		logger.Error("reconciliation error when trying to fetch missing items from different peers:", err)
+		return 0, 0, 0, err
+	}
+	if len(fetchedData.AvailableElements) == 0 {
+		logger.Warning("missing private data is not available on other peers")
+		return 0, 0, 0, nil
+	}
+
+	pvtDataToCommit := r.preparePvtDataToCommit(fetchedData.AvailableElements)
+	// commit missing private data that was reconciled and log mismatched
+	pvtdataHashMismatch, err := r.CommitPvtDataOfOldBlocks(pvtDataToCommit)
+	if err != nil {
+		return 0, 0, 0, errors.Wrap(err, "failed to commit private data")
+	}
+	r.logMismatched(pvtdataHashMismatch)
+	return len(fetchedData.AvailableElements), minB, maxB, nil
 }
 
 func (r *Reconciler) reportReconciliationDuration(startTime time.Time) {
@@ -236,11 +471,18 @@ func (r *Reconciler) getMostRecentCollectionConfig(chaincodeName string, collect
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("cannot find recent collection config update below block sequence = %d for chaincode %s", blockNum, chaincodeName))
 	}
-	if configInfo == nil {
-		return nil, errors.New(fmt.Sprintf("no collection config update below block sequence = %d for chaincode %s is available", blockNum, chaincodeName))
+	var configPackage *common.CollectionConfigPackage
+	if configInfo != nil {
+		configPackage = configInfo.CollectionConfig
 	}
 
-	collectionConfig := extractCollectionConfig(configInfo.CollectionConfig, collectionName)
+	collectionConfig, err := extractCollectionConfigOrImplicit(configPackage, chaincodeName, collectionName, r.ImplicitCollectionRetriever)
+	if err != nil {
+		return nil, errors.WithMessage(err, fmt.Sprintf("cannot retrieve the implicit collection %s for chaincode %s", collectionName, chaincodeName))
+	}
+	if collectionConfig == nil && configInfo == nil {
+		return nil, errors.New(fmt.Sprintf("no collection config update below block sequence = %d for chaincode %s is available", blockNum, chaincodeName))
+	}
 	if collectionConfig == nil {
 		return nil, errors.New(fmt.Sprintf("no collection config was found for collection %s for chaincode %s", collectionName, chaincodeName))
 	}
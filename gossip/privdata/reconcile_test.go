@@ -14,6 +14,7 @@ import (
 
 	"github.com/hyperledger/fabric/common/metrics/disabled"
 	util2 "github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/gossip/metrics"
 	gmetricsmocks "github.com/hyperledger/fabric/gossip/metrics/mocks"
@@ -247,7 +248,7 @@ func TestReconciliationHappyPathWithScheduler(t *testing.T) {
 		wg.Done()
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
 
-	r := NewReconciler("", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher,
+	r := NewReconciler("", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
 		&ReconcilerConfig{SleepInterval: time.Millisecond * 100, BatchSize: 1, IsEnabled: true})
 	r.Start()
 	wg.Wait()
@@ -357,7 +358,7 @@ func TestReconciliationPullingMissingPrivateDataAtOnePass(t *testing.T) {
 		wg.Done()
 	}).Return([]*ledger.PvtdataHashMismatch{}, nil)
 
-	r := NewReconciler("", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher,
+	r := NewReconciler("", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
 		&ReconcilerConfig{SleepInterval: time.Millisecond * 100, BatchSize: 1, IsEnabled: true})
 	r.Start()
 	<-stopC
@@ -452,7 +453,7 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 	fetcher := &mocks.ReconciliationFetcher{}
 	committer.On("GetMissingPvtDataTracker").Return(nil, errors.New("failed to obtain missing pvt data tracker"))
 
-	r := NewReconciler("", metrics, committer, fetcher,
+	r := NewReconciler("", metrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
 		&ReconcilerConfig{SleepInterval: time.Millisecond * 100, BatchSize: 1, IsEnabled: true})
 	err := r.reconcile()
 	assert.Error(t, err)
@@ -460,7 +461,7 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 
 	committer.Mock = mock.Mock{}
 	committer.On("GetMissingPvtDataTracker").Return(nil, nil)
-	r = NewReconciler("", metrics, committer, fetcher,
+	r = NewReconciler("", metrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
 		&ReconcilerConfig{SleepInterval: time.Millisecond * 100, BatchSize: 1, IsEnabled: true})
 	err = r.reconcile()
 	assert.Error(t, err)
@@ -471,9 +472,183 @@ func TestFailuresWhileReconcilingMissingPvtData(t *testing.T) {
 
 	committer.Mock = mock.Mock{}
 	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
-	r = NewReconciler("", metrics, committer, fetcher,
+	r = NewReconciler("", metrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
 		&ReconcilerConfig{SleepInterval: time.Millisecond * 100, BatchSize: 1, IsEnabled: true})
 	err = r.reconcile()
 	assert.Error(t, err)
 	assert.Contains(t, "failed get missing pvt data for recent blocks", err.Error())
 }
+
+func TestReconcileImplicitCollections(t *testing.T) {
+	// Scenario: the missing private data of implicit collections is reconciled only if the collection is
+	// the implicit collection of an organization of the channel and the chaincode is deployed
+	committer := &mocks.Committer{}
+	configHistoryRetriever := &mocks.ConfigHistoryRetriever{}
+	implicitCollectionRetriever := &mocks.ImplicitCollectionRetriever{}
+	configHistoryRetriever.On("MostRecentCollectionConfigBelow", mock.Anything, mock.Anything).Return(nil, nil)
+	committer.On("GetConfigHistoryRetriever").Return(configHistoryRetriever, nil)
+	org1Collection := privdata.GenerateImplicitCollectionForOrg("Org1MSP")
+	implicitCollectionRetriever.On("RetrieveImplicitCollection", "ns1", "_implicit_org_Org1MSP").Return(org1Collection, nil)
+	implicitCollectionRetriever.On("RetrieveImplicitCollection", "ns1", "_implicit_org_Org9MSP").Return(nil, nil)
+	implicitCollectionRetriever.On("RetrieveImplicitCollection", "ns2", "_implicit_org_Org1MSP").Return(nil, errors.New("state unavailable"))
+
+	r := NewReconciler("mychannel", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, &mocks.ReconciliationFetcher{},
+		implicitCollectionRetriever, &ReconcilerConfig{SleepInterval: time.Minute, BatchSize: 1, IsEnabled: true})
+
+	missingPvtDataInfo := make(ledger.MissingPvtDataInfo)
+	missingPvtDataInfo.Add(2, 1, "ns1", "_implicit_org_Org1MSP")
+	missingPvtDataInfo.Add(2, 2, "ns1", "_implicit_org_Org9MSP")
+	missingPvtDataInfo.Add(2, 3, "ns2", "_implicit_org_Org1MSP")
+	dig2CollectionConfig, _, _ := r.getDig2CollectionConfig(missingPvtDataInfo)
+	assert.Equal(t, privdatacommon.Dig2CollectionConfig{
+		{Namespace: "ns1", Collection: "_implicit_org_Org1MSP", BlockSeq: 2, SeqInBlock: 1}: org1Collection,
+	}, dig2CollectionConfig)
+	implicitCollectionRetriever.AssertExpectations(t)
+}
+
+func TestReconcileBlockRange(t *testing.T) {
+	// Scenario: the eligible missing private data of a range of blocks is reconciled on demand, one block at a time.
+	// The ineligible and the unrecoverable missing private data is skipped, the progress is reported by Status
+	// while the reconciliation is running and the outcome once it is over.
+	committer := &mocks.Committer{}
+	fetcher := &mocks.ReconciliationFetcher{}
+	configHistoryRetriever := &mocks.ConfigHistoryRetriever{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+
+	missingPvtDataTracker.On("ListMissingPvtData", uint64(2), uint64(5)).Return([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "ns1", Collection: "col1", IsEligible: true},
+		{BlockNum: 2, TxNum: 2, Namespace: "ns1", Collection: "col2"},
+		{BlockNum: 3, TxNum: 0, Namespace: "ns1", Collection: "col1", IsEligible: true, IsUnrecoverable: true},
+		{BlockNum: 4, TxNum: 0, Namespace: "ns1", Collection: "col1", IsEligible: true},
+		{BlockNum: 4, TxNum: 3, Namespace: "ns1", Collection: "col1", IsEligible: true},
+	}, nil)
+	collectionConfigInfo := ledger.CollectionConfigInfo{
+		CollectionConfig: &common.CollectionConfigPackage{
+			Config: []*common.CollectionConfig{
+				{Payload: &common.CollectionConfig_StaticCollectionConfig{
+					StaticCollectionConfig: &common.StaticCollectionConfig{
+						Name: "col1",
+					},
+				}},
+			},
+		},
+		CommittingBlockNum: 1,
+	}
+	configHistoryRetriever.On("MostRecentCollectionConfigBelow", mock.Anything, mock.Anything).Return(&collectionConfigInfo, nil)
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	committer.On("GetConfigHistoryRetriever").Return(configHistoryRetriever, nil)
+
+	var fetchedDigests [][]privdatacommon.DigKey
+	fetcher.On("FetchReconciledItems", mock.Anything).Return(func(dig2CollectionConfig privdatacommon.Dig2CollectionConfig) *privdatacommon.FetchedPvtDataContainer {
+		result := &privdatacommon.FetchedPvtDataContainer{}
+		var digests []privdatacommon.DigKey
+		for digest := range dig2CollectionConfig {
+			digests = append(digests, digest)
+			result.AvailableElements = append(result.AvailableElements, &gossip2.PvtDataElement{
+				Digest: &gossip2.PvtDataDigest{
+					BlockSeq:   digest.BlockSeq,
+					Collection: digest.Collection,
+					Namespace:  digest.Namespace,
+					SeqInBlock: digest.SeqInBlock,
+				},
+				Payload: [][]byte{[]byte("rws-pre-image")},
+			})
+		}
+		fetchedDigests = append(fetchedDigests, digests)
+		return result
+	}, nil)
+	var committedBlocks []uint64
+	var progress []ReconciliationStatus
+	var r *Reconciler
+	committer.On("CommitPvtDataOfOldBlocks", mock.Anything).Run(func(args mock.Arguments) {
+		for _, blockPvtData := range args.Get(0).([]*ledger.BlockPvtData) {
+			committedBlocks = append(committedBlocks, blockPvtData.BlockNum)
+		}
+		progress = append(progress, *r.Status())
+		// neither another blocks range nor the scheduled reconciliation are reconciled meanwhile
+		_, err := r.ReconcileBlockRange(0, 1)
+		assert.EqualError(t, err, "the reconciliation of another blocks range is in progress")
+		assert.NoError(t, r.reconcile())
+	}).Return(nil, nil)
+
+	r = NewReconciler("mychannel", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, fetcher, &mocks.ImplicitCollectionRetriever{},
+		&ReconcilerConfig{SleepInterval: time.Minute, BatchSize: 1, IsEnabled: true})
+	assert.Nil(t, r.Status())
+
+	_, err := r.ReconcileBlockRange(5, 2)
+	assert.EqualError(t, err, "invalid block range [5 - 2]")
+
+	reconciled, err := r.ReconcileBlockRange(2, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, reconciled)
+	assert.Len(t, fetchedDigests, 2)
+	assert.Equal(t, []privdatacommon.DigKey{{Namespace: "ns1", Collection: "col1", BlockSeq: 2, SeqInBlock: 1}}, fetchedDigests[0])
+	assert.Len(t, fetchedDigests[1], 2)
+	assert.Equal(t, []uint64{2, 4}, committedBlocks)
+	assert.Len(t, progress, 2)
+	assert.True(t, progress[0].InProgress)
+	assert.Equal(t, 0, progress[0].Reconciled)
+	assert.True(t, progress[1].InProgress)
+	assert.Equal(t, 1, progress[1].Reconciled)
+	status := r.Status()
+	assert.NotNil(t, status)
+	assert.False(t, status.InProgress)
+	assert.Equal(t, 3, status.Reconciled)
+	assert.NoError(t, status.Err)
+	missingPvtDataTracker.AssertNotCalled(t, "GetMissingPvtDataInfoForMostRecentBlocks", mock.Anything)
+
+	missingPvtDataTracker.On("ListMissingPvtData", uint64(6), uint64(6)).Return(nil, errors.New("failed listing"))
+	_, err = r.ReconcileBlockRange(6, 6)
+	assert.EqualError(t, err, "failed listing")
+	assert.EqualError(t, r.Status().Err, "failed listing")
+	assert.Equal(t, 0, r.Status().Reconciled)
+}
+
+func TestMarkUnrecoverable(t *testing.T) {
+	committer := &mocks.Committer{}
+	missingPvtDataTracker := &mocks.MissingPvtDataTracker{}
+	committer.On("GetMissingPvtDataTracker").Return(missingPvtDataTracker, nil)
+	missingPvtDataTracker.On("ListMissingPvtData", uint64(0), uint64(10)).Return([]*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "ns1", Collection: "col1", IsEligible: true},
+		{BlockNum: 2, TxNum: 2, Namespace: "ns1", Collection: "col2", IsEligible: true},
+		{BlockNum: 3, TxNum: 0, Namespace: "ns1", Collection: "col1"},
+		{BlockNum: 3, TxNum: 1, Namespace: "ns1", Collection: "col1", IsEligible: true, IsUnrecoverable: true},
+		{BlockNum: 4, TxNum: 0, Namespace: "ns2", Collection: "col1", IsEligible: true},
+		{BlockNum: 4, TxNum: 3, Namespace: "ns1", Collection: "col1", IsEligible: true},
+	}, nil)
+	var markedInfo ledger.MissingPvtDataInfo
+	missingPvtDataTracker.On("MarkMissingPvtDataUnrecoverable", mock.Anything).Run(func(args mock.Arguments) {
+		markedInfo = args.Get(0).(ledger.MissingPvtDataInfo)
+	}).Return(nil)
+
+	r := NewReconciler("mychannel", metrics.NewGossipMetrics(&disabled.Provider{}).PrivdataMetrics, committer, &mocks.ReconciliationFetcher{}, &mocks.ImplicitCollectionRetriever{},
+		&ReconcilerConfig{SleepInterval: time.Minute, BatchSize: 1, IsEnabled: true})
+
+	marked, err := r.MarkUnrecoverable(0, 10, "ns1", "col1")
+	assert.NoError(t, err)
+	assert.Equal(t, []*ledger.MissingPvtDataDetails{
+		{BlockNum: 2, TxNum: 1, Namespace: "ns1", Collection: "col1", IsEligible: true, IsUnrecoverable: true},
+		{BlockNum: 4, TxNum: 3, Namespace: "ns1", Collection: "col1", IsEligible: true, IsUnrecoverable: true},
+	}, marked)
+	expectedInfo := make(ledger.MissingPvtDataInfo)
+	expectedInfo.Add(2, 1, "ns1", "col1")
+	expectedInfo.Add(4, 3, "ns1", "col1")
+	assert.Equal(t, expectedInfo, markedInfo)
+
+	marked, err = r.MarkUnrecoverable(0, 10, "ns3", "")
+	assert.NoError(t, err)
+	assert.Empty(t, marked)
+	missingPvtDataTracker.AssertNumberOfCalls(t, "MarkMissingPvtDataUnrecoverable", 1)
+
+	_, err = r.MarkUnrecoverable(10, 0, "", "")
+	assert.EqualError(t, err, "invalid block range [10 - 0]")
+}
+
+func TestNoOpReconciler(t *testing.T) {
+	r := &NoOpReconciler{}
+	assert.Nil(t, r.Status())
+	_, err := r.ReconcileBlockRange(0, 10)
+	assert.EqualError(t, err, "private data reconciliation has been disabled")
+	_, err = r.MarkUnrecoverable(0, 10, "", "")
+	assert.EqualError(t, err, "private data reconciliation has been disabled")
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/common/privdata"
 	"github.com/hyperledger/fabric/core/ledger"
 	"github.com/hyperledger/fabric/core/ledger/kvledger/txmgmt/rwsetutil"
 	privdatacommon "github.com/hyperledger/fabric/gossip/privdata/common"
@@ -242,6 +243,31 @@ func extractCollectionConfig(configPackage *common.CollectionConfigPackage, coll
 	return nil
 }
 
+// extractCollectionConfigOrImplicit returns the configuration of the given collection of the given chaincode
+// from the given package, which may be nil. When the collection is not defined in the package, the configuration
+// of the implicit collection with the same name is returned, if the chaincode is deployed and the collection is
+// the implicit collection of an organization of the channel
+func extractCollectionConfigOrImplicit(configPackage *common.CollectionConfigPackage, chaincodeName, collectionName string,
+	icr ImplicitCollectionRetriever) (*common.CollectionConfig, error) {
+	if configPackage != nil {
+		if config := extractCollectionConfig(configPackage, collectionName); config != nil {
+			return config, nil
+		}
+	}
+	if isImplicit, _ := privdata.MSPIDIfImplicitCollection(collectionName); !isImplicit {
+		return nil, nil
+	}
+	implicitCollection, err := icr.RetrieveImplicitCollection(chaincodeName, collectionName)
+	if err != nil || implicitCollection == nil {
+		return nil, err
+	}
+	return &common.CollectionConfig{
+		Payload: &common.CollectionConfig_StaticCollectionConfig{
+			StaticCollectionConfig: implicitCollection,
+		},
+	}, nil
+}
+
 type pvtDataFactory struct {
 	data []*ledger.TxPvtData
 }
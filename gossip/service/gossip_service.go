@@ -28,6 +28,7 @@ import (
 	"github.com/hyperledger/fabric/gossip/util"
 	"github.com/hyperledger/fabric/protos/common"
 	gproto "github.com/hyperledger/fabric/protos/gossip"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
 	"github.com/hyperledger/fabric/protos/transientstore"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -54,6 +55,8 @@ type GossipService interface {
 	InitializeChannel(chainID string, oac OrdererAddressConfig, support Support)
 	// AddPayload appends message payload to for given chain
 	AddPayload(chainID string, payload *gproto.Payload) error
+	// PvtDataReconciler returns the reconciler of the missing private data of the given chain
+	PvtDataReconciler(chainID string) (privdata2.PvtDataReconciler, error)
 }
 
 // DeliveryServiceFactory factory to create and initialize delivery service instance
@@ -69,11 +72,12 @@ type deliveryFactoryImpl struct {
 // Returns an instance of delivery client
 func (d *deliveryFactoryImpl) Service(g GossipService, ec OrdererAddressConfig, mcs api.MessageCryptoService) (deliverclient.DeliverService, error) {
 	return deliverclient.NewDeliverService(&deliverclient.Config{
-		IsStaticLeader: d.isStaticLeader,
-		CryptoSvc:      mcs,
-		Gossip:         g,
-		ConnFactory:    deliverclient.DefaultConnectionFactory,
-		ABCFactory:     deliverclient.DefaultABCFactory,
+		IsStaticLeader:         d.isStaticLeader,
+		CryptoSvc:              mcs,
+		Gossip:                 g,
+		ConnFactory:            deliverclient.DefaultConnectionFactory,
+		ABCFactory:             deliverclient.DefaultABCFactory,
+		MonitorBlockCensorship: ec.ConsensusType == bft.TypeKey,
 	}, deliverclient.ConnectionCriteria{
 		OrdererEndpointsByOrg:    ec.AddressesByOrg,
 		Organizations:            ec.Organizations,
@@ -83,11 +87,13 @@ func (d *deliveryFactoryImpl) Service(g GossipService, ec OrdererAddressConfig,
 }
 
 // OrdererAddressConfig defines the addresses of the ordering service nodes
+// along with the consensus type of the ordering service
 type OrdererAddressConfig struct {
 	Addresses        []string
 	AddressesByOrg   map[string][]string
 	Organizations    []string
 	AddressOverrides map[string]*comm.OrdererEndpoint
+	ConsensusType    string
 }
 
 type privateHandler struct {
@@ -246,6 +252,17 @@ func (g *gossipServiceImpl) DistributePrivateData(chainID string, txID string, p
 	return nil
 }
 
+// PvtDataReconciler returns the reconciler of the missing private data of the given chain
+func (g *gossipServiceImpl) PvtDataReconciler(chainID string) (privdata2.PvtDataReconciler, error) {
+	g.lock.RLock()
+	handler, exists := g.privateHandlers[chainID]
+	g.lock.RUnlock()
+	if !exists {
+		return nil, errors.Errorf("No private data handler for %s", chainID)
+	}
+	return handler.reconciler, nil
+}
+
 // NewConfigEventer creates a ConfigProcessor which the channelconfig.BundleSource can ultimately route config updates to
 func (g *gossipServiceImpl) NewConfigEventer() ConfigProcessor {
 	return newConfigEventer(g)
@@ -260,6 +277,7 @@ type Support struct {
 	Cs                   privdata.CollectionStore
 	IdDeserializeFactory privdata2.IdentityDeserializerFactory
 	CapabilityProvider   privdata2.CapabilityProvider
+	ImplicitCollections  privdata2.ImplicitCollectionRetriever
 }
 
 // DataStoreSupport aggregates interfaces capable
@@ -267,6 +285,7 @@ type Support struct {
 type DataStoreSupport struct {
 	committer.Committer
 	privdata2.TransientStore
+	privdata2.ImplicitCollectionRetriever
 }
 
 // InitializeChannel allocates the state provider and should be invoked once per channel per execution
@@ -281,8 +300,9 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, oac OrdererAddress
 	// DataStore interface to capture ability of retrieving
 	// private data
 	storeSupport := &DataStoreSupport{
-		TransientStore: support.Store,
-		Committer:      support.Committer,
+		TransientStore:              support.Store,
+		Committer:                   support.Committer,
+		ImplicitCollectionRetriever: support.ImplicitCollections,
 	}
 	// Initialize private data fetcher
 	dataRetriever := privdata2.NewDataRetriever(storeSupport)
@@ -313,7 +333,7 @@ func (g *gossipServiceImpl) InitializeChannel(chainID string, oac OrdererAddress
 
 	if reconcilerConfig.IsEnabled {
 		reconciler = privdata2.NewReconciler(chainID, g.metrics.PrivdataMetrics,
-			support.Committer, fetcher, reconcilerConfig)
+			support.Committer, fetcher, support.ImplicitCollections, reconcilerConfig)
 	} else {
 		reconciler = &privdata2.NoOpReconciler{}
 	}
@@ -159,6 +159,11 @@ func TestLeaderElectionWithDeliverClient(t *testing.T) {
 		assert.True(t, exist, "Leader election service should be created for peer %d and channel %s", i, channelName)
 		services[i] = &electionService{nil, false, 0}
 		services[i].LeaderElectionService = service
+		reconciler, err := gossips[i].PvtDataReconciler(channelName)
+		assert.NoError(t, err)
+		assert.NotNil(t, reconciler)
+		_, err = gossips[i].PvtDataReconciler("nonexistent")
+		assert.EqualError(t, err, "No private data handler for nonexistent")
 	}
 
 	// Is single leader was elected.
@@ -350,6 +355,10 @@ func (li *mockLedgerInfo) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetri
 	panic("implement me")
 }
 
+func (li *mockLedgerInfo) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	panic("implement me")
+}
+
 func (li *mockLedgerInfo) GetMissingPvtDataTracker() (ledger.MissingPvtDataTracker, error) {
 	panic("implement me")
 }
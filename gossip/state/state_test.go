@@ -289,6 +289,10 @@ func (mock *ramLedger) GetConfigHistoryRetriever() (ledger.ConfigHistoryRetrieve
 	panic("implement me")
 }
 
+func (mock *ramLedger) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	panic("implement me")
+}
+
 func (mock *ramLedger) GetPvtDataAndBlockByNum(blockNum uint64, filter ledger.PvtNsCollFilter) (*ledger.BlockAndPvtData, error) {
 	mock.RLock()
 	defer mock.RUnlock()
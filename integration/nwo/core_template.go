@@ -61,6 +61,9 @@ peer:
     pvtData:
       pullRetryThreshold: 60s
       transientstoreMaxBlockRetention: 1000
+      transientstoreMaxAge: 0s
+      transientstorePurgeInterval: 1m
+      transientstoreEncryptionEnabled: false
       pushAckTimeout: 3s
       reconcileBatchSize: 10
       reconcileSleepInterval: 10s
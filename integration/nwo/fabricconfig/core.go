@@ -106,6 +106,9 @@ type GossipElection struct {
 type GossipPvtData struct {
 	PullRetryThreshold              time.Duration `yaml:"pullRetryThreshold,omitempty"`
 	TransientstoreMaxBlockRetention int           `yaml:"transientstoreMaxBlockRetention,omitempty"`
+	TransientstoreMaxAge            time.Duration `yaml:"transientstoreMaxAge,omitempty"`
+	TransientstorePurgeInterval     time.Duration `yaml:"transientstorePurgeInterval,omitempty"`
+	TransientstoreEncryptionEnabled bool          `yaml:"transientstoreEncryptionEnabled,omitempty"`
 	PushAckTimeout                  time.Duration `yaml:"pushAckTimeout,omitempty"`
 }
 
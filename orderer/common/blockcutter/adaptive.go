@@ -0,0 +1,119 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockcutter
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	ab "github.com/hyperledger/fabric/protos/orderer"
+)
+
+// smoothingFactor is the weight given to the latest observation by the
+// exponentially weighted moving averages of adaptive batching.
+const smoothingFactor = 0.2
+
+// CommitLatencyReporter is implemented by receivers which take the commit
+// latency of blocks into account when cutting batches. The block writer of
+// the channel reports the latency of each block it commits to the ledger of
+// the orderer; peers do not report their own commit latency to the orderer.
+type CommitLatencyReporter interface {
+	// ReportCommitLatency records the time it took to commit a recent block
+	ReportCommitLatency(latency time.Duration)
+}
+
+// adaptiveBatcher estimates the arrival rate of messages and the commit latency
+// of blocks, and derives from them the number of messages at which a batch
+// should be cut.
+type adaptiveBatcher struct {
+	lastArrival  time.Time
+	intervals    int
+	meanInterval float64 // seconds between two messages
+
+	targetLatencyString string
+	targetLatency       time.Duration
+
+	mutex         sync.Mutex
+	commitLatency time.Duration
+}
+
+// observeArrival records the arrival of a message at the given time.
+func (a *adaptiveBatcher) observeArrival(now time.Time) {
+	if !a.lastArrival.IsZero() {
+		interval := now.Sub(a.lastArrival).Seconds()
+		if a.intervals == 0 {
+			a.meanInterval = interval
+		} else {
+			a.meanInterval = smoothingFactor*interval + (1-smoothingFactor)*a.meanInterval
+		}
+		a.intervals++
+	}
+	a.lastArrival = now
+}
+
+// arrivalRate returns the estimated number of messages per second, or 0 when
+// too few messages arrived to estimate it.
+func (a *adaptiveBatcher) arrivalRate() float64 {
+	if a.intervals == 0 {
+		return 0
+	}
+	if a.meanInterval <= 0 {
+		// Messages keep arriving at the very same instant
+		return math.Inf(1)
+	}
+	return 1 / a.meanInterval
+}
+
+// reportCommitLatency records the commit latency of a recent block.
+func (a *adaptiveBatcher) reportCommitLatency(latency time.Duration) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.commitLatency == 0 {
+		a.commitLatency = latency
+		return
+	}
+	a.commitLatency = time.Duration(smoothingFactor*float64(latency) + (1-smoothingFactor)*float64(a.commitLatency))
+}
+
+func (a *adaptiveBatcher) latency() time.Duration {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.commitLatency
+}
+
+// fillWindow returns the time a batch should take to fill. Cutting batches
+// faster than blocks are committed only queues them up, so the window is never
+// shorter than the commit latency.
+func (a *adaptiveBatcher) fillWindow(config *ab.AdaptiveBatching) time.Duration {
+	if config.TargetLatency != a.targetLatencyString {
+		a.targetLatencyString = config.TargetLatency
+		var err error
+		if a.targetLatency, err = time.ParseDuration(config.TargetLatency); err != nil {
+			logger.Warningf("Invalid adaptive batching target latency %s: %s", config.TargetLatency, err)
+			a.targetLatency = 0
+		}
+	}
+	if commitLatency := a.latency(); commitLatency > a.targetLatency {
+		return commitLatency
+	}
+	return a.targetLatency
+}
+
+// targetMessageCount returns the number of messages expected to arrive within
+// the fill window, bounded by the configured minimum and maxMessageCount.
+func (a *adaptiveBatcher) targetMessageCount(config *ab.AdaptiveBatching, window time.Duration, maxMessageCount uint32) uint32 {
+	expected := a.arrivalRate() * window.Seconds()
+	switch {
+	case expected < float64(config.MinMessageCount):
+		return config.MinMessageCount
+	case expected >= float64(maxMessageCount):
+		return maxMessageCount
+	default:
+		return uint32(math.Ceil(expected))
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockcutter
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveBatcherArrivalRate(t *testing.T) {
+	a := &adaptiveBatcher{}
+	assert.Equal(t, float64(0), a.arrivalRate())
+
+	start := time.Now()
+	a.observeArrival(start)
+	assert.Equal(t, float64(0), a.arrivalRate())
+
+	a.observeArrival(start.Add(100 * time.Millisecond))
+	assert.InDelta(t, 10, a.arrivalRate(), 0.001)
+
+	// The mean interval moves a fifth of the way towards the latest one
+	a.observeArrival(start.Add(200 * time.Millisecond).Add(100 * time.Millisecond))
+	assert.InDelta(t, 1/0.12, a.arrivalRate(), 0.001)
+
+	a = &adaptiveBatcher{}
+	a.observeArrival(start)
+	a.observeArrival(start)
+	assert.True(t, math.IsInf(a.arrivalRate(), 1))
+}
+
+func TestAdaptiveBatcherFillWindow(t *testing.T) {
+	a := &adaptiveBatcher{}
+	config := &ab.AdaptiveBatching{MinMessageCount: 1, TargetLatency: "500ms"}
+	assert.Equal(t, 500*time.Millisecond, a.fillWindow(config))
+
+	a.reportCommitLatency(200 * time.Millisecond)
+	assert.Equal(t, 500*time.Millisecond, a.fillWindow(config))
+
+	a.reportCommitLatency(2200 * time.Millisecond)
+	assert.Equal(t, 600*time.Millisecond, a.latency())
+	assert.Equal(t, 600*time.Millisecond, a.fillWindow(config))
+
+	config.TargetLatency = "1s"
+	assert.Equal(t, time.Second, a.fillWindow(config))
+
+	config.TargetLatency = "bogus"
+	assert.Equal(t, 600*time.Millisecond, a.fillWindow(config))
+}
+
+func TestAdaptiveBatcherTargetMessageCount(t *testing.T) {
+	config := &ab.AdaptiveBatching{MinMessageCount: 5, TargetLatency: "1s"}
+	a := &adaptiveBatcher{}
+	assert.Equal(t, uint32(5), a.targetMessageCount(config, time.Second, 100))
+
+	start := time.Now()
+	a.observeArrival(start)
+	a.observeArrival(start.Add(40 * time.Millisecond))
+	assert.Equal(t, uint32(25), a.targetMessageCount(config, time.Second, 100))
+	assert.Equal(t, uint32(5), a.targetMessageCount(config, 100*time.Millisecond, 100))
+	assert.Equal(t, uint32(100), a.targetMessageCount(config, 10*time.Second, 100))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/flogging"
 	cb "github.com/hyperledger/fabric/protos/common"
+	ab "github.com/hyperledger/fabric/protos/orderer"
 )
 
 var logger = flogging.MustGetLogger("orderer.common.blockcutter")
@@ -39,6 +40,8 @@ type receiver struct {
 	PendingBatchStartTime time.Time
 	ChannelID             string
 	Metrics               *Metrics
+
+	adaptive *adaptiveBatcher
 }
 
 // NewReceiverImpl creates a Receiver implementation based on the given configtxorderer manager
@@ -47,6 +50,7 @@ func NewReceiverImpl(channelID string, sharedConfigFetcher OrdererConfigFetcher,
 		sharedConfigFetcher: sharedConfigFetcher,
 		Metrics:             metrics,
 		ChannelID:           channelID,
+		adaptive:            &adaptiveBatcher{},
 	}
 }
 
@@ -58,6 +62,8 @@ func NewReceiverImpl(channelID string, sharedConfigFetcher OrdererConfigFetcher,
 //   - no batch is cut and there are messages pending
 // messageBatches length: 1, pending: false
 //   - the message count reaches BatchSize.MaxMessageCount
+//   - adaptive batching is enabled, and the message count reaches its target
+//     or the pending batch has been filling for longer than its fill window
 // messageBatches length: 1, pending: true
 //   - the current message will cause the pending batch size in bytes to exceed BatchSize.PreferredMaxBytes.
 // messageBatches length: 2, pending: false
@@ -67,9 +73,10 @@ func NewReceiverImpl(channelID string, sharedConfigFetcher OrdererConfigFetcher,
 //
 // Note that messageBatches can not be greater than 2.
 func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, pending bool) {
+	now := time.Now()
 	if len(r.pendingBatch) == 0 {
 		// We are beginning a new batch, mark the time
-		r.PendingBatchStartTime = time.Now()
+		r.PendingBatchStartTime = now
 	}
 
 	ordererConfig, ok := r.sharedConfigFetcher.OrdererConfig()
@@ -78,6 +85,9 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 	}
 
 	batchSize := ordererConfig.BatchSize()
+	if batchSize.Adaptive != nil {
+		r.adaptive.observeArrival(now)
+	}
 
 	messageSizeBytes := messageSizeBytes(msg)
 	if messageSizeBytes > batchSize.PreferredMaxBytes {
@@ -118,11 +128,48 @@ func (r *receiver) Ordered(msg *cb.Envelope) (messageBatches [][]*cb.Envelope, p
 		messageBatch := r.Cut()
 		messageBatches = append(messageBatches, messageBatch)
 		pending = false
+		return
+	}
+
+	if batchSize.Adaptive != nil {
+		if reason := r.adaptiveCutReason(batchSize, now); reason != "" {
+			logger.Debugf("Adaptive batching cutting batch of %d messages, reason: %s", len(r.pendingBatch), reason)
+			r.Metrics.AdaptiveBlocksCut.With("channel", r.ChannelID, "reason", reason).Add(1)
+			messageBatch := r.Cut()
+			messageBatches = append(messageBatches, messageBatch)
+			pending = false
+		}
 	}
 
 	return
 }
 
+// adaptiveCutReason returns why adaptive batching cuts the pending batch, or an
+// empty string if the batch should keep filling.
+func (r *receiver) adaptiveCutReason(batchSize *ab.BatchSize, now time.Time) string {
+	window := r.adaptive.fillWindow(batchSize.Adaptive)
+	target := r.adaptive.targetMessageCount(batchSize.Adaptive, window, batchSize.MaxMessageCount)
+
+	r.Metrics.AdaptiveArrivalRate.With("channel", r.ChannelID).Set(r.adaptive.arrivalRate())
+	r.Metrics.AdaptiveTargetMessageCount.With("channel", r.ChannelID).Set(float64(target))
+
+	switch {
+	case uint32(len(r.pendingBatch)) >= target:
+		return "message_count"
+	case now.Sub(r.PendingBatchStartTime) >= window:
+		return "fill_window"
+	default:
+		return ""
+	}
+}
+
+// ReportCommitLatency records the time it took to commit a recent block, which
+// adaptive batching takes into account when sizing batches.
+func (r *receiver) ReportCommitLatency(latency time.Duration) {
+	r.adaptive.reportCommitLatency(latency)
+	r.Metrics.AdaptiveCommitLatency.With("channel", r.ChannelID).Set(r.adaptive.latency().Seconds())
+}
+
 // Cut returns the current batch and starts a new one
 func (r *receiver) Cut() []*cb.Envelope {
 	r.Metrics.BlockFillDuration.With("channel", r.ChannelID).Observe(time.Since(r.PendingBatchStartTime).Seconds())
@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package blockcutter_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -173,6 +175,148 @@ var _ = Describe("Blockcutter", func() {
 			})
 		})
 
+		Context("when adaptive batching is enabled", func() {
+			var (
+				fakeTargetMessageCount *mock.MetricsGauge
+				fakeArrivalRate        *mock.MetricsGauge
+				fakeCommitLatency      *mock.MetricsGauge
+				fakeBlocksCut          *mock.MetricsCounter
+			)
+
+			BeforeEach(func() {
+				fakeTargetMessageCount = &mock.MetricsGauge{}
+				fakeTargetMessageCount.WithReturns(fakeTargetMessageCount)
+				fakeArrivalRate = &mock.MetricsGauge{}
+				fakeArrivalRate.WithReturns(fakeArrivalRate)
+				fakeCommitLatency = &mock.MetricsGauge{}
+				fakeCommitLatency.WithReturns(fakeCommitLatency)
+				fakeBlocksCut = &mock.MetricsCounter{}
+				fakeBlocksCut.WithReturns(fakeBlocksCut)
+				metrics.AdaptiveTargetMessageCount = fakeTargetMessageCount
+				metrics.AdaptiveArrivalRate = fakeArrivalRate
+				metrics.AdaptiveCommitLatency = fakeCommitLatency
+				metrics.AdaptiveBlocksCut = fakeBlocksCut
+
+				fakeConfig.BatchSizeReturns(&ab.BatchSize{
+					MaxMessageCount:   10,
+					PreferredMaxBytes: 1000,
+					Adaptive: &ab.AdaptiveBatching{
+						MinMessageCount: 2,
+						TargetLatency:   "1h",
+					},
+				})
+			})
+
+			It("cuts at the min message count until the arrival rate is known", func() {
+				batches, pending := bc.Ordered(message)
+				Expect(batches).To(BeEmpty())
+				Expect(pending).To(BeTrue())
+				Expect(fakeArrivalRate.SetArgsForCall(0)).To(Equal(float64(0)))
+				Expect(fakeTargetMessageCount.SetArgsForCall(0)).To(Equal(float64(2)))
+				Expect(fakeTargetMessageCount.WithArgsForCall(0)).To(Equal([]string{"channel", "mychannel"}))
+			})
+
+			It("raises the target message count as messages keep arriving", func() {
+				for i := 0; i < 9; i++ {
+					batches, pending := bc.Ordered(message)
+					Expect(batches).To(BeEmpty())
+					Expect(pending).To(BeTrue())
+				}
+				Expect(fakeArrivalRate.SetArgsForCall(8)).To(BeNumerically(">", 0))
+				Expect(fakeTargetMessageCount.SetArgsForCall(8)).To(Equal(float64(10)))
+
+				batches, pending := bc.Ordered(message)
+				Expect(batches).To(HaveLen(1))
+				Expect(batches[0]).To(HaveLen(10))
+				Expect(pending).To(BeFalse())
+				Expect(fakeBlocksCut.AddCallCount()).To(Equal(0))
+			})
+
+			Context("when the target message count is reached", func() {
+				BeforeEach(func() {
+					fakeConfig.BatchSizeReturns(&ab.BatchSize{
+						MaxMessageCount:   10,
+						PreferredMaxBytes: 1000,
+						Adaptive: &ab.AdaptiveBatching{
+							MinMessageCount: 1,
+							TargetLatency:   "1h",
+						},
+					})
+				})
+
+				It("cuts the batch", func() {
+					batches, pending := bc.Ordered(message)
+					Expect(batches).To(HaveLen(1))
+					Expect(batches[0]).To(HaveLen(1))
+					Expect(pending).To(BeFalse())
+
+					Expect(fakeBlocksCut.AddCallCount()).To(Equal(1))
+					Expect(fakeBlocksCut.AddArgsForCall(0)).To(Equal(float64(1)))
+					Expect(fakeBlocksCut.WithArgsForCall(0)).To(Equal([]string{"channel", "mychannel", "reason", "message_count"}))
+				})
+			})
+
+			Context("when the pending batch has been filling for longer than the fill window", func() {
+				BeforeEach(func() {
+					fakeConfig.BatchSizeReturns(&ab.BatchSize{
+						MaxMessageCount:   10,
+						PreferredMaxBytes: 1000,
+						Adaptive: &ab.AdaptiveBatching{
+							MinMessageCount: 5,
+							TargetLatency:   "1ns",
+						},
+					})
+				})
+
+				It("cuts the batch", func() {
+					batches, pending := bc.Ordered(message)
+					Expect(batches).To(BeEmpty())
+					Expect(pending).To(BeTrue())
+
+					batches, pending = bc.Ordered(message)
+					Expect(batches).To(HaveLen(1))
+					Expect(batches[0]).To(HaveLen(2))
+					Expect(pending).To(BeFalse())
+
+					Expect(fakeBlocksCut.AddCallCount()).To(Equal(1))
+					Expect(fakeBlocksCut.WithArgsForCall(0)).To(Equal([]string{"channel", "mychannel", "reason", "fill_window"}))
+					Expect(fakeBlockFillDuration.ObserveCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the commit latency exceeds the target latency", func() {
+				BeforeEach(func() {
+					fakeConfig.BatchSizeReturns(&ab.BatchSize{
+						MaxMessageCount:   10,
+						PreferredMaxBytes: 1000,
+						Adaptive: &ab.AdaptiveBatching{
+							MinMessageCount: 2,
+							TargetLatency:   "1ns",
+						},
+					})
+					bc.(blockcutter.CommitLatencyReporter).ReportCommitLatency(time.Hour)
+				})
+
+				It("fills batches for as long as blocks take to commit", func() {
+					Expect(fakeCommitLatency.SetArgsForCall(0)).To(Equal(time.Hour.Seconds()))
+					Expect(fakeCommitLatency.WithArgsForCall(0)).To(Equal([]string{"channel", "mychannel"}))
+
+					for i := 0; i < 9; i++ {
+						batches, pending := bc.Ordered(message)
+						Expect(batches).To(BeEmpty())
+						Expect(pending).To(BeTrue())
+					}
+					Expect(fakeBlocksCut.AddCallCount()).To(Equal(0))
+
+					batches, pending := bc.Ordered(message)
+					Expect(batches).To(HaveLen(1))
+					Expect(batches[0]).To(HaveLen(10))
+					Expect(pending).To(BeFalse())
+					Expect(fakeTargetMessageCount.SetArgsForCall(8)).To(Equal(float64(10)))
+				})
+			})
+		})
+
 		Context("when the orderer config cannot be retrieved", func() {
 			BeforeEach(func() {
 				fakeConfigFetcher.OrdererConfigReturns(nil, false)
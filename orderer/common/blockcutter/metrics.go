@@ -16,14 +16,54 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+	adaptiveTargetMessageCount = metrics.GaugeOpts{
+		Namespace:    "blockcutter",
+		Subsystem:    "adaptive",
+		Name:         "target_message_count",
+		Help:         "The number of messages at which adaptive batching cuts the next block.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	adaptiveArrivalRate = metrics.GaugeOpts{
+		Namespace:    "blockcutter",
+		Subsystem:    "adaptive",
+		Name:         "arrival_rate",
+		Help:         "The observed arrival rate of messages in messages per second.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	adaptiveCommitLatency = metrics.GaugeOpts{
+		Namespace:    "blockcutter",
+		Subsystem:    "adaptive",
+		Name:         "commit_latency",
+		Help:         "The recent commit latency of blocks on the orderer in seconds.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	adaptiveBlocksCut = metrics.CounterOpts{
+		Namespace:    "blockcutter",
+		Subsystem:    "adaptive",
+		Name:         "blocks_cut",
+		Help:         "The number of blocks cut by adaptive batching.",
+		LabelNames:   []string{"channel", "reason"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{reason}",
+	}
 )
 
 type Metrics struct {
-	BlockFillDuration metrics.Histogram
+	BlockFillDuration          metrics.Histogram
+	AdaptiveTargetMessageCount metrics.Gauge
+	AdaptiveArrivalRate        metrics.Gauge
+	AdaptiveCommitLatency      metrics.Gauge
+	AdaptiveBlocksCut          metrics.Counter
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
 	return &Metrics{
-		BlockFillDuration: p.NewHistogram(blockFillDuration),
+		BlockFillDuration:          p.NewHistogram(blockFillDuration),
+		AdaptiveTargetMessageCount: p.NewGauge(adaptiveTargetMessageCount),
+		AdaptiveArrivalRate:        p.NewGauge(adaptiveArrivalRate),
+		AdaptiveCommitLatency:      p.NewGauge(adaptiveCommitLatency),
+		AdaptiveBlocksCut:          p.NewCounter(adaptiveBlocksCut),
 	}
 }
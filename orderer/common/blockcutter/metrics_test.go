@@ -23,14 +23,22 @@ var _ = Describe("Metrics", func() {
 		BeforeEach(func() {
 			fakeProvider = &mock.MetricsProvider{}
 			fakeProvider.NewHistogramReturns(&mock.MetricsHistogram{})
+			fakeProvider.NewGaugeReturns(&mock.MetricsGauge{})
+			fakeProvider.NewCounterReturns(&mock.MetricsCounter{})
 		})
 
 		It("uses the provider to initialize its field", func() {
 			metrics := blockcutter.NewMetrics(fakeProvider)
 			Expect(metrics).NotTo(BeNil())
 			Expect(metrics.BlockFillDuration).To(Equal(&mock.MetricsHistogram{}))
+			Expect(metrics.AdaptiveTargetMessageCount).To(Equal(&mock.MetricsGauge{}))
+			Expect(metrics.AdaptiveArrivalRate).To(Equal(&mock.MetricsGauge{}))
+			Expect(metrics.AdaptiveCommitLatency).To(Equal(&mock.MetricsGauge{}))
+			Expect(metrics.AdaptiveBlocksCut).To(Equal(&mock.MetricsCounter{}))
 
 			Expect(fakeProvider.NewHistogramCallCount()).To(Equal(1))
+			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(3))
+			Expect(fakeProvider.NewCounterCallCount()).To(Equal(1))
 		})
 	})
 })
@@ -100,6 +100,16 @@ type OrdererConfig struct {
 	organizationsReturnsOnCall map[int]struct {
 		result1 map[string]channelconfig.OrdererOrg
 	}
+	RateLimitsStub        func() *orderer.RateLimits
+	rateLimitsMutex       sync.RWMutex
+	rateLimitsArgsForCall []struct {
+	}
+	rateLimitsReturns struct {
+		result1 *orderer.RateLimits
+	}
+	rateLimitsReturnsOnCall map[int]struct {
+		result1 *orderer.RateLimits
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -572,6 +582,58 @@ func (fake *OrdererConfig) OrganizationsReturnsOnCall(i int, result1 map[string]
 	}{result1}
 }
 
+func (fake *OrdererConfig) RateLimits() *orderer.RateLimits {
+	fake.rateLimitsMutex.Lock()
+	ret, specificReturn := fake.rateLimitsReturnsOnCall[len(fake.rateLimitsArgsForCall)]
+	fake.rateLimitsArgsForCall = append(fake.rateLimitsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RateLimits", []interface{}{})
+	fake.rateLimitsMutex.Unlock()
+	if fake.RateLimitsStub != nil {
+		return fake.RateLimitsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rateLimitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *OrdererConfig) RateLimitsCallCount() int {
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
+	return len(fake.rateLimitsArgsForCall)
+}
+
+func (fake *OrdererConfig) RateLimitsCalls(stub func() *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = stub
+}
+
+func (fake *OrdererConfig) RateLimitsReturns(result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	fake.rateLimitsReturns = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
+func (fake *OrdererConfig) RateLimitsReturnsOnCall(i int, result1 *orderer.RateLimits) {
+	fake.rateLimitsMutex.Lock()
+	defer fake.rateLimitsMutex.Unlock()
+	fake.RateLimitsStub = nil
+	if fake.rateLimitsReturnsOnCall == nil {
+		fake.rateLimitsReturnsOnCall = make(map[int]struct {
+			result1 *orderer.RateLimits
+		})
+	}
+	fake.rateLimitsReturnsOnCall[i] = struct {
+		result1 *orderer.RateLimits
+	}{result1}
+}
+
 func (fake *OrdererConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -593,6 +655,8 @@ func (fake *OrdererConfig) Invocations() map[string][][]interface{} {
 	defer fake.maxChannelsCountMutex.RUnlock()
 	fake.organizationsMutex.RLock()
 	defer fake.organizationsMutex.RUnlock()
+	fake.rateLimitsMutex.RLock()
+	defer fake.rateLimitsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -36,6 +36,10 @@ type ChannelSupportRegistrar interface {
 type ChannelSupport interface {
 	msgprocessor.Processor
 	Consenter
+
+	// CheckRateLimit returns an error if the organization which created the message
+	// exhausted its rate limit on the channel
+	CheckRateLimit(env *cb.Envelope) error
 }
 
 // Consenter provides methods to send messages through consensus
@@ -165,6 +169,12 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 			logger.Warningf("[channel: %s] Rejecting broadcast of normal message from %s because of error: %s", chdr.ChannelId, addr, err)
 			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
 		}
+
+		if err = processor.CheckRateLimit(msg); err != nil {
+			logger.Debugf("[channel: %s] Rejecting broadcast of normal message from %s with TOO_MANY_REQUESTS: %s", chdr.ChannelId, addr, err)
+			bh.recordRateLimited(chdr.ChannelId, err)
+			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
+		}
 		tracker.EndValidate()
 
 		tracker.BeginEnqueue()
@@ -174,6 +184,10 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 		}
 
 		err = processor.Order(msg, configSeq)
+		if _, ok := errors.Cause(err).(*msgprocessor.FairQueueFullError); ok {
+			logger.Debugf("[channel: %s] Rejecting broadcast of normal message from %s with TOO_MANY_REQUESTS: %s", chdr.ChannelId, addr, err)
+			return &ab.BroadcastResponse{Status: cb.Status_TOO_MANY_REQUESTS, Info: err.Error()}
+		}
 		if err != nil {
 			logger.Warningf("[channel: %s] Rejecting broadcast of normal message from %s with SERVICE_UNAVAILABLE: rejected by Order: %s", chdr.ChannelId, addr, err)
 			return &ab.BroadcastResponse{Status: cb.Status_SERVICE_UNAVAILABLE, Info: err.Error()}
@@ -186,6 +200,12 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 			logger.Warningf("[channel: %s] Rejecting broadcast of config message from %s because of error: %s", chdr.ChannelId, addr, err)
 			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
 		}
+
+		if err = processor.CheckRateLimit(msg); err != nil {
+			logger.Debugf("[channel: %s] Rejecting broadcast of config message from %s with TOO_MANY_REQUESTS: %s", chdr.ChannelId, addr, err)
+			bh.recordRateLimited(chdr.ChannelId, err)
+			return &ab.BroadcastResponse{Status: ClassifyError(err), Info: err.Error()}
+		}
 		tracker.EndValidate()
 
 		tracker.BeginEnqueue()
@@ -206,8 +226,20 @@ func (bh *Handler) ProcessMessage(msg *cb.Envelope, addr string) (resp *ab.Broad
 	return &ab.BroadcastResponse{Status: cb.Status_SUCCESS}
 }
 
+// recordRateLimited counts the messages rejected because the organization
+// which created them exceeded its rate limit.
+func (bh *Handler) recordRateLimited(channelID string, err error) {
+	if rateLimitErr, ok := errors.Cause(err).(*msgprocessor.RateLimitError); ok {
+		bh.Metrics.RateLimitedCount.With("channel", channelID, "msp_id", rateLimitErr.MSPID).Add(1)
+	}
+}
+
 // ClassifyError converts an error type into a status code.
 func ClassifyError(err error) cb.Status {
+	if _, ok := errors.Cause(err).(*msgprocessor.RateLimitError); ok {
+		return cb.Status_TOO_MANY_REQUESTS
+	}
+
 	switch errors.Cause(err) {
 	case msgprocessor.ErrChannelDoesNotExist:
 		return cb.Status_NOT_FOUND
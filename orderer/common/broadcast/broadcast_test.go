@@ -29,6 +29,7 @@ var _ = Describe("Broadcast", func() {
 		fakeValidateHistogram *mock.MetricsHistogram
 		fakeEnqueueHistogram  *mock.MetricsHistogram
 		fakeProcessedCounter  *mock.MetricsCounter
+		fakeRateLimitCounter  *mock.MetricsCounter
 	)
 
 	BeforeEach(func() {
@@ -43,12 +44,16 @@ var _ = Describe("Broadcast", func() {
 		fakeProcessedCounter = &mock.MetricsCounter{}
 		fakeProcessedCounter.WithReturns(fakeProcessedCounter)
 
+		fakeRateLimitCounter = &mock.MetricsCounter{}
+		fakeRateLimitCounter.WithReturns(fakeRateLimitCounter)
+
 		handler = &broadcast.Handler{
 			SupportRegistrar: fakeSupportRegistrar,
 			Metrics: &broadcast.Metrics{
 				ValidateDuration: fakeValidateHistogram,
 				EnqueueDuration:  fakeEnqueueHistogram,
 				ProcessedCount:   fakeProcessedCounter,
+				RateLimitedCount: fakeRateLimitCounter,
 			},
 		}
 	})
@@ -88,6 +93,9 @@ var _ = Describe("Broadcast", func() {
 			Expect(fakeSupport.ProcessNormalMsgCallCount()).To(Equal(1))
 			Expect(fakeSupport.ProcessNormalMsgArgsForCall(0)).To(Equal(fakeMsg))
 
+			Expect(fakeSupport.CheckRateLimitCallCount()).To(Equal(1))
+			Expect(fakeSupport.CheckRateLimitArgsForCall(0)).To(Equal(fakeMsg))
+
 			Expect(fakeSupport.WaitReadyCallCount()).To(Equal(1))
 
 			Expect(fakeSupport.OrderCallCount()).To(Equal(1))
@@ -225,6 +233,23 @@ var _ = Describe("Broadcast", func() {
 			})
 		})
 
+		Context("when the fair queue of the submitter is full", func() {
+			BeforeEach(func() {
+				fakeSupport.OrderReturns(&msgprocessor.FairQueueFullError{MSPID: "Org1MSP"})
+			})
+
+			It("returns a too many requests status", func() {
+				err := handler.Handle(fakeABServer)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeABServer.SendCallCount()).To(Equal(1))
+				Expect(proto.Equal(
+					fakeABServer.SendArgsForCall(0),
+					&ab.BroadcastResponse{Status: cb.Status_TOO_MANY_REQUESTS, Info: "queue of submitter of organization Org1MSP is full"}),
+				).To(BeTrue())
+			})
+		})
+
 		Context("when the message processor returns an error", func() {
 			BeforeEach(func() {
 				fakeSupport.ProcessNormalMsgReturns(0, fmt.Errorf("normal-messsage-processing-error"))
@@ -274,6 +299,36 @@ var _ = Describe("Broadcast", func() {
 					)).To(BeTrue())
 				})
 			})
+
+		})
+
+		Context("when the organization of the submitter exceeded its rate limit", func() {
+			BeforeEach(func() {
+				fakeSupport.CheckRateLimitReturns(&msgprocessor.RateLimitError{MSPID: "Org1MSP"})
+			})
+
+			It("returns the error and a too many requests status", func() {
+				err := handler.Handle(fakeABServer)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSupport.CheckRateLimitCallCount()).To(Equal(1))
+				Expect(fakeSupport.CheckRateLimitArgsForCall(0)).To(Equal(fakeMsg))
+				Expect(fakeSupport.OrderCallCount()).To(Equal(0))
+
+				Expect(fakeABServer.SendCallCount()).To(Equal(1))
+				Expect(proto.Equal(
+					fakeABServer.SendArgsForCall(0),
+					&ab.BroadcastResponse{Status: cb.Status_TOO_MANY_REQUESTS, Info: "rate limit of organization Org1MSP exceeded"},
+				)).To(BeTrue())
+
+				Expect(fakeRateLimitCounter.WithCallCount()).To(Equal(1))
+				Expect(fakeRateLimitCounter.WithArgsForCall(0)).To(Equal([]string{
+					"channel", "fake-channel",
+					"msp_id", "Org1MSP",
+				}))
+				Expect(fakeRateLimitCounter.AddCallCount()).To(Equal(1))
+				Expect(fakeRateLimitCounter.AddArgsForCall(0)).To(Equal(float64(1)))
+			})
 		})
 
 		Context("when the message is a config message", func() {
@@ -346,6 +401,27 @@ var _ = Describe("Broadcast", func() {
 				})
 			})
 
+			Context("when the organization of the submitter exceeded its rate limit", func() {
+				BeforeEach(func() {
+					fakeSupport.CheckRateLimitReturns(&msgprocessor.RateLimitError{MSPID: "Org1MSP"})
+				})
+
+				It("returns the error and a too many requests status", func() {
+					err := handler.Handle(fakeABServer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeSupport.CheckRateLimitCallCount()).To(Equal(1))
+					Expect(fakeSupport.CheckRateLimitArgsForCall(0)).To(Equal(fakeMsg))
+					Expect(fakeSupport.ConfigureCallCount()).To(Equal(0))
+
+					Expect(fakeABServer.SendCallCount()).To(Equal(1))
+					Expect(proto.Equal(
+						fakeABServer.SendArgsForCall(0),
+						&ab.BroadcastResponse{Status: cb.Status_TOO_MANY_REQUESTS, Info: "rate limit of organization Org1MSP exceeded"},
+					)).To(BeTrue())
+				})
+			})
+
 			Context("when the processing of the config update fails", func() {
 				BeforeEach(func() {
 					fakeSupport.ProcessConfigUpdateMsgReturns(nil, 0, fmt.Errorf("config-processing-error"))
@@ -10,6 +10,17 @@ import (
 )
 
 type ChannelSupport struct {
+	CheckRateLimitStub        func(*common.Envelope) error
+	checkRateLimitMutex       sync.RWMutex
+	checkRateLimitArgsForCall []struct {
+		arg1 *common.Envelope
+	}
+	checkRateLimitReturns struct {
+		result1 error
+	}
+	checkRateLimitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ClassifyMsgStub        func(*common.ChannelHeader) msgprocessor.Classification
 	classifyMsgMutex       sync.RWMutex
 	classifyMsgArgsForCall []struct {
@@ -102,6 +113,66 @@ type ChannelSupport struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *ChannelSupport) CheckRateLimit(arg1 *common.Envelope) error {
+	fake.checkRateLimitMutex.Lock()
+	ret, specificReturn := fake.checkRateLimitReturnsOnCall[len(fake.checkRateLimitArgsForCall)]
+	fake.checkRateLimitArgsForCall = append(fake.checkRateLimitArgsForCall, struct {
+		arg1 *common.Envelope
+	}{arg1})
+	fake.recordInvocation("CheckRateLimit", []interface{}{arg1})
+	fake.checkRateLimitMutex.Unlock()
+	if fake.CheckRateLimitStub != nil {
+		return fake.CheckRateLimitStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkRateLimitReturns
+	return fakeReturns.result1
+}
+
+func (fake *ChannelSupport) CheckRateLimitCallCount() int {
+	fake.checkRateLimitMutex.RLock()
+	defer fake.checkRateLimitMutex.RUnlock()
+	return len(fake.checkRateLimitArgsForCall)
+}
+
+func (fake *ChannelSupport) CheckRateLimitCalls(stub func(*common.Envelope) error) {
+	fake.checkRateLimitMutex.Lock()
+	defer fake.checkRateLimitMutex.Unlock()
+	fake.CheckRateLimitStub = stub
+}
+
+func (fake *ChannelSupport) CheckRateLimitArgsForCall(i int) *common.Envelope {
+	fake.checkRateLimitMutex.RLock()
+	defer fake.checkRateLimitMutex.RUnlock()
+	argsForCall := fake.checkRateLimitArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ChannelSupport) CheckRateLimitReturns(result1 error) {
+	fake.checkRateLimitMutex.Lock()
+	defer fake.checkRateLimitMutex.Unlock()
+	fake.CheckRateLimitStub = nil
+	fake.checkRateLimitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *ChannelSupport) CheckRateLimitReturnsOnCall(i int, result1 error) {
+	fake.checkRateLimitMutex.Lock()
+	defer fake.checkRateLimitMutex.Unlock()
+	fake.CheckRateLimitStub = nil
+	if fake.checkRateLimitReturnsOnCall == nil {
+		fake.checkRateLimitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkRateLimitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *ChannelSupport) ClassifyMsg(arg1 *common.ChannelHeader) msgprocessor.Classification {
 	fake.classifyMsgMutex.Lock()
 	ret, specificReturn := fake.classifyMsgReturnsOnCall[len(fake.classifyMsgArgsForCall)]
@@ -534,6 +605,8 @@ func (fake *ChannelSupport) WaitReadyReturnsOnCall(i int, result1 error) {
 func (fake *ChannelSupport) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkRateLimitMutex.RLock()
+	defer fake.checkRateLimitMutex.RUnlock()
 	fake.classifyMsgMutex.RLock()
 	defer fake.classifyMsgMutex.RUnlock()
 	fake.configureMutex.RLock()
@@ -0,0 +1,214 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package channelparticipation implements the channel participation API of the orderer,
+// through which orderer admins join, list and remove application channels without a
+// system channel.
+package channelparticipation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/mux"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/types"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	// URLBaseV1 is the base path of version 1 of the channel participation API.
+	URLBaseV1 = "/participation/v1/"
+	// URLBaseV1Channels is the path of the channels resource.
+	URLBaseV1Channels = URLBaseV1 + "channels"
+	// FormDataConfigBlockKey is the key of the form field which carries the genesis block
+	// of the channel to join.
+	FormDataConfigBlockKey = "config-block"
+
+	channelIDKey        = "channelID"
+	urlWithChannelIDKey = URLBaseV1Channels + "/{" + channelIDKey + "}"
+)
+
+var logger = flogging.MustGetLogger("orderer.common.channelparticipation")
+
+//go:generate counterfeiter -o mock/channel_management.go -fake-name ChannelManagement . ChannelManagement
+
+// ChannelManagement joins, lists and removes the channels of the orderer.
+type ChannelManagement interface {
+	// ChannelList returns the system channel, if any, and the application channels of the orderer
+	ChannelList() types.ChannelList
+	// ChannelInfo returns the information of a channel
+	ChannelInfo(channelID string) (types.ChannelInfo, error)
+	// JoinChannel makes the orderer a member of the channel with the given genesis block
+	JoinChannel(channelID string, genesisBlock *cb.Block) (types.ChannelInfo, error)
+	// RemoveChannel makes the orderer stop serving a channel, and removes its ledger
+	RemoveChannel(channelID string) error
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// HTTPHandler serves the channel participation API.
+type HTTPHandler struct {
+	config    localconfig.ChannelParticipation
+	registrar ChannelManagement
+	router    *mux.Router
+}
+
+// NewHTTPHandler returns a handler of the channel participation API, which manages the
+// channels of the given registrar.
+func NewHTTPHandler(config localconfig.ChannelParticipation, registrar ChannelManagement) *HTTPHandler {
+	handler := &HTTPHandler{
+		config:    config,
+		registrar: registrar,
+		router:    mux.NewRouter(),
+	}
+
+	handler.router.HandleFunc(urlWithChannelIDKey, handler.serveListOne).Methods(http.MethodGet)
+	handler.router.HandleFunc(urlWithChannelIDKey, handler.serveRemove).Methods(http.MethodDelete)
+	handler.router.HandleFunc(urlWithChannelIDKey, handler.serveNotAllowed)
+
+	handler.router.HandleFunc(URLBaseV1Channels, handler.serveListAll).Methods(http.MethodGet)
+	handler.router.HandleFunc(URLBaseV1Channels, handler.serveJoin).Methods(http.MethodPost)
+	handler.router.HandleFunc(URLBaseV1Channels, handler.serveNotAllowed)
+
+	return handler
+}
+
+func (h *HTTPHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	h.router.ServeHTTP(resp, req)
+}
+
+// List all channels
+func (h *HTTPHandler) serveListAll(resp http.ResponseWriter, req *http.Request) {
+	channelList := h.registrar.ChannelList()
+	if channelList.SystemChannel != nil {
+		channelList.SystemChannel.URL = channelURL(channelList.SystemChannel.Name)
+	}
+	for i := range channelList.Channels {
+		channelList.Channels[i].URL = channelURL(channelList.Channels[i].Name)
+	}
+	h.sendResponseJSON(resp, http.StatusOK, channelList)
+}
+
+// List a single channel
+func (h *HTTPHandler) serveListOne(resp http.ResponseWriter, req *http.Request) {
+	channelID := mux.Vars(req)[channelIDKey]
+	info, err := h.registrar.ChannelInfo(channelID)
+	if err != nil {
+		h.sendResponseError(resp, errorStatus(err), err)
+		return
+	}
+	info.URL = channelURL(channelID)
+	h.sendResponseJSON(resp, http.StatusOK, info)
+}
+
+// Join a channel, with its genesis block carried in a multipart form
+func (h *HTTPHandler) serveJoin(resp http.ResponseWriter, req *http.Request) {
+	block, err := h.readGenesisBlock(resp, req)
+	if err != nil {
+		h.sendResponseError(resp, http.StatusBadRequest, err)
+		return
+	}
+	channelID, err := utils.GetChainIDFromBlock(block)
+	if err != nil {
+		h.sendResponseError(resp, http.StatusBadRequest, errors.WithMessage(err, "cannot extract channel ID from config block"))
+		return
+	}
+
+	info, err := h.registrar.JoinChannel(channelID, block)
+	if err != nil {
+		logger.Warningf("Failed to join channel %s: %s", channelID, err)
+		h.sendResponseError(resp, errorStatus(err), errors.WithMessage(err, "cannot join channel "+channelID))
+		return
+	}
+	info.URL = channelURL(channelID)
+	logger.Infof("Joined channel %s", channelID)
+	resp.Header().Set("Location", info.URL)
+	h.sendResponseJSON(resp, http.StatusCreated, info)
+}
+
+func (h *HTTPHandler) readGenesisBlock(resp http.ResponseWriter, req *http.Request) (*cb.Block, error) {
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+		return nil, errors.New("unsupported Content-Type, the config block must be sent as multipart/form-data")
+	}
+	req.Body = http.MaxBytesReader(resp, req.Body, int64(h.config.MaxRequestBodySize))
+	if err := req.ParseMultipartForm(int64(h.config.MaxRequestBodySize)); err != nil {
+		return nil, errors.Wrap(err, "cannot read form")
+	}
+	file, _, err := req.FormFile(FormDataConfigBlockKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "form does not contain the %s field", FormDataConfigBlockKey)
+	}
+	defer file.Close()
+	blockBytes, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read the %s field", FormDataConfigBlockKey)
+	}
+	block := &cb.Block{}
+	if err := proto.Unmarshal(blockBytes, block); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal the config block")
+	}
+	return block, nil
+}
+
+// Remove a channel
+func (h *HTTPHandler) serveRemove(resp http.ResponseWriter, req *http.Request) {
+	channelID := mux.Vars(req)[channelIDKey]
+	if err := h.registrar.RemoveChannel(channelID); err != nil {
+		logger.Warningf("Failed to remove channel %s: %s", channelID, err)
+		h.sendResponseError(resp, errorStatus(err), errors.WithMessage(err, "cannot remove channel "+channelID))
+		return
+	}
+	logger.Infof("Removed channel %s", channelID)
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) serveNotAllowed(resp http.ResponseWriter, req *http.Request) {
+	allowed := "GET, POST"
+	if _, ok := mux.Vars(req)[channelIDKey]; ok {
+		allowed = "GET, DELETE"
+	}
+	resp.Header().Set("Allow", allowed)
+	h.sendResponseError(resp, http.StatusMethodNotAllowed, errors.Errorf("invalid request method: %s", req.Method))
+}
+
+func (h *HTTPHandler) sendResponseJSON(resp http.ResponseWriter, code int, payload interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		logger.Errorf("Failed to encode payload: %s", err)
+	}
+}
+
+func (h *HTTPHandler) sendResponseError(resp http.ResponseWriter, code int, err error) {
+	h.sendResponseJSON(resp, code, &errorResponse{Error: err.Error()})
+}
+
+func errorStatus(err error) int {
+	switch errors.Cause(err) {
+	case types.ErrChannelNotExist:
+		return http.StatusNotFound
+	case types.ErrChannelAlreadyExists:
+		return http.StatusConflict
+	case types.ErrSystemChannelExists:
+		return http.StatusMethodNotAllowed
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func channelURL(channelID string) string {
+	return path.Join(URLBaseV1Channels, channelID)
+}
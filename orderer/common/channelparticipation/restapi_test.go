@@ -0,0 +1,235 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package channelparticipation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/fabric/orderer/common/channelparticipation"
+	"github.com/hyperledger/fabric/orderer/common/channelparticipation/mock"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/types"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var config = localconfig.ChannelParticipation{Enabled: true, MaxRequestBodySize: 1024 * 1024}
+
+func genesisBlock(t *testing.T, channelID string) []byte {
+	env, err := utils.CreateSignedEnvelope(cb.HeaderType_CONFIG, channelID, nil, &cb.ConfigEnvelope{}, 0, 0)
+	require.NoError(t, err)
+	block := cb.NewBlock(0, nil)
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(env)}
+	return utils.MarshalOrPanic(block)
+}
+
+func joinRequest(t *testing.T, field string, content []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "config.block")
+	require.NoError(t, err)
+	_, err = io.Copy(part, bytes.NewReader(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, channelparticipation.URLBaseV1Channels, body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func checkErrorResponse(t *testing.T, resp *httptest.ResponseRecorder, code int, message string) {
+	assert.Equal(t, code, resp.Code)
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	errResp := map[string]string{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &errResp))
+	assert.Equal(t, message, errResp["error"])
+}
+
+func TestHTTPHandlerListAll(t *testing.T) {
+	registrar := &mock.ChannelManagement{}
+	handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+	t.Run("without system channel", func(t *testing.T) {
+		registrar.ChannelListReturns(types.ChannelList{
+			Channels: []types.ChannelInfoShort{{Name: "app1"}, {Name: "app2"}},
+		})
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, channelparticipation.URLBaseV1Channels, nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"systemChannel": null,
+			"channels": [
+				{"name": "app1", "url": "/participation/v1/channels/app1"},
+				{"name": "app2", "url": "/participation/v1/channels/app2"}
+			]
+		}`, resp.Body.String())
+	})
+
+	t.Run("with system channel", func(t *testing.T) {
+		registrar.ChannelListReturns(types.ChannelList{
+			SystemChannel: &types.ChannelInfoShort{Name: "sys"},
+		})
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, channelparticipation.URLBaseV1Channels, nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{
+			"systemChannel": {"name": "sys", "url": "/participation/v1/channels/sys"},
+			"channels": null
+		}`, resp.Body.String())
+	})
+}
+
+func TestHTTPHandlerListOne(t *testing.T) {
+	registrar := &mock.ChannelManagement{}
+	handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+	registrar.ChannelInfoReturns(types.ChannelInfo{Name: "app1", ConsensusType: "etcdraft", Height: 5}, nil)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, channelparticipation.URLBaseV1Channels+"/app1", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"name": "app1", "url": "/participation/v1/channels/app1", "consensusType": "etcdraft", "height": 5}`, resp.Body.String())
+	require.Equal(t, 1, registrar.ChannelInfoCallCount())
+	assert.Equal(t, "app1", registrar.ChannelInfoArgsForCall(0))
+
+	registrar.ChannelInfoReturns(types.ChannelInfo{}, types.ErrChannelNotExist)
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, channelparticipation.URLBaseV1Channels+"/app2", nil))
+	checkErrorResponse(t, resp, http.StatusNotFound, "channel does not exist")
+}
+
+func TestHTTPHandlerJoin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		registrar := &mock.ChannelManagement{}
+		registrar.JoinChannelReturns(types.ChannelInfo{Name: "app1", ConsensusType: "etcdraft", Height: 1}, nil)
+		handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, joinRequest(t, channelparticipation.FormDataConfigBlockKey, genesisBlock(t, "app1")))
+		assert.Equal(t, http.StatusCreated, resp.Code)
+		assert.Equal(t, "/participation/v1/channels/app1", resp.Header().Get("Location"))
+		assert.JSONEq(t, `{"name": "app1", "url": "/participation/v1/channels/app1", "consensusType": "etcdraft", "height": 1}`, resp.Body.String())
+
+		require.Equal(t, 1, registrar.JoinChannelCallCount())
+		channelID, block := registrar.JoinChannelArgsForCall(0)
+		assert.Equal(t, "app1", channelID)
+		assert.Equal(t, uint64(0), block.Header.Number)
+	})
+
+	for _, tc := range []struct {
+		name    string
+		joinErr error
+		code    int
+		message string
+	}{
+		{
+			name:    "channel exists",
+			joinErr: types.ErrChannelAlreadyExists,
+			code:    http.StatusConflict,
+			message: "cannot join channel app1: channel already exists",
+		},
+		{
+			name:    "system channel exists",
+			joinErr: types.ErrSystemChannelExists,
+			code:    http.StatusMethodNotAllowed,
+			message: "cannot join channel app1: system channel exists",
+		},
+		{
+			name:    "invalid block",
+			joinErr: errors.New("block number is 3, but only the genesis block of a channel can be joined"),
+			code:    http.StatusBadRequest,
+			message: "cannot join channel app1: block number is 3, but only the genesis block of a channel can be joined",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			registrar := &mock.ChannelManagement{}
+			registrar.JoinChannelReturns(types.ChannelInfo{}, tc.joinErr)
+			handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, joinRequest(t, channelparticipation.FormDataConfigBlockKey, genesisBlock(t, "app1")))
+			checkErrorResponse(t, resp, tc.code, tc.message)
+		})
+	}
+
+	t.Run("bad requests", func(t *testing.T) {
+		registrar := &mock.ChannelManagement{}
+		handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, channelparticipation.URLBaseV1Channels, bytes.NewReader(genesisBlock(t, "app1")))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		handler.ServeHTTP(resp, req)
+		checkErrorResponse(t, resp, http.StatusBadRequest, "unsupported Content-Type, the config block must be sent as multipart/form-data")
+
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, joinRequest(t, "block", genesisBlock(t, "app1")))
+		checkErrorResponse(t, resp, http.StatusBadRequest, "form does not contain the config-block field: http: no such file")
+
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, joinRequest(t, channelparticipation.FormDataConfigBlockKey, []byte{1, 2, 3}))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "cannot unmarshal the config block")
+
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, joinRequest(t, channelparticipation.FormDataConfigBlockKey, utils.MarshalOrPanic(cb.NewBlock(0, nil))))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "cannot extract channel ID from config block")
+
+		smallHandler := channelparticipation.NewHTTPHandler(localconfig.ChannelParticipation{Enabled: true, MaxRequestBodySize: 16}, registrar)
+		resp = httptest.NewRecorder()
+		smallHandler.ServeHTTP(resp, joinRequest(t, channelparticipation.FormDataConfigBlockKey, genesisBlock(t, "app1")))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "cannot read form")
+
+		assert.Equal(t, 0, registrar.JoinChannelCallCount())
+	})
+}
+
+func TestHTTPHandlerRemove(t *testing.T) {
+	registrar := &mock.ChannelManagement{}
+	handler := channelparticipation.NewHTTPHandler(config, registrar)
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, channelparticipation.URLBaseV1Channels+"/app1", nil))
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	require.Equal(t, 1, registrar.RemoveChannelCallCount())
+	assert.Equal(t, "app1", registrar.RemoveChannelArgsForCall(0))
+
+	registrar.RemoveChannelReturns(types.ErrChannelNotExist)
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, channelparticipation.URLBaseV1Channels+"/app2", nil))
+	checkErrorResponse(t, resp, http.StatusNotFound, "cannot remove channel app2: channel does not exist")
+
+	registrar.RemoveChannelReturns(types.ErrSystemChannelExists)
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, channelparticipation.URLBaseV1Channels+"/app1", nil))
+	checkErrorResponse(t, resp, http.StatusMethodNotAllowed, "cannot remove channel app1: system channel exists")
+}
+
+func TestHTTPHandlerMethodNotAllowed(t *testing.T) {
+	handler := channelparticipation.NewHTTPHandler(config, &mock.ChannelManagement{})
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, channelparticipation.URLBaseV1Channels, nil))
+	checkErrorResponse(t, resp, http.StatusMethodNotAllowed, "invalid request method: PUT")
+	assert.Equal(t, "GET, POST", resp.Header().Get("Allow"))
+
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, channelparticipation.URLBaseV1Channels+"/app1", nil))
+	checkErrorResponse(t, resp, http.StatusMethodNotAllowed, "invalid request method: POST")
+	assert.Equal(t, "GET, DELETE", resp.Header().Get("Allow"))
+}
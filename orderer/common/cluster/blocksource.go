@@ -0,0 +1,228 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const blockFileSuffix = ".block"
+
+//go:generate mockery -dir . -name BlockSource -case underscore -output mocks/
+
+// BlockSource is a source of blocks of channels other than the ordering service nodes,
+// such as a directory of exported block files or the Deliver service of peers.
+// Blocks pulled from a BlockSource are verified before they are committed.
+type BlockSource interface {
+	// ChainPuller returns a ChainPuller which pulls blocks of the given channel,
+	// or an error if the source has no blocks of the channel.
+	ChainPuller(channel string) (ChainPuller, error)
+
+	// String describes the source
+	String() string
+}
+
+// BlockFileSource is a BlockSource of block files in a directory, which are
+// named <channel>_<number>.block as written by 'peer channel fetch'.
+type BlockFileSource struct {
+	Directory string
+	Logger    *flogging.FabricLogger
+}
+
+// ChainPuller returns a ChainPuller of the block files of the given channel.
+func (s *BlockFileSource) ChainPuller(channel string) (ChainPuller, error) {
+	files, err := ioutil.ReadDir(s.Directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed listing block files in %s", s.Directory)
+	}
+
+	prefix := channel + "_"
+	var height uint64
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, blockFileSuffix) {
+			continue
+		}
+		// Channel names cannot contain underscores, so files of other channels are not parsed as ours
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, prefix), blockFileSuffix), 10, 64)
+		if err != nil {
+			s.Logger.Debugf("Skipping block file %s: %v", name, err)
+			continue
+		}
+		if seq+1 > height {
+			height = seq + 1
+		}
+	}
+	if height == 0 {
+		return nil, errors.Errorf("no block files of channel %s found in %s", channel, s.Directory)
+	}
+
+	return &blockFilePuller{
+		directory: s.Directory,
+		channel:   channel,
+		height:    height,
+		logger:    s.Logger,
+	}, nil
+}
+
+func (s *BlockFileSource) String() string {
+	return fmt.Sprintf("block files in %s", s.Directory)
+}
+
+type blockFilePuller struct {
+	directory string
+	channel   string
+	height    uint64
+	logger    *flogging.FabricLogger
+}
+
+// PullBlock reads the block file with the given sequence, or returns nil if it is missing or malformed.
+func (p *blockFilePuller) PullBlock(seq uint64) *common.Block {
+	path := filepath.Join(p.directory, fmt.Sprintf("%s_%d%s", p.channel, seq, blockFileSuffix))
+	rawBlock, err := ioutil.ReadFile(path)
+	if err != nil {
+		p.logger.Warningf("Failed reading block [%d] of channel %s: %v", seq, p.channel, err)
+		return nil
+	}
+	block := &common.Block{}
+	if err := proto.Unmarshal(rawBlock, block); err != nil {
+		p.logger.Warningf("Failed unmarshaling block [%d] of channel %s from %s: %v", seq, p.channel, path, err)
+		return nil
+	}
+	return block
+}
+
+// HeightsByEndpoints returns the height of the highest block file of the channel.
+func (p *blockFilePuller) HeightsByEndpoints() (map[string]uint64, error) {
+	return map[string]uint64{p.directory: p.height}, nil
+}
+
+// Close does nothing, as block files are not kept open.
+func (p *blockFilePuller) Close() {}
+
+// PeerBlockSource is a BlockSource of the Deliver service of peers.
+type PeerBlockSource struct {
+	Puller *BlockPuller
+}
+
+// NewPeerBlockSource returns a PeerBlockSource which pulls blocks from the peers
+// at the given endpoints.
+func NewPeerBlockSource(conf PullerConfig, endpoints []EndpointCriteria) (*PeerBlockSource, error) {
+	dialer, tlsCert, err := dialerFromPullerConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeerBlockSource{
+		Puller: &BlockPuller{
+			Logger:  flogging.MustGetLogger("orderer.common.cluster.replication"),
+			Dialer:  dialer,
+			TLSCert: tlsCert,
+			// Block signatures are verified by the Replicator,
+			// so only verify the hash chain of the blocks here.
+			VerifyBlockSequence: func(blocks []*common.Block, _ string) error {
+				for i := range blocks {
+					if err := VerifyBlockHash(i, blocks); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			NewStream:           NewPeerImpatientStream,
+			MaxTotalBufferBytes: conf.MaxTotalBufferBytes,
+			Endpoints:           endpoints,
+			RetryTimeout:        RetryTimeout,
+			FetchTimeout:        conf.Timeout,
+			Signer:              conf.Signer,
+		},
+	}, nil
+}
+
+// ChainPuller returns a BlockPuller which pulls blocks of the given channel from the peers.
+func (s *PeerBlockSource) ChainPuller(channel string) (ChainPuller, error) {
+	puller := s.Puller.Clone()
+	puller.Channel = channel
+	return puller, nil
+}
+
+func (s *PeerBlockSource) String() string {
+	var endpoints []string
+	for _, endpoint := range s.Puller.Endpoints {
+		endpoints = append(endpoints, endpoint.Endpoint)
+	}
+	return fmt.Sprintf("peers %v", endpoints)
+}
+
+// NewPeerImpatientStream returns an ImpatientStreamCreator that creates
+// ImpatientStreams with the Deliver service of peers.
+func NewPeerImpatientStream(conn *grpc.ClientConn, waitTimeout time.Duration) ImpatientStreamCreator {
+	deliverClient := peer.NewDeliverClient(conn)
+	return newImpatientStream(waitTimeout, func(ctx context.Context, opts ...grpc.CallOption) (orderer.AtomicBroadcast_DeliverClient, error) {
+		stream, err := deliverClient.Deliver(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &peerDeliverStream{Deliver_DeliverClient: stream}, nil
+	})
+}
+
+// peerDeliverStream converts the responses of the Deliver service of peers
+// to the responses of the Deliver service of orderers.
+type peerDeliverStream struct {
+	peer.Deliver_DeliverClient
+}
+
+func (stream *peerDeliverStream) Recv() (*orderer.DeliverResponse, error) {
+	resp, err := stream.Deliver_DeliverClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	switch t := resp.Type.(type) {
+	case *peer.DeliverResponse_Block:
+		block := t.Block
+		removePeerMetadata(block)
+		return &orderer.DeliverResponse{Type: &orderer.DeliverResponse_Block{Block: block}}, nil
+	case *peer.DeliverResponse_Status:
+		return &orderer.DeliverResponse{Type: &orderer.DeliverResponse_Status{Status: t.Status}}, nil
+	default:
+		return nil, errors.Errorf("response is of type %T, but expected a block", resp.Type)
+	}
+}
+
+// removePeerMetadata reduces the metadata of a block pulled from a peer to the metadata
+// orderers write. Peers record the validation results of the transactions and the commit
+// hash in the block metadata, which orderers leave empty.
+func removePeerMetadata(block *common.Block) {
+	if block.GetMetadata() == nil {
+		return
+	}
+	if len(block.Metadata.Metadata) > len(common.BlockMetadataIndex_name) {
+		block.Metadata.Metadata = block.Metadata.Metadata[:len(common.BlockMetadataIndex_name)]
+	}
+	for _, index := range []common.BlockMetadataIndex{
+		common.BlockMetadataIndex_TRANSACTIONS_FILTER,
+		common.BlockMetadataIndex_COMMIT_HASH,
+	} {
+		if len(block.Metadata.Metadata) > int(index) {
+			block.Metadata.Metadata[index] = []byte{}
+		}
+	}
+}
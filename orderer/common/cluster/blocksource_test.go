@@ -0,0 +1,269 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cluster_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/cluster/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestBlockFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blockfiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	blockchain := createBlockChain(0, 3)
+	for _, block := range blockchain {
+		path := filepath.Join(dir, fmt.Sprintf("mychannel_%d.block", block.Header.Number))
+		require.NoError(t, ioutil.WriteFile(path, utils.MarshalOrPanic(block), 0644))
+	}
+	// Files which are not block files of the channel are ignored
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "mychannel_newest.block"), nil, 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "mychannel.block"), nil, 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "other_10.block"), nil, 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "mychannel_20.block"), 0755))
+	// Malformed block file
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "mychannel_2.block"), []byte{1, 2, 3}, 0644))
+
+	source := &cluster.BlockFileSource{Directory: dir, Logger: flogging.MustGetLogger("test")}
+	assert.Equal(t, "block files in "+dir, source.String())
+
+	puller, err := source.ChainPuller("mychannel")
+	require.NoError(t, err)
+	defer puller.Close()
+
+	heights, err := puller.HeightsByEndpoints()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{dir: 4}, heights)
+
+	for _, seq := range []uint64{0, 1, 3} {
+		assert.True(t, proto.Equal(blockchain[seq], puller.PullBlock(seq)))
+	}
+	assert.Nil(t, puller.PullBlock(2))
+	assert.Nil(t, puller.PullBlock(4))
+
+	_, err = source.ChainPuller("absent")
+	assert.EqualError(t, err, fmt.Sprintf("no block files of channel absent found in %s", dir))
+
+	source.Directory = filepath.Join(dir, "nonexistent")
+	_, err = source.ChainPuller("mychannel")
+	assert.Contains(t, err.Error(), "failed listing block files in "+source.Directory)
+}
+
+func TestReplicatorPullChannelFromBlockSources(t *testing.T) {
+	// Scenario: The system channel is pulled from block sources only.
+	// The first source serves a block which fails verification,
+	// the second source doesn't have the channel, and the third source
+	// has all the blocks up to the boot block.
+	blockchain := createBlockChain(0, 4)
+	tamperedBlock := proto.Clone(blockchain[2]).(*common.Block)
+
+	chainPuller := func(blocks ...*common.Block) *mocks.ChainPuller {
+		puller := &mocks.ChainPuller{}
+		puller.On("HeightsByEndpoints").Return(map[string]uint64{"source": uint64(len(blocks))}, nil)
+		puller.On("Close")
+		for _, block := range blocks {
+			puller.On("PullBlock", block.Header.Number).Return(block)
+		}
+		return puller
+	}
+
+	firstPuller := chainPuller(blockchain[0], blockchain[1], tamperedBlock)
+	firstSource := &mocks.BlockSource{}
+	firstSource.On("String").Return("first")
+	firstSource.On("ChainPuller", "system").Return(firstPuller, nil)
+
+	secondSource := &mocks.BlockSource{}
+	secondSource.On("String").Return("second")
+	secondSource.On("ChainPuller", "system").Return(nil, errors.New("no blocks"))
+
+	thirdPuller := chainPuller(blockchain...)
+	thirdSource := &mocks.BlockSource{}
+	thirdSource.On("String").Return("third")
+	thirdSource.On("ChainPuller", "system").Return(thirdPuller, nil)
+
+	var committed []*common.Block
+	lw := &mocks.LedgerWriter{}
+	lw.On("Append", mock.Anything).Return(nil).Run(func(arg mock.Arguments) {
+		committed = append(committed, arg.Get(0).(*common.Block))
+	})
+	lw.On("Height").Return(func() uint64 {
+		return uint64(len(committed))
+	})
+
+	lf := &mocks.LedgerFactory{}
+	lf.On("GetOrCreate", "system").Return(lw, nil)
+
+	var verified []uint64
+	r := cluster.Replicator{
+		Filter:        cluster.AnyChannel,
+		Logger:        flogging.MustGetLogger("test"),
+		SystemChannel: "system",
+		BootBlock:     proto.Clone(blockchain[4]).(*common.Block),
+		LedgerFactory: lf,
+		// The puller has no endpoints, so the channel can only be pulled from the block sources.
+		Puller: &cluster.BlockPuller{
+			VerifyBlockSequence: func(blocks []*common.Block, channel string) error {
+				assert.Equal(t, "system", channel)
+				assert.Len(t, blocks, 1)
+				if blocks[0] == tamperedBlock {
+					return errors.New("bad signature")
+				}
+				verified = append(verified, blocks[0].Header.Number)
+				return nil
+			},
+		},
+		BlockSources: []cluster.BlockSource{firstSource, secondSource, thirdSource},
+	}
+
+	err := r.PullChannel("system")
+	assert.NoError(t, err)
+	assert.Equal(t, blockchain, committed)
+	assert.Equal(t, []uint64{0, 1, 2, 3, 4}, verified)
+	firstPuller.AssertCalled(t, "Close")
+	thirdPuller.AssertCalled(t, "Close")
+	thirdPuller.AssertNotCalled(t, "PullBlock", uint64(0))
+	thirdPuller.AssertNotCalled(t, "PullBlock", uint64(1))
+}
+
+func TestReplicatorPullChannelFromBlockSourcesHashMismatch(t *testing.T) {
+	// Scenario: The second block source serves blocks of another chain
+	// than the first one, so the replicator doesn't commit them and
+	// falls back to pulling from orderers.
+	blockchain := createBlockChain(0, 4)
+	otherBlockchain := createBlockChain(0, 4)
+	otherBlockchain[1].Data.Data = append(otherBlockchain[1].Data.Data, []byte{1})
+	otherBlockchain[1].Header.DataHash = otherBlockchain[1].Data.Hash()
+	assignHashes(otherBlockchain)
+
+	chainPuller := func(blocks ...*common.Block) *mocks.ChainPuller {
+		puller := &mocks.ChainPuller{}
+		puller.On("HeightsByEndpoints").Return(map[string]uint64{"source": uint64(len(blocks))}, nil)
+		puller.On("Close")
+		for _, block := range blocks {
+			puller.On("PullBlock", block.Header.Number).Return(block)
+		}
+		return puller
+	}
+
+	firstSource := &mocks.BlockSource{}
+	firstSource.On("String").Return("first")
+	firstSource.On("ChainPuller", "mychannel").Return(chainPuller(blockchain[0], blockchain[1]), nil)
+
+	secondSource := &mocks.BlockSource{}
+	secondSource.On("String").Return("second")
+	secondSource.On("ChainPuller", "mychannel").Return(chainPuller(otherBlockchain...), nil)
+
+	var committed []*common.Block
+	lw := &mocks.LedgerWriter{}
+	lw.On("Append", mock.Anything).Return(nil).Run(func(arg mock.Arguments) {
+		committed = append(committed, arg.Get(0).(*common.Block))
+	})
+	lw.On("Height").Return(func() uint64 {
+		return uint64(len(committed))
+	})
+
+	lf := &mocks.LedgerFactory{}
+	lf.On("GetOrCreate", "mychannel").Return(lw, nil)
+
+	r := cluster.Replicator{
+		Filter:        cluster.AnyChannel,
+		Logger:        flogging.MustGetLogger("test"),
+		SystemChannel: "system",
+		LedgerFactory: lf,
+		Puller: &cluster.BlockPuller{
+			Logger: flogging.MustGetLogger("test"),
+			VerifyBlockSequence: func(blocks []*common.Block, channel string) error {
+				return nil
+			},
+		},
+		BlockSources: []cluster.BlockSource{firstSource, secondSource},
+	}
+
+	err := r.PullChannel("mychannel")
+	assert.EqualError(t, err, "failed obtaining the latest block for channel mychannel")
+	assert.Equal(t, blockchain[:2], committed)
+}
+
+type peerDeliverServer struct {
+	responses []*peer.DeliverResponse
+}
+
+func (s *peerDeliverServer) Deliver(stream peer.Deliver_DeliverServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	for _, resp := range s.responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *peerDeliverServer) DeliverFiltered(stream peer.Deliver_DeliverFilteredServer) error {
+	panic("should not be called")
+}
+
+func TestPeerImpatientStream(t *testing.T) {
+	block := createBlockChain(0, 0)[0]
+	blockWithTxFilter := proto.Clone(block).(*common.Block)
+	blockWithTxFilter.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{0}
+	blockWithTxFilter.Metadata.Metadata[common.BlockMetadataIndex_COMMIT_HASH] = utils.MarshalOrPanic(&common.Metadata{Value: []byte{1, 2, 3}})
+	blockWithTxFilter.Metadata.Metadata = append(blockWithTxFilter.Metadata.Metadata, []byte{4, 5, 6})
+
+	srv := grpc.NewServer()
+	defer srv.Stop()
+	peer.RegisterDeliverServer(srv, &peerDeliverServer{
+		responses: []*peer.DeliverResponse{
+			{Type: &peer.DeliverResponse_Block{Block: blockWithTxFilter}},
+			{Type: &peer.DeliverResponse_Status{Status: common.Status_FORBIDDEN}},
+			{Type: &peer.DeliverResponse_FilteredBlock{FilteredBlock: &peer.FilteredBlock{}}},
+		},
+	})
+	lsnr, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(lsnr)
+
+	conn, err := grpc.Dial(lsnr.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := cluster.NewPeerImpatientStream(conn, time.Minute)()
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&common.Envelope{}))
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	// The validation results, the commit hash, and any other metadata recorded by the peer are removed
+	assert.True(t, proto.Equal(block, resp.GetBlock()))
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, common.Status_FORBIDDEN, resp.GetStatus())
+
+	_, err = stream.Recv()
+	assert.EqualError(t, err, "response is of type *peer.DeliverResponse_FilteredBlock, but expected a block")
+}
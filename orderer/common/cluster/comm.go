@@ -12,6 +12,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -192,6 +193,39 @@ func (c *Comm) Remote(channel string, id uint64) (*RemoteContext, error) {
 	return stub.RemoteContext, nil
 }
 
+// RemoteNodeStatus describes the connection of this node with a remote node of a channel.
+type RemoteNodeStatus struct {
+	// ID is the ID of the remote node
+	ID uint64
+	// Endpoint is the endpoint of the remote node
+	Endpoint string
+	// State is the state of the gRPC connection with the remote node,
+	// or empty if no connection has been established with it yet
+	State string
+}
+
+// RemoteNodesStatus returns the status of the connections with the remote nodes
+// of the given channel, sorted by their IDs.
+func (c *Comm) RemoteNodesStatus(channel string) []RemoteNodeStatus {
+	c.Lock.RLock()
+	defer c.Lock.RUnlock()
+
+	var statuses []RemoteNodeStatus
+	for _, stub := range c.Chan2Members[channel] {
+		status := RemoteNodeStatus{ID: stub.ID, Endpoint: stub.Endpoint}
+		stub.lock.RLock()
+		if stub.isActive() && stub.RemoteContext.conn != nil {
+			status.State = stub.RemoteContext.conn.GetState().String()
+		}
+		stub.lock.RUnlock()
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ID < statuses[j].ID
+	})
+	return statuses
+}
+
 // Configure configures the channel with the given RemoteNodes
 func (c *Comm) Configure(channel string, newNodes []RemoteNode) {
 	c.Logger.Infof("Entering, channel: %s, nodes: %v", channel, newNodes)
@@ -1439,3 +1439,36 @@ func wrapSubmitReq(req *orderer.SubmitRequest) *orderer.StepRequest {
 		},
 	}
 }
+
+func TestRemoteNodesStatus(t *testing.T) {
+	t.Parallel()
+	// Scenario: node 1 is configured with node 2, which is up,
+	// and with node 3, which is down. Only the connection with
+	// node 2 is reported to be ready.
+
+	node1 := newTestNode(t)
+	defer node1.stop()
+	node2 := newTestNode(t)
+	defer node2.stop()
+	node3 := newTestNode(t)
+	node3.stop()
+
+	assert.Empty(t, node1.c.RemoteNodesStatus(testChannel))
+
+	node1.c.Configure(testChannel, []cluster.RemoteNode{node3.nodeInfo, node2.nodeInfo})
+
+	gt := gomega.NewGomegaWithT(t)
+	gt.Eventually(func() string {
+		return node1.c.RemoteNodesStatus(testChannel)[0].State
+	}, time.Minute).Should(gomega.Equal("READY"))
+
+	statuses := node1.c.RemoteNodesStatus(testChannel)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, node2.nodeInfo.ID, statuses[0].ID)
+	assert.Equal(t, node2.nodeInfo.Endpoint, statuses[0].Endpoint)
+	assert.Equal(t, node3.nodeInfo.ID, statuses[1].ID)
+	assert.Equal(t, node3.nodeInfo.Endpoint, statuses[1].Endpoint)
+	assert.NotEqual(t, "READY", statuses[1].State)
+
+	assert.Empty(t, node1.c.RemoteNodesStatus("other"))
+}
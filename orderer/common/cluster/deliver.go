@@ -40,6 +40,9 @@ type BlockPuller struct {
 	Dialer              Dialer
 	VerifyBlockSequence BlockSequenceVerifier
 	Endpoints           []EndpointCriteria
+	// NewStream creates the deliver streams of the BlockPuller.
+	// If it is nil, then NewImpatientStream is used.
+	NewStream func(conn *grpc.ClientConn, waitTimeout time.Duration) ImpatientStreamCreator
 	// Internal state
 	stream       *ImpatientStream
 	blockBuff    []*common.Block
@@ -199,7 +202,7 @@ func (p *BlockPuller) obtainStream(reConnected bool, env *common.Envelope, seq u
 	var err error
 	if reConnected {
 		p.Logger.Infof("Sending request for block [%d] to %s", seq, p.endpoint)
-		stream, err = p.requestBlocks(p.endpoint, NewImpatientStream(p.conn, p.FetchTimeout), env)
+		stream, err = p.requestBlocks(p.endpoint, p.newStream(p.conn), env)
 		if err != nil {
 			return nil, err
 		}
@@ -347,7 +350,7 @@ func (p *BlockPuller) fetchLastBlockSeq(minRequestedSequence uint64, endpoint st
 		return 0, err
 	}
 
-	stream, err := p.requestBlocks(endpoint, NewImpatientStream(conn, p.FetchTimeout), env)
+	stream, err := p.requestBlocks(endpoint, p.newStream(conn), env)
 	if err != nil {
 		return 0, err
 	}
@@ -377,6 +380,13 @@ func (p *BlockPuller) fetchLastBlockSeq(minRequestedSequence uint64, endpoint st
 	return block.Header.Number, nil
 }
 
+func (p *BlockPuller) newStream(conn *grpc.ClientConn) ImpatientStreamCreator {
+	if p.NewStream == nil {
+		return NewImpatientStream(conn, p.FetchTimeout)
+	}
+	return p.NewStream(conn, p.FetchTimeout)
+}
+
 // requestBlocks starts requesting blocks from the given endpoint, using the given ImpatientStreamCreator by sending
 // the given envelope.
 // It returns a stream that is used to pull blocks, or error if something goes wrong.
@@ -398,20 +408,10 @@ func (p *BlockPuller) requestBlocks(endpoint string, newStream ImpatientStreamCr
 func extractBlockFromResponse(resp *orderer.DeliverResponse) (*common.Block, error) {
 	switch t := resp.Type.(type) {
 	case *orderer.DeliverResponse_Block:
-		block := t.Block
-		if block == nil {
-			return nil, errors.New("block is nil")
-		}
-		if block.Data == nil {
-			return nil, errors.New("block data is nil")
-		}
-		if block.Header == nil {
-			return nil, errors.New("block header is nil")
-		}
-		if block.Metadata == nil || len(block.Metadata.Metadata) == 0 {
-			return nil, errors.New("block metadata is empty")
+		if err := validateBlockStructure(t.Block); err != nil {
+			return nil, err
 		}
-		return block, nil
+		return t.Block, nil
 	case *orderer.DeliverResponse_Status:
 		if t.Status == common.Status_FORBIDDEN {
 			return nil, ErrForbidden
@@ -419,12 +419,32 @@ func extractBlockFromResponse(resp *orderer.DeliverResponse) (*common.Block, err
 		if t.Status == common.Status_SERVICE_UNAVAILABLE {
 			return nil, ErrServiceUnavailable
 		}
+		if t.Status == common.Status_GONE {
+			return nil, ErrBlocksPruned
+		}
 		return nil, errors.Errorf("faulty node, received: %v", resp)
 	default:
 		return nil, errors.Errorf("response is of type %v, but expected a block", reflect.TypeOf(resp.Type))
 	}
 }
 
+// validateBlockStructure returns an error if the given block lacks any of its parts.
+func validateBlockStructure(block *common.Block) error {
+	if block == nil {
+		return errors.New("block is nil")
+	}
+	if block.Data == nil {
+		return errors.New("block data is nil")
+	}
+	if block.Header == nil {
+		return errors.New("block header is nil")
+	}
+	if block.Metadata == nil || len(block.Metadata.Metadata) == 0 {
+		return errors.New("block metadata is empty")
+	}
+	return nil
+}
+
 func (p *BlockPuller) seekLastEnvelope() (*common.Envelope, error) {
 	return utils.CreateSignedEnvelopeWithTLSBinding(
 		common.HeaderType_DELIVER_SEEK_INFO,
@@ -542,11 +562,14 @@ func (stream *ImpatientStream) Recv() (*orderer.DeliverResponse, error) {
 
 // NewImpatientStream returns a ImpatientStreamCreator that creates impatientStreams.
 func NewImpatientStream(conn *grpc.ClientConn, waitTimeout time.Duration) ImpatientStreamCreator {
+	return newImpatientStream(waitTimeout, orderer.NewAtomicBroadcastClient(conn).Deliver)
+}
+
+func newImpatientStream(waitTimeout time.Duration, deliver func(ctx context.Context, opts ...grpc.CallOption) (orderer.AtomicBroadcast_DeliverClient, error)) ImpatientStreamCreator {
 	return func() (*ImpatientStream, error) {
-		abc := orderer.NewAtomicBroadcastClient(conn)
 		ctx, cancel := context.WithCancel(context.Background())
 
-		stream, err := abc.Deliver(ctx)
+		stream, err := deliver(ctx)
 		if err != nil {
 			cancel()
 			return nil, err
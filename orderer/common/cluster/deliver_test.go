@@ -919,6 +919,13 @@ func TestBlockPullerBadBlocks(t *testing.T) {
 		return resp
 	}
 
+	goneStatus := func(resp *orderer.DeliverResponse) *orderer.DeliverResponse {
+		resp.Type = &orderer.DeliverResponse_Status{
+			Status: common.Status_GONE,
+		}
+		return resp
+	}
+
 	changeSequence := func(resp *orderer.DeliverResponse) *orderer.DeliverResponse {
 		resp.GetBlock().Header.Number = 3
 		return resp
@@ -954,6 +961,11 @@ func TestBlockPullerBadBlocks(t *testing.T) {
 			corruptBlock:   statusType,
 			expectedErrMsg: "faulty node, received: status:INTERNAL_SERVER_ERROR ",
 		},
+		{
+			name:           "pruned block",
+			corruptBlock:   goneStatus,
+			expectedErrMsg: cluster.ErrBlocksPruned.Error(),
+		},
 		{
 			name:           "wrong number",
 			corruptBlock:   changeSequence,
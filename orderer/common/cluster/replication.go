@@ -90,6 +90,9 @@ type Replicator struct {
 	BootBlock                       *common.Block
 	AmIPartOfChannel                SelfMembershipPredicate
 	LedgerFactory                   LedgerFactory
+	// BlockSources are pulled from before the ordering service nodes,
+	// in order to spare them.
+	BlockSources []BlockSource
 }
 
 // IsReplicationNeeded returns whether replication is needed,
@@ -175,8 +178,8 @@ func (r *Replicator) discoverChannels() []ChannelGenesisBlock {
 	return channels
 }
 
-// PullChannel pulls the given channel from some orderer,
-// and commits it to the ledger.
+// PullChannel pulls the given channel from the block sources and then
+// from some orderer, and commits it to the ledger.
 func (r *Replicator) PullChannel(channel string) error {
 	if !r.Filter(channel) {
 		r.Logger.Infof("Channel %s shouldn't be pulled. Skipping it", channel)
@@ -192,6 +195,15 @@ func (r *Replicator) PullChannel(channel string) error {
 		r.Logger.Panicf("Failed to create a ledger for channel %s: %v", channel, err)
 	}
 
+	var prevHash []byte
+	for _, source := range r.BlockSources {
+		prevHash = r.pullChannelFromSource(channel, source, ledger, prevHash)
+	}
+	if len(r.BlockSources) > 0 && channel == r.SystemChannel && ledger.Height() > r.BootBlock.Header.Number {
+		r.Logger.Infof("Pulled system channel %s up to the boot block from the block sources", channel)
+		return nil
+	}
+
 	endpoint, latestHeight, _ := latestHeightAndEndpoint(puller)
 	if endpoint == "" {
 		return errors.Errorf("failed obtaining the latest block for channel %s", channel)
@@ -204,43 +216,107 @@ func (r *Replicator) PullChannel(channel string) error {
 		return errors.Errorf("latest height found among system channel(%s) orderers is %d, but the boot block's "+
 			"sequence is %d", r.SystemChannel, latestHeight, r.BootBlock.Header.Number)
 	}
-	return r.pullChannelBlocks(channel, puller, latestHeight, ledger)
+	_, err = r.pullChannelBlocks(channel, puller, latestHeight, ledger, prevHash)
+	return err
+}
+
+// pullChannelFromSource pulls the blocks of the given channel that the given block source has,
+// and commits them to the ledger after verifying them.
+// It returns the hash of the last block committed, or the given prevHash if none was committed.
+func (r *Replicator) pullChannelFromSource(channel string, source BlockSource, ledger LedgerWriter, prevHash []byte) []byte {
+	puller, err := source.ChainPuller(channel)
+	if err != nil {
+		r.Logger.Infof("Not pulling channel %s from %s: %v", channel, source, err)
+		return prevHash
+	}
+	defer puller.Close()
+
+	_, latestHeight, err := latestHeightAndEndpoint(puller)
+	if err != nil {
+		r.Logger.Warningf("Failed obtaining the latest block of channel %s from %s: %v", channel, source, err)
+		return prevHash
+	}
+	r.Logger.Infof("Pulling channel %s from %s, which is at height %d", channel, source, latestHeight)
+
+	verifyingPuller := &verifyingChainPuller{
+		ChainPuller: puller,
+		channel:     channel,
+		verify:      r.Puller.VerifyBlockSequence,
+		logger:      r.Logger,
+	}
+	prevHash, err = r.pullChannelBlocks(channel, verifyingPuller, latestHeight, ledger, prevHash)
+	if err != nil {
+		r.Logger.Warningf("Failed pulling channel %s from %s: %v", channel, source, err)
+	}
+	return prevHash
 }
 
-func (r *Replicator) pullChannelBlocks(channel string, puller *BlockPuller, latestHeight uint64, ledger LedgerWriter) error {
+// pullChannelBlocks pulls the blocks of the given channel from the height of the ledger
+// up to the given latest height, and commits them to the ledger.
+// If prevHash isn't nil, the first block pulled must point to it.
+// It returns the hash of the last block committed, or the given prevHash if none was committed.
+func (r *Replicator) pullChannelBlocks(channel string, puller ChainPuller, latestHeight uint64, ledger LedgerWriter, prevHash []byte) ([]byte, error) {
 	nextBlockToPull := ledger.Height()
 	if nextBlockToPull == latestHeight {
 		r.Logger.Infof("Latest height found (%d) is equal to our height, skipping pulling channel %s", latestHeight, channel)
-		return nil
+		return prevHash, nil
 	}
-	// Pull the next block and remember its hash.
-	nextBlock := puller.PullBlock(nextBlockToPull)
-	if nextBlock == nil {
-		return ErrRetryCountExhausted
+	if nextBlockToPull > latestHeight {
+		r.Logger.Infof("Latest height found (%d) is below our height (%d), skipping pulling channel %s", latestHeight, nextBlockToPull, channel)
+		return prevHash, nil
 	}
-	r.appendBlock(nextBlock, ledger, channel)
-	actualPrevHash := nextBlock.Header.Hash()
 
-	for seq := uint64(nextBlockToPull + 1); seq < latestHeight; seq++ {
+	for seq := nextBlockToPull; seq < latestHeight; seq++ {
 		block := puller.PullBlock(seq)
 		if block == nil {
-			return ErrRetryCountExhausted
+			return prevHash, ErrRetryCountExhausted
 		}
 		reportedPrevHash := block.Header.PreviousHash
-		if !bytes.Equal(reportedPrevHash, actualPrevHash) {
-			return errors.Errorf("block header mismatch on sequence %d, expected %x, got %x",
-				block.Header.Number, actualPrevHash, reportedPrevHash)
+		if prevHash != nil && !bytes.Equal(reportedPrevHash, prevHash) {
+			return prevHash, errors.Errorf("block header mismatch on sequence %d, expected %x, got %x",
+				block.Header.Number, prevHash, reportedPrevHash)
 		}
-		actualPrevHash = block.Header.Hash()
+		prevHash = block.Header.Hash()
 		if channel == r.SystemChannel && block.Header.Number == r.BootBlock.Header.Number {
 			r.compareBootBlockWithSystemChannelLastConfigBlock(block)
 			r.appendBlock(block, ledger, channel)
 			// No need to pull further blocks from the system channel
-			return nil
+			return prevHash, nil
 		}
 		r.appendBlock(block, ledger, channel)
 	}
-	return nil
+	return prevHash, nil
+}
+
+// verifyingChainPuller verifies each block pulled by the ChainPuller it wraps,
+// and returns nil instead of blocks that fail verification.
+type verifyingChainPuller struct {
+	ChainPuller
+	channel string
+	verify  BlockSequenceVerifier
+	logger  *flogging.FabricLogger
+}
+
+func (p *verifyingChainPuller) PullBlock(seq uint64) *common.Block {
+	block := p.ChainPuller.PullBlock(seq)
+	if block == nil {
+		return nil
+	}
+	if err := validateBlockStructure(block); err != nil {
+		p.logger.Warningf("Received a bad block [%d] of channel %s: %v", seq, p.channel, err)
+		return nil
+	}
+	if block.Header.Number != seq {
+		p.logger.Warningf("Expected block [%d] of channel %s but got block [%d] instead", seq, p.channel, block.Header.Number)
+		return nil
+	}
+	// The signatures are verified with the configuration committed to the ledger,
+	// which is up to date since the blocks are committed one by one.
+	if err := p.verify([]*common.Block{block}, p.channel); err != nil {
+		p.logger.Warningf("Failed verifying block [%d] of channel %s: %v", seq, p.channel, err)
+		return nil
+	}
+	return block
 }
 
 func (r *Replicator) appendBlock(block *common.Block, ledger LedgerWriter, channel string) {
@@ -362,29 +438,15 @@ func BlockPullerFromConfigBlock(conf PullerConfig, block *common.Block, verifier
 		return nil, err
 	}
 
-	clientConf := comm.ClientConfig{
-		Timeout: conf.Timeout,
-		SecOpts: &comm.SecureOptions{
-			Certificate:       conf.TLSCert,
-			Key:               conf.TLSKey,
-			RequireClientCert: true,
-			UseTLS:            true,
-		},
-	}
-
-	dialer := &StandardDialer{
-		ClientConfig: clientConf.Clone(),
-	}
-
-	tlsCertAsDER, _ := pem.Decode(conf.TLSCert)
-	if tlsCertAsDER == nil {
-		return nil, errors.Errorf("unable to decode TLS certificate PEM: %s", base64.StdEncoding.EncodeToString(conf.TLSCert))
+	dialer, tlsCert, err := dialerFromPullerConfig(conf)
+	if err != nil {
+		return nil, err
 	}
 
 	return &BlockPuller{
 		Logger:  flogging.MustGetLogger("orderer.common.cluster.replication"),
 		Dialer:  dialer,
-		TLSCert: tlsCertAsDER.Bytes,
+		TLSCert: tlsCert,
 		VerifyBlockSequence: func(blocks []*common.Block, channel string) error {
 			verifier := verifierRetriever.RetrieveVerifier(channel)
 			if verifier == nil {
@@ -401,6 +463,30 @@ func BlockPullerFromConfigBlock(conf PullerConfig, block *common.Block, verifier
 	}, nil
 }
 
+// dialerFromPullerConfig returns a Dialer which connects with the TLS key pair of the given PullerConfig,
+// and the DER encoding of its TLS certificate.
+func dialerFromPullerConfig(conf PullerConfig) (*StandardDialer, []byte, error) {
+	clientConf := comm.ClientConfig{
+		Timeout: conf.Timeout,
+		SecOpts: &comm.SecureOptions{
+			Certificate:       conf.TLSCert,
+			Key:               conf.TLSKey,
+			RequireClientCert: true,
+			UseTLS:            true,
+		},
+	}
+
+	dialer := &StandardDialer{
+		ClientConfig: clientConf.Clone(),
+	}
+
+	tlsCertAsDER, _ := pem.Decode(conf.TLSCert)
+	if tlsCertAsDER == nil {
+		return nil, nil, errors.Errorf("unable to decode TLS certificate PEM: %s", base64.StdEncoding.EncodeToString(conf.TLSCert))
+	}
+	return dialer, tlsCertAsDER.Bytes, nil
+}
+
 // NoopBlockVerifier doesn't verify block signatures
 type NoopBlockVerifier struct{}
 
@@ -439,6 +525,9 @@ var ErrForbidden = errors.New("forbidden pulling the channel")
 // ErrServiceUnavailable denotes that an ordering node is not servicing at the moment.
 var ErrServiceUnavailable = errors.New("service unavailable")
 
+// ErrBlocksPruned denotes that an ordering node has pruned the requested blocks from its ledger.
+var ErrBlocksPruned = errors.New("blocks pruned")
+
 // ErrNotInChannel denotes that an ordering node is not in the channel
 var ErrNotInChannel = errors.New("not in the channel")
 
@@ -28,14 +28,15 @@ var logger = flogging.MustGetLogger("localconfig")
 // modify the default mapping, see the "Unmarshal"
 // section of https://github.com/spf13/viper for more info.
 type TopLevel struct {
-	General    General
-	FileLedger FileLedger
-	RAMLedger  RAMLedger
-	Kafka      Kafka
-	Debug      Debug
-	Consensus  interface{}
-	Operations Operations
-	Metrics    Metrics
+	General              General
+	FileLedger           FileLedger
+	RAMLedger            RAMLedger
+	Kafka                Kafka
+	Debug                Debug
+	Consensus            interface{}
+	Operations           Operations
+	Metrics              Metrics
+	ChannelParticipation ChannelParticipation
 }
 
 // General contains config which should be common among all orderer types.
@@ -56,6 +57,8 @@ type General struct {
 	LocalMSPID        string
 	BCCSP             *bccsp.FactoryOpts
 	Authentication    Authentication
+	RateLimits        RateLimits
+	FairQueuing       FairQueuing
 }
 
 type Cluster struct {
@@ -73,11 +76,27 @@ type Cluster struct {
 	ReplicationRetryTimeout              time.Duration
 	ReplicationBackgroundRefreshInterval time.Duration
 	ReplicationMaxRetries                int
+	ReplicationSources                   ReplicationSources
 	SendBufferSize                       int
 	CertExpirationWarningThreshold       time.Duration
 	TLSHandshakeTimeShift                time.Duration
 }
 
+// ReplicationSources contains configuration for the sources of blocks
+// which are pulled from before other ordering service nodes, when the
+// orderer replicates channels.
+type ReplicationSources struct {
+	BlockDirectory string
+	Peers          []ReplicationPeer
+}
+
+// ReplicationPeer contains configuration for a peer whose Deliver service
+// is a source of blocks.
+type ReplicationPeer struct {
+	Address string
+	RootCAs []string
+}
+
 // Keepalive contains configuration for gRPC servers.
 type Keepalive struct {
 	ServerMinInterval time.Duration
@@ -109,6 +128,37 @@ type Authentication struct {
 	NoExpirationChecks bool
 }
 
+// RateLimits contains the limits on the rate at which organizations may
+// broadcast transactions to each channel. Limits set in the channel
+// configuration take precedence over these.
+type RateLimits struct {
+	Default       RateLimit
+	Organizations []OrganizationRateLimit
+}
+
+// FairQueuing contains configuration for the queuing of the transactions broadcast to
+// each channel, which orders them in turns between their submitters rather than in
+// order of arrival. QueueSize bounds the number of transactions queued per submitter.
+type FairQueuing struct {
+	Enabled   bool
+	QueueSize uint32
+}
+
+// RateLimit bounds the number of transactions per second, allowing bursts of up
+// to Burst transactions. A zero TransactionsPerSecond disables the limit.
+type RateLimit struct {
+	TransactionsPerSecond uint32
+	Burst                 uint32
+}
+
+// OrganizationRateLimit is the rate limit of the organization with the given
+// MSP ID.
+type OrganizationRateLimit struct {
+	MSPID                 string
+	TransactionsPerSecond uint32
+	Burst                 uint32
+}
+
 // Profile contains configuration for Go pprof profiling.
 type Profile struct {
 	Enabled bool
@@ -117,8 +167,16 @@ type Profile struct {
 
 // FileLedger contains configuration for the file-based ledger.
 type FileLedger struct {
-	Location string
-	Prefix   string
+	Location  string
+	Prefix    string
+	Retention Retention
+}
+
+// Retention contains configuration for the pruning of blocks from the file-based ledger.
+type Retention struct {
+	MaxBlocks    uint64
+	ConfigBlocks uint64
+	ArchiveDir   string
 }
 
 // RAMLedger contains configuration for the RAM ledger.
@@ -202,6 +260,13 @@ type Metrics struct {
 	Statsd   Statsd
 }
 
+// ChannelParticipation configures the channel participation API of the orderer,
+// which is served by the operations endpoint.
+type ChannelParticipation struct {
+	Enabled            bool
+	MaxRequestBodySize uint32
+}
+
 // Statsd provides the configuration required to emit statsd metrics from the orderer.
 type Statsd struct {
 	Network       string
@@ -241,6 +306,9 @@ var Defaults = TopLevel{
 		Authentication: Authentication{
 			TimeWindow: time.Duration(15 * time.Minute),
 		},
+		FairQueuing: FairQueuing{
+			QueueSize: 100,
+		},
 	},
 	RAMLedger: RAMLedger{
 		HistorySize: 10000,
@@ -291,6 +359,10 @@ var Defaults = TopLevel{
 	Metrics: Metrics{
 		Provider: "disabled",
 	},
+	ChannelParticipation: ChannelParticipation{
+		Enabled:            false,
+		MaxRequestBodySize: 1024 * 1024,
+	},
 }
 
 // Load parses the orderer YAML file and environment, producing
@@ -326,6 +398,15 @@ func (c *TopLevel) completeInitialization(configDir string) {
 			coreconfig.TranslatePathInPlace(configDir, &c.General.Cluster.ClientCertificate)
 		}
 		c.General.Cluster.RootCAs = translateCAs(configDir, c.General.Cluster.RootCAs)
+		if c.General.Cluster.ReplicationSources.BlockDirectory != "" {
+			coreconfig.TranslatePathInPlace(configDir, &c.General.Cluster.ReplicationSources.BlockDirectory)
+		}
+		for i, peer := range c.General.Cluster.ReplicationSources.Peers {
+			c.General.Cluster.ReplicationSources.Peers[i].RootCAs = translateCAs(configDir, peer.RootCAs)
+		}
+		if c.FileLedger.Retention.ArchiveDir != "" {
+			coreconfig.TranslatePathInPlace(configDir, &c.FileLedger.Retention.ArchiveDir)
+		}
 		// Translate any paths for general TLS configuration
 		c.General.TLS.RootCAs = translateCAs(configDir, c.General.TLS.RootCAs)
 		c.General.TLS.ClientRootCAs = translateCAs(configDir, c.General.TLS.ClientRootCAs)
@@ -350,6 +431,8 @@ func (c *TopLevel) completeInitialization(configDir string) {
 
 		case c.General.GenesisMethod == "":
 			c.General.GenesisMethod = Defaults.General.GenesisMethod
+		case c.General.GenesisMethod == "none" && !c.ChannelParticipation.Enabled:
+			logger.Panic("ChannelParticipation.Enabled must be set to true if General.GenesisMethod is set to none.")
 		case c.General.GenesisFile == "":
 			c.General.GenesisFile = Defaults.General.GenesisFile
 		case c.General.GenesisProfile == "":
@@ -401,6 +484,14 @@ func (c *TopLevel) completeInitialization(configDir string) {
 			logger.Infof("General.Authentication.TimeWindow unset, setting to %s", Defaults.General.Authentication.TimeWindow)
 			c.General.Authentication.TimeWindow = Defaults.General.Authentication.TimeWindow
 
+		case c.General.FairQueuing.Enabled && c.General.FairQueuing.QueueSize == 0:
+			logger.Infof("General.FairQueuing.QueueSize unset, setting to %d", Defaults.General.FairQueuing.QueueSize)
+			c.General.FairQueuing.QueueSize = Defaults.General.FairQueuing.QueueSize
+
+		case c.ChannelParticipation.MaxRequestBodySize == 0:
+			logger.Infof("ChannelParticipation.MaxRequestBodySize unset, setting to %v", Defaults.ChannelParticipation.MaxRequestBodySize)
+			c.ChannelParticipation.MaxRequestBodySize = Defaults.ChannelParticipation.MaxRequestBodySize
+
 		case c.FileLedger.Prefix == "":
 			logger.Infof("FileLedger.Prefix unset, setting to %s", Defaults.FileLedger.Prefix)
 			c.FileLedger.Prefix = Defaults.FileLedger.Prefix
@@ -8,12 +8,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hyperledger/fabric/common/viperutil"
 	"github.com/hyperledger/fabric/core/config/configtest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadGoodConfig(t *testing.T) {
@@ -235,3 +237,69 @@ func TestConnectionTimeout(t *testing.T) {
 		assert.Equal(t, cfg.General.ConnectionTimeout, 10*time.Second)
 	})
 }
+
+func TestChannelParticipation(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.False(t, cfg.ChannelParticipation.Enabled)
+	assert.Equal(t, uint32(1024*1024), cfg.ChannelParticipation.MaxRequestBodySize)
+
+	t.Run("genesis method none requires channel participation", func(t *testing.T) {
+		uconf := &TopLevel{General: General{GenesisMethod: "none"}}
+		assert.Panics(t, func() { uconf.completeInitialization("/dummy/path") })
+
+		uconf = &TopLevel{General: General{GenesisMethod: "none"}, ChannelParticipation: ChannelParticipation{Enabled: true}}
+		assert.NotPanics(t, func() { uconf.completeInitialization("/dummy/path") })
+		assert.Equal(t, Defaults.ChannelParticipation.MaxRequestBodySize, uconf.ChannelParticipation.MaxRequestBodySize)
+	})
+}
+
+func TestRateLimits(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, RateLimits{}, cfg.General.RateLimits)
+
+	dir, err := ioutil.TempDir("", "ratelimits")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sample, err := ioutil.ReadFile(filepath.Join(os.Getenv("FABRIC_CFG_PATH"), "orderer.yaml"))
+	require.NoError(t, err)
+	config := strings.Replace(string(sample), `    RateLimits:
+        Default:
+            TransactionsPerSecond: 0
+            Burst: 0
+`, `    RateLimits:
+        Default:
+            TransactionsPerSecond: 100
+        Organizations:
+          - MSPID: Org1MSP
+            TransactionsPerSecond: 10
+            Burst: 50
+`, 1)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "orderer.yaml"), []byte(config), 0644))
+	os.Setenv("FABRIC_CFG_PATH", dir)
+
+	cfg, err = Load()
+	require.NoError(t, err)
+	assert.Equal(t, RateLimits{
+		Default:       RateLimit{TransactionsPerSecond: 100},
+		Organizations: []OrganizationRateLimit{{MSPID: "Org1MSP", TransactionsPerSecond: 10, Burst: 50}},
+	}, cfg.General.RateLimits)
+}
+
+func TestFairQueuing(t *testing.T) {
+	cleanup := configtest.SetDevFabricConfigPath(t)
+	defer cleanup()
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, FairQueuing{QueueSize: 100}, cfg.General.FairQueuing)
+
+	cfg.General.FairQueuing = FairQueuing{Enabled: true}
+	cfg.completeInitialization("/dummy/path")
+	assert.Equal(t, FairQueuing{Enabled: true, QueueSize: 100}, cfg.General.FairQueuing)
+}
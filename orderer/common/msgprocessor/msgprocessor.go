@@ -10,6 +10,7 @@ package msgprocessor
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/hyperledger/fabric/common/flogging"
 	cb "github.com/hyperledger/fabric/protos/common"
@@ -35,6 +36,16 @@ var ErrPermissionDenied = errors.New("permission denied")
 // as defined by ConsensusType.State != NORMAL. This typically happens during consensus-type migration.
 var ErrMaintenanceMode = errors.New("maintenance mode")
 
+// FairQueueFullError is returned when a message is ordered while the queue of
+// the identity which created it is full.
+type FairQueueFullError struct {
+	MSPID string
+}
+
+func (e *FairQueueFullError) Error() string {
+	return fmt.Sprintf("queue of submitter of organization %s is full", e.MSPID)
+}
+
 // Classification represents the possible message types for the system.
 type Classification int
 
@@ -0,0 +1,151 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/pkg/errors"
+)
+
+// RateLimitError is returned by the rate limit filter when the organization
+// which created a message broadcast more messages than its rate limit allows.
+type RateLimitError struct {
+	MSPID string
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of organization %s exceeded", e.MSPID)
+}
+
+// RateLimitFilterResources defines the subset of the channel resources required to create this filter
+type RateLimitFilterResources interface {
+	// OrdererConfig returns the config.Orderer for the channel and whether the Orderer config exists
+	OrdererConfig() (channelconfig.Orderer, bool)
+}
+
+// NewRateLimitFilter creates a filter which enforces a token bucket rate limit per
+// organization, identified by the MSP ID of the creator of a message. The limits of
+// the channel configuration take precedence over the given local ones. As every
+// application of the filter consumes a token, it is not part of the rule sets of
+// the channels, which messages pass again when they are revalidated, and is only
+// applied to messages as they are broadcast.
+func NewRateLimitFilter(resources RateLimitFilterResources, config localconfig.RateLimits) *RateLimitFilter {
+	return &RateLimitFilter{
+		resources: resources,
+		config:    config,
+		buckets:   make(map[string]*tokenBucket),
+		now:       time.Now,
+	}
+}
+
+// RateLimitFilter implements the Rule interface.
+type RateLimitFilter struct {
+	resources RateLimitFilterResources
+	config    localconfig.RateLimits
+	now       func() time.Time
+
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// Apply returns a RateLimitError if the organization which created the message
+// exhausted its rate limit.
+func (r *RateLimitFilter) Apply(message *common.Envelope) error {
+	ordererConf, ok := r.resources.OrdererConfig()
+	if !ok {
+		logger.Panic("Programming error: orderer config not found")
+	}
+
+	channelLimits := ordererConf.RateLimits()
+	if channelLimits.GetDefault() == nil && len(channelLimits.GetOrganizations()) == 0 &&
+		r.config.Default.TransactionsPerSecond == 0 && len(r.config.Organizations) == 0 {
+		return nil
+	}
+
+	signedData, err := message.AsSignedData()
+	if err != nil {
+		return errors.Errorf("could not convert message to signedData: %s", err)
+	}
+	identity := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(signedData[0].Identity, identity); err != nil {
+		return errors.Wrap(err, "could not unmarshal the identity of the message creator")
+	}
+
+	tps, burst := r.limit(channelLimits, identity.Mspid)
+	if tps == 0 {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	bucket, exists := r.buckets[identity.Mspid]
+	if !exists {
+		bucket = &tokenBucket{}
+		r.buckets[identity.Mspid] = bucket
+	}
+	if !bucket.take(r.now(), tps, burst) {
+		return &RateLimitError{MSPID: identity.Mspid}
+	}
+	return nil
+}
+
+// limit returns the rate limit of the organization with the given MSP ID. The
+// limit of the organization takes precedence over the default one, and the
+// channel configuration takes precedence over the local one. A zero rate means
+// that the organization is not limited.
+func (r *RateLimitFilter) limit(channelLimits *ab.RateLimits, mspID string) (tps, burst uint32) {
+	for _, orgLimit := range channelLimits.GetOrganizations() {
+		if orgLimit.MspId == mspID {
+			return orgLimit.Limit.GetTransactionsPerSecond(), orgLimit.Limit.GetBurst()
+		}
+	}
+	for _, orgLimit := range r.config.Organizations {
+		if orgLimit.MSPID == mspID {
+			return orgLimit.TransactionsPerSecond, orgLimit.Burst
+		}
+	}
+	if defaultLimit := channelLimits.GetDefault(); defaultLimit != nil {
+		return defaultLimit.TransactionsPerSecond, defaultLimit.Burst
+	}
+	return r.config.Default.TransactionsPerSecond, r.config.Default.Burst
+}
+
+// tokenBucket holds the tokens left to an organization. It is refilled at a
+// rate of tps tokens per second and holds up to burst tokens, or tps tokens
+// when burst is smaller.
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+func (b *tokenBucket) take(now time.Time, tps, burst uint32) bool {
+	capacity := math.Max(float64(burst), float64(tps))
+	if b.lastRefill.IsZero() {
+		b.tokens = capacity
+	} else if elapsed := now.Sub(b.lastRefill).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * float64(tps)
+	}
+	b.lastRefill = now
+	// The capacity also shrinks when the limit is lowered by a config update
+	b.tokens = math.Min(capacity, b.tokens)
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,120 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package msgprocessor
+
+import (
+	"testing"
+	"time"
+
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	ab "github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func envelopeFrom(t *testing.T, mspID string) *cb.Envelope {
+	return createEnvelope(t, utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID}))
+}
+
+func TestRateLimitFilter(t *testing.T) {
+	ordererConfig := &mockconfig.Orderer{}
+	now := time.Now()
+	filter := NewRateLimitFilter(&mockconfig.Resources{OrdererConfigVal: ordererConfig}, localconfig.RateLimits{})
+	filter.now = func() time.Time { return now }
+
+	t.Run("unlimited", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+		}
+		// The creator is not inspected when no limit is configured
+		assert.NoError(t, filter.Apply(&cb.Envelope{Payload: []byte("garbage")}))
+	})
+
+	t.Run("burst and refill", func(t *testing.T) {
+		ordererConfig.RateLimitsVal = &ab.RateLimits{
+			Organizations: []*ab.OrganizationRateLimit{
+				{MspId: "Org1MSP", Limit: &ab.RateLimit{TransactionsPerSecond: 2, Burst: 4}},
+			},
+		}
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+		}
+		err := filter.Apply(envelopeFrom(t, "Org1MSP"))
+		assert.Equal(t, &RateLimitError{MSPID: "Org1MSP"}, err)
+		assert.EqualError(t, err, "rate limit of organization Org1MSP exceeded")
+
+		// Other organizations are not limited
+		assert.NoError(t, filter.Apply(envelopeFrom(t, "Org2MSP")))
+
+		now = now.Add(500 * time.Millisecond)
+		assert.NoError(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+		assert.Error(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+
+		now = now.Add(time.Hour)
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+		}
+		assert.Error(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+	})
+
+	t.Run("lowered limit", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		ordererConfig.RateLimitsVal = &ab.RateLimits{
+			Organizations: []*ab.OrganizationRateLimit{
+				{MspId: "Org1MSP", Limit: &ab.RateLimit{TransactionsPerSecond: 1}},
+			},
+		}
+		assert.NoError(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+		assert.Error(t, filter.Apply(envelopeFrom(t, "Org1MSP")))
+	})
+
+	t.Run("malformed envelope", func(t *testing.T) {
+		err := filter.Apply(&cb.Envelope{Payload: []byte("garbage")})
+		assert.Contains(t, err.Error(), "could not convert message to signedData")
+
+		err = filter.Apply(createEnvelope(t, []byte("garbage")))
+		assert.Contains(t, err.Error(), "could not unmarshal the identity of the message creator")
+	})
+}
+
+func TestRateLimitFilterLimit(t *testing.T) {
+	filter := NewRateLimitFilter(nil, localconfig.RateLimits{
+		Default: localconfig.RateLimit{TransactionsPerSecond: 100},
+		Organizations: []localconfig.OrganizationRateLimit{
+			{MSPID: "Org1MSP", TransactionsPerSecond: 10, Burst: 20},
+			{MSPID: "Org2MSP", TransactionsPerSecond: 30},
+		},
+	})
+	channelLimits := &ab.RateLimits{
+		Default: &ab.RateLimit{TransactionsPerSecond: 50, Burst: 60},
+		Organizations: []*ab.OrganizationRateLimit{
+			{MspId: "Org2MSP", Limit: &ab.RateLimit{TransactionsPerSecond: 5}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name          string
+		channelLimits *ab.RateLimits
+		mspID         string
+		tps, burst    uint32
+	}{
+		{name: "local organization limit", channelLimits: nil, mspID: "Org1MSP", tps: 10, burst: 20},
+		{name: "local default limit", channelLimits: nil, mspID: "Org3MSP", tps: 100},
+		{name: "channel organization limit", channelLimits: channelLimits, mspID: "Org2MSP", tps: 5},
+		{name: "local organization over channel default", channelLimits: channelLimits, mspID: "Org1MSP", tps: 10, burst: 20},
+		{name: "channel default limit", channelLimits: channelLimits, mspID: "Org3MSP", tps: 50, burst: 60},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tps, burst := filter.limit(tc.channelLimits, tc.mspID)
+			assert.Equal(t, tc.tps, tps)
+			assert.Equal(t, tc.burst, burst)
+		})
+	}
+}
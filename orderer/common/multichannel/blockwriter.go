@@ -8,6 +8,7 @@ package multichannel
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	newchannelconfig "github.com/hyperledger/fabric/common/channelconfig"
@@ -15,6 +16,7 @@ import (
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/orderer/common/blockcutter"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/utils"
 )
@@ -39,6 +41,9 @@ type BlockWriter struct {
 	lastConfigSeq      uint64
 	lastBlock          *cb.Block
 	committingBlock    sync.Mutex
+	// commitLatencyReporter, if set, is told how long each block took to be
+	// committed since it was handed to the block writer
+	commitLatencyReporter blockcutter.CommitLatencyReporter
 }
 
 func newBlockWriter(lastBlock *cb.Block, r *Registrar, support blockWriterSupport) *BlockWriter {
@@ -90,6 +95,39 @@ func (bw *BlockWriter) CreateNextBlock(messages []*cb.Envelope) *cb.Block {
 // This call will block until the new config has taken effect, then will return
 // while the block is written asynchronously to disk.
 func (bw *BlockWriter) WriteConfigBlock(block *cb.Block, encodedMetadataValue []byte) {
+	if bw.applyConfigBlock(block) {
+		encodedMetadataValue = nil
+	}
+
+	bw.WriteBlock(block, encodedMetadataValue)
+}
+
+// WriteSignedBlock should be invoked for blocks which already carry their orderer
+// metadata and the signatures the consenter collected for them. If the block contains
+// a config transaction, this call will block until the new config has taken effect.
+// Unlike WriteBlock, only the LAST_CONFIG metadata of the block is set, and the block
+// is written asynchronously to disk with its signatures untouched.
+func (bw *BlockWriter) WriteSignedBlock(block *cb.Block) {
+	if utils.IsConfigBlock(block) {
+		bw.applyConfigBlock(block)
+	}
+
+	handedOver := time.Now()
+	bw.committingBlock.Lock()
+	bw.lastBlock = block
+
+	go func() {
+		defer bw.committingBlock.Unlock()
+		bw.addLastConfigSignature(bw.lastBlock)
+		bw.appendBlock()
+		bw.reportCommitLatency(handedOver)
+	}()
+}
+
+// applyConfigBlock applies the config transaction inside the given block, and
+// returns whether the block migrates the channel to another consensus type.
+func (bw *BlockWriter) applyConfigBlock(block *cb.Block) bool {
+	var migration bool
 	ctx, err := utils.ExtractEnvelope(block, 0)
 	if err != nil {
 		logger.Panicf("Told to write a config block, but could not get configtx: %s", err)
@@ -141,7 +179,7 @@ func (bw *BlockWriter) WriteConfigBlock(block *cb.Block, encodedMetadataValue []
 		currentType := bw.support.SharedConfig().ConsensusType()
 		nextType := oc.ConsensusType()
 		if currentType != nextType {
-			encodedMetadataValue = nil
+			migration = true
 			logger.Debugf("[channel: %s] Consensus-type migration: maintenance mode, change from %s to %s, setting metadata to nil",
 				bw.support.ChainID(), currentType, nextType)
 		}
@@ -156,7 +194,7 @@ func (bw *BlockWriter) WriteConfigBlock(block *cb.Block, encodedMetadataValue []
 		logger.Panicf("Told to write a config block with unknown header type: %v", chdr.Type)
 	}
 
-	bw.WriteBlock(block, encodedMetadataValue)
+	return migration
 }
 
 // WriteBlock should be invoked for blocks which contain normal transactions.
@@ -166,15 +204,27 @@ func (bw *BlockWriter) WriteConfigBlock(block *cb.Block, encodedMetadataValue []
 // then release the lock.  This allows the calling thread to begin assembling the next block
 // before the commit phase is complete.
 func (bw *BlockWriter) WriteBlock(block *cb.Block, encodedMetadataValue []byte) {
+	handedOver := time.Now()
 	bw.committingBlock.Lock()
 	bw.lastBlock = block
 
 	go func() {
 		defer bw.committingBlock.Unlock()
 		bw.commitBlock(encodedMetadataValue)
+		bw.reportCommitLatency(handedOver)
 	}()
 }
 
+// reportCommitLatency reports the time since the committed block was handed to the
+// block writer, including the time it waited for the commit of the previous block.
+// This is the commit latency of the orderer's own ledger, which stands in for the
+// commit latency of peers as peers do not report it back to the orderer.
+func (bw *BlockWriter) reportCommitLatency(handedOver time.Time) {
+	if bw.commitLatencyReporter != nil {
+		bw.commitLatencyReporter.ReportCommitLatency(time.Since(handedOver))
+	}
+}
+
 // commitBlock should only ever be invoked with the bw.committingBlock held
 // this ensures that the encoded config sequence numbers stay in sync
 func (bw *BlockWriter) commitBlock(encodedMetadataValue []byte) {
@@ -185,7 +235,11 @@ func (bw *BlockWriter) commitBlock(encodedMetadataValue []byte) {
 
 	bw.addLastConfigSignature(bw.lastBlock)
 	bw.addBlockSignature(bw.lastBlock)
+	bw.appendBlock()
+}
 
+// appendBlock should only ever be invoked with the bw.committingBlock held
+func (bw *BlockWriter) appendBlock() {
 	err := bw.support.Append(bw.lastBlock)
 	if err != nil {
 		logger.Panicf("[channel: %s] Could not append block: %s", bw.support.ChainID(), err)
@@ -14,11 +14,14 @@ import (
 	"github.com/hyperledger/fabric/common/crypto"
 	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	ramledger "github.com/hyperledger/fabric/common/ledger/blockledger/ram"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	mockconfigtx "github.com/hyperledger/fabric/common/mocks/configtx"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter/mock"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/utils"
@@ -258,6 +261,63 @@ func TestMigrationWriteConfig(t *testing.T) {
 	assert.Equal(t, []byte(nil), omd.Value)
 }
 
+func TestWriteSignedBlock(t *testing.T) {
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+	_, l := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+
+	fakeConfig := &mock.OrdererConfig{}
+	fakeConfig.ConsensusTypeReturns("solo")
+	validator := &mockconfigtx.Validator{ChainIDVal: genesisconfig.TestChainID}
+	bw := newBlockWriter(genesisBlockSys, nil,
+		&mockBlockWriterSupport{
+			LocalSigner: mockCrypto(),
+			ReadWriter:  l,
+			Validator:   validator,
+			fakeConfig:  fakeConfig,
+		},
+	)
+
+	signatures := utils.MarshalOrPanic(&cb.Metadata{
+		Value: []byte("value"),
+		Signatures: []*cb.MetadataSignature{
+			{SignatureHeader: []byte("header1"), Signature: []byte("signature1")},
+			{SignatureHeader: []byte("header2"), Signature: []byte("signature2")},
+		},
+	})
+	consenterMetadata := utils.MarshalOrPanic(&cb.Metadata{Value: []byte("foo")})
+
+	// A normal block is committed as is
+	block := cb.NewBlock(1, genesisBlockSys.Header.Hash())
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(&cb.Envelope{Payload: []byte("tx")})}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_ORDERER] = consenterMetadata
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = signatures
+	bw.WriteSignedBlock(block)
+
+	// A config block is committed after its config takes effect
+	configBlock := cb.NewBlock(2, block.Header.Hash())
+	configBlock.Data.Data = [][]byte{utils.MarshalOrPanic(makeConfigTxFull(genesisconfig.TestChainID, 1))}
+	configBlock.Metadata.Metadata[cb.BlockMetadataIndex_ORDERER] = consenterMetadata
+	configBlock.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = signatures
+	bw.WriteSignedBlock(configBlock)
+	assert.Equal(t, uint64(1), validator.SequenceVal)
+
+	// Wait for the commit to complete
+	bw.committingBlock.Lock()
+	bw.committingBlock.Unlock()
+
+	for _, expected := range []*cb.Block{block, configBlock} {
+		cBlock := blockledger.GetBlock(l, expected.Header.Number)
+		assert.Equal(t, expected.Header, cBlock.Header)
+		assert.Equal(t, expected.Data, cBlock.Data)
+		assert.Equal(t, signatures, cBlock.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES])
+		assert.Equal(t, consenterMetadata, cBlock.Metadata.Metadata[cb.BlockMetadataIndex_ORDERER])
+	}
+
+	assert.Equal(t, uint64(0), utils.GetLastConfigIndexFromBlockOrPanic(blockledger.GetBlock(l, 1)))
+	assert.Equal(t, uint64(2), utils.GetLastConfigIndexFromBlockOrPanic(blockledger.GetBlock(l, 2)))
+}
+
 func TestRaceWriteConfig(t *testing.T) {
 	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
 	genesisBlockSys := encoder.New(confSys).GenesisBlock()
@@ -306,3 +366,27 @@ func TestRaceWriteConfig(t *testing.T) {
 	omd := utils.GetMetadataFromBlockOrPanic(block1, cb.BlockMetadataIndex_ORDERER)
 	assert.Equal(t, consenterMetadata1, omd.Value)
 }
+
+func TestWriteBlockReportsCommitLatency(t *testing.T) {
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+	lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, genesisBlockSys)
+
+	commitLatency := &mock.MetricsGauge{}
+	commitLatency.WithReturns(commitLatency)
+	registrar := NewRegistrar(localconfig.TopLevel{}, lf, mockCrypto(), &disabled.Provider{})
+	registrar.blockcutterMetrics.AdaptiveCommitLatency = commitLatency
+	registrar.Initialize(map[string]consensus.Consenter{"solo": &mockConsenter{}})
+	cs := registrar.GetChain(genesisconfig.TestChainID)
+	defer close(cs.Chain.(*mockChain).queue)
+
+	// The block cutter of the channel is told how long the block took to be committed
+	cs.WriteBlock(cs.CreateNextBlock([]*cb.Envelope{{Payload: []byte("tx")}}), nil)
+	cs.BlockWriter.committingBlock.Lock()
+	cs.BlockWriter.committingBlock.Unlock()
+
+	assert.Equal(t, uint64(2), cs.Height())
+	assert.Equal(t, 1, commitLatency.SetCallCount())
+	assert.True(t, commitLatency.SetArgsForCall(0) > 0)
+	assert.Equal(t, []string{"channel", genesisconfig.TestChainID}, commitLatency.WithArgsForCall(0))
+}
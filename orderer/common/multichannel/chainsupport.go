@@ -27,6 +27,9 @@ type ChainSupport struct {
 	consensus.Chain
 	cutter blockcutter.Receiver
 	crypto.LocalSigner
+	// fairQueue is nil unless fair queuing is enabled
+	fairQueue   *fairQueue
+	rateLimiter *msgprocessor.RateLimitFilter
 }
 
 func newChainSupport(
@@ -58,9 +61,13 @@ func newChainSupport(
 
 	// Set up the msgprocessor
 	cs.Processor = msgprocessor.NewStandardChannel(cs, msgprocessor.CreateStandardChannelFilters(cs, registrar.config))
+	cs.rateLimiter = msgprocessor.NewRateLimitFilter(cs, registrar.config.General.RateLimits)
 
 	// Set up the block writer
 	cs.BlockWriter = newBlockWriter(lastBlock, registrar, cs)
+	if reporter, ok := cs.cutter.(blockcutter.CommitLatencyReporter); ok {
+		cs.BlockWriter.commitLatencyReporter = reporter
+	}
 
 	// Set up the consenter
 	consenterType := ledgerResources.SharedConfig().ConsensusType()
@@ -74,6 +81,10 @@ func newChainSupport(
 		logger.Panicf("[channel: %s] Error creating consenter: %s", cs.ChainID(), err)
 	}
 
+	if fairQueuing := registrar.config.General.FairQueuing; fairQueuing.Enabled {
+		cs.fairQueue = newFairQueue(cs.ChainID(), fairQueuing.QueueSize, cs.Chain.Order, registrar.fairQueueMetrics)
+	}
+
 	logger.Debugf("[channel: %s] Done creating channel support resources", cs.ChainID())
 
 	return cs
@@ -99,6 +110,50 @@ func (cs *ChainSupport) Signer() crypto.LocalSigner {
 
 func (cs *ChainSupport) start() {
 	cs.Chain.Start()
+	if cs.fairQueue != nil {
+		go cs.fairQueue.run()
+	}
+}
+
+// Order passes the message to the fair queue of the channel, if fair queuing is enabled,
+// or otherwise directly to the consenter. A queued message is waited for until its turn
+// to be passed to the consenter comes. When the queue of the identity which created
+// the message is full, a msgprocessor.FairQueueFullError is returned.
+func (cs *ChainSupport) Order(env *cb.Envelope, configSeq uint64) error {
+	if cs.fairQueue != nil {
+		return cs.fairQueue.Order(env, configSeq)
+	}
+	return cs.Chain.Order(env, configSeq)
+}
+
+// CheckRateLimit returns a RateLimitError if the organization which created the
+// message exhausted its rate limit. It is only applied to messages received by
+// broadcast, so that messages revalidated after a config update do not consume
+// the tokens of their organization twice.
+func (cs *ChainSupport) CheckRateLimit(env *cb.Envelope) error {
+	return cs.rateLimiter.Apply(env)
+}
+
+// Prunes returns whether blocks are pruned from the ledger of the channel.
+func (cs *ChainSupport) Prunes() bool {
+	pruner, ok := cs.ledgerResources.ReadWriter.(blockledger.Pruner)
+	return ok && pruner.Prunes()
+}
+
+// RetainFrom prevents the block with the given number and the blocks after it
+// from being pruned from the ledger of the channel.
+func (cs *ChainSupport) RetainFrom(blockNum uint64) {
+	if pruner, ok := cs.ledgerResources.ReadWriter.(blockledger.Pruner); ok {
+		pruner.RetainFrom(blockNum)
+	}
+}
+
+// Halt stops the fair queue of the channel, if fair queuing is enabled, and the consenter.
+func (cs *ChainSupport) Halt() {
+	if cs.fairQueue != nil {
+		cs.fairQueue.halt()
+	}
+	cs.Chain.Halt()
 }
 
 // BlockCutter returns the blockcutter.Receiver instance for this channel.
@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/fabric/common/channelconfig"
 	"github.com/hyperledger/fabric/common/deliver/mock"
 	"github.com/hyperledger/fabric/common/ledger/blockledger/mocks"
+	"github.com/hyperledger/fabric/common/metrics/disabled"
 	"github.com/hyperledger/fabric/common/mocks/config"
 	mockconfigtx "github.com/hyperledger/fabric/common/mocks/configtx"
 	mockpolicies "github.com/hyperledger/fabric/common/mocks/policies"
@@ -19,6 +20,9 @@ import (
 	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
 	"github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	ordererconfig "github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/consensus"
 	"github.com/hyperledger/fabric/protos/common"
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/pkg/errors"
@@ -95,6 +99,30 @@ func TestVerifyBlockSignature(t *testing.T) {
 
 }
 
+func TestChainSupportCheckRateLimit(t *testing.T) {
+	confSys := configtxgentest.Load(localconfig.SampleInsecureSoloProfile)
+	genesisBlockSys := encoder.New(confSys).GenesisBlock()
+	lf, _ := newRAMLedgerAndFactory(10, localconfig.TestChainID, genesisBlockSys)
+
+	conf := ordererconfig.TopLevel{}
+	conf.General.RateLimits.Default = ordererconfig.RateLimit{TransactionsPerSecond: 1, Burst: 2}
+	consenters := map[string]consensus.Consenter{confSys.Orderer.OrdererType: &mockConsenter{}}
+	registrar := NewRegistrar(conf, lf, mockCrypto(), &disabled.Provider{})
+	registrar.Initialize(consenters)
+	cs := registrar.GetChain(localconfig.TestChainID)
+
+	// Revalidating a message must not consume the tokens of its organization
+	for i := 0; i < 5; i++ {
+		_, err := cs.ProcessNormalMsg(makeNormalTx(localconfig.TestChainID, i))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, cs.CheckRateLimit(makeNormalTx(localconfig.TestChainID, 0)))
+	assert.NoError(t, cs.CheckRateLimit(makeNormalTx(localconfig.TestChainID, 1)))
+	err := cs.CheckRateLimit(makeNormalTx(localconfig.TestChainID, 2))
+	assert.Equal(t, &msgprocessor.RateLimitError{MSPID: ""}, err)
+}
+
 func testConfigEnvelope(t *testing.T) *common.ConfigEnvelope {
 	config := configtxgentest.Load(localconfig.SampleInsecureSoloProfile)
 	group, err := encoder.NewChannelGroup(config)
@@ -0,0 +1,184 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/pkg/errors"
+)
+
+type queuedMessage struct {
+	env       *cb.Envelope
+	configSeq uint64
+	enqueued  time.Time
+	// result receives the error returned by the chain when ordering the message,
+	// or an error if the message is dropped as the queue is halted
+	result chan error
+}
+
+type submitterQueue struct {
+	identity string
+	mspID    string
+	messages []*queuedMessage
+}
+
+// fairQueue queues the messages of a channel per the identity which created them,
+// and orders them in turns between the identities with queued messages.
+type fairQueue struct {
+	channelID string
+	queueSize int
+	order     func(env *cb.Envelope, configSeq uint64) error
+	metrics   *FairQueueMetrics
+
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	halted bool
+	queued int
+	// queues holds the queues of the identities with queued messages,
+	// and turns holds the same queues in the order of their next turn.
+	queues map[string]*submitterQueue
+	turns  []*submitterQueue
+}
+
+func newFairQueue(channelID string, queueSize uint32, order func(env *cb.Envelope, configSeq uint64) error, metrics *FairQueueMetrics) *fairQueue {
+	fq := &fairQueue{
+		channelID: channelID,
+		queueSize: int(queueSize),
+		order:     order,
+		metrics:   metrics,
+		queues:    make(map[string]*submitterQueue),
+	}
+	fq.cond = sync.NewCond(&fq.mutex)
+	return fq
+}
+
+// Order queues the message and waits for its turn to be ordered, and returns
+// the error returned by the chain when ordering it. A
+// msgprocessor.FairQueueFullError is returned if the queue of the identity which
+// created it is full.
+func (fq *fairQueue) Order(env *cb.Envelope, configSeq uint64) error {
+	msg, err := fq.enqueue(env, configSeq)
+	if err != nil {
+		return err
+	}
+	return <-msg.result
+}
+
+// enqueue queues the message, or returns a msgprocessor.FairQueueFullError if the
+// queue of the identity which created it is full.
+func (fq *fairQueue) enqueue(env *cb.Envelope, configSeq uint64) (*queuedMessage, error) {
+	signedData, err := env.AsSignedData()
+	if err != nil {
+		return nil, errors.Errorf("could not convert message to signedData: %s", err)
+	}
+	identity := string(signedData[0].Identity)
+
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+
+	if fq.halted {
+		return nil, errors.Errorf("channel %s is halted", fq.channelID)
+	}
+
+	queue, exists := fq.queues[identity]
+	if !exists {
+		sID := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(signedData[0].Identity, sID); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal the identity of the message creator")
+		}
+		queue = &submitterQueue{identity: identity, mspID: sID.Mspid}
+		fq.queues[identity] = queue
+		fq.turns = append(fq.turns, queue)
+	}
+
+	if len(queue.messages) >= fq.queueSize {
+		fq.metrics.RejectedCount.With("channel", fq.channelID, "msp_id", queue.mspID).Add(1)
+		return nil, &msgprocessor.FairQueueFullError{MSPID: queue.mspID}
+	}
+
+	msg := &queuedMessage{env: env, configSeq: configSeq, enqueued: time.Now(), result: make(chan error, 1)}
+	queue.messages = append(queue.messages, msg)
+	fq.queued++
+	fq.reportDepth()
+	fq.cond.Signal()
+	return msg, nil
+}
+
+// next blocks until a message is queued, and returns the message of the identity
+// whose turn it is, or false if the queue is halted.
+func (fq *fairQueue) next() (*queuedMessage, bool) {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+
+	for len(fq.turns) == 0 && !fq.halted {
+		fq.cond.Wait()
+	}
+	if fq.halted {
+		return nil, false
+	}
+
+	queue := fq.turns[0]
+	fq.turns = fq.turns[1:]
+	msg := queue.messages[0]
+	queue.messages = queue.messages[1:]
+	if len(queue.messages) == 0 {
+		delete(fq.queues, queue.identity)
+	} else {
+		fq.turns = append(fq.turns, queue)
+	}
+	fq.queued--
+	fq.reportDepth()
+	return msg, true
+}
+
+func (fq *fairQueue) reportDepth() {
+	fq.metrics.QueuedMessages.With("channel", fq.channelID).Set(float64(fq.queued))
+	fq.metrics.Submitters.With("channel", fq.channelID).Set(float64(len(fq.turns)))
+}
+
+// run orders the queued messages in turns until the queue is halted,
+// and hands the result of ordering each message to its submitter.
+func (fq *fairQueue) run() {
+	for {
+		msg, ok := fq.next()
+		if !ok {
+			return
+		}
+		fq.metrics.WaitDuration.With("channel", fq.channelID).Observe(time.Since(msg.enqueued).Seconds())
+		msg.result <- fq.order(msg.env, msg.configSeq)
+	}
+}
+
+// halt stops ordering queued messages, and drops them.
+func (fq *fairQueue) halt() {
+	fq.mutex.Lock()
+	defer fq.mutex.Unlock()
+
+	if fq.halted {
+		return
+	}
+	if fq.queued > 0 {
+		logger.Warningf("[channel: %s] Dropping %d queued messages as the channel is halted", fq.channelID, fq.queued)
+	}
+	for _, queue := range fq.queues {
+		for _, msg := range queue.messages {
+			msg.result <- errors.Errorf("channel %s is halted", fq.channelID)
+		}
+	}
+	fq.halted = true
+	fq.queues = nil
+	fq.turns = nil
+	fq.queued = 0
+	fq.reportDepth()
+	fq.cond.Broadcast()
+}
@@ -0,0 +1,172 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric/common/metrics/disabled"
+	"github.com/hyperledger/fabric/common/metrics/metricsfakes"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envelopeOf(mspID, cert string, txID string) *cb.Envelope {
+	creator := utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: mspID, IdBytes: []byte(cert)})
+	return &cb.Envelope{
+		Payload: utils.MarshalOrPanic(&cb.Payload{
+			Header: &cb.Header{
+				ChannelHeader:   utils.MarshalOrPanic(&cb.ChannelHeader{TxId: txID}),
+				SignatureHeader: utils.MarshalOrPanic(&cb.SignatureHeader{Creator: creator}),
+			},
+		}),
+	}
+}
+
+func txIDOf(t *testing.T, env *cb.Envelope) string {
+	chdr, err := utils.ChannelHeader(env)
+	require.NoError(t, err)
+	return chdr.TxId
+}
+
+type orderRecorder struct {
+	sync.Mutex
+	txIDs   []string
+	ordered chan struct{}
+}
+
+func (or *orderRecorder) order(t *testing.T) func(env *cb.Envelope, configSeq uint64) error {
+	return func(env *cb.Envelope, configSeq uint64) error {
+		or.Lock()
+		or.txIDs = append(or.txIDs, txIDOf(t, env))
+		or.Unlock()
+		or.ordered <- struct{}{}
+		return nil
+	}
+}
+
+func TestFairQueueTurns(t *testing.T) {
+	recorder := &orderRecorder{ordered: make(chan struct{}, 10)}
+	fq := newFairQueue("mychannel", 10, recorder.order(t), NewFairQueueMetrics(&disabled.Provider{}))
+
+	var msgs []*queuedMessage
+	enqueue := func(mspID, cert, txID string) {
+		msg, err := fq.enqueue(envelopeOf(mspID, cert, txID), 0)
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	// A high volume submitter queues its messages before the others
+	for _, txID := range []string{"a1", "a2", "a3", "a4"} {
+		enqueue("Org1MSP", "alice", txID)
+	}
+	enqueue("Org1MSP", "bob", "b1")
+	enqueue("Org2MSP", "carol", "c1")
+	enqueue("Org2MSP", "carol", "c2")
+
+	go fq.run()
+	defer fq.halt()
+	for i := 0; i < 7; i++ {
+		select {
+		case <-recorder.ordered:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the queued messages to be ordered")
+		}
+	}
+	for _, msg := range msgs {
+		assert.NoError(t, <-msg.result)
+	}
+
+	recorder.Lock()
+	defer recorder.Unlock()
+	assert.Equal(t, []string{"a1", "b1", "c1", "a2", "c2", "a3", "a4"}, recorder.txIDs)
+}
+
+func TestFairQueueFull(t *testing.T) {
+	rejectedCount := &metricsfakes.Counter{}
+	rejectedCount.WithReturns(rejectedCount)
+	metrics := NewFairQueueMetrics(&disabled.Provider{})
+	metrics.RejectedCount = rejectedCount
+
+	fq := newFairQueue("mychannel", 2, nil, metrics)
+	_, err := fq.enqueue(envelopeOf("Org1MSP", "alice", "a1"), 0)
+	require.NoError(t, err)
+	_, err = fq.enqueue(envelopeOf("Org1MSP", "alice", "a2"), 0)
+	require.NoError(t, err)
+
+	err = fq.Order(envelopeOf("Org1MSP", "alice", "a3"), 0)
+	assert.Equal(t, &msgprocessor.FairQueueFullError{MSPID: "Org1MSP"}, err)
+	assert.EqualError(t, err, "queue of submitter of organization Org1MSP is full")
+	assert.Equal(t, 1, rejectedCount.WithCallCount())
+	assert.Equal(t, []string{"channel", "mychannel", "msp_id", "Org1MSP"}, rejectedCount.WithArgsForCall(0))
+
+	// Other submitters of the same organization have queues of their own
+	_, err = fq.enqueue(envelopeOf("Org1MSP", "bob", "b1"), 0)
+	assert.NoError(t, err)
+
+	// A message is queued again once one of the queued messages is ordered
+	msg, ok := fq.next()
+	assert.True(t, ok)
+	assert.Equal(t, "a1", txIDOf(t, msg.env))
+	_, err = fq.enqueue(envelopeOf("Org1MSP", "alice", "a3"), 0)
+	assert.NoError(t, err)
+}
+
+func TestFairQueueHalt(t *testing.T) {
+	order := func(env *cb.Envelope, configSeq uint64) error {
+		return nil
+	}
+	fq := newFairQueue("mychannel", 10, order, NewFairQueueMetrics(&disabled.Provider{}))
+	msg, err := fq.enqueue(envelopeOf("Org1MSP", "alice", "a1"), 0)
+	require.NoError(t, err)
+
+	fq.halt()
+	// The submitter of a message dropped from the queue is told it was not ordered
+	assert.EqualError(t, <-msg.result, "channel mychannel is halted")
+
+	done := make(chan struct{})
+	go func() {
+		fq.run()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("fair queue did not stop")
+	}
+
+	err = fq.Order(envelopeOf("Org1MSP", "alice", "a2"), 0)
+	assert.EqualError(t, err, "channel mychannel is halted")
+}
+
+func TestFairQueueOrderError(t *testing.T) {
+	order := func(env *cb.Envelope, configSeq uint64) error {
+		if txIDOf(t, env) == "a2" {
+			return errors.New("chain is not ready")
+		}
+		return nil
+	}
+	fq := newFairQueue("mychannel", 10, order, NewFairQueueMetrics(&disabled.Provider{}))
+	go fq.run()
+	defer fq.halt()
+
+	// The submitter waits for its message to be ordered, and gets the error of the chain
+	assert.NoError(t, fq.Order(envelopeOf("Org1MSP", "alice", "a1"), 0))
+	assert.EqualError(t, fq.Order(envelopeOf("Org1MSP", "alice", "a2"), 0), "chain is not ready")
+}
+
+func TestFairQueueBadMessage(t *testing.T) {
+	fq := newFairQueue("mychannel", 10, nil, NewFairQueueMetrics(&disabled.Provider{}))
+	err := fq.Order(&cb.Envelope{Payload: []byte{1, 2, 3}}, 0)
+	assert.Contains(t, err.Error(), "could not convert message to signedData")
+}
@@ -0,0 +1,58 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package multichannel
+
+import "github.com/hyperledger/fabric/common/metrics"
+
+var (
+	fairQueueQueuedMessages = metrics.GaugeOpts{
+		Namespace:    "fair_queue",
+		Name:         "queued_messages",
+		Help:         "The number of transactions waiting in the fair queue to be ordered.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	fairQueueSubmitters = metrics.GaugeOpts{
+		Namespace:    "fair_queue",
+		Name:         "submitters",
+		Help:         "The number of submitters with transactions waiting in the fair queue.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	fairQueueWaitDuration = metrics.HistogramOpts{
+		Namespace:    "fair_queue",
+		Name:         "wait_duration",
+		Help:         "The time transactions wait in the fair queue before they are ordered, in seconds.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	fairQueueRejectedCount = metrics.CounterOpts{
+		Namespace:    "fair_queue",
+		Name:         "rejected_count",
+		Help:         "The number of transactions rejected because the queue of their submitter was full.",
+		LabelNames:   []string{"channel", "msp_id"},
+		StatsdFormat: "%{#fqname}.%{channel}.%{msp_id}",
+	}
+)
+
+// FairQueueMetrics are the metrics of the fair queues of the channels.
+type FairQueueMetrics struct {
+	QueuedMessages metrics.Gauge
+	Submitters     metrics.Gauge
+	WaitDuration   metrics.Histogram
+	RejectedCount  metrics.Counter
+}
+
+// NewFairQueueMetrics creates the metrics of the fair queues from the given provider.
+func NewFairQueueMetrics(p metrics.Provider) *FairQueueMetrics {
+	return &FairQueueMetrics{
+		QueuedMessages: p.NewGauge(fairQueueQueuedMessages),
+		Submitters:     p.NewGauge(fairQueueSubmitters),
+		WaitDuration:   p.NewHistogram(fairQueueWaitDuration),
+		RejectedCount:  p.NewCounter(fairQueueRejectedCount),
+	}
+}
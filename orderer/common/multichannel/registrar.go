@@ -10,7 +10,9 @@ SPDX-License-Identifier: Apache-2.0
 package multichannel
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/hyperledger/fabric/common/channelconfig"
@@ -22,6 +24,7 @@ import (
 	"github.com/hyperledger/fabric/orderer/common/blockcutter"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/common/types"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -99,6 +102,7 @@ type Registrar struct {
 	ledgerFactory      blockledger.Factory
 	signer             crypto.LocalSigner
 	blockcutterMetrics *blockcutter.Metrics
+	fairQueueMetrics   *FairQueueMetrics
 	systemChannelID    string
 	systemChannel      *ChainSupport
 	templator          msgprocessor.ChannelConfigTemplator
@@ -138,6 +142,7 @@ func NewRegistrar(
 		ledgerFactory:      ledgerFactory,
 		signer:             signer,
 		blockcutterMetrics: blockcutter.NewMetrics(metricsProvider),
+		fairQueueMetrics:   NewFairQueueMetrics(metricsProvider),
 		callbacks:          callbacks,
 	}
 
@@ -209,6 +214,10 @@ func (r *Registrar) Initialize(consenters map[string]consensus.Consenter) {
 	}
 
 	if r.systemChannelID == "" {
+		if r.config.General.GenesisMethod == "none" {
+			logger.Infof("Starting without a system channel, with %d application channels", len(r.chains))
+			return
+		}
 		logger.Panicf("No system chain found.  If bootstrapping, does your system channel contain a consortiums group definition?")
 	}
 }
@@ -230,6 +239,9 @@ func (r *Registrar) BroadcastChannelSupport(msg *cb.Envelope) (*cb.ChannelHeader
 	cs := r.GetChain(chdr.ChannelId)
 	// New channel creation
 	if cs == nil {
+		if r.systemChannel == nil {
+			return nil, false, nil, errors.Errorf("channel %s does not exist, and channel creation requests are not allowed because the orderer has no system channel", chdr.ChannelId)
+		}
 		cs = r.systemChannel
 	}
 
@@ -343,11 +355,184 @@ func (r *Registrar) ChannelsCount() int {
 	return len(r.chains)
 }
 
+// ChannelIDs returns the IDs of the channels the orderer is a member of, sorted.
+func (r *Registrar) ChannelIDs() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	channelIDs := make([]string, 0, len(r.chains))
+	for channelID := range r.chains {
+		channelIDs = append(channelIDs, channelID)
+	}
+	sort.Strings(channelIDs)
+	return channelIDs
+}
+
 // NewChannelConfig produces a new template channel configuration based on the system channel's current config.
 func (r *Registrar) NewChannelConfig(envConfigUpdate *cb.Envelope) (channelconfig.Resources, error) {
+	if r.templator == nil {
+		return nil, errors.New("channel creation is not supported because the orderer has no system channel")
+	}
 	return r.templator.NewChannelConfig(envConfigUpdate)
 }
 
+// ChannelList returns the system channel, if any, and the application channels of the orderer.
+func (r *Registrar) ChannelList() types.ChannelList {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	list := types.ChannelList{}
+	for channelID := range r.chains {
+		if channelID == r.systemChannelID {
+			list.SystemChannel = &types.ChannelInfoShort{Name: channelID}
+			continue
+		}
+		list.Channels = append(list.Channels, types.ChannelInfoShort{Name: channelID})
+	}
+	sort.Slice(list.Channels, func(i, j int) bool {
+		return list.Channels[i].Name < list.Channels[j].Name
+	})
+	return list
+}
+
+// ChannelInfo returns the information of the given channel.
+func (r *Registrar) ChannelInfo(channelID string) (types.ChannelInfo, error) {
+	cs := r.GetChain(channelID)
+	if cs == nil {
+		return types.ChannelInfo{}, types.ErrChannelNotExist
+	}
+	return types.ChannelInfo{
+		Name:          channelID,
+		ConsensusType: cs.SharedConfig().ConsensusType(),
+		Height:        cs.Height(),
+	}, nil
+}
+
+// JoinChannel makes the orderer a member of the application channel with the given genesis block,
+// creating the ledger of the channel and starting its chain. Channels can only be joined when
+// the orderer has no system channel.
+func (r *Registrar) JoinChannel(channelID string, genesisBlock *cb.Block) (types.ChannelInfo, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.systemChannelID != "" {
+		return types.ChannelInfo{}, types.ErrSystemChannelExists
+	}
+	if _, exists := r.chains[channelID]; exists {
+		return types.ChannelInfo{}, types.ErrChannelAlreadyExists
+	}
+
+	configTx, err := validateJoinBlock(channelID, genesisBlock, r.consenters)
+	if err != nil {
+		return types.ChannelInfo{}, err
+	}
+
+	ledger, err := r.ledgerFactory.GetOrCreate(channelID)
+	if err != nil {
+		return types.ChannelInfo{}, errors.WithMessage(err, "failed to create ledger of channel "+channelID)
+	}
+	if ledger.Height() == 0 {
+		if err := ledger.Append(genesisBlock); err != nil {
+			return types.ChannelInfo{}, errors.WithMessage(err, "failed to append genesis block of channel "+channelID)
+		}
+	}
+
+	ledgerResources := r.newLedgerResources(configTx)
+	cs := newChainSupport(r, ledgerResources, r.consenters, r.signer, r.blockcutterMetrics)
+
+	// Copy the map to allow concurrent reads from broadcast/deliver while the new chainSupport is added
+	newChains := make(map[string]*ChainSupport)
+	for key, value := range r.chains {
+		newChains[key] = value
+	}
+	newChains[channelID] = cs
+	logger.Infof("Joined and starting channel %s", channelID)
+	cs.start()
+	r.chains = newChains
+
+	return types.ChannelInfo{
+		Name:          channelID,
+		ConsensusType: cs.SharedConfig().ConsensusType(),
+		Height:        cs.Height(),
+	}, nil
+}
+
+// validateJoinBlock checks that the given block is the genesis block of the given application
+// channel, of a consensus type supported by the given consenters, and returns its config transaction.
+func validateJoinBlock(channelID string, block *cb.Block, consenters map[string]consensus.Consenter) (*cb.Envelope, error) {
+	if block == nil || block.Header == nil || block.Data == nil || len(block.Data.Data) == 0 {
+		return nil, errors.New("block is empty")
+	}
+	if block.Header.Number != 0 {
+		return nil, errors.Errorf("block number is %d, but only the genesis block of a channel can be joined", block.Header.Number)
+	}
+	if !bytes.Equal(block.Data.Hash(), block.Header.DataHash) {
+		return nil, errors.New("block data hash does not match the block header")
+	}
+	env, err := utils.ExtractEnvelope(block, 0)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to extract config transaction")
+	}
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to extract channel header")
+	}
+	if chdr.Type != int32(cb.HeaderType_CONFIG) {
+		return nil, errors.Errorf("block is not a config block, its header type is %d", chdr.Type)
+	}
+	if chdr.ChannelId != channelID {
+		return nil, errors.Errorf("block is of channel %s, not of channel %s", chdr.ChannelId, channelID)
+	}
+	bundle, err := channelconfig.NewBundleFromEnvelope(env)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to parse channel config")
+	}
+	if err := checkResources(bundle); err != nil {
+		return nil, err
+	}
+	if _, isSystemChannel := bundle.ConsortiumsConfig(); isSystemChannel {
+		return nil, errors.New("block is of a system channel, which cannot be joined")
+	}
+	ordererConfig, ok := bundle.OrdererConfig()
+	if !ok {
+		return nil, errors.New("block has no orderer config")
+	}
+	if _, supported := consenters[ordererConfig.ConsensusType()]; !supported {
+		return nil, errors.Errorf("consensus type %s of the channel is not supported by this orderer", ordererConfig.ConsensusType())
+	}
+	return env, nil
+}
+
+// RemoveChannel halts the chain of the given application channel and removes its ledger.
+// Channels can only be removed when the orderer has no system channel.
+func (r *Registrar) RemoveChannel(channelID string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.systemChannelID != "" {
+		return types.ErrSystemChannelExists
+	}
+	cs, exists := r.chains[channelID]
+	if !exists {
+		return types.ErrChannelNotExist
+	}
+
+	cs.Halt()
+	newChains := make(map[string]*ChainSupport)
+	for key, value := range r.chains {
+		if key != channelID {
+			newChains[key] = value
+		}
+	}
+	r.chains = newChains
+
+	if err := r.ledgerFactory.Remove(channelID); err != nil {
+		return errors.WithMessage(err, "failed to remove ledger of channel "+channelID)
+	}
+	logger.Infof("Removed channel %s", channelID)
+	return nil
+}
+
 // CreateBundle calls channelconfig.NewBundle
 func (r *Registrar) CreateBundle(channelID string, config *cb.Config) (channelconfig.Resources, error) {
 	return channelconfig.NewBundle(channelID, config)
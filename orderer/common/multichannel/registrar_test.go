@@ -22,6 +22,7 @@ import (
 	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/blockcutter"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/types"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -189,10 +190,12 @@ func TestCreateChain(t *testing.T) {
 
 		// Before creating the chain, it doesn't exist
 		assert.Nil(t, manager.GetChain("mychannel"))
+		assert.Equal(t, []string{genesisconfig.TestChainID}, manager.ChannelIDs())
 		// After creating the chain, it exists
 		manager.CreateChain("mychannel")
 		chain := manager.GetChain("mychannel")
 		assert.NotNil(t, chain)
+		assert.Equal(t, []string{"mychannel", genesisconfig.TestChainID}, manager.ChannelIDs())
 		// A subsequent creation, replaces the chain.
 		manager.CreateChain("mychannel")
 		chain2 := manager.GetChain("mychannel")
@@ -390,3 +393,92 @@ func TestBroadcastChannelSupportRejection(t *testing.T) {
 		assert.Error(t, err, "Messages of type HeaderType_CONFIG should return an error.")
 	})
 }
+
+func TestChannelParticipation(t *testing.T) {
+	appProfile := configtxgentest.Load(genesisconfig.SampleDevModeSoloProfile)
+	appProfile.Consortiums = nil
+	genesisBlockApp := encoder.New(appProfile).GenesisBlockForChannel("mychannel")
+	confSys := configtxgentest.Load(genesisconfig.SampleInsecureSoloProfile)
+	consenters := map[string]consensus.Consenter{"solo": &mockConsenter{}}
+
+	t.Run("without system channel", func(t *testing.T) {
+		conf := localconfig.TopLevel{General: localconfig.General{GenesisMethod: "none"}}
+		lf := ramledger.New(10)
+		registrar := NewRegistrar(conf, lf, mockCrypto(), &disabled.Provider{})
+		registrar.Initialize(consenters)
+		assert.Equal(t, types.ChannelList{}, registrar.ChannelList())
+
+		_, err := registrar.JoinChannel("otherchannel", genesisBlockApp)
+		assert.EqualError(t, err, "block is of channel mychannel, not of channel otherchannel")
+
+		laterBlock := proto.Clone(genesisBlockApp).(*cb.Block)
+		laterBlock.Header.Number = 1
+		_, err = registrar.JoinChannel("mychannel", laterBlock)
+		assert.EqualError(t, err, "block number is 1, but only the genesis block of a channel can be joined")
+
+		tamperedBlock := proto.Clone(genesisBlockApp).(*cb.Block)
+		tamperedBlock.Data.Data[0] = append(tamperedBlock.Data.Data[0], 0)
+		_, err = registrar.JoinChannel("mychannel", tamperedBlock)
+		assert.EqualError(t, err, "block data hash does not match the block header")
+
+		_, err = registrar.JoinChannel("syschannel", encoder.New(confSys).GenesisBlockForChannel("syschannel"))
+		assert.EqualError(t, err, "block is of a system channel, which cannot be joined")
+		assert.Empty(t, lf.ChainIDs(), "no ledger should be created for rejected blocks")
+
+		info, err := registrar.JoinChannel("mychannel", genesisBlockApp)
+		assert.NoError(t, err)
+		assert.Equal(t, types.ChannelInfo{Name: "mychannel", ConsensusType: "solo", Height: 1}, info)
+		chain := registrar.GetChain("mychannel")
+		assert.NotNil(t, chain)
+		assert.Equal(t, types.ChannelList{Channels: []types.ChannelInfoShort{{Name: "mychannel"}}}, registrar.ChannelList())
+		info, err = registrar.ChannelInfo("mychannel")
+		assert.NoError(t, err)
+		assert.Equal(t, types.ChannelInfo{Name: "mychannel", ConsensusType: "solo", Height: 1}, info)
+
+		_, err = registrar.JoinChannel("mychannel", genesisBlockApp)
+		assert.Equal(t, types.ErrChannelAlreadyExists, err)
+
+		_, _, _, err = registrar.BroadcastChannelSupport(makeConfigTx("newchannel", 1))
+		assert.EqualError(t, err, "channel newchannel does not exist, and channel creation requests are not allowed because the orderer has no system channel")
+		_, err = registrar.NewChannelConfig(makeConfigTx("newchannel", 1))
+		assert.EqualError(t, err, "channel creation is not supported because the orderer has no system channel")
+
+		assert.NoError(t, registrar.RemoveChannel("mychannel"))
+		_, open := <-chain.Chain.(*mockChain).queue
+		assert.False(t, open, "the chain of the removed channel should be halted")
+		assert.Nil(t, registrar.GetChain("mychannel"))
+		assert.Equal(t, types.ChannelList{}, registrar.ChannelList())
+		assert.Empty(t, lf.ChainIDs())
+		_, err = registrar.ChannelInfo("mychannel")
+		assert.Equal(t, types.ErrChannelNotExist, err)
+		assert.Equal(t, types.ErrChannelNotExist, registrar.RemoveChannel("mychannel"))
+
+		// a removed channel can be joined again
+		_, err = registrar.JoinChannel("mychannel", genesisBlockApp)
+		assert.NoError(t, err)
+		close(registrar.GetChain("mychannel").Chain.(*mockChain).queue)
+	})
+
+	t.Run("unsupported consensus type", func(t *testing.T) {
+		conf := localconfig.TopLevel{General: localconfig.General{GenesisMethod: "none"}}
+		lf := ramledger.New(10)
+		registrar := NewRegistrar(conf, lf, mockCrypto(), &disabled.Provider{})
+		registrar.Initialize(map[string]consensus.Consenter{"etcdraft": &mockConsenter{}})
+
+		_, err := registrar.JoinChannel("mychannel", genesisBlockApp)
+		assert.EqualError(t, err, "consensus type solo of the channel is not supported by this orderer")
+		assert.Empty(t, lf.ChainIDs(), "no ledger should be created for rejected blocks")
+		assert.Nil(t, registrar.GetChain("mychannel"))
+	})
+
+	t.Run("with system channel", func(t *testing.T) {
+		lf, _ := newRAMLedgerAndFactory(10, genesisconfig.TestChainID, encoder.New(confSys).GenesisBlock())
+		registrar := NewRegistrar(localconfig.TopLevel{}, lf, mockCrypto(), &disabled.Provider{})
+		registrar.Initialize(consenters)
+
+		assert.Equal(t, types.ChannelList{SystemChannel: &types.ChannelInfoShort{Name: genesisconfig.TestChainID}}, registrar.ChannelList())
+		_, err := registrar.JoinChannel("mychannel", genesisBlockApp)
+		assert.Equal(t, types.ErrSystemChannelExists, err)
+		assert.Equal(t, types.ErrSystemChannelExists, registrar.RemoveChannel(genesisconfig.TestChainID))
+	})
+}
@@ -0,0 +1,149 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/types"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
+)
+
+// AdminStatusPath is the path of the operations endpoint that reports the status of the channels of the orderer.
+const AdminStatusPath = "/admin/v1/status"
+
+//go:generate counterfeiter -o mocks/admin_channels.go -fake-name AdminChannels . AdminChannels
+
+// AdminChannels provides the channels of the orderer to the admin status endpoint.
+type AdminChannels interface {
+	// ChannelList returns the system channel, if any, and the application channels of the orderer
+	ChannelList() types.ChannelList
+	// ChannelInfo returns the information of a channel
+	ChannelInfo(channelID string) (types.ChannelInfo, error)
+	// ConsensusChain returns the chain of a channel, or nil if it does not exist
+	ConsensusChain(channelID string) consensus.Chain
+}
+
+// RaftChain is a chain which reports its Raft state.
+type RaftChain interface {
+	RaftStatus() (etcdraft.RaftStatus, error)
+	ClusterStatus() etcdraft.ClusterStatus
+}
+
+// AdminStatusHandler reports the status of the channels of the orderer.
+type AdminStatusHandler struct {
+	Channels AdminChannels
+}
+
+// ServeHTTP responds to GET requests with the status of every channel, or with the status
+// of the channel in the "channel" query parameter.
+func (h *AdminStatusHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		resp.Header().Set("Allow", "GET")
+		h.sendResponse(resp, http.StatusMethodNotAllowed, errorResponse{Error: fmt.Sprintf("invalid request method: %s", req.Method)})
+		return
+	}
+
+	if channelID := req.URL.Query().Get("channel"); channelID != "" {
+		status, err := h.channelStatus(channelID)
+		if err == types.ErrChannelNotExist {
+			h.sendResponse(resp, http.StatusNotFound, errorResponse{Error: fmt.Sprintf("channel %s does not exist", channelID)})
+			return
+		}
+		if err != nil {
+			h.sendResponse(resp, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+			return
+		}
+		h.sendResponse(resp, http.StatusOK, status)
+		return
+	}
+
+	list := h.Channels.ChannelList()
+	var channelIDs []string
+	if list.SystemChannel != nil {
+		channelIDs = append(channelIDs, list.SystemChannel.Name)
+	}
+	for _, channel := range list.Channels {
+		channelIDs = append(channelIDs, channel.Name)
+	}
+
+	nodeStatus := types.NodeStatus{Channels: []types.ChannelStatus{}}
+	for _, channelID := range channelIDs {
+		status, err := h.channelStatus(channelID)
+		if err != nil {
+			// The channel was removed after it was listed
+			logger.Debugf("Skipping status of channel %s: %s", channelID, err)
+			continue
+		}
+		status.SystemChannel = list.SystemChannel != nil && channelID == list.SystemChannel.Name
+		nodeStatus.Channels = append(nodeStatus.Channels, status)
+	}
+	h.sendResponse(resp, http.StatusOK, nodeStatus)
+}
+
+func (h *AdminStatusHandler) channelStatus(channelID string) (types.ChannelStatus, error) {
+	info, err := h.Channels.ChannelInfo(channelID)
+	if err != nil {
+		return types.ChannelStatus{}, err
+	}
+	status := types.ChannelStatus{
+		Name:          info.Name,
+		ConsensusType: info.ConsensusType,
+		Height:        info.Height,
+	}
+
+	raftChain, ok := h.Channels.ConsensusChain(channelID).(RaftChain)
+	if !ok {
+		return status, nil
+	}
+	raftStatus, err := raftChain.RaftStatus()
+	if err != nil {
+		logger.Debugf("Raft status of channel %s is unavailable: %s", channelID, err)
+		return status, nil
+	}
+	clusterStatus := raftChain.ClusterStatus()
+	status.Raft = &types.RaftStatus{
+		NodeID:       raftStatus.ID,
+		State:        raftStatus.State,
+		Leader:       raftStatus.Leader,
+		Term:         raftStatus.Term,
+		CommitIndex:  raftStatus.CommitIndex,
+		AppliedIndex: raftStatus.AppliedIndex,
+		Voters:       clusterStatus.Voters,
+		Learners:     clusterStatus.Learners,
+		Consenters:   consenterConnections(raftStatus.Consenters),
+	}
+	return status, nil
+}
+
+func consenterConnections(statuses []cluster.RemoteNodeStatus) []types.ConsenterConnection {
+	connections := []types.ConsenterConnection{}
+	for _, status := range statuses {
+		connections = append(connections, types.ConsenterConnection{
+			ID:       status.ID,
+			Endpoint: status.Endpoint,
+			State:    status.State,
+		})
+	}
+	return connections
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h *AdminStatusHandler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/server/mocks"
+	"github.com/hyperledger/fabric/orderer/common/types"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type raftChain struct {
+	consensus.Chain
+	raftStatus    etcdraft.RaftStatus
+	raftStatusErr error
+	clusterStatus etcdraft.ClusterStatus
+}
+
+func (rc *raftChain) RaftStatus() (etcdraft.RaftStatus, error) {
+	return rc.raftStatus, rc.raftStatusErr
+}
+
+func (rc *raftChain) ClusterStatus() etcdraft.ClusterStatus {
+	return rc.clusterStatus
+}
+
+func newAdminChannels() *mocks.AdminChannels {
+	channels := &mocks.AdminChannels{}
+	channels.ChannelListReturns(types.ChannelList{
+		SystemChannel: &types.ChannelInfoShort{Name: "system-channel"},
+		Channels: []types.ChannelInfoShort{
+			{Name: "app-channel"},
+			{Name: "removed-channel"},
+		},
+	})
+	channels.ChannelInfoStub = func(channelID string) (types.ChannelInfo, error) {
+		switch channelID {
+		case "system-channel":
+			return types.ChannelInfo{Name: channelID, ConsensusType: "solo", Height: 3}, nil
+		case "app-channel":
+			return types.ChannelInfo{Name: channelID, ConsensusType: "etcdraft", Height: 10}, nil
+		default:
+			return types.ChannelInfo{}, types.ErrChannelNotExist
+		}
+	}
+	channels.ConsensusChainStub = func(channelID string) consensus.Chain {
+		if channelID != "app-channel" {
+			return nil
+		}
+		return &raftChain{
+			raftStatus: etcdraft.RaftStatus{
+				ID:           1,
+				State:        "leader",
+				Leader:       1,
+				Term:         2,
+				CommitIndex:  12,
+				AppliedIndex: 11,
+				Consenters: []cluster.RemoteNodeStatus{
+					{ID: 2, Endpoint: "orderer2:7050", State: "READY"},
+					{ID: 3, Endpoint: "orderer3:7050", State: "TRANSIENT_FAILURE"},
+				},
+			},
+			clusterStatus: etcdraft.ClusterStatus{
+				Leader: 1,
+				Voters: []uint64{1, 2, 3},
+			},
+		}
+	}
+	return channels
+}
+
+func getStatus(t *testing.T, h *AdminStatusHandler, method, target string, payload interface{}) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(method, target, nil))
+	assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(payload))
+	return resp
+}
+
+func TestAdminStatusHandler(t *testing.T) {
+	expectedAppChannel := types.ChannelStatus{
+		Name:          "app-channel",
+		ConsensusType: "etcdraft",
+		Height:        10,
+		Raft: &types.RaftStatus{
+			NodeID:       1,
+			State:        "leader",
+			Leader:       1,
+			Term:         2,
+			CommitIndex:  12,
+			AppliedIndex: 11,
+			Voters:       []uint64{1, 2, 3},
+			Consenters: []types.ConsenterConnection{
+				{ID: 2, Endpoint: "orderer2:7050", State: "READY"},
+				{ID: 3, Endpoint: "orderer3:7050", State: "TRANSIENT_FAILURE"},
+			},
+		},
+	}
+
+	t.Run("all channels", func(t *testing.T) {
+		h := &AdminStatusHandler{Channels: newAdminChannels()}
+		status := types.NodeStatus{}
+		resp := getStatus(t, h, http.MethodGet, AdminStatusPath, &status)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, types.NodeStatus{
+			Channels: []types.ChannelStatus{
+				{Name: "system-channel", SystemChannel: true, ConsensusType: "solo", Height: 3},
+				expectedAppChannel,
+			},
+		}, status)
+	})
+
+	t.Run("single channel", func(t *testing.T) {
+		h := &AdminStatusHandler{Channels: newAdminChannels()}
+		status := types.ChannelStatus{}
+		resp := getStatus(t, h, http.MethodGet, AdminStatusPath+"?channel=app-channel", &status)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedAppChannel, status)
+	})
+
+	t.Run("raft status unavailable", func(t *testing.T) {
+		channels := newAdminChannels()
+		channels.ConsensusChainReturns(&raftChain{raftStatusErr: errors.New("chain is stopped")})
+		channels.ConsensusChainStub = nil
+		h := &AdminStatusHandler{Channels: channels}
+		status := types.ChannelStatus{}
+		resp := getStatus(t, h, http.MethodGet, AdminStatusPath+"?channel=app-channel", &status)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, types.ChannelStatus{Name: "app-channel", ConsensusType: "etcdraft", Height: 10}, status)
+	})
+
+	t.Run("channel does not exist", func(t *testing.T) {
+		h := &AdminStatusHandler{Channels: newAdminChannels()}
+		errResp := errorResponse{}
+		resp := getStatus(t, h, http.MethodGet, AdminStatusPath+"?channel=removed-channel", &errResp)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, "channel removed-channel does not exist", errResp.Error)
+	})
+
+	t.Run("bad method", func(t *testing.T) {
+		h := &AdminStatusHandler{Channels: newAdminChannels()}
+		errResp := errorResponse{}
+		resp := getStatus(t, h, http.MethodPost, AdminStatusPath, &errResp)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+		assert.Equal(t, "GET", resp.Header().Get("Allow"))
+		assert.Equal(t, "invalid request method: POST", errResp.Error)
+	})
+}
@@ -40,13 +40,16 @@ import (
 	"github.com/hyperledger/fabric/msp"
 	mspmgmt "github.com/hyperledger/fabric/msp/mgmt"
 	"github.com/hyperledger/fabric/orderer/common/bootstrap/file"
+	"github.com/hyperledger/fabric/orderer/common/channelparticipation"
 	"github.com/hyperledger/fabric/orderer/common/cluster"
 	"github.com/hyperledger/fabric/orderer/common/localconfig"
 	"github.com/hyperledger/fabric/orderer/common/metadata"
 	"github.com/hyperledger/fabric/orderer/common/multichannel"
 	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/bft"
 	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
 	"github.com/hyperledger/fabric/orderer/consensus/kafka"
+	"github.com/hyperledger/fabric/orderer/consensus/migration"
 	"github.com/hyperledger/fabric/orderer/consensus/solo"
 	cb "github.com/hyperledger/fabric/protos/common"
 	ab "github.com/hyperledger/fabric/protos/orderer"
@@ -66,7 +69,7 @@ var (
 	version   = app.Command("version", "Show version information")
 	benchmark = app.Command("benchmark", "Run orderer in benchmark mode")
 
-	clusterTypes = map[string]struct{}{"etcdraft": {}}
+	clusterTypes = map[string]struct{}{"etcdraft": {}, "bft": {}}
 )
 
 // Main is the entry point of orderer process
@@ -93,9 +96,12 @@ func Main() {
 
 // Start provides a layer of abstraction for benchmark test
 func Start(cmd string, conf *localconfig.TopLevel) {
-	bootstrapBlock := extractBootstrapBlock(conf)
-	if err := ValidateBootstrapBlock(bootstrapBlock); err != nil {
-		logger.Panicf("Failed validating bootstrap block: %v", err)
+	var bootstrapBlock *cb.Block
+	if conf.General.GenesisMethod != "none" {
+		bootstrapBlock = extractBootstrapBlock(conf)
+		if err := ValidateBootstrapBlock(bootstrapBlock); err != nil {
+			logger.Panicf("Failed validating bootstrap block: %v", err)
+		}
 	}
 
 	opsSystem := newOperationsSystem(conf.Operations, conf.Metrics)
@@ -107,8 +113,11 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	metricsProvider := opsSystem.Provider
 
 	lf, _ := createLedgerFactory(conf, metricsProvider)
-	sysChanLastConfigBlock := extractSysChanLastConfig(lf, bootstrapBlock)
-	clusterBootBlock := selectClusterBootBlock(bootstrapBlock, sysChanLastConfigBlock)
+	var clusterBootBlock *cb.Block
+	if bootstrapBlock != nil {
+		sysChanLastConfigBlock := extractSysChanLastConfig(lf, bootstrapBlock)
+		clusterBootBlock = selectClusterBootBlock(bootstrapBlock, sysChanLastConfigBlock)
+	}
 
 	signer := localmsp.NewSigner()
 
@@ -130,10 +139,15 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	var clusterDialer *cluster.PredicateDialer
 
 	var reuseGrpcListener bool
-	typ := consensusType(bootstrapBlock)
 	var serversToUpdate []*comm.GRPCServer
 
-	clusterType := isClusterType(clusterBootBlock)
+	// Without a system channel, the orderer is a member of the Raft clusters of the channels it joins.
+	typ := "etcdraft"
+	clusterType := true
+	if bootstrapBlock != nil {
+		typ = consensusType(bootstrapBlock)
+		clusterType = isClusterType(clusterBootBlock)
+	}
 	if clusterType {
 		logger.Infof("Setting up cluster for orderer type %s", typ)
 
@@ -142,10 +156,12 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 			ClientConfig: clusterClientConfig,
 		}
 
-		r = createReplicator(lf, bootstrapBlock, conf, clusterClientConfig.SecOpts, signer)
-		// Only clusters that are equipped with a recent config block can replicate.
-		if conf.General.GenesisMethod == "file" {
-			r.replicateIfNeeded(bootstrapBlock)
+		if bootstrapBlock != nil {
+			r = createReplicator(lf, bootstrapBlock, conf, clusterClientConfig.SecOpts, signer)
+			// Only clusters that are equipped with a recent config block can replicate.
+			if conf.General.GenesisMethod == "file" {
+				r.replicateIfNeeded(bootstrapBlock)
+			}
 		}
 
 		if reuseGrpcListener = reuseListener(conf, typ); !reuseGrpcListener {
@@ -187,6 +203,13 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 		time.AfterFunc)
 
 	manager := initializeMultichannelRegistrar(clusterBootBlock, r, clusterDialer, clusterServerConfig, clusterGRPCServer, conf, signer, metricsProvider, opsSystem, lf, tlsCallback)
+	opsSystem.RegisterHandler(migration.StatusPath, &migration.StatusHandler{Channels: &channelConfigs{Registrar: manager}})
+	leadership := &etcdraft.LeadershipHandler{Channels: manager, Logger: flogging.MustGetLogger("orderer.consensus.etcdraft")}
+	opsSystem.RegisterHandler(etcdraft.LeadershipPath, leadership)
+	opsSystem.RegisterHandler(AdminStatusPath, &AdminStatusHandler{Channels: &adminChannels{Registrar: manager}})
+	if conf.ChannelParticipation.Enabled {
+		opsSystem.RegisterHandler(channelparticipation.URLBaseV1, channelparticipation.NewHTTPHandler(conf.ChannelParticipation, manager))
+	}
 	mutualTLS := serverConfig.SecOpts.UseTLS && serverConfig.SecOpts.RequireClientCert
 	expiration := conf.General.Authentication.NoExpirationChecks
 	server := NewServer(manager, metricsProvider, &conf.Debug, conf.General.Authentication.TimeWindow, mutualTLS, expiration)
@@ -194,6 +217,9 @@ func Start(cmd string, conf *localconfig.TopLevel) {
 	logger.Infof("Starting %s", metadata.GetVersionInfo())
 	go handleSignals(addPlatformSignals(map[os.Signal]func(){
 		syscall.SIGTERM: func() {
+			// Hand the leadership of Raft channels over before shutting down,
+			// to spare the followers an election timeout.
+			leadership.TransferLeadership("", "")
 			grpcServer.Stop()
 			if clusterGRPCServer != grpcServer {
 				clusterGRPCServer.Stop()
@@ -653,12 +679,14 @@ func initializeMultichannelRegistrar(
 	lf blockledger.Factory,
 	callbacks ...channelconfig.BundleActor,
 ) *multichannel.Registrar {
-	genesisBlock := extractBootstrapBlock(conf)
-	// Are we bootstrapping?
-	if len(lf.ChainIDs()) == 0 {
-		initializeBootstrapChannel(genesisBlock, lf)
-	} else {
-		logger.Info("Not bootstrapping because of existing channels")
+	if conf.General.GenesisMethod != "none" {
+		genesisBlock := extractBootstrapBlock(conf)
+		// Are we bootstrapping?
+		if len(lf.ChainIDs()) == 0 {
+			initializeBootstrapChannel(genesisBlock, lf)
+		} else {
+			logger.Info("Not bootstrapping because of existing channels")
+		}
 	}
 
 	consenters := make(map[string]consensus.Consenter)
@@ -666,7 +694,13 @@ func initializeMultichannelRegistrar(
 	registrar := multichannel.NewRegistrar(*conf, lf, signer, metricsProvider, callbacks...)
 
 	var icr etcdraft.InactiveChainRegistry
-	if isClusterType(bootstrapBlock) {
+	if bootstrapBlock == nil {
+		icr = &untrackedChains{}
+		consenters["etcdraft"] = etcdraft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider)
+	} else if consensusType(bootstrapBlock) == "bft" {
+		bftConsenter := initializeBFTConsenter(consenters, conf, lf, clusterDialer, bootstrapBlock, ri, srvConf, srv, registrar, metricsProvider)
+		icr = bftConsenter.InactiveChainRegistry
+	} else if isClusterType(bootstrapBlock) {
 		etcdConsenter := initializeEtcdraftConsenter(consenters, conf, lf, clusterDialer, bootstrapBlock, ri, srvConf, srv, registrar, metricsProvider)
 		icr = etcdConsenter.InactiveChainRegistry
 	}
@@ -694,6 +728,38 @@ func initializeEtcdraftConsenter(
 	registrar *multichannel.Registrar,
 	metricsProvider metrics.Provider,
 ) *etcdraft.Consenter {
+	icr := newInactiveChainReplicator(conf, lf, bootstrapBlock, ri)
+	go icr.run()
+	raftConsenter := etcdraft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider)
+	consenters["etcdraft"] = raftConsenter
+	return raftConsenter
+}
+
+func initializeBFTConsenter(
+	consenters map[string]consensus.Consenter,
+	conf *localconfig.TopLevel,
+	lf blockledger.Factory,
+	clusterDialer *cluster.PredicateDialer,
+	bootstrapBlock *cb.Block,
+	ri *replicationInitiator,
+	srvConf comm.ServerConfig,
+	srv *comm.GRPCServer,
+	registrar *multichannel.Registrar,
+	metricsProvider metrics.Provider,
+) *bft.Consenter {
+	icr := newInactiveChainReplicator(conf, lf, bootstrapBlock, ri)
+	go icr.run()
+	bftConsenter := bft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider)
+	consenters["bft"] = bftConsenter
+	return bftConsenter
+}
+
+func newInactiveChainReplicator(
+	conf *localconfig.TopLevel,
+	lf blockledger.Factory,
+	bootstrapBlock *cb.Block,
+	ri *replicationInitiator,
+) *inactiveChainReplicator {
 	replicationRefreshInterval := conf.General.Cluster.ReplicationBackgroundRefreshInterval
 	if replicationRefreshInterval == 0 {
 		replicationRefreshInterval = defaultReplicationBackgroundRefreshInterval
@@ -730,10 +796,16 @@ func initializeEtcdraftConsenter(
 	// the channels in the system.
 	ri.channelLister = icr
 
-	go icr.run()
-	raftConsenter := etcdraft.New(clusterDialer, conf, srvConf, srv, registrar, icr, metricsProvider)
-	consenters["etcdraft"] = raftConsenter
-	return raftConsenter
+	return icr
+}
+
+// untrackedChains is the InactiveChainRegistry of orderers without a system channel,
+// which have no system channel to replicate the chains they are not a consenter of from.
+// Such a channel needs to be removed and joined again once the orderer is added to its consenters.
+type untrackedChains struct{}
+
+func (*untrackedChains) TrackChain(chainName string, _ *cb.Block, _ func()) {
+	logger.Warningf("This node is not a consenter of channel %s, which is therefore inactive", chainName)
 }
 
 func newOperationsSystem(ops localconfig.Operations, metrics localconfig.Metrics) *operations.System {
@@ -760,6 +832,33 @@ func newOperationsSystem(ops localconfig.Operations, metrics localconfig.Metrics
 	})
 }
 
+// channelConfigs provides the orderer config of the channels of the registrar
+// to the migration status endpoint.
+type channelConfigs struct {
+	*multichannel.Registrar
+}
+
+func (c *channelConfigs) OrdererConfig(channelID string) (channelconfig.Orderer, bool) {
+	cs := c.GetChain(channelID)
+	if cs == nil {
+		return nil, false
+	}
+	return cs.OrdererConfig()
+}
+
+// adminChannels provides the channels of the registrar to the admin status endpoint.
+type adminChannels struct {
+	*multichannel.Registrar
+}
+
+func (a *adminChannels) ConsensusChain(channelID string) consensus.Chain {
+	cs := a.GetChain(channelID)
+	if cs == nil {
+		return nil
+	}
+	return cs.Chain
+}
+
 func updateTrustedRoots(rootCASupport *comm.CredentialSupport, cm channelconfig.Resources, servers ...*comm.GRPCServer) {
 	rootCASupport.Lock()
 	defer rootCASupport.Unlock()
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package server
 
 import (
+	"io/ioutil"
 	"sync"
 	"time"
 
@@ -75,6 +76,7 @@ func (ri *replicationInitiator) createReplicator(bootstrapBlock *common.Block, f
 			Puller:          puller,
 			LastConfigBlock: bootstrapBlock,
 		},
+		BlockSources: ri.blockSources(pullerConfig),
 	}
 
 	// If a custom channel lister is requested, use it
@@ -85,6 +87,42 @@ func (ri *replicationInitiator) createReplicator(bootstrapBlock *common.Block, f
 	return replicator
 }
 
+// blockSources returns the block sources configured to be pulled from
+// before other ordering service nodes.
+func (ri *replicationInitiator) blockSources(pullerConfig cluster.PullerConfig) []cluster.BlockSource {
+	sourcesConfig := ri.conf.General.Cluster.ReplicationSources
+	var sources []cluster.BlockSource
+	if sourcesConfig.BlockDirectory != "" {
+		sources = append(sources, &cluster.BlockFileSource{
+			Directory: sourcesConfig.BlockDirectory,
+			Logger:    ri.logger,
+		})
+	}
+	if len(sourcesConfig.Peers) == 0 {
+		return sources
+	}
+
+	var endpoints []cluster.EndpointCriteria
+	for _, peer := range sourcesConfig.Peers {
+		endpoint := cluster.EndpointCriteria{Endpoint: peer.Address}
+		for _, rootCAFile := range peer.RootCAs {
+			rootCA, err := ioutil.ReadFile(rootCAFile)
+			if err != nil {
+				ri.logger.Panicf("Failed loading TLS root CA certificate of peer %s: %v", peer.Address, err)
+			}
+			endpoint.TLSRootCAs = append(endpoint.TLSRootCAs, rootCA)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	peerSource, err := cluster.NewPeerBlockSource(pullerConfig, endpoints)
+	if err != nil {
+		ri.logger.Panicf("Failed creating block source of peers: %v", err)
+	}
+	peerSource.Puller.MaxPullBlockRetries = uint64(ri.conf.General.Cluster.ReplicationMaxRetries)
+	peerSource.Puller.RetryTimeout = ri.conf.General.Cluster.ReplicationRetryTimeout
+	return append(sources, peerSource)
+}
+
 func (ri *replicationInitiator) replicateNeededChannels(bootstrapBlock *common.Block) {
 	replicator := ri.createReplicator(bootstrapBlock, cluster.AnyChannel)
 	defer replicator.Puller.Close()
@@ -854,6 +854,41 @@ func TestInactiveChainReplicatorChannels(t *testing.T) {
 	icr.Close()
 }
 
+func TestReplicationInitiatorBlockSources(t *testing.T) {
+	ri := &replicationInitiator{
+		logger: flogging.MustGetLogger("test"),
+		conf:   &localconfig.TopLevel{},
+	}
+	pullerConfig := cluster.PullerConfig{
+		TLSCert: loadPEM("server.crt", t),
+		TLSKey:  loadPEM("server.key", t),
+	}
+	assert.Empty(t, ri.blockSources(pullerConfig))
+
+	ri.conf.General.Cluster.ReplicationMaxRetries = 3
+	ri.conf.General.Cluster.ReplicationSources = localconfig.ReplicationSources{
+		BlockDirectory: "blocks",
+		Peers: []localconfig.ReplicationPeer{
+			{Address: "peer0:7051", RootCAs: []string{filepath.Join("testdata", "tls", "ca.crt")}},
+			{Address: "peer1:7051"},
+		},
+	}
+	sources := ri.blockSources(pullerConfig)
+	assert.Len(t, sources, 2)
+	assert.Equal(t, &cluster.BlockFileSource{Directory: "blocks", Logger: ri.logger}, sources[0])
+	peerSource := sources[1].(*cluster.PeerBlockSource)
+	assert.Equal(t, []cluster.EndpointCriteria{
+		{Endpoint: "peer0:7051", TLSRootCAs: [][]byte{loadPEM("ca.crt", t)}},
+		{Endpoint: "peer1:7051"},
+	}, peerSource.Puller.Endpoints)
+	assert.Equal(t, uint64(3), peerSource.Puller.MaxPullBlockRetries)
+
+	ri.conf.General.Cluster.ReplicationSources.Peers[0].RootCAs = []string{"nonexistent"}
+	assert.PanicsWithValue(t, "Failed loading TLS root CA certificate of peer peer0:7051: open nonexistent: no such file or directory", func() {
+		ri.blockSources(pullerConfig)
+	})
+}
+
 func TestLedgerFactory(t *testing.T) {
 	lf := &ledgerFactory{
 		Factory:       ramledger.New(1),
@@ -71,6 +71,15 @@ func (rs *responseSender) SendBlockResponse(block *cb.Block) error {
 	return rs.Send(response)
 }
 
+func (rs *responseSender) SendTxIDsResponse(header *cb.BlockHeader, txIDs []string) error {
+	response := &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_BlockTransactionIds{
+			BlockTransactionIds: &ab.BlockTransactionIDs{Header: header, TxIds: txIDs},
+		},
+	}
+	return rs.Send(response)
+}
+
 // NewServer creates an ab.AtomicBroadcastServer based on the broadcast target and ledger Reader
 func NewServer(
 	r *multichannel.Registrar,
@@ -30,7 +30,11 @@ func createLedgerFactory(conf *config.TopLevel, metricsProvider metrics.Provider
 			ld = createTempDir(conf.FileLedger.Prefix)
 		}
 		logger.Debug("Ledger dir:", ld)
-		lf = fileledger.New(ld, metricsProvider)
+		lf = fileledger.New(ld, metricsProvider, fileledger.RetentionPolicy{
+			MaxBlocks:    conf.FileLedger.Retention.MaxBlocks,
+			ConfigBlocks: conf.FileLedger.Retention.ConfigBlocks,
+			ArchiveDir:   conf.FileLedger.Retention.ArchiveDir,
+		})
 		// The file-based ledger stores the blocks for each channel
 		// in a fsblkstorage.ChainsDir sub-directory that we have
 		// to create separately. Otherwise the call to the ledger
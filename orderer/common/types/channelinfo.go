@@ -0,0 +1,36 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package types holds the types exchanged through the channel participation and admin APIs of the orderer.
+package types
+
+// ChannelInfoShort carries the name and URL of a channel.
+type ChannelInfoShort struct {
+	// The channel name
+	Name string `json:"name"`
+	// The channel relative URL, which can be used to query the ChannelInfo of the channel
+	URL string `json:"url"`
+}
+
+// ChannelList carries the channels the orderer is a member of.
+type ChannelList struct {
+	// The system channel, nil if the orderer has no system channel
+	SystemChannel *ChannelInfoShort `json:"systemChannel"`
+	// The application channels, sorted by name
+	Channels []ChannelInfoShort `json:"channels"`
+}
+
+// ChannelInfo carries the information of a channel the orderer is a member of.
+type ChannelInfo struct {
+	// The channel name
+	Name string `json:"name"`
+	// The channel relative URL
+	URL string `json:"url"`
+	// The consensus type of the channel
+	ConsensusType string `json:"consensusType"`
+	// The number of blocks in the ledger of the channel
+	Height uint64 `json:"height"`
+}
@@ -0,0 +1,19 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package types
+
+import "github.com/pkg/errors"
+
+// ErrSystemChannelExists is returned when trying to join or remove an application channel
+// while the orderer has a system channel.
+var ErrSystemChannelExists = errors.New("system channel exists")
+
+// ErrChannelAlreadyExists is returned when trying to join a channel the orderer is already a member of.
+var ErrChannelAlreadyExists = errors.New("channel already exists")
+
+// ErrChannelNotExist is returned when trying to remove or query a channel the orderer is not a member of.
+var ErrChannelNotExist = errors.New("channel does not exist")
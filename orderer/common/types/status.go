@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package types
+
+// NodeStatus carries the status of the channels of an orderer.
+type NodeStatus struct {
+	// The channels of the orderer, sorted by name
+	Channels []ChannelStatus `json:"channels"`
+}
+
+// ChannelStatus carries the status of a channel the orderer is a member of.
+type ChannelStatus struct {
+	// The channel name
+	Name string `json:"name"`
+	// Whether the channel is the system channel
+	SystemChannel bool `json:"systemChannel,omitempty"`
+	// The consensus type of the channel
+	ConsensusType string `json:"consensusType"`
+	// The number of blocks in the ledger of the channel
+	Height uint64 `json:"height"`
+	// The Raft status of the channel, nil unless the orderer is a running consenter of an etcdraft channel
+	Raft *RaftStatus `json:"raft,omitempty"`
+}
+
+// RaftStatus carries the Raft state of a channel, as seen by the orderer.
+type RaftStatus struct {
+	// The Raft ID of the orderer
+	NodeID uint64 `json:"nodeID"`
+	// The role of the orderer: follower, pre-candidate, candidate or leader
+	State string `json:"state"`
+	// The Raft ID of the leader, or zero if there is none
+	Leader uint64 `json:"leader"`
+	// The current term
+	Term uint64 `json:"term"`
+	// The index of the latest entry known to be committed
+	CommitIndex uint64 `json:"commitIndex"`
+	// The index of the latest entry applied by the orderer
+	AppliedIndex uint64 `json:"appliedIndex"`
+	// The Raft IDs of the voting consenters
+	Voters []uint64 `json:"voters"`
+	// The Raft IDs of the consenters which are learners
+	Learners []uint64 `json:"learners,omitempty"`
+	// The connections of the orderer with the other consenters, sorted by their Raft IDs
+	Consenters []ConsenterConnection `json:"consenters"`
+}
+
+// ConsenterConnection carries the state of the connection of the orderer with another consenter of a channel.
+type ConsenterConnection struct {
+	// The Raft ID of the consenter
+	ID uint64 `json:"id"`
+	// The cluster endpoint of the consenter
+	Endpoint string `json:"endpoint"`
+	// The state of the gRPC connection with the consenter, such as READY or
+	// TRANSIENT_FAILURE, or empty if no connection has been established with it
+	State string `json:"state,omitempty"`
+}
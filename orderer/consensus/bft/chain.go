@@ -0,0 +1,1526 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// DefaultTickInterval is the interval in which a node checks its timeouts
+const DefaultTickInterval = 100 * time.Millisecond
+
+// Configurator is used to configure the communication layer
+// when the chain starts.
+type Configurator interface {
+	Configure(channel string, newNodes []cluster.RemoteNode)
+}
+
+// RPC is used to mock the transport layer in tests.
+type RPC interface {
+	SendConsensus(dest uint64, msg *orderer.ConsensusRequest) error
+	SendSubmit(dest uint64, request *orderer.SubmitRequest) error
+}
+
+// BlockPuller is used to pull blocks from other OSN
+type BlockPuller interface {
+	PullBlock(seq uint64) *common.Block
+	HeightsByEndpoints() (map[string]uint64, error)
+	Close()
+}
+
+// CreateBlockPuller is a function to create BlockPuller on demand.
+// It is passed into chain initializer so that tests could mock
+// block puller.
+type CreateBlockPuller func() (BlockPuller, error)
+
+// Options contains all the configurations relevant to the chain.
+type Options struct {
+	SelfID     uint64
+	Consenters map[uint64]*bft.Consenter
+	// View is the view the node starts in
+	View   uint64
+	Logger *flogging.FabricLogger
+
+	RequestTimeout    time.Duration
+	ViewChangeTimeout time.Duration
+	RequestPoolSize   int
+	TickInterval      time.Duration
+}
+
+type submission struct {
+	req     *orderer.SubmitRequest
+	sender  uint64
+	resultC chan submitResult
+}
+
+type submitResult struct {
+	// leader is the node the request should be forwarded to, if any
+	leader uint64
+	err    error
+}
+
+type consensusMessage struct {
+	msg    *bft.ConsensusMessage
+	sender uint64
+}
+
+// slot identifies an instance of the agreement protocol
+type slot struct {
+	view uint64
+	seq  uint64
+}
+
+// proposal is a block accepted by this node in the current view
+type proposal struct {
+	prePrepare *bft.PrePrepare
+	digest     []byte
+	value      []byte
+	start      time.Time
+	sentCommit bool
+	// prepares holds the prepares for the proposal whose signatures were verified
+	prepares map[uint64]*bft.Prepare
+	// verified holds the consenters whose commit signatures were verified
+	verified map[uint64]*common.MetadataSignature
+}
+
+// Chain implements consensus.Chain interface.
+type Chain struct {
+	configurator Configurator
+	rpc          RPC
+	verifier     SignatureVerifier
+	createPuller CreateBlockPuller
+	haltCallback func()
+
+	channelID string
+	selfID    uint64
+	support   consensus.ConsenterSupport
+	opts      Options
+	logger    *flogging.FabricLogger
+
+	submitC    chan *submission
+	consensusC chan *consensusMessage
+	haltC      chan struct{}
+	doneC      chan struct{}
+	startC     chan struct{}
+
+	errorCLock sync.RWMutex
+	errorC     chan struct{}
+
+	// The fields below are only accessed by the serving goroutine
+	nodes        []uint64
+	f, q         int
+	view         uint64
+	lastBlock    *common.Block
+	lastConfig   uint64
+	pool         *requestPool
+	proposal     *proposal
+	prepared     *bft.PrePrepare
+	preparedBy   map[uint64]*bft.Prepare
+	pending      map[uint64]*bft.PrePrepare
+	aheadVotes   map[uint64][]*bft.ConsensusMessage
+	prepares     map[slot]map[uint64]*bft.Prepare
+	commits      map[slot]map[uint64]*bft.Commit
+	viewChanges  map[uint64]*bft.ViewChange
+	viewHints    map[uint64]uint64
+	seqHints     map[uint64]uint64
+	viewChanging bool
+	nextView     uint64
+	viewChangeAt time.Time
+	behindSince  time.Time
+	evicted      bool
+}
+
+// NewChain constructs a chain object.
+func NewChain(
+	support consensus.ConsenterSupport,
+	opts Options,
+	conf Configurator,
+	rpc RPC,
+	verifier SignatureVerifier,
+	f CreateBlockPuller,
+	haltCallback func(),
+) (*Chain, error) {
+	lg := opts.Logger.With("channel", support.ChainID(), "node", opts.SelfID)
+
+	lastBlock := support.Block(support.Height() - 1)
+	if lastBlock == nil {
+		return nil, errors.Errorf("failed to retrieve block [%d]", support.Height()-1)
+	}
+	lastConfig, err := utils.GetLastConfigIndexFromBlock(lastBlock)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read the index of the last config block")
+	}
+
+	if opts.TickInterval == 0 {
+		opts.TickInterval = DefaultTickInterval
+	}
+
+	c := &Chain{
+		configurator: conf,
+		rpc:          rpc,
+		verifier:     verifier,
+		createPuller: f,
+		haltCallback: haltCallback,
+		channelID:    support.ChainID(),
+		selfID:       opts.SelfID,
+		support:      support,
+		opts:         opts,
+		logger:       lg,
+		submitC:      make(chan *submission),
+		consensusC:   make(chan *consensusMessage),
+		haltC:        make(chan struct{}),
+		doneC:        make(chan struct{}),
+		startC:       make(chan struct{}),
+		errorC:       make(chan struct{}),
+		view:         opts.View,
+		lastBlock:    lastBlock,
+		lastConfig:   lastConfig,
+		pool:         newRequestPool(opts.RequestPoolSize),
+		pending:      make(map[uint64]*bft.PrePrepare),
+		aheadVotes:   make(map[uint64][]*bft.ConsensusMessage),
+		prepares:     make(map[slot]map[uint64]*bft.Prepare),
+		commits:      make(map[slot]map[uint64]*bft.Commit),
+		viewChanges:  make(map[uint64]*bft.ViewChange),
+		viewHints:    make(map[uint64]uint64),
+		seqHints:     make(map[uint64]uint64),
+	}
+	c.setConsenters(opts.Consenters)
+
+	return c, nil
+}
+
+// Start instructs the orderer to begin serving the chain and keep it current.
+func (c *Chain) Start() {
+	c.logger.Infof("Starting BFT node in view %d", c.view)
+
+	if err := c.configureComm(); err != nil {
+		c.logger.Errorf("Failed to start chain, aborting: +%v", err)
+		close(c.doneC)
+		return
+	}
+
+	close(c.startC)
+	go c.serve()
+}
+
+// Order submits normal type transactions for ordering.
+func (c *Chain) Order(env *common.Envelope, configSeq uint64) error {
+	return c.submit(&orderer.SubmitRequest{LastValidationSeq: configSeq, Payload: env, Channel: c.channelID}, c.selfID)
+}
+
+// Configure submits config type transactions for ordering.
+func (c *Chain) Configure(env *common.Envelope, configSeq uint64) error {
+	if err := c.checkConfig(env); err != nil {
+		return err
+	}
+	return c.submit(&orderer.SubmitRequest{LastValidationSeq: configSeq, Payload: env, Channel: c.channelID}, c.selfID)
+}
+
+// WaitReady blocks when the chain:
+// - is catching up with other nodes
+// - is halted
+// The former is not applied here, as catching up is performed
+// by the same goroutine that orders transactions.
+func (c *Chain) WaitReady() error {
+	return c.isRunning()
+}
+
+// Errored returns a channel that closes when the chain stops.
+func (c *Chain) Errored() <-chan struct{} {
+	c.errorCLock.RLock()
+	defer c.errorCLock.RUnlock()
+	return c.errorC
+}
+
+// Halt stops the chain.
+func (c *Chain) Halt() {
+	select {
+	case <-c.startC:
+	default:
+		c.logger.Warnf("Attempted to halt a chain that has not started")
+		return
+	}
+
+	select {
+	case c.haltC <- struct{}{}:
+	case <-c.doneC:
+		return
+	}
+	<-c.doneC
+}
+
+func (c *Chain) isRunning() error {
+	select {
+	case <-c.startC:
+	default:
+		return errors.Errorf("chain is not started")
+	}
+
+	select {
+	case <-c.doneC:
+		return errors.Errorf("chain is stopped")
+	default:
+	}
+
+	return nil
+}
+
+// Consensus passes the given ConsensusRequest message to the chain.
+func (c *Chain) Consensus(req *orderer.ConsensusRequest, sender uint64) error {
+	if err := c.isRunning(); err != nil {
+		return err
+	}
+
+	msg := &bft.ConsensusMessage{}
+	if err := proto.Unmarshal(req.Payload, msg); err != nil {
+		return errors.Errorf("failed to unmarshal consensus message, error: %s", err)
+	}
+
+	select {
+	case c.consensusC <- &consensusMessage{msg: msg, sender: sender}:
+		return nil
+	case <-c.doneC:
+		return errors.Errorf("chain is stopped")
+	}
+}
+
+// Submit forwards the incoming request to:
+// - the local serving goroutine which adds it to the pending requests
+// - the leader, if the request was submitted by a client of this node
+func (c *Chain) Submit(req *orderer.SubmitRequest, sender uint64) error {
+	return c.submit(req, sender)
+}
+
+func (c *Chain) submit(req *orderer.SubmitRequest, sender uint64) error {
+	if err := c.isRunning(); err != nil {
+		return err
+	}
+
+	resultC := make(chan submitResult, 1)
+	select {
+	case c.submitC <- &submission{req: req, sender: sender, resultC: resultC}:
+	case <-c.doneC:
+		return errors.Errorf("chain is stopped")
+	}
+
+	var res submitResult
+	select {
+	case res = <-resultC:
+	case <-c.doneC:
+		return errors.Errorf("chain is stopped")
+	}
+	if res.err != nil {
+		return res.err
+	}
+
+	if res.leader != 0 {
+		c.logger.Debugf("Forwarding request to leader %d", res.leader)
+		if err := c.rpc.SendSubmit(res.leader, req); err != nil {
+			// The request remains pending, and would be forwarded to all nodes upon timeout
+			c.logger.Warningf("Failed to forward request to leader %d: %v", res.leader, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Chain) serve() {
+	ticker := time.NewTicker(c.opts.TickInterval)
+
+	defer func() {
+		ticker.Stop()
+		c.errorCLock.Lock()
+		close(c.errorC)
+		c.errorCLock.Unlock()
+		close(c.doneC)
+		if c.evicted && c.haltCallback != nil {
+			c.haltCallback()
+		}
+	}()
+
+	for {
+		select {
+		case s := <-c.submitC:
+			leader, err := c.handleSubmission(s)
+			s.resultC <- submitResult{leader: leader, err: err}
+		case m := <-c.consensusC:
+			c.handleMessage(m.msg, m.sender)
+		case now := <-ticker.C:
+			c.tick(now)
+		case <-c.haltC:
+			c.logger.Infof("Stop serving requests")
+			return
+		}
+
+		if c.evicted {
+			c.logger.Warningf("This node was removed from the consenters of the channel, halting")
+			return
+		}
+	}
+}
+
+func (c *Chain) handleSubmission(s *submission) (uint64, error) {
+	env := s.req.Payload
+	if env == nil {
+		return 0, errors.New("request is empty")
+	}
+
+	id, err := requestID(env)
+	if err != nil {
+		return 0, errors.WithMessage(err, "malformed request")
+	}
+	if c.pool.contains(id) {
+		return 0, nil
+	}
+
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return 0, errors.WithMessage(err, "malformed request")
+	}
+	var isConfig bool
+	switch c.support.ClassifyMsg(chdr) {
+	case msgprocessor.NormalMsg:
+	case msgprocessor.ConfigMsg:
+		isConfig = true
+	default:
+		return 0, errors.Errorf("unexpected request of type %s", common.HeaderType(chdr.Type))
+	}
+
+	local := s.sender == c.selfID
+	configSeq := s.req.LastValidationSeq
+	if !local {
+		// Requests forwarded by other nodes are validated, as they may be faulty
+		configSeq = c.support.Sequence()
+		if isConfig {
+			env, _, err = c.support.ProcessConfigMsg(env)
+		} else {
+			_, err = c.support.ProcessNormalMsg(env)
+		}
+		if err != nil {
+			return 0, errors.WithMessage(err, fmt.Sprintf("invalid request forwarded by %d", s.sender))
+		}
+	}
+
+	now := time.Now()
+	err = c.pool.add(&request{
+		id:        id,
+		env:       env,
+		isConfig:  isConfig,
+		configSeq: configSeq,
+		local:     local,
+		arrival:   now,
+		timestamp: now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if c.isLeader() {
+		c.tryPropose(now)
+		return 0, nil
+	}
+	if local && !c.viewChanging {
+		return c.leader(), nil
+	}
+	return 0, nil
+}
+
+func (c *Chain) handleMessage(msg *bft.ConsensusMessage, sender uint64) {
+	if _, known := c.opts.Consenters[sender]; !known || sender == c.selfID {
+		c.logger.Warningf("Discarding consensus message from unknown node %d", sender)
+		return
+	}
+
+	switch content := msg.Content.(type) {
+	case *bft.ConsensusMessage_PrePrepare:
+		c.handlePrePrepare(content.PrePrepare, sender)
+	case *bft.ConsensusMessage_Prepare:
+		c.handlePrepare(content.Prepare, sender)
+	case *bft.ConsensusMessage_Commit:
+		c.handleCommit(content.Commit, sender)
+	case *bft.ConsensusMessage_ViewChange:
+		c.handleViewChange(content.ViewChange, sender)
+	default:
+		c.logger.Warningf("Discarding consensus message of unknown type from %d", sender)
+	}
+}
+
+func (c *Chain) tick(now time.Time) {
+	if c.viewChanging {
+		if now.Sub(c.viewChangeAt) < c.opts.ViewChangeTimeout {
+			return
+		}
+		if c.viewChangeSupport(c.nextView) > c.f {
+			c.logger.Warningf("View change to view %d did not complete in time", c.nextView)
+			c.startViewChange(c.nextView + 1)
+			return
+		}
+		c.logger.Warningf("View change to view %d is not supported by other nodes, remaining in view %d", c.nextView, c.view)
+		c.viewChanging = false
+		delete(c.viewChanges, c.selfID)
+		c.pool.restartTimers(now)
+		return
+	}
+
+	if c.isBehind(now) {
+		c.catchUp()
+		return
+	}
+
+	if c.isLeader() {
+		c.tryPropose(now)
+		return
+	}
+
+	if c.proposal != nil && now.Sub(c.proposal.start) > c.opts.RequestTimeout {
+		c.logger.Warningf("Block [%d] proposed in view %d was not committed in time, suspecting leader %d",
+			c.proposal.prePrepare.Seq, c.view, c.leader())
+		c.startViewChange(c.view + 1)
+		return
+	}
+
+	var toBroadcast []*orderer.SubmitRequest
+	for _, r := range c.pool.expired(now, c.opts.RequestTimeout) {
+		if r.local && !r.broadcast {
+			// The leader might have not received the request, so it is sent to all nodes
+			// which would then suspect the leader if the request is not ordered in time.
+			r.broadcast = true
+			r.timestamp = now
+			toBroadcast = append(toBroadcast, c.submitRequest(r))
+			continue
+		}
+		c.logger.Warningf("Request %s was not ordered in time, suspecting leader %d", r.id, c.leader())
+		c.startViewChange(c.view + 1)
+		return
+	}
+	if len(toBroadcast) > 0 {
+		c.logger.Infof("Forwarding %d requests that were not ordered in time to all nodes", len(toBroadcast))
+		go c.sendRequests(c.remoteNodes(), toBroadcast)
+	}
+}
+
+// tryPropose proposes the next block if this node is the leader,
+// there is no block in flight and a batch of requests is ready.
+func (c *Chain) tryPropose(now time.Time) {
+	if !c.isLeader() || c.viewChanging || c.proposal != nil || c.pool.size() == 0 {
+		return
+	}
+
+	sharedConfig := c.support.SharedConfig()
+	batchSize := sharedConfig.BatchSize()
+	if c.pool.size() < int(batchSize.MaxMessageCount) && !c.pool.hasConfig() &&
+		now.Sub(c.pool.oldest().arrival) < sharedConfig.BatchTimeout() {
+		return
+	}
+
+	var batch [][]byte
+	var batchBytes uint32
+	for _, r := range c.pool.all() {
+		if r.configSeq < c.support.Sequence() {
+			var err error
+			if r.isConfig {
+				r.env, _, err = c.support.ProcessConfigMsg(r.env)
+			} else {
+				_, err = c.support.ProcessNormalMsg(r.env)
+			}
+			if err != nil {
+				c.logger.Warningf("Discarding request %s as it is no longer valid: %s", r.id, err)
+				c.pool.remove(r.id)
+				continue
+			}
+			r.configSeq = c.support.Sequence()
+		}
+
+		data := utils.MarshalOrPanic(r.env)
+		if r.isConfig {
+			// A config transaction is ordered in a block of its own
+			if len(batch) == 0 {
+				batch = [][]byte{data}
+			}
+			break
+		}
+		if len(batch) > 0 && (len(batch) == int(batchSize.MaxMessageCount) ||
+			batchBytes+uint32(len(data)) > batchSize.PreferredMaxBytes) {
+			break
+		}
+		batch = append(batch, data)
+		batchBytes += uint32(len(data))
+	}
+	if len(batch) == 0 {
+		return
+	}
+
+	block := c.newBlock(batch)
+	pp := &bft.PrePrepare{View: c.view, Seq: block.Header.Number, Block: block}
+	c.logger.Debugf("Proposing block [%d] with %d transactions in view %d", pp.Seq, len(batch), c.view)
+	c.broadcast(&bft.ConsensusMessage{Content: &bft.ConsensusMessage_PrePrepare{PrePrepare: pp}})
+	c.accept(pp)
+}
+
+func (c *Chain) newBlock(batch [][]byte) *common.Block {
+	block := common.NewBlock(c.lastBlock.Header.Number+1, c.lastBlock.Header.Hash())
+	block.Data.Data = batch
+	block.Header.DataHash = block.Data.Hash()
+	block.Metadata.Metadata[common.BlockMetadataIndex_ORDERER] = utils.MarshalOrPanic(&common.Metadata{
+		Value: utils.MarshalOrPanic(&bft.BlockMetadata{View: c.view}),
+	})
+	return block
+}
+
+func (c *Chain) handlePrePrepare(pp *bft.PrePrepare, sender uint64) {
+	c.updateViewHint(sender, pp.View)
+
+	if sender != c.leaderOf(pp.View) {
+		c.logger.Warningf("Discarding proposal of node %d for view %d, as it is not the leader of the view", sender, pp.View)
+		return
+	}
+	if err := checkBlockStructure(pp.Block); err != nil || pp.Block.Header.Number != pp.Seq {
+		c.logger.Warningf("Discarding malformed proposal of node %d for view %d", sender, pp.View)
+		return
+	}
+
+	if pp.Seq < c.height() || pp.View < c.view {
+		return
+	}
+	if pp.Seq > c.height() || pp.View > c.view || c.viewChanging {
+		if prev, exists := c.pending[sender]; !exists || prev.Seq < pp.Seq || prev.Seq == pp.Seq && prev.View <= pp.View {
+			c.pending[sender] = pp
+		}
+		return
+	}
+
+	digest := proposalDigest(pp.Block)
+	if c.proposal != nil {
+		if !bytes.Equal(c.proposal.digest, digest) {
+			c.logger.Warningf("Leader %d proposed two different blocks for sequence %d in view %d", sender, pp.Seq, pp.View)
+			c.startViewChange(c.view + 1)
+		}
+		return
+	}
+
+	// A block other than the one this node prepared is only accepted
+	// if it is proven to have been prepared in a later view.
+	if c.prepared != nil && c.prepared.Seq == pp.Seq && !bytes.Equal(proposalDigest(c.prepared.Block), digest) {
+		preparedView, err := c.verifyPrepared(pp.Block, pp.Prepares)
+		if err != nil || preparedView <= c.prepared.View {
+			c.logger.Warningf("Rejecting proposal for sequence %d in view %d, as a different block was prepared in view %d",
+				pp.Seq, pp.View, c.prepared.View)
+			return
+		}
+	}
+
+	if err := c.verifyProposal(pp); err != nil {
+		c.logger.Warningf("Leader %d proposed an invalid block for sequence %d in view %d: %s", sender, pp.Seq, pp.View, err)
+		c.startViewChange(c.view + 1)
+		return
+	}
+
+	c.accept(pp)
+}
+
+// accept accepts a proposal of the current view, and votes for it.
+func (c *Chain) accept(pp *bft.PrePrepare) {
+	c.proposal = &proposal{
+		prePrepare: pp,
+		digest:     proposalDigest(pp.Block),
+		value:      signedValue(pp.Block, c.lastConfigOf(pp.Block)),
+		start:      time.Now(),
+		prepares:   make(map[uint64]*bft.Prepare),
+		verified:   make(map[uint64]*common.MetadataSignature),
+	}
+
+	prepare := &bft.Prepare{View: pp.View, Seq: pp.Seq, Digest: c.proposal.digest}
+	prepare.Signature = c.signPrepare(prepare)
+	c.proposal.prepares[c.selfID] = prepare
+	c.broadcast(&bft.ConsensusMessage{Content: &bft.ConsensusMessage_Prepare{Prepare: prepare}})
+	c.handlePrepare(prepare, c.selfID)
+}
+
+// signPrepare signs a prepare of this node, so that it can be used to prove that a proposal was prepared.
+func (c *Chain) signPrepare(p *bft.Prepare) *common.MetadataSignature {
+	sigHdr, err := c.support.NewSignatureHeader()
+	if err != nil {
+		c.logger.Panicf("Failed creating signature header: %s", err)
+	}
+	sigHdrBytes := utils.MarshalOrPanic(sigHdr)
+	signature, err := c.support.Sign(prepareSignedData(c.channelID, p, sigHdrBytes))
+	if err != nil {
+		c.logger.Panicf("Failed signing prepare for block [%d]: %s", p.Seq, err)
+	}
+	return &common.MetadataSignature{
+		SignatureHeader: sigHdrBytes,
+		Signature:       signature,
+	}
+}
+
+// verifyPrepare verifies the signature of a consenter over its prepare.
+func (c *Chain) verifyPrepare(id uint64, p *bft.Prepare) error {
+	consenter, exists := c.opts.Consenters[id]
+	if !exists {
+		return errors.Errorf("node %d is not a consenter", id)
+	}
+	if p.Signature == nil {
+		return errors.Errorf("prepare of node %d is not signed", id)
+	}
+	sigHdr, err := utils.GetSignatureHeader(p.Signature.SignatureHeader)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("prepare of node %d has a malformed signature header", id))
+	}
+	return c.verifier.VerifySignature(consenter, &common.SignedData{
+		Identity:  sigHdr.Creator,
+		Data:      prepareSignedData(c.channelID, p, p.Signature.SignatureHeader),
+		Signature: p.Signature.Signature,
+	})
+}
+
+// verifyPrepared verifies that the given prepares of a quorum of consenters prove
+// that the block was prepared, and returns the view in which it was prepared.
+func (c *Chain) verifyPrepared(block *common.Block, prepares map[uint64]*bft.Prepare) (uint64, error) {
+	if len(prepares) < c.q {
+		return 0, errors.Errorf("%d prepares are not a quorum", len(prepares))
+	}
+	digest := proposalDigest(block)
+	var view uint64
+	first := true
+	for id, p := range prepares {
+		if p == nil || p.Seq != block.Header.Number || !bytes.Equal(p.Digest, digest) {
+			return 0, errors.Errorf("prepare of node %d is not for block [%d]", id, block.Header.Number)
+		}
+		if first {
+			view, first = p.View, false
+		} else if p.View != view {
+			return 0, errors.Errorf("prepares of block [%d] are of different views", block.Header.Number)
+		}
+		if err := c.verifyPrepare(id, p); err != nil {
+			return 0, err
+		}
+	}
+	return view, nil
+}
+
+func (c *Chain) handlePrepare(p *bft.Prepare, sender uint64) {
+	c.updateViewHint(sender, p.View)
+	c.updateSeqHint(sender, p.Seq)
+
+	s := slot{view: p.View, seq: p.Seq}
+	if p.Signature == nil {
+		return
+	}
+	if !c.inWindow(s) {
+		c.keepAhead(s, sender, &bft.ConsensusMessage{Content: &bft.ConsensusMessage_Prepare{Prepare: p}})
+		return
+	}
+	if c.prepares[s] == nil {
+		c.prepares[s] = make(map[uint64]*bft.Prepare)
+	}
+	if _, exists := c.prepares[s][sender]; exists {
+		return
+	}
+	c.prepares[s][sender] = p
+
+	c.maybePrepared()
+}
+
+// maybePrepared sends a commit for the current proposal once a quorum of nodes voted for it.
+func (c *Chain) maybePrepared() {
+	p := c.proposal
+	if p == nil || p.sentCommit {
+		return
+	}
+
+	s := slot{view: p.prePrepare.View, seq: p.prePrepare.Seq}
+	for id, prepare := range c.prepares[s] {
+		if _, verified := p.prepares[id]; verified || !bytes.Equal(prepare.Digest, p.digest) {
+			continue
+		}
+		if err := c.verifyPrepare(id, prepare); err != nil {
+			c.logger.Warningf("Discarding prepare of node %d for block [%d]: %s", id, s.seq, err)
+			delete(c.prepares[s], id)
+			continue
+		}
+		p.prepares[id] = prepare
+	}
+	if len(p.prepares) < c.q {
+		return
+	}
+
+	c.prepared = p.prePrepare
+	c.preparedBy = p.prepares
+	p.sentCommit = true
+
+	sigHdr, err := c.support.NewSignatureHeader()
+	if err != nil {
+		c.logger.Panicf("Failed creating signature header: %s", err)
+	}
+	sigHdrBytes := utils.MarshalOrPanic(sigHdr)
+	signature, err := c.support.Sign(signedData(p.value, sigHdrBytes, p.prePrepare.Block))
+	if err != nil {
+		c.logger.Panicf("Failed signing block [%d]: %s", p.prePrepare.Seq, err)
+	}
+
+	commit := &bft.Commit{
+		View:   p.prePrepare.View,
+		Seq:    p.prePrepare.Seq,
+		Digest: p.digest,
+		Signature: &common.MetadataSignature{
+			SignatureHeader: sigHdrBytes,
+			Signature:       signature,
+		},
+	}
+	p.verified[c.selfID] = commit.Signature
+	c.broadcast(&bft.ConsensusMessage{Content: &bft.ConsensusMessage_Commit{Commit: commit}})
+	c.handleCommit(commit, c.selfID)
+}
+
+func (c *Chain) handleCommit(commit *bft.Commit, sender uint64) {
+	c.updateViewHint(sender, commit.View)
+	c.updateSeqHint(sender, commit.Seq)
+
+	s := slot{view: commit.View, seq: commit.Seq}
+	if commit.Signature == nil {
+		return
+	}
+	if !c.inWindow(s) {
+		c.keepAhead(s, sender, &bft.ConsensusMessage{Content: &bft.ConsensusMessage_Commit{Commit: commit}})
+		return
+	}
+	if c.commits[s] == nil {
+		c.commits[s] = make(map[uint64]*bft.Commit)
+	}
+	if _, exists := c.commits[s][sender]; exists {
+		return
+	}
+	c.commits[s][sender] = commit
+
+	c.maybeCommitted()
+}
+
+// maybeCommitted writes the block of the current proposal once
+// a quorum of nodes signed it.
+func (c *Chain) maybeCommitted() {
+	p := c.proposal
+	if p == nil {
+		return
+	}
+
+	block := p.prePrepare.Block
+	s := slot{view: p.prePrepare.View, seq: p.prePrepare.Seq}
+	for id, commit := range c.commits[s] {
+		if _, verified := p.verified[id]; verified || !bytes.Equal(commit.Digest, p.digest) {
+			continue
+		}
+		sigHdr, err := utils.GetSignatureHeader(commit.Signature.SignatureHeader)
+		if err != nil {
+			c.logger.Warningf("Discarding commit of node %d with a malformed signature header: %s", id, err)
+			delete(c.commits[s], id)
+			continue
+		}
+		err = c.verifier.VerifySignature(c.opts.Consenters[id], &common.SignedData{
+			Identity:  sigHdr.Creator,
+			Data:      signedData(p.value, commit.Signature.SignatureHeader, block),
+			Signature: commit.Signature.Signature,
+		})
+		if err != nil {
+			c.logger.Warningf("Discarding commit of node %d for block [%d]: %s", id, s.seq, err)
+			delete(c.commits[s], id)
+			continue
+		}
+		p.verified[id] = commit.Signature
+	}
+	if len(p.verified) < c.q {
+		return
+	}
+
+	var signers []uint64
+	for id := range p.verified {
+		signers = append(signers, id)
+	}
+	sort.Slice(signers, func(i, j int) bool { return signers[i] < signers[j] })
+	var signatures []*common.MetadataSignature
+	for _, id := range signers {
+		signatures = append(signatures, p.verified[id])
+	}
+
+	block = proto.Clone(block).(*common.Block)
+	block.Metadata.Metadata[common.BlockMetadataIndex_SIGNATURES] = utils.MarshalOrPanic(&common.Metadata{
+		Value:      p.value,
+		Signatures: signatures,
+	})
+
+	c.logger.Infof("Writing block [%d] proposed in view %d with %d signatures", block.Header.Number, s.view, len(signatures))
+	c.support.WriteSignedBlock(block)
+	c.committed(block)
+	if c.evicted {
+		return
+	}
+
+	c.processPending()
+	c.tryPropose(time.Now())
+}
+
+// committed updates the state of the node after a block is written.
+func (c *Chain) committed(block *common.Block) {
+	c.lastBlock = block
+	c.behindSince = time.Time{}
+	c.proposal = nil
+	c.prepared = nil
+	c.preparedBy = nil
+
+	for _, data := range block.Data.Data {
+		env, err := utils.UnmarshalEnvelope(data)
+		if err != nil {
+			continue
+		}
+		id, err := requestID(env)
+		if err != nil {
+			continue
+		}
+		c.pool.markCommitted(id)
+	}
+
+	for s := range c.prepares {
+		if s.seq < c.height() {
+			delete(c.prepares, s)
+		}
+	}
+	for s := range c.commits {
+		if s.seq < c.height() {
+			delete(c.commits, s)
+		}
+	}
+
+	if isConfigBlock(block) {
+		c.lastConfig = block.Header.Number
+		c.reconfigure()
+	}
+}
+
+func (c *Chain) reconfigure() {
+	m := &bft.ConfigMetadata{}
+	if err := proto.Unmarshal(c.support.SharedConfig().ConsensusMetadata(), m); err != nil {
+		c.logger.Panicf("Failed to unmarshal consensus metadata: %s", err)
+	}
+
+	consenters := make(map[uint64]*bft.Consenter)
+	for _, consenter := range m.Consenters {
+		consenters[consenter.Id] = consenter
+	}
+	if _, exists := consenters[c.selfID]; !exists {
+		c.evicted = true
+		return
+	}
+
+	if m.Options != nil {
+		if d, err := time.ParseDuration(m.Options.RequestTimeout); err == nil {
+			c.opts.RequestTimeout = d
+		}
+		if d, err := time.ParseDuration(m.Options.ViewChangeTimeout); err == nil {
+			c.opts.ViewChangeTimeout = d
+		}
+		if m.Options.RequestPoolSize != 0 {
+			c.opts.RequestPoolSize = int(m.Options.RequestPoolSize)
+			c.pool.setCapacity(c.opts.RequestPoolSize)
+		}
+	}
+
+	c.setConsenters(consenters)
+	if err := c.configureComm(); err != nil {
+		c.logger.Panicf("Failed to configure communication: %s", err)
+	}
+	c.logger.Infof("Consenters of the channel are now %v", c.nodes)
+}
+
+// keepAhead keeps the votes of a node which is ahead of this node. Only the
+// latest votes of each node are kept, which are needed to agree on the block
+// that follows the blocks this node pulls when it catches up.
+func (c *Chain) keepAhead(s slot, sender uint64, vote *bft.ConsensusMessage) {
+	if s.seq <= c.height()+1 && s.view <= c.view+1 {
+		return
+	}
+	votes := c.aheadVotes[sender]
+	if len(votes) > 0 {
+		latest := voteSlot(votes[0])
+		if latest.seq > s.seq || latest.seq == s.seq && latest.view > s.view {
+			return
+		}
+		if latest != s {
+			votes = nil
+		}
+	}
+	c.aheadVotes[sender] = append(votes, vote)
+}
+
+func voteSlot(vote *bft.ConsensusMessage) slot {
+	if p := vote.GetPrepare(); p != nil {
+		return slot{view: p.View, seq: p.Seq}
+	}
+	commit := vote.GetCommit()
+	return slot{view: commit.View, seq: commit.Seq}
+}
+
+// processPending handles the proposal of the leader and the votes
+// that were received ahead of the current view or sequence, if any.
+func (c *Chain) processPending() {
+	for sender, pp := range c.pending {
+		if pp.Seq < c.height() || pp.View < c.view {
+			delete(c.pending, sender)
+		}
+	}
+	leader := c.leader()
+	if pp, exists := c.pending[leader]; exists && !c.viewChanging && pp.View == c.view && pp.Seq == c.height() {
+		delete(c.pending, leader)
+		c.handlePrePrepare(pp, leader)
+	}
+
+	var ready []*consensusMessage
+	for sender, votes := range c.aheadVotes {
+		s := voteSlot(votes[0])
+		if s.seq < c.height() || s.view < c.view {
+			delete(c.aheadVotes, sender)
+			continue
+		}
+		if !c.inWindow(s) {
+			continue
+		}
+		delete(c.aheadVotes, sender)
+		for _, vote := range votes {
+			ready = append(ready, &consensusMessage{msg: vote, sender: sender})
+		}
+	}
+	for _, m := range ready {
+		c.handleMessage(m.msg, m.sender)
+	}
+}
+
+func (c *Chain) startViewChange(view uint64) {
+	if view <= c.view || c.viewChanging && view <= c.nextView {
+		return
+	}
+
+	c.logger.Warningf("Starting view change from view %d to view %d", c.view, view)
+	c.viewChanging = true
+	c.nextView = view
+	c.viewChangeAt = time.Now()
+
+	vc := &bft.ViewChange{NextView: view}
+	if c.prepared != nil && c.prepared.Seq == c.height() {
+		vc.Prepared = c.prepared
+		vc.Prepares = c.preparedBy
+	}
+	c.broadcast(&bft.ConsensusMessage{Content: &bft.ConsensusMessage_ViewChange{ViewChange: vc}})
+	c.handleViewChange(vc, c.selfID)
+}
+
+func (c *Chain) handleViewChange(vc *bft.ViewChange, sender uint64) {
+	if vc.NextView <= c.view {
+		return
+	}
+	if prev, exists := c.viewChanges[sender]; exists && prev.NextView >= vc.NextView {
+		return
+	}
+	c.viewChanges[sender] = vc
+
+	// Join a view change which at least one correct node takes part in
+	var views []uint64
+	for id, vc := range c.viewChanges {
+		if id != c.selfID && vc.NextView > c.view {
+			views = append(views, vc.NextView)
+		}
+	}
+	if len(views) > c.f {
+		sort.Slice(views, func(i, j int) bool { return views[i] > views[j] })
+		if !c.viewChanging || views[c.f] > c.nextView {
+			c.startViewChange(views[c.f])
+			return
+		}
+	}
+
+	if c.viewChanging && c.viewChangeSupport(c.nextView) >= c.q {
+		c.enterView(c.nextView)
+	}
+}
+
+// viewChangeSupport returns the number of nodes that asked to move to the given view.
+func (c *Chain) viewChangeSupport(view uint64) int {
+	var count int
+	for _, vc := range c.viewChanges {
+		if vc.NextView == view {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *Chain) enterView(view uint64) {
+	c.logger.Infof("Entering view %d, the leader is %d", view, c.leaderOf(view))
+
+	// The proposals prepared by the nodes which moved to the view
+	prepared := make(map[uint64]*bft.ViewChange)
+	for id, vc := range c.viewChanges {
+		if vc.NextView == view && vc.Prepared != nil {
+			prepared[id] = vc
+		}
+	}
+
+	c.view = view
+	c.viewChanging = false
+	c.proposal = nil
+	for id, vc := range c.viewChanges {
+		if vc.NextView <= view {
+			delete(c.viewChanges, id)
+		}
+	}
+	for s := range c.prepares {
+		if s.view < view {
+			delete(c.prepares, s)
+		}
+	}
+	for s := range c.commits {
+		if s.view < view {
+			delete(c.commits, s)
+		}
+	}
+
+	now := time.Now()
+	c.pool.restartTimers(now)
+
+	if !c.isLeader() {
+		var toForward []*orderer.SubmitRequest
+		for _, r := range c.pool.all() {
+			if r.local {
+				toForward = append(toForward, c.submitRequest(r))
+			}
+		}
+		if len(toForward) > 0 {
+			go c.sendRequests([]uint64{c.leader()}, toForward)
+		}
+		c.processPending()
+		return
+	}
+
+	// A block that may have been committed by some node in a previous view
+	// must be proposed again, so the most recently prepared block is re-proposed.
+	// Only blocks which are proven to have been prepared by a quorum are considered.
+	var reproposal *bft.PrePrepare
+	var reproposalPrepares map[uint64]*bft.Prepare
+	for id, vc := range prepared {
+		pp := vc.Prepared
+		if pp.Seq != c.height() || checkBlockStructure(pp.Block) != nil {
+			continue
+		}
+		preparedView, err := c.verifyPrepared(pp.Block, vc.Prepares)
+		if err != nil {
+			c.logger.Warningf("Ignoring block [%d] node %d claims to have prepared: %s", pp.Seq, id, err)
+			continue
+		}
+		if reproposal == nil || preparedView > reproposal.View {
+			reproposal = &bft.PrePrepare{View: preparedView, Seq: pp.Seq, Block: pp.Block}
+			reproposalPrepares = vc.Prepares
+		}
+	}
+	if reproposal != nil {
+		if err := c.verifyProposal(reproposal); err != nil {
+			c.logger.Warningf("Not proposing again block [%d] prepared in view %d: %s", reproposal.Seq, reproposal.View, err)
+			reproposal = nil
+		}
+	}
+	if reproposal == nil {
+		c.tryPropose(now)
+	} else {
+		pp := &bft.PrePrepare{View: view, Seq: reproposal.Seq, Block: reproposal.Block, Prepares: reproposalPrepares}
+		c.logger.Infof("Proposing again block [%d] prepared in view %d", pp.Seq, reproposal.View)
+		c.broadcast(&bft.ConsensusMessage{Content: &bft.ConsensusMessage_PrePrepare{PrePrepare: pp}})
+		c.accept(pp)
+	}
+	c.processPending()
+}
+
+// verifyProposal verifies that the proposed block extends the ledger of this node,
+// and that all of its transactions are valid.
+func (c *Chain) verifyProposal(pp *bft.PrePrepare) error {
+	block := pp.Block
+	if block.Header.Number != c.height() {
+		return errors.Errorf("expected block number %d but got %d", c.height(), block.Header.Number)
+	}
+	if !bytes.Equal(block.Header.PreviousHash, c.lastBlock.Header.Hash()) {
+		return errors.Errorf("previous hash of block [%d] does not match the hash of block [%d]", block.Header.Number, c.lastBlock.Header.Number)
+	}
+	if len(block.Data.Data) == 0 {
+		return errors.Errorf("block [%d] is empty", block.Header.Number)
+	}
+	if !bytes.Equal(block.Header.DataHash, block.Data.Hash()) {
+		return errors.Errorf("data hash of block [%d] does not match its data", block.Header.Number)
+	}
+
+	md, err := utils.GetMetadataFromBlock(block, common.BlockMetadataIndex_ORDERER)
+	if err != nil {
+		return err
+	}
+	bm, err := ReadBlockMetadata(md)
+	if err != nil {
+		return err
+	}
+	if bm.View > pp.View {
+		return errors.Errorf("block [%d] was proposed in view %d which is ahead of view %d", block.Header.Number, bm.View, pp.View)
+	}
+
+	batchSize := c.support.SharedConfig().BatchSize()
+	if len(block.Data.Data) > int(batchSize.MaxMessageCount) {
+		return errors.Errorf("block [%d] has %d transactions, more than %d", block.Header.Number, len(block.Data.Data), batchSize.MaxMessageCount)
+	}
+
+	for i, data := range block.Data.Data {
+		env, err := utils.UnmarshalEnvelope(data)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("transaction %d is malformed", i))
+		}
+		chdr, err := utils.ChannelHeader(env)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("transaction %d is malformed", i))
+		}
+		if chdr.ChannelId != c.channelID {
+			return errors.Errorf("transaction %d is of channel %s", i, chdr.ChannelId)
+		}
+
+		switch c.support.ClassifyMsg(chdr) {
+		case msgprocessor.NormalMsg:
+			if _, err := c.support.ProcessNormalMsg(env); err != nil {
+				return errors.WithMessage(err, fmt.Sprintf("transaction %d is invalid", i))
+			}
+		case msgprocessor.ConfigMsg:
+			if len(block.Data.Data) != 1 {
+				return errors.Errorf("config transaction is not alone in block [%d]", block.Header.Number)
+			}
+			if err := c.verifyConfig(env); err != nil {
+				return errors.WithMessage(err, "config transaction is invalid")
+			}
+		default:
+			return errors.Errorf("transaction %d is of unexpected type %s", i, common.HeaderType(chdr.Type))
+		}
+	}
+
+	return nil
+}
+
+// verifyConfig verifies that a config transaction is the result
+// of applying its config update to the current config.
+func (c *Chain) verifyConfig(env *common.Envelope) error {
+	configEnv, err := configEnvelope(env)
+	if err != nil {
+		return err
+	}
+	expected, _, err := c.support.ProcessConfigMsg(env)
+	if err != nil {
+		return err
+	}
+	expectedConfigEnv, err := configEnvelope(expected)
+	if err != nil {
+		return err
+	}
+	if !proto.Equal(configEnv.Config, expectedConfigEnv.Config) {
+		return errors.New("config does not match the result of applying its config update")
+	}
+	return c.checkConfig(env)
+}
+
+// checkConfig verifies that a config transaction of the channel
+// retains a valid BFT configuration.
+func (c *Chain) checkConfig(env *common.Envelope) error {
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return err
+	}
+	if common.HeaderType(chdr.Type) != common.HeaderType_CONFIG {
+		return nil
+	}
+	configEnv, err := configEnvelope(env)
+	if err != nil {
+		return err
+	}
+
+	ordererGroup, exists := configEnv.GetConfig().GetChannelGroup().GetGroups()[channelconfig.OrdererGroupKey]
+	if !exists {
+		return errors.New("config does not contain the orderer group")
+	}
+	value, exists := ordererGroup.Values[channelconfig.ConsensusTypeKey]
+	if !exists {
+		return errors.New("config does not contain the consensus type")
+	}
+	consensusType := &orderer.ConsensusType{}
+	if err := proto.Unmarshal(value.Value, consensusType); err != nil {
+		return errors.Wrap(err, "failed to unmarshal consensus type")
+	}
+	if consensusType.Type != bft.TypeKey {
+		return errors.Errorf("changing the consensus type from %s to %s is not supported", bft.TypeKey, consensusType.Type)
+	}
+	m := &bft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusType.Metadata, m); err != nil {
+		return errors.Wrap(err, "failed to unmarshal consensus metadata")
+	}
+	if err := CheckConfigMetadata(m); err != nil {
+		return err
+	}
+	current := &bft.ConfigMetadata{}
+	if err := proto.Unmarshal(c.support.SharedConfig().ConsensusMetadata(), current); err != nil {
+		return errors.Wrap(err, "failed to unmarshal current consensus metadata")
+	}
+	return checkBlockValidationPolicy(ordererGroup, current.Consenters, m.Consenters)
+}
+
+// isBehind returns whether enough nodes are ahead of this node
+// for it to pull the blocks it is missing.
+func (c *Chain) isBehind(now time.Time) bool {
+	height := c.height()
+	var ahead, farAhead int
+	for _, seq := range c.seqHints {
+		if seq > height {
+			ahead++
+		}
+		if seq > height+1 {
+			farAhead++
+		}
+	}
+	if farAhead > c.f {
+		return true
+	}
+	if ahead <= c.f {
+		c.behindSince = time.Time{}
+		return false
+	}
+	// The nodes might be only slightly ahead, while the block is being committed by this node
+	if c.behindSince.IsZero() {
+		c.behindSince = now
+		return false
+	}
+	return now.Sub(c.behindSince) >= c.opts.RequestTimeout
+}
+
+// catchUp pulls the blocks which at least one correct node has committed.
+func (c *Chain) catchUp() {
+	var seqs []uint64
+	for _, seq := range c.seqHints {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] > seqs[j] })
+	target := seqs[c.f]
+
+	c.logger.Infof("Catching up from block [%d] to block [%d]", c.height(), target-1)
+	puller, err := c.createPuller()
+	if err != nil {
+		c.logger.Errorf("Failed creating block puller: %s", err)
+		return
+	}
+	defer puller.Close()
+
+	for c.height() < target {
+		block := puller.PullBlock(c.height())
+		if block == nil {
+			c.logger.Warningf("Failed pulling block [%d]", c.height())
+			return
+		}
+		c.support.WriteSignedBlock(block)
+		c.committed(block)
+		if c.evicted {
+			return
+		}
+	}
+	c.processPending()
+}
+
+func (c *Chain) updateViewHint(sender, view uint64) {
+	if sender == c.selfID || view <= c.viewHints[sender] {
+		return
+	}
+	c.viewHints[sender] = view
+	if view <= c.view {
+		return
+	}
+
+	// Follow the view at least one correct node is in
+	var views []uint64
+	for _, v := range c.viewHints {
+		if v > c.view {
+			views = append(views, v)
+		}
+	}
+	if len(views) <= c.f {
+		return
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i] > views[j] })
+	c.logger.Infof("Nodes have moved to view %d", views[c.f])
+	c.enterView(views[c.f])
+}
+
+func (c *Chain) updateSeqHint(sender, seq uint64) {
+	if sender != c.selfID && seq > c.seqHints[sender] {
+		c.seqHints[sender] = seq
+	}
+}
+
+// inWindow returns whether messages of the given slot are kept.
+func (c *Chain) inWindow(s slot) bool {
+	return s.view >= c.view && s.view <= c.view+1 && s.seq >= c.height() && s.seq <= c.height()+1
+}
+
+func (c *Chain) broadcast(msg *bft.ConsensusMessage) {
+	req := &orderer.ConsensusRequest{Channel: c.channelID, Payload: utils.MarshalOrPanic(msg)}
+	for _, id := range c.nodes {
+		if id == c.selfID {
+			continue
+		}
+		if err := c.rpc.SendConsensus(id, req); err != nil {
+			c.logger.Debugf("Failed to send consensus message to %d: %v", id, err)
+		}
+	}
+}
+
+func (c *Chain) submitRequest(r *request) *orderer.SubmitRequest {
+	return &orderer.SubmitRequest{Channel: c.channelID, LastValidationSeq: r.configSeq, Payload: r.env}
+}
+
+func (c *Chain) sendRequests(destinations []uint64, requests []*orderer.SubmitRequest) {
+	for _, dest := range destinations {
+		for _, req := range requests {
+			if err := c.rpc.SendSubmit(dest, req); err != nil {
+				c.logger.Warningf("Failed to forward request to %d: %v", dest, err)
+				break
+			}
+		}
+	}
+}
+
+func (c *Chain) setConsenters(consenters map[uint64]*bft.Consenter) {
+	c.opts.Consenters = consenters
+	c.nodes = c.nodes[:0]
+	for id := range consenters {
+		c.nodes = append(c.nodes, id)
+	}
+	sort.Slice(c.nodes, func(i, j int) bool { return c.nodes[i] < c.nodes[j] })
+	c.f, c.q = bft.Quorum(len(c.nodes))
+
+	for id := range c.viewHints {
+		if _, exists := consenters[id]; !exists {
+			delete(c.viewHints, id)
+		}
+	}
+	for id := range c.seqHints {
+		if _, exists := consenters[id]; !exists {
+			delete(c.seqHints, id)
+		}
+	}
+	for id := range c.viewChanges {
+		if _, exists := consenters[id]; !exists {
+			delete(c.viewChanges, id)
+		}
+	}
+	for id := range c.pending {
+		if _, exists := consenters[id]; !exists {
+			delete(c.pending, id)
+		}
+	}
+	for id := range c.aheadVotes {
+		if _, exists := consenters[id]; !exists {
+			delete(c.aheadVotes, id)
+		}
+	}
+}
+
+func (c *Chain) remoteNodes() []uint64 {
+	var nodes []uint64
+	for _, id := range c.nodes {
+		if id != c.selfID {
+			nodes = append(nodes, id)
+		}
+	}
+	return nodes
+}
+
+func (c *Chain) configureComm() error {
+	nodes, err := c.remotePeers()
+	if err != nil {
+		return err
+	}
+
+	c.configurator.Configure(c.channelID, nodes)
+	return nil
+}
+
+func (c *Chain) remotePeers() ([]cluster.RemoteNode, error) {
+	var nodes []cluster.RemoteNode
+	for id, consenter := range c.opts.Consenters {
+		// No need to know yourself
+		if id == c.selfID {
+			continue
+		}
+		serverCertAsDER, err := pemToDER(consenter.ServerTlsCert, id, "server", c.logger)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		clientCertAsDER, err := pemToDER(consenter.ClientTlsCert, id, "client", c.logger)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		nodes = append(nodes, cluster.RemoteNode{
+			ID:            id,
+			Endpoint:      fmt.Sprintf("%s:%d", consenter.Host, consenter.Port),
+			ServerTLSCert: serverCertAsDER,
+			ClientTLSCert: clientCertAsDER,
+		})
+	}
+	return nodes, nil
+}
+
+func (c *Chain) height() uint64 {
+	return c.lastBlock.Header.Number + 1
+}
+
+func (c *Chain) leaderOf(view uint64) uint64 {
+	return c.nodes[view%uint64(len(c.nodes))]
+}
+
+func (c *Chain) leader() uint64 {
+	return c.leaderOf(c.view)
+}
+
+func (c *Chain) isLeader() bool {
+	return c.leader() == c.selfID
+}
+
+// lastConfigOf returns the index of the last config block as of the given block.
+func (c *Chain) lastConfigOf(block *common.Block) uint64 {
+	if isConfigBlock(block) {
+		return block.Header.Number
+	}
+	return c.lastConfig
+}
+
+// checkBlockStructure verifies that the block has all of its parts.
+func checkBlockStructure(block *common.Block) error {
+	if block == nil || block.Header == nil || block.Data == nil || block.Metadata == nil {
+		return errors.New("block is incomplete")
+	}
+	if len(block.Metadata.Metadata) < len(common.BlockMetadataIndex_name) {
+		return errors.Errorf("block has %d metadata entries", len(block.Metadata.Metadata))
+	}
+	return nil
+}
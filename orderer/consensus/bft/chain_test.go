@@ -0,0 +1,497 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/msgprocessor"
+	"github.com/hyperledger/fabric/orderer/consensus/bft"
+	"github.com/hyperledger/fabric/orderer/consensus/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	protosbft "github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const channelID = "mychannel"
+
+func sign(identity, data []byte) []byte {
+	digest := sha256.Sum256(util.ConcatenateBytes(identity, data))
+	return digest[:]
+}
+
+type verifier struct{}
+
+func (verifier) VerifySignature(consenter *protosbft.Consenter, sd *common.SignedData) error {
+	if !bytes.Equal(sd.Identity, consenter.Identity) {
+		return errors.Errorf("signature is not of consenter %d", consenter.Id)
+	}
+	if !bytes.Equal(sd.Signature, sign(consenter.Identity, sd.Data)) {
+		return errors.Errorf("invalid signature of consenter %d", consenter.Id)
+	}
+	return nil
+}
+
+type configurator struct{}
+
+func (configurator) Configure(channel string, newNodes []cluster.RemoteNode) {}
+
+type node struct {
+	id      uint64
+	chain   *bft.Chain
+	support *mocks.FakeConsenterSupport
+
+	lock   sync.RWMutex
+	ledger []*common.Block
+	source *node
+}
+
+func (n *node) height() uint64 {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return uint64(len(n.ledger))
+}
+
+func (n *node) block(seq uint64) *common.Block {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if seq >= uint64(len(n.ledger)) {
+		return nil
+	}
+	return n.ledger[seq]
+}
+
+func (n *node) PullBlock(seq uint64) *common.Block {
+	return n.source.block(seq)
+}
+
+func (n *node) HeightsByEndpoints() (map[string]uint64, error) {
+	return map[string]uint64{fmt.Sprintf("node%d", n.source.id): n.source.height()}, nil
+}
+
+func (n *node) Close() {}
+
+type network struct {
+	lock         sync.RWMutex
+	nodes        map[uint64]*node
+	disconnected map[uint64]bool
+}
+
+func (net *network) connected(from, to uint64) *node {
+	net.lock.RLock()
+	defer net.lock.RUnlock()
+	if net.disconnected[from] || net.disconnected[to] {
+		return nil
+	}
+	return net.nodes[to]
+}
+
+func (net *network) disconnect(id uint64) {
+	net.lock.Lock()
+	defer net.lock.Unlock()
+	net.disconnected[id] = true
+}
+
+func (net *network) connect(id uint64) {
+	net.lock.Lock()
+	defer net.lock.Unlock()
+	delete(net.disconnected, id)
+}
+
+type rpc struct {
+	net  *network
+	from uint64
+}
+
+func (r *rpc) SendConsensus(dest uint64, msg *orderer.ConsensusRequest) error {
+	go func() {
+		if n := r.net.connected(r.from, dest); n != nil {
+			n.chain.Consensus(msg, r.from)
+		}
+	}()
+	return nil
+}
+
+func (r *rpc) SendSubmit(dest uint64, request *orderer.SubmitRequest) error {
+	n := r.net.connected(r.from, dest)
+	if n == nil {
+		return errors.Errorf("node %d is unreachable", dest)
+	}
+	return n.chain.Submit(request, r.from)
+}
+
+func genesisBlock() *common.Block {
+	block := common.NewBlock(0, nil)
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(makeTx(0))}
+	block.Header.DataHash = block.Data.Hash()
+	block.Metadata.Metadata[common.BlockMetadataIndex_LAST_CONFIG] = utils.MarshalOrPanic(&common.Metadata{
+		Value: utils.MarshalOrPanic(&common.LastConfig{Index: 0}),
+	})
+	return block
+}
+
+func makeTx(i int) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+					ChannelId: channelID,
+					TxId:      fmt.Sprintf("tx%d", i),
+				}),
+			},
+			Data: []byte(fmt.Sprintf("transaction %d", i)),
+		}),
+	}
+}
+
+func newNetwork(t *testing.T, size int) *network {
+	net := &network{
+		nodes:        make(map[uint64]*node),
+		disconnected: make(map[uint64]bool),
+	}
+
+	tlsCert, err := ioutil.ReadFile("testdata/tls-server-1.pem")
+	require.NoError(t, err)
+	consenters := make(map[uint64]*protosbft.Consenter)
+	for id := uint64(1); id <= uint64(size); id++ {
+		consenters[id] = &protosbft.Consenter{
+			Id:            id,
+			Identity:      []byte(fmt.Sprintf("node%d", id)),
+			ClientTlsCert: tlsCert,
+			ServerTlsCert: tlsCert,
+		}
+	}
+
+	for id := uint64(1); id <= uint64(size); id++ {
+		n := &node{id: id, ledger: []*common.Block{genesisBlock()}}
+		identity := consenters[id].Identity
+
+		support := &mocks.FakeConsenterSupport{}
+		support.ChainIDReturns(channelID)
+		support.HeightStub = n.height
+		support.BlockStub = n.block
+		support.SharedConfigReturns(&mockconfig.Orderer{
+			BatchSizeVal: &orderer.BatchSize{
+				MaxMessageCount:   10,
+				AbsoluteMaxBytes:  10 * 1024 * 1024,
+				PreferredMaxBytes: 1024 * 1024,
+			},
+			BatchTimeoutVal: 50 * time.Millisecond,
+		})
+		support.ClassifyMsgStub = func(chdr *common.ChannelHeader) msgprocessor.Classification {
+			switch common.HeaderType(chdr.Type) {
+			case common.HeaderType_CONFIG, common.HeaderType_ORDERER_TRANSACTION:
+				return msgprocessor.ConfigMsg
+			case common.HeaderType_CONFIG_UPDATE:
+				return msgprocessor.ConfigUpdateMsg
+			default:
+				return msgprocessor.NormalMsg
+			}
+		}
+		support.NewSignatureHeaderReturns(&common.SignatureHeader{Creator: identity}, nil)
+		support.SignStub = func(data []byte) ([]byte, error) {
+			return sign(identity, data), nil
+		}
+		support.WriteSignedBlockStub = func(block *common.Block) {
+			n.lock.Lock()
+			defer n.lock.Unlock()
+			n.ledger = append(n.ledger, block)
+		}
+		n.support = support
+		n.source = n
+
+		chain, err := bft.NewChain(
+			support,
+			bft.Options{
+				SelfID:            id,
+				Consenters:        consenters,
+				Logger:            flogging.MustGetLogger("test"),
+				RequestTimeout:    time.Second,
+				ViewChangeTimeout: 2 * time.Second,
+				RequestPoolSize:   100,
+				TickInterval:      10 * time.Millisecond,
+			},
+			configurator{},
+			&rpc{net: net, from: id},
+			verifier{},
+			func() (bft.BlockPuller, error) { return n, nil },
+			nil,
+		)
+		require.NoError(t, err)
+		n.chain = chain
+		net.nodes[id] = n
+	}
+
+	return net
+}
+
+func (net *network) start(ids ...uint64) {
+	for _, id := range ids {
+		net.nodes[id].chain.Start()
+	}
+}
+
+func (net *network) halt() {
+	for _, n := range net.nodes {
+		n.chain.Halt()
+	}
+}
+
+func waitForHeight(t *testing.T, height uint64, nodes ...*node) {
+	deadline := time.Now().Add(20 * time.Second)
+	for _, n := range nodes {
+		for n.height() < height {
+			if time.Now().After(deadline) {
+				t.Fatalf("node %d reached height %d instead of %d", n.id, n.height(), height)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func signers(t *testing.T, block *common.Block) []string {
+	md, err := utils.GetMetadataFromBlock(block, common.BlockMetadataIndex_SIGNATURES)
+	require.NoError(t, err)
+	var res []string
+	for _, sig := range md.Signatures {
+		sigHdr, err := utils.GetSignatureHeader(sig.SignatureHeader)
+		require.NoError(t, err)
+		assert.Equal(t, sign(sigHdr.Creator, util.ConcatenateBytes(md.Value, sig.SignatureHeader, block.Header.Bytes())), sig.Signature)
+		res = append(res, string(sigHdr.Creator))
+	}
+	return res
+}
+
+func proposedInView(t *testing.T, block *common.Block) uint64 {
+	md, err := utils.GetMetadataFromBlock(block, common.BlockMetadataIndex_ORDERER)
+	require.NoError(t, err)
+	bm, err := bft.ReadBlockMetadata(md)
+	require.NoError(t, err)
+	return bm.View
+}
+
+func TestSingleNode(t *testing.T) {
+	net := newNetwork(t, 1)
+	net.start(1)
+	defer net.halt()
+
+	n := net.nodes[1]
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, n.chain.Order(makeTx(i), 0))
+	}
+	waitForHeight(t, 2, n)
+
+	block := n.block(1)
+	assert.Len(t, block.Data.Data, 3)
+	assert.Equal(t, []string{"node1"}, signers(t, block))
+	assert.Equal(t, genesisBlock().Header.Hash(), block.Header.PreviousHash)
+
+	// Re-submitting an ordered transaction is ignored
+	require.NoError(t, n.chain.Order(makeTx(1), 0))
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, uint64(2), n.height())
+}
+
+func TestOrderAcrossNodes(t *testing.T) {
+	net := newNetwork(t, 4)
+	net.start(1, 2, 3, 4)
+	defer net.halt()
+
+	// Transactions are submitted to a follower, which forwards them to the leader
+	for i := 1; i <= 10; i++ {
+		require.NoError(t, net.nodes[3].chain.Order(makeTx(i), 0))
+	}
+	waitForHeight(t, 2, net.nodes[1], net.nodes[2], net.nodes[3], net.nodes[4])
+
+	for _, n := range net.nodes {
+		block := n.block(1)
+		assert.Len(t, block.Data.Data, 10)
+		assert.True(t, len(signers(t, block)) >= 3)
+		assert.Equal(t, net.nodes[1].block(1).Header, block.Header)
+		assert.Equal(t, uint64(0), proposedInView(t, block))
+	}
+}
+
+func TestLeaderCrash(t *testing.T) {
+	net := newNetwork(t, 4)
+	net.disconnect(1)
+	net.start(1, 2, 3, 4)
+	defer net.halt()
+
+	require.NoError(t, net.nodes[3].chain.Order(makeTx(1), 0))
+	waitForHeight(t, 2, net.nodes[2], net.nodes[3], net.nodes[4])
+
+	for _, id := range []uint64{2, 3, 4} {
+		block := net.nodes[id].block(1)
+		assert.Equal(t, uint64(1), proposedInView(t, block))
+		assert.NotContains(t, signers(t, block), "node1")
+	}
+	assert.Equal(t, uint64(1), net.nodes[1].height())
+}
+
+func TestInvalidProposal(t *testing.T) {
+	net := newNetwork(t, 4)
+	// The leader of the first view is faulty
+	net.start(2, 3, 4)
+	defer func() {
+		for _, id := range []uint64{2, 3, 4} {
+			net.nodes[id].chain.Halt()
+		}
+	}()
+
+	block := common.NewBlock(1, []byte("not the hash of the previous block"))
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(makeTx(1))}
+	block.Header.DataHash = block.Data.Hash()
+	pp := &protosbft.ConsensusMessage{
+		Content: &protosbft.ConsensusMessage_PrePrepare{
+			PrePrepare: &protosbft.PrePrepare{View: 0, Seq: 1, Block: block},
+		},
+	}
+	for _, id := range []uint64{2, 3, 4} {
+		err := net.nodes[id].chain.Consensus(&orderer.ConsensusRequest{
+			Channel: channelID,
+			Payload: utils.MarshalOrPanic(pp),
+		}, 1)
+		require.NoError(t, err)
+	}
+
+	// The nodes move to the next view, in which the transactions are ordered
+	require.NoError(t, net.nodes[4].chain.Order(makeTx(2), 0))
+	waitForHeight(t, 2, net.nodes[2], net.nodes[3], net.nodes[4])
+	for _, id := range []uint64{2, 3, 4} {
+		block := net.nodes[id].block(1)
+		assert.Equal(t, genesisBlock().Header.Hash(), block.Header.PreviousHash)
+		assert.Equal(t, uint64(1), proposedInView(t, block))
+		env, err := utils.UnmarshalEnvelope(block.Data.Data[0])
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(makeTx(2), env))
+	}
+}
+
+func TestViewChangeWithForgedPreparedBlock(t *testing.T) {
+	net := newNetwork(t, 4)
+	// The leader of the first view is faulty
+	net.start(2, 3, 4)
+	defer func() {
+		for _, id := range []uint64{2, 3, 4} {
+			net.nodes[id].chain.Halt()
+		}
+	}()
+
+	// The faulty node claims to have prepared a block in a high view,
+	// without the prepares of a quorum of nodes that prove it
+	forged := common.NewBlock(1, genesisBlock().Header.Hash())
+	forged.Data.Data = [][]byte{utils.MarshalOrPanic(makeTx(100))}
+	forged.Header.DataHash = forged.Data.Hash()
+	forged.Metadata.Metadata[common.BlockMetadataIndex_ORDERER] = utils.MarshalOrPanic(&common.Metadata{
+		Value: utils.MarshalOrPanic(&protosbft.BlockMetadata{View: 5}),
+	})
+	vc := &protosbft.ConsensusMessage{
+		Content: &protosbft.ConsensusMessage_ViewChange{
+			ViewChange: &protosbft.ViewChange{
+				NextView: 1,
+				Prepared: &protosbft.PrePrepare{View: 5, Seq: 1, Block: forged},
+				Prepares: map[uint64]*protosbft.Prepare{
+					1: {View: 5, Seq: 1, Digest: []byte("digest")},
+					2: {View: 5, Seq: 1, Digest: []byte("digest")},
+					3: {View: 5, Seq: 1, Digest: []byte("digest")},
+				},
+			},
+		},
+	}
+	for _, id := range []uint64{2, 3, 4} {
+		err := net.nodes[id].chain.Consensus(&orderer.ConsensusRequest{
+			Channel: channelID,
+			Payload: utils.MarshalOrPanic(vc),
+		}, 1)
+		require.NoError(t, err)
+	}
+
+	// The new leader ignores the forged block and proposes the submitted transaction
+	require.NoError(t, net.nodes[3].chain.Order(makeTx(1), 0))
+	waitForHeight(t, 2, net.nodes[2], net.nodes[3], net.nodes[4])
+	for _, id := range []uint64{2, 3, 4} {
+		block := net.nodes[id].block(1)
+		assert.Equal(t, uint64(1), proposedInView(t, block))
+		env, err := utils.UnmarshalEnvelope(block.Data.Data[0])
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(makeTx(1), env))
+	}
+}
+
+func TestCatchUp(t *testing.T) {
+	net := newNetwork(t, 4)
+	net.disconnect(4)
+	net.start(1, 2, 3, 4)
+	defer net.halt()
+
+	require.NoError(t, net.nodes[1].chain.Order(makeTx(1), 0))
+	waitForHeight(t, 2, net.nodes[1], net.nodes[2], net.nodes[3])
+	require.NoError(t, net.nodes[1].chain.Order(makeTx(2), 0))
+	waitForHeight(t, 3, net.nodes[1], net.nodes[2], net.nodes[3])
+	assert.Equal(t, uint64(1), net.nodes[4].height())
+
+	// Once reconnected, the node pulls the blocks it missed
+	net.nodes[4].source = net.nodes[1]
+	net.connect(4)
+	require.NoError(t, net.nodes[1].chain.Order(makeTx(3), 0))
+	waitForHeight(t, 4, net.nodes[1], net.nodes[2], net.nodes[3], net.nodes[4])
+
+	for seq := uint64(1); seq < 4; seq++ {
+		assert.Equal(t, net.nodes[1].block(seq).Header, net.nodes[4].block(seq).Header)
+	}
+}
+
+func TestRequestPoolFull(t *testing.T) {
+	net := newNetwork(t, 4)
+	// Without the other nodes, nothing is ordered
+	net.disconnect(1)
+	net.start(2)
+	defer net.nodes[2].chain.Halt()
+
+	for i := 1; i <= 100; i++ {
+		require.NoError(t, net.nodes[2].chain.Order(makeTx(i), 0))
+	}
+	err := net.nodes[2].chain.Order(makeTx(101), 0)
+	assert.EqualError(t, err, "request pool is full (100 requests)")
+}
+
+func TestHalt(t *testing.T) {
+	net := newNetwork(t, 1)
+	n := net.nodes[1]
+
+	err := n.chain.Order(makeTx(1), 0)
+	assert.EqualError(t, err, "chain is not started")
+
+	n.chain.Start()
+	require.NoError(t, n.chain.WaitReady())
+	n.chain.Halt()
+
+	select {
+	case <-n.chain.Errored():
+	case <-time.After(time.Second):
+		t.Fatal("chain did not signal it stopped")
+	}
+	assert.EqualError(t, n.chain.WaitReady(), "chain is stopped")
+	assert.EqualError(t, n.chain.Order(makeTx(1), 0), "chain is stopped")
+}
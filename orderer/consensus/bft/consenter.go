@@ -0,0 +1,263 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"bytes"
+	"reflect"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/metrics"
+	"github.com/hyperledger/fabric/core/comm"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/inactive"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/pkg/errors"
+)
+
+// DefaultRequestPoolSize is the maximum number of pending requests of a node,
+// unless specified otherwise in the channel configuration.
+const DefaultRequestPoolSize = 10000
+
+// InactiveChainRegistry registers chains that are inactive
+type InactiveChainRegistry interface {
+	// TrackChain tracks a chain with the given name, and calls the given callback
+	// when this chain should be created.
+	TrackChain(chainName string, genesisBlock *common.Block, createChain func())
+}
+
+// ChainGetter obtains instances of ChainSupport for the given channel
+type ChainGetter interface {
+	// GetChain obtains the ChainSupport for the given channel.
+	// Returns nil, false when the ChainSupport for the given channel
+	// isn't found.
+	GetChain(chainID string) *multichannel.ChainSupport
+}
+
+// Consenter implements the BFT consenter
+type Consenter struct {
+	CreateChain           func(chainName string)
+	InactiveChainRegistry InactiveChainRegistry
+	Dialer                *cluster.PredicateDialer
+	Communication         cluster.Communicator
+	*Dispatcher
+	Chains        ChainGetter
+	Logger        *flogging.FabricLogger
+	OrdererConfig localconfig.TopLevel
+	Cert          []byte
+}
+
+// TargetChannel extracts the channel from the given proto.Message.
+// Returns an empty string on failure.
+func (c *Consenter) TargetChannel(message proto.Message) string {
+	switch req := message.(type) {
+	case *orderer.ConsensusRequest:
+		return req.Channel
+	case *orderer.SubmitRequest:
+		return req.Channel
+	default:
+		return ""
+	}
+}
+
+// ReceiverByChain returns the MessageReceiver for the given channelID or nil
+// if not found.
+func (c *Consenter) ReceiverByChain(channelID string) MessageReceiver {
+	cs := c.Chains.GetChain(channelID)
+	if cs == nil {
+		return nil
+	}
+	if cs.Chain == nil {
+		c.Logger.Panicf("Programming error - Chain %s is nil although it exists in the mapping", channelID)
+	}
+	if bftChain, isBFTChain := cs.Chain.(*Chain); isBFTChain {
+		return bftChain
+	}
+	c.Logger.Warningf("Chain %s is of type %v and not bft.Chain", channelID, reflect.TypeOf(cs.Chain))
+	return nil
+}
+
+func (c *Consenter) detectSelfID(consenters map[uint64]*bft.Consenter) (uint64, error) {
+	thisNodeCertAsDER, err := pemToDER(c.Cert, 0, "server", c.Logger)
+	if err != nil {
+		return 0, err
+	}
+
+	var serverCertificates []string
+	for nodeID, cst := range consenters {
+		serverCertificates = append(serverCertificates, string(cst.ServerTlsCert))
+
+		certAsDER, err := pemToDER(cst.ServerTlsCert, nodeID, "server", c.Logger)
+		if err != nil {
+			return 0, err
+		}
+
+		if bytes.Equal(thisNodeCertAsDER, certAsDER) {
+			return nodeID, nil
+		}
+	}
+
+	c.Logger.Warning("Could not find", string(c.Cert), "among", serverCertificates)
+	return 0, cluster.ErrNotInChannel
+}
+
+// HandleChain returns a new Chain instance or an error upon failure
+func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *common.Metadata) (consensus.Chain, error) {
+	m := &bft.ConfigMetadata{}
+	if err := proto.Unmarshal(support.SharedConfig().ConsensusMetadata(), m); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal consensus metadata")
+	}
+
+	if m.Options == nil {
+		return nil, errors.New("bft options have not been provided")
+	}
+
+	if err := CheckConfigMetadata(m); err != nil {
+		return nil, errors.WithMessage(err, "invalid bft configuration")
+	}
+
+	blockMetadata, err := ReadBlockMetadata(metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read BFT metadata")
+	}
+
+	msps, isMSPManagerProvider := support.(MSPManagerProvider)
+	if !isMSPManagerProvider {
+		return nil, errors.Errorf("support of channel %s does not provide the MSPs of the channel", support.ChainID())
+	}
+
+	consenters := map[uint64]*bft.Consenter{}
+	for _, consenter := range m.Consenters {
+		consenters[consenter.Id] = consenter
+	}
+
+	id, err := c.detectSelfID(consenters)
+	if err != nil {
+		c.InactiveChainRegistry.TrackChain(support.ChainID(), support.Block(0), func() {
+			c.CreateChain(support.ChainID())
+		})
+		return &inactive.Chain{Err: errors.Errorf("channel %s is not serviced by me", support.ChainID())}, nil
+	}
+
+	// The options have been validated by CheckConfigMetadata
+	requestTimeout, _ := time.ParseDuration(m.Options.RequestTimeout)
+	viewChangeTimeout, _ := time.ParseDuration(m.Options.ViewChangeTimeout)
+	requestPoolSize := int(m.Options.RequestPoolSize)
+	if requestPoolSize == 0 {
+		requestPoolSize = DefaultRequestPoolSize
+	}
+
+	opts := Options{
+		SelfID:     id,
+		Consenters: consenters,
+		View:       blockMetadata.View,
+		Logger:     c.Logger,
+
+		RequestTimeout:    requestTimeout,
+		ViewChangeTimeout: viewChangeTimeout,
+		RequestPoolSize:   requestPoolSize,
+		TickInterval:      DefaultTickInterval,
+	}
+
+	rpc := &cluster.RPC{
+		Timeout:       c.OrdererConfig.General.Cluster.RPCTimeout,
+		Logger:        c.Logger,
+		Channel:       support.ChainID(),
+		Comm:          c.Communication,
+		StreamsByType: cluster.NewStreamsByType(),
+	}
+	return NewChain(
+		support,
+		opts,
+		c.Communication,
+		rpc,
+		&MSPSignatureVerifier{MSPs: msps},
+		func() (BlockPuller, error) { return newBlockPuller(support, c.Dialer, c.OrdererConfig.General.Cluster) },
+		func() {
+			c.InactiveChainRegistry.TrackChain(support.ChainID(), nil, func() { c.CreateChain(support.ChainID()) })
+		},
+	)
+}
+
+// ReadBlockMetadata reads the BFT metadata from the orderer metadata of a block.
+// An empty metadata yields the metadata of the first view.
+func ReadBlockMetadata(blockMetadata *common.Metadata) (*bft.BlockMetadata, error) {
+	m := &bft.BlockMetadata{}
+	if blockMetadata == nil || len(blockMetadata.Value) == 0 {
+		return m, nil
+	}
+	if err := proto.Unmarshal(blockMetadata.Value, m); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal block's metadata")
+	}
+	return m, nil
+}
+
+// New creates a BFT Consenter
+func New(
+	clusterDialer *cluster.PredicateDialer,
+	conf *localconfig.TopLevel,
+	srvConf comm.ServerConfig,
+	srv *comm.GRPCServer,
+	r *multichannel.Registrar,
+	icr InactiveChainRegistry,
+	metricsProvider metrics.Provider,
+) *Consenter {
+	logger := flogging.MustGetLogger("orderer.consensus.bft")
+
+	consenter := &Consenter{
+		CreateChain:           r.CreateChain,
+		Cert:                  srvConf.SecOpts.Certificate,
+		Logger:                logger,
+		Chains:                r,
+		OrdererConfig:         *conf,
+		Dialer:                clusterDialer,
+		InactiveChainRegistry: icr,
+	}
+	consenter.Dispatcher = &Dispatcher{
+		Logger:        logger,
+		ChainSelector: consenter,
+	}
+
+	comm := createComm(clusterDialer, consenter, conf.General.Cluster, metricsProvider)
+	consenter.Communication = comm
+	svc := &cluster.Service{
+		CertExpWarningThreshold:          conf.General.Cluster.CertExpirationWarningThreshold,
+		MinimumExpirationWarningInterval: cluster.MinimumExpirationWarningInterval,
+		StreamCountReporter: &cluster.StreamCountReporter{
+			Metrics: comm.Metrics,
+		},
+		StepLogger: flogging.MustGetLogger("orderer.common.cluster.step"),
+		Logger:     flogging.MustGetLogger("orderer.common.cluster"),
+		Dispatcher: comm,
+	}
+	orderer.RegisterClusterServer(srv.Server(), svc)
+	return consenter
+}
+
+func createComm(clusterDialer *cluster.PredicateDialer, c *Consenter, config localconfig.Cluster, p metrics.Provider) *cluster.Comm {
+	metrics := cluster.NewMetrics(p)
+	comm := &cluster.Comm{
+		MinimumExpirationWarningInterval: cluster.MinimumExpirationWarningInterval,
+		CertExpWarningThreshold:          config.CertExpirationWarningThreshold,
+		SendBufferSize:                   config.SendBufferSize,
+		Logger:                           flogging.MustGetLogger("orderer.common.cluster"),
+		Chan2Members:                     make(map[string]cluster.MemberMapping),
+		Connections:                      cluster.NewConnectionStore(clusterDialer, metrics.EgressTLSConnectionCount),
+		Metrics:                          metrics,
+		ChanExt:                          c,
+		H:                                c,
+	}
+	c.Communication = comm
+	return comm
+}
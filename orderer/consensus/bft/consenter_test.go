@@ -0,0 +1,136 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	mockconfig "github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/orderer/consensus/bft"
+	"github.com/hyperledger/fabric/orderer/consensus/inactive"
+	"github.com/hyperledger/fabric/orderer/consensus/mocks"
+	"github.com/hyperledger/fabric/protos/common"
+	protosbft "github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type inactiveChainRegistry struct {
+	tracked []string
+}
+
+func (r *inactiveChainRegistry) TrackChain(chainName string, _ *common.Block, _ func()) {
+	r.tracked = append(r.tracked, chainName)
+}
+
+type supportWithMSPs struct {
+	*mocks.FakeConsenterSupport
+}
+
+func (supportWithMSPs) MSPManager() msp.MSPManager {
+	return nil
+}
+
+func TestHandleChain(t *testing.T) {
+	load := func(name string) []byte {
+		cert, err := ioutil.ReadFile("testdata/" + name)
+		require.NoError(t, err)
+		return cert
+	}
+
+	metadata := &protosbft.ConfigMetadata{
+		Consenters: []*protosbft.Consenter{
+			{
+				Id:            1,
+				Host:          "orderer1",
+				Port:          7050,
+				MspId:         "OrdererMSP",
+				Identity:      load("tls-client-1.pem"),
+				ClientTlsCert: load("tls-client-1.pem"),
+				ServerTlsCert: load("tls-server-1.pem"),
+			},
+			{
+				Id:            2,
+				Host:          "orderer2",
+				Port:          7050,
+				MspId:         "OrdererMSP",
+				Identity:      load("tls-client-2.pem"),
+				ClientTlsCert: load("tls-client-2.pem"),
+				ServerTlsCert: load("tls-server-2.pem"),
+			},
+		},
+		Options: &protosbft.Options{
+			RequestTimeout:    "10s",
+			ViewChangeTimeout: "20s",
+		},
+	}
+
+	newSupport := func() supportWithMSPs {
+		support := &mocks.FakeConsenterSupport{}
+		support.ChainIDReturns("mychannel")
+		support.HeightReturns(1)
+		support.BlockReturns(genesisBlock())
+		support.SharedConfigReturns(&mockconfig.Orderer{ConsensusMetadataVal: utils.MarshalOrPanic(metadata)})
+		return supportWithMSPs{FakeConsenterSupport: support}
+	}
+
+	newConsenter := func(cert string) (*bft.Consenter, *inactiveChainRegistry) {
+		registry := &inactiveChainRegistry{}
+		return &bft.Consenter{
+			Cert:                  load(cert),
+			Logger:                flogging.MustGetLogger("test"),
+			InactiveChainRegistry: registry,
+			CreateChain:           func(string) {},
+		}, registry
+	}
+
+	t.Run("member of the channel", func(t *testing.T) {
+		consenter, registry := newConsenter("tls-server-2.pem")
+		chain, err := consenter.HandleChain(newSupport(), nil)
+		require.NoError(t, err)
+		assert.IsType(t, &bft.Chain{}, chain)
+		assert.Empty(t, registry.tracked)
+	})
+
+	t.Run("not a member of the channel", func(t *testing.T) {
+		consenter, registry := newConsenter("tls-server-3.pem")
+		chain, err := consenter.HandleChain(newSupport(), nil)
+		require.NoError(t, err)
+		assert.IsType(t, &inactive.Chain{}, chain)
+		assert.Equal(t, []string{"mychannel"}, registry.tracked)
+	})
+
+	t.Run("invalid metadata", func(t *testing.T) {
+		consenter, _ := newConsenter("tls-server-1.pem")
+		support := newSupport()
+		support.SharedConfigReturns(&mockconfig.Orderer{ConsensusMetadataVal: utils.MarshalOrPanic(&protosbft.ConfigMetadata{
+			Consenters: metadata.Consenters,
+			Options:    &protosbft.Options{RequestTimeout: "10s"},
+		})})
+		_, err := consenter.HandleChain(support, nil)
+		assert.EqualError(t, err, "invalid bft configuration: failed to parse ViewChangeTimeout () to time duration: time: invalid duration \"\"")
+	})
+
+	t.Run("support without MSPs", func(t *testing.T) {
+		consenter, _ := newConsenter("tls-server-1.pem")
+		_, err := consenter.HandleChain(newSupport().FakeConsenterSupport, nil)
+		assert.EqualError(t, err, "support of channel mychannel does not provide the MSPs of the channel")
+	})
+
+	t.Run("view from block metadata", func(t *testing.T) {
+		md, err := bft.ReadBlockMetadata(&common.Metadata{Value: utils.MarshalOrPanic(&protosbft.BlockMetadata{View: 5})})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), md.View)
+		md, err = bft.ReadBlockMetadata(nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), md.View)
+	})
+}
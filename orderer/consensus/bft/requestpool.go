@@ -0,0 +1,154 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+)
+
+// request is a transaction pending to be ordered
+type request struct {
+	id        string
+	env       *common.Envelope
+	isConfig  bool
+	configSeq uint64
+	// local is true if the request was submitted by a client of this node
+	local bool
+	// broadcast is true if the request was forwarded to all nodes
+	broadcast bool
+	// arrival is the time the request was added to the pool
+	arrival time.Time
+	// timestamp is the time from which the request timeout is measured
+	timestamp time.Time
+}
+
+// requestPool holds the pending requests of a node in order of arrival,
+// along with the identifiers of recently ordered requests.
+// Its operations are not thread safe.
+type requestPool struct {
+	capacity     int
+	requests     *list.List
+	byID         map[string]*list.Element
+	configCount  int
+	committed    *list.List
+	committedIDs map[string]struct{}
+}
+
+func newRequestPool(capacity int) *requestPool {
+	return &requestPool{
+		capacity:     capacity,
+		requests:     list.New(),
+		byID:         make(map[string]*list.Element),
+		committed:    list.New(),
+		committedIDs: make(map[string]struct{}),
+	}
+}
+
+// add adds a request to the pool, or returns an error if the pool is full.
+func (p *requestPool) add(r *request) error {
+	if p.contains(r.id) {
+		return nil
+	}
+	if p.requests.Len() >= p.capacity {
+		return errors.Errorf("request pool is full (%d requests)", p.capacity)
+	}
+	p.byID[r.id] = p.requests.PushBack(r)
+	if r.isConfig {
+		p.configCount++
+	}
+	return nil
+}
+
+// contains returns whether the request is pending, or was recently ordered.
+func (p *requestPool) contains(id string) bool {
+	if _, exists := p.byID[id]; exists {
+		return true
+	}
+	_, exists := p.committedIDs[id]
+	return exists
+}
+
+func (p *requestPool) remove(id string) {
+	e, exists := p.byID[id]
+	if !exists {
+		return
+	}
+	if e.Value.(*request).isConfig {
+		p.configCount--
+	}
+	p.requests.Remove(e)
+	delete(p.byID, id)
+}
+
+// markCommitted removes the request from the pool and remembers it was ordered,
+// so it would not be ordered again if it is forwarded by a lagging node.
+func (p *requestPool) markCommitted(id string) {
+	p.remove(id)
+	if _, exists := p.committedIDs[id]; exists {
+		return
+	}
+	p.committedIDs[id] = struct{}{}
+	p.committed.PushBack(id)
+	for p.committed.Len() > p.capacity {
+		oldest := p.committed.Front()
+		delete(p.committedIDs, oldest.Value.(string))
+		p.committed.Remove(oldest)
+	}
+}
+
+func (p *requestPool) size() int {
+	return p.requests.Len()
+}
+
+func (p *requestPool) hasConfig() bool {
+	return p.configCount > 0
+}
+
+// oldest returns the request that arrived first, or nil if the pool is empty.
+func (p *requestPool) oldest() *request {
+	e := p.requests.Front()
+	if e == nil {
+		return nil
+	}
+	return e.Value.(*request)
+}
+
+// all returns the pending requests in order of arrival.
+func (p *requestPool) all() []*request {
+	res := make([]*request, 0, p.requests.Len())
+	for e := p.requests.Front(); e != nil; e = e.Next() {
+		res = append(res, e.Value.(*request))
+	}
+	return res
+}
+
+// expired returns the requests that have been pending for longer than the given timeout.
+func (p *requestPool) expired(now time.Time, timeout time.Duration) []*request {
+	var res []*request
+	for e := p.requests.Front(); e != nil; e = e.Next() {
+		r := e.Value.(*request)
+		if now.Sub(r.timestamp) > timeout {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// restartTimers restarts the timeouts of all pending requests.
+func (p *requestPool) restartTimers(now time.Time) {
+	for e := p.requests.Front(); e != nil; e = e.Next() {
+		e.Value.(*request).timestamp = now
+	}
+}
+
+func (p *requestPool) setCapacity(capacity int) {
+	p.capacity = capacity
+}
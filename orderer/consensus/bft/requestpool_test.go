@@ -0,0 +1,61 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestPool(t *testing.T) {
+	now := time.Now()
+	pool := newRequestPool(3)
+
+	require.NoError(t, pool.add(&request{id: "a", timestamp: now.Add(-time.Minute)}))
+	require.NoError(t, pool.add(&request{id: "b", isConfig: true, timestamp: now}))
+	require.NoError(t, pool.add(&request{id: "c", timestamp: now}))
+	// Adding a pending request again has no effect
+	require.NoError(t, pool.add(&request{id: "a"}))
+	assert.EqualError(t, pool.add(&request{id: "d"}), "request pool is full (3 requests)")
+
+	assert.Equal(t, 3, pool.size())
+	assert.True(t, pool.hasConfig())
+	assert.Equal(t, "a", pool.oldest().id)
+	var ids []string
+	for _, r := range pool.all() {
+		ids = append(ids, r.id)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+
+	expired := pool.expired(now, time.Second)
+	require.Len(t, expired, 1)
+	assert.Equal(t, "a", expired[0].id)
+	pool.restartTimers(now)
+	assert.Empty(t, pool.expired(now, time.Second))
+
+	pool.markCommitted("b")
+	assert.False(t, pool.hasConfig())
+	assert.Equal(t, 2, pool.size())
+	assert.True(t, pool.contains("b"))
+	// An ordered request is not added again
+	require.NoError(t, pool.add(&request{id: "b"}))
+	assert.Equal(t, 2, pool.size())
+
+	pool.remove("a")
+	assert.False(t, pool.contains("a"))
+	assert.Equal(t, "c", pool.oldest().id)
+
+	// Only the most recently ordered requests are remembered
+	for _, id := range []string{"x", "y", "z"} {
+		pool.markCommitted(id)
+	}
+	assert.False(t, pool.contains("b"))
+	assert.True(t, pool.contains("z"))
+}
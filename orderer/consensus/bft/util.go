@@ -0,0 +1,359 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/configtx"
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/orderer/common/cluster"
+	"github.com/hyperledger/fabric/orderer/common/localconfig"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
+	"github.com/hyperledger/fabric/protos/common"
+	pmsp "github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+// maxPullBlockRetries is the number of consecutive failed attempts to pull
+// a block after which catching up with the cluster is abandoned.
+const maxPullBlockRetries = 10
+
+// CheckConfigMetadata validates BFT config metadata
+func CheckConfigMetadata(metadata *bft.ConfigMetadata) error {
+	if metadata == nil {
+		return errors.Errorf("nil BFT config metadata")
+	}
+
+	if metadata.Options == nil {
+		return errors.Errorf("nil BFT config metadata options")
+	}
+
+	if d, err := time.ParseDuration(metadata.Options.RequestTimeout); err != nil {
+		return errors.Errorf("failed to parse RequestTimeout (%s) to time duration: %s", metadata.Options.RequestTimeout, err)
+	} else if d <= 0 {
+		return errors.Errorf("RequestTimeout must be positive")
+	}
+
+	if d, err := time.ParseDuration(metadata.Options.ViewChangeTimeout); err != nil {
+		return errors.Errorf("failed to parse ViewChangeTimeout (%s) to time duration: %s", metadata.Options.ViewChangeTimeout, err)
+	} else if d <= 0 {
+		return errors.Errorf("ViewChangeTimeout must be positive")
+	}
+
+	if len(metadata.Consenters) == 0 {
+		return errors.Errorf("empty consenter set")
+	}
+
+	ids := make(map[uint64]struct{})
+	identities := make(map[string]struct{})
+	for _, consenter := range metadata.Consenters {
+		if consenter.Id == 0 {
+			return errors.Errorf("consenter %s:%d has no id", consenter.Host, consenter.Port)
+		}
+		if _, exists := ids[consenter.Id]; exists {
+			return errors.Errorf("duplicate consenter id %d", consenter.Id)
+		}
+		ids[consenter.Id] = struct{}{}
+		if _, exists := identities[consenterIdentityKey(consenter)]; exists {
+			return errors.Errorf("consenter %d has the same identity as another consenter", consenter.Id)
+		}
+		identities[consenterIdentityKey(consenter)] = struct{}{}
+
+		if consenter.MspId == "" {
+			return errors.Errorf("consenter %d has no MSP ID", consenter.Id)
+		}
+		if err := validateCert(consenter.Identity, "signing"); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("consenter %d", consenter.Id))
+		}
+		if err := validateCert(consenter.ServerTlsCert, "server TLS"); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("consenter %d", consenter.Id))
+		}
+		if err := validateCert(consenter.ClientTlsCert, "client TLS"); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("consenter %d", consenter.Id))
+		}
+	}
+
+	return nil
+}
+
+// checkBlockValidationPolicy verifies that when the consenters of a channel change, the
+// BlockValidation policy of the orderer group changes to require the signatures of a quorum
+// of the new consenters, as blocks are otherwise validated against the former consenters.
+func checkBlockValidationPolicy(ordererGroup *common.ConfigGroup, current, next []*bft.Consenter) error {
+	if sameConsenterIdentities(current, next) {
+		return nil
+	}
+	policy, exists := ordererGroup.Policies[channelconfig.BlockValidationPolicyKey]
+	if !exists || policy.Policy == nil || policy.Policy.Type != int32(common.Policy_SIGNATURE) {
+		return errors.Errorf("the consenters of the channel change, but the %s policy of the orderer is not a signature policy", channelconfig.BlockValidationPolicyKey)
+	}
+	envelope := &common.SignaturePolicyEnvelope{}
+	if err := proto.Unmarshal(policy.Policy.Value, envelope); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the %s policy of the orderer", channelconfig.BlockValidationPolicyKey)
+	}
+	expectedEnvelope := &common.SignaturePolicyEnvelope{}
+	if err := proto.Unmarshal(channelconfig.BFTBlockValidationPolicy(next).Policy.Value, expectedEnvelope); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal the expected %s policy", channelconfig.BlockValidationPolicyKey)
+	}
+	if !proto.Equal(envelope, expectedEnvelope) {
+		return errors.Errorf("the consenters of the channel change, but the %s policy of the orderer does not require the signatures of a quorum of the new consenters", channelconfig.BlockValidationPolicyKey)
+	}
+	return nil
+}
+
+// sameConsenterIdentities returns whether the given consenter sets consist of the same identities.
+// A consenter set with duplicate identities is never the same as another one.
+func sameConsenterIdentities(a, b []*bft.Consenter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	identities := make(map[string]bool, len(a))
+	for _, consenter := range a {
+		identities[consenterIdentityKey(consenter)] = false
+	}
+	for _, consenter := range b {
+		seen, exists := identities[consenterIdentityKey(consenter)]
+		if !exists || seen {
+			return false
+		}
+		identities[consenterIdentityKey(consenter)] = true
+	}
+	return true
+}
+
+func consenterIdentityKey(consenter *bft.Consenter) string {
+	return consenter.MspId + "\x00" + string(consenter.Identity)
+}
+
+func validateCert(pemData []byte, certRole string) error {
+	bl, _ := pem.Decode(pemData)
+
+	if bl == nil {
+		return errors.Errorf("%s certificate is not PEM encoded: %s", certRole, string(pemData))
+	}
+
+	if _, err := x509.ParseCertificate(bl.Bytes); err != nil {
+		return errors.Errorf("%s certificate has invalid ASN1 structure, %v: %s", certRole, err, string(pemData))
+	}
+	return nil
+}
+
+func pemToDER(pemBytes []byte, id uint64, certType string, logger *flogging.FabricLogger) ([]byte, error) {
+	bl, _ := pem.Decode(pemBytes)
+	if bl == nil {
+		logger.Errorf("Rejecting PEM block of %s TLS cert for node %d, offending PEM is: %s", certType, id, string(pemBytes))
+		return nil, errors.Errorf("invalid PEM block")
+	}
+	return bl.Bytes, nil
+}
+
+// newBlockPuller creates a new block puller
+func newBlockPuller(support consensus.ConsenterSupport,
+	baseDialer *cluster.PredicateDialer,
+	clusterConfig localconfig.Cluster) (BlockPuller, error) {
+
+	verifyBlockSequence := func(blocks []*common.Block, _ string) error {
+		return cluster.VerifyBlocks(blocks, support)
+	}
+
+	stdDialer := &cluster.StandardDialer{
+		ClientConfig: baseDialer.ClientConfig.Clone(),
+	}
+	stdDialer.ClientConfig.AsyncConnect = false
+	stdDialer.ClientConfig.SecOpts.VerifyCertificate = nil
+
+	// Extract the TLS CA certs and endpoints from the configuration,
+	endpoints, err := etcdraft.EndpointconfigFromFromSupport(support)
+	if err != nil {
+		return nil, err
+	}
+
+	der, _ := pem.Decode(stdDialer.ClientConfig.SecOpts.Certificate)
+	if der == nil {
+		return nil, errors.Errorf("client certificate isn't in PEM format: %v",
+			string(stdDialer.ClientConfig.SecOpts.Certificate))
+	}
+
+	return &cluster.BlockPuller{
+		VerifyBlockSequence: verifyBlockSequence,
+		Logger:              flogging.MustGetLogger("orderer.common.cluster.puller"),
+		RetryTimeout:        clusterConfig.ReplicationRetryTimeout,
+		MaxTotalBufferBytes: clusterConfig.ReplicationBufferSize,
+		MaxPullBlockRetries: maxPullBlockRetries,
+		FetchTimeout:        clusterConfig.ReplicationPullTimeout,
+		Endpoints:           endpoints,
+		Signer:              support,
+		TLSCert:             der.Bytes,
+		Channel:             support.ChainID(),
+		Dialer:              stdDialer,
+	}, nil
+}
+
+// SignatureVerifier verifies signatures of consenters over blocks
+type SignatureVerifier interface {
+	// VerifySignature verifies that the given signed data was signed by the given consenter
+	VerifySignature(consenter *bft.Consenter, signedData *common.SignedData) error
+}
+
+// MSPManagerProvider provides the MSP manager of a channel
+type MSPManagerProvider interface {
+	// MSPManager returns the MSP manager of the current config of the channel
+	MSPManager() msp.MSPManager
+}
+
+// MSPSignatureVerifier verifies signatures of consenters using the MSPs of the channel
+type MSPSignatureVerifier struct {
+	MSPs MSPManagerProvider
+}
+
+// VerifySignature verifies that the given signed data was signed by the given consenter
+func (v *MSPSignatureVerifier) VerifySignature(consenter *bft.Consenter, signedData *common.SignedData) error {
+	if !isConsenterIdentity(consenter, signedData.Identity) {
+		return errors.Errorf("signature is not of consenter %d", consenter.Id)
+	}
+	identity, err := v.MSPs.MSPManager().DeserializeIdentity(signedData.Identity)
+	if err != nil {
+		return errors.Wrapf(err, "failed deserializing identity of consenter %d", consenter.Id)
+	}
+	if err := identity.Verify(signedData.Data, signedData.Signature); err != nil {
+		return errors.Wrapf(err, "invalid signature of consenter %d", consenter.Id)
+	}
+	return nil
+}
+
+// isConsenterIdentity returns whether the given serialized identity
+// is the identity of the given consenter.
+func isConsenterIdentity(consenter *bft.Consenter, serializedIdentity []byte) bool {
+	sID := &pmsp.SerializedIdentity{}
+	if err := proto.Unmarshal(serializedIdentity, sID); err != nil {
+		return false
+	}
+	if sID.Mspid != consenter.MspId {
+		return false
+	}
+	actual, _ := pem.Decode(sID.IdBytes)
+	expected, _ := pem.Decode(consenter.Identity)
+	if actual == nil || expected == nil {
+		return false
+	}
+	return bytes.Equal(actual.Bytes, expected.Bytes)
+}
+
+// proposalDigest computes the digest consenters agree on for a proposed block,
+// which covers the header and the orderer metadata of the block.
+func proposalDigest(block *common.Block) []byte {
+	h := sha256.New()
+	h.Write(block.Header.Bytes())
+	h.Write(block.Metadata.Metadata[common.BlockMetadataIndex_ORDERER])
+	return h.Sum(nil)
+}
+
+// requestID returns the identifier of a request. Config transactions are
+// identified by their config update, as they may be reproduced by the leader.
+func requestID(env *common.Envelope) (string, error) {
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return "", err
+	}
+	switch common.HeaderType(chdr.Type) {
+	case common.HeaderType_CONFIG, common.HeaderType_ORDERER_TRANSACTION:
+		configEnv, err := configEnvelope(env)
+		if err != nil {
+			return "", err
+		}
+		if configEnv.LastUpdate == nil {
+			return "", errors.New("config envelope is missing the config update")
+		}
+		return digestString(utils.MarshalOrPanic(configEnv.LastUpdate)), nil
+	default:
+		return digestString(utils.MarshalOrPanic(env)), nil
+	}
+}
+
+func digestString(data []byte) string {
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:])
+}
+
+// configEnvelope extracts the ConfigEnvelope of a CONFIG transaction,
+// or of the CONFIG transaction wrapped in an ORDERER_TRANSACTION.
+func configEnvelope(env *common.Envelope) (*common.ConfigEnvelope, error) {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Header == nil {
+		return nil, errors.New("envelope is missing a header")
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+	switch common.HeaderType(chdr.Type) {
+	case common.HeaderType_CONFIG:
+		return configtx.UnmarshalConfigEnvelope(payload.Data)
+	case common.HeaderType_ORDERER_TRANSACTION:
+		inner, err := utils.UnmarshalEnvelope(payload.Data)
+		if err != nil {
+			return nil, err
+		}
+		return configEnvelope(inner)
+	default:
+		return nil, errors.Errorf("envelope is of type %s and not a config transaction", common.HeaderType(chdr.Type))
+	}
+}
+
+// isConfigBlock returns whether the block is a config block of its channel,
+// that is, whether the block should be referenced as the last config block.
+func isConfigBlock(block *common.Block) bool {
+	if len(block.Data.Data) != 1 {
+		return false
+	}
+	env, err := utils.UnmarshalEnvelope(block.Data.Data[0])
+	if err != nil {
+		return false
+	}
+	chdr, err := utils.ChannelHeader(env)
+	if err != nil {
+		return false
+	}
+	return common.HeaderType(chdr.Type) == common.HeaderType_CONFIG
+}
+
+// signedValue returns the value of the signatures metadata of a block,
+// over which the consenters sign.
+func signedValue(block *common.Block, lastConfig uint64) []byte {
+	return utils.MarshalOrPanic(&common.OrdererBlockMetadata{
+		LastConfig:        &common.LastConfig{Index: lastConfig},
+		ConsenterMetadata: block.Metadata.Metadata[common.BlockMetadataIndex_ORDERER],
+	})
+}
+
+// signedData returns the data signed by a consenter over a block.
+func signedData(value []byte, signatureHeader []byte, block *common.Block) []byte {
+	return util.ConcatenateBytes(value, signatureHeader, block.Header.Bytes())
+}
+
+// prepareSignedData returns the data signed by a consenter over its prepare.
+func prepareSignedData(channelID string, p *bft.Prepare, signatureHeader []byte) []byte {
+	vote := utils.MarshalOrPanic(&bft.Prepare{View: p.View, Seq: p.Seq, Digest: p.Digest})
+	return util.ConcatenateBytes([]byte(channelID), vote, signatureHeader)
+}
@@ -0,0 +1,240 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadCert(t *testing.T, name string) []byte {
+	cert, err := ioutil.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestCheckConfigMetadata(t *testing.T) {
+	validMetadata := func() *bft.ConfigMetadata {
+		return &bft.ConfigMetadata{
+			Consenters: []*bft.Consenter{
+				{
+					Id:            1,
+					Host:          "orderer1",
+					Port:          7050,
+					MspId:         "OrdererMSP",
+					Identity:      loadCert(t, "tls-client-1.pem"),
+					ClientTlsCert: loadCert(t, "tls-client-1.pem"),
+					ServerTlsCert: loadCert(t, "tls-server-1.pem"),
+				},
+				{
+					Id:            2,
+					Host:          "orderer2",
+					Port:          7050,
+					MspId:         "OrdererMSP",
+					Identity:      loadCert(t, "tls-client-2.pem"),
+					ClientTlsCert: loadCert(t, "tls-client-2.pem"),
+					ServerTlsCert: loadCert(t, "tls-server-2.pem"),
+				},
+			},
+			Options: &bft.Options{
+				RequestTimeout:    "10s",
+				ViewChangeTimeout: "20s",
+			},
+		}
+	}
+
+	assert.NoError(t, CheckConfigMetadata(validMetadata()))
+
+	for _, testCase := range []struct {
+		name          string
+		mutate        func(m *bft.ConfigMetadata)
+		expectedError string
+	}{
+		{
+			name:          "no options",
+			mutate:        func(m *bft.ConfigMetadata) { m.Options = nil },
+			expectedError: "nil BFT config metadata options",
+		},
+		{
+			name:          "bad request timeout",
+			mutate:        func(m *bft.ConfigMetadata) { m.Options.RequestTimeout = "ten seconds" },
+			expectedError: "failed to parse RequestTimeout (ten seconds) to time duration: time: invalid duration \"ten seconds\"",
+		},
+		{
+			name:          "zero view change timeout",
+			mutate:        func(m *bft.ConfigMetadata) { m.Options.ViewChangeTimeout = "0s" },
+			expectedError: "ViewChangeTimeout must be positive",
+		},
+		{
+			name:          "no consenters",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters = nil },
+			expectedError: "empty consenter set",
+		},
+		{
+			name:          "no id",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters[1].Id = 0 },
+			expectedError: "consenter orderer2:7050 has no id",
+		},
+		{
+			name:          "duplicate id",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters[1].Id = 1 },
+			expectedError: "duplicate consenter id 1",
+		},
+		{
+			name:          "duplicate identity",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters[1].Identity = m.Consenters[0].Identity },
+			expectedError: "consenter 2 has the same identity as another consenter",
+		},
+		{
+			name:          "no MSP ID",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters[0].MspId = "" },
+			expectedError: "consenter 1 has no MSP ID",
+		},
+		{
+			name:          "bad identity",
+			mutate:        func(m *bft.ConfigMetadata) { m.Consenters[0].Identity = []byte("identity") },
+			expectedError: "consenter 1: signing certificate is not PEM encoded: identity",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := validMetadata()
+			testCase.mutate(m)
+			assert.EqualError(t, CheckConfigMetadata(m), testCase.expectedError)
+		})
+	}
+}
+
+func TestCheckBlockValidationPolicy(t *testing.T) {
+	var consenters []*bft.Consenter
+	for i := 1; i <= 3; i++ {
+		consenters = append(consenters, &bft.Consenter{
+			Id:       uint64(i),
+			MspId:    "OrdererMSP",
+			Identity: loadCert(t, fmt.Sprintf("tls-client-%d.pem", i)),
+		})
+	}
+	ordererGroupWithPolicyOf := func(consenters []*bft.Consenter) *common.ConfigGroup {
+		return &common.ConfigGroup{
+			Policies: map[string]*common.ConfigPolicy{
+				channelconfig.BlockValidationPolicyKey: channelconfig.BFTBlockValidationPolicy(consenters),
+			},
+		}
+	}
+
+	// The policy is not checked when the consenters do not change
+	assert.NoError(t, checkBlockValidationPolicy(&common.ConfigGroup{}, consenters, consenters))
+	reordered := []*bft.Consenter{consenters[2], consenters[0], consenters[1]}
+	assert.NoError(t, checkBlockValidationPolicy(&common.ConfigGroup{}, consenters, reordered))
+
+	// Removing a consenter requires the policy to require a quorum of the remaining consenters
+	remaining := consenters[:2]
+	assert.NoError(t, checkBlockValidationPolicy(ordererGroupWithPolicyOf(remaining), consenters, remaining))
+	err := checkBlockValidationPolicy(ordererGroupWithPolicyOf(consenters), consenters, remaining)
+	assert.EqualError(t, err, "the consenters of the channel change, but the BlockValidation policy of the orderer does not require the signatures of a quorum of the new consenters")
+	err = checkBlockValidationPolicy(&common.ConfigGroup{}, consenters, remaining)
+	assert.EqualError(t, err, "the consenters of the channel change, but the BlockValidation policy of the orderer is not a signature policy")
+
+	// Replacing a consenter does too
+	replaced := []*bft.Consenter{consenters[0], consenters[1], {Id: 4, MspId: "OrdererMSP", Identity: loadCert(t, "tls-server-1.pem")}}
+	assert.NoError(t, checkBlockValidationPolicy(ordererGroupWithPolicyOf(replaced), consenters, replaced))
+	err = checkBlockValidationPolicy(ordererGroupWithPolicyOf(consenters), consenters, replaced)
+	assert.Error(t, err)
+
+	// Replacing a consenter with a duplicate of another one does too
+	duplicated := []*bft.Consenter{consenters[0], consenters[1], {Id: 4, MspId: "OrdererMSP", Identity: consenters[0].Identity}}
+	err = checkBlockValidationPolicy(ordererGroupWithPolicyOf(consenters), consenters, duplicated)
+	assert.Error(t, err)
+}
+
+func TestIsConsenterIdentity(t *testing.T) {
+	cert := loadCert(t, "tls-client-1.pem")
+	consenter := &bft.Consenter{Id: 1, MspId: "OrdererMSP", Identity: cert}
+
+	// The PEM encoding of the identity may differ from the one in the config
+	identity := utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "OrdererMSP", IdBytes: append([]byte("\n"), cert...)})
+	assert.True(t, isConsenterIdentity(consenter, identity))
+
+	identity = utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "OtherMSP", IdBytes: cert})
+	assert.False(t, isConsenterIdentity(consenter, identity))
+
+	identity = utils.MarshalOrPanic(&msp.SerializedIdentity{Mspid: "OrdererMSP", IdBytes: loadCert(t, "tls-client-2.pem")})
+	assert.False(t, isConsenterIdentity(consenter, identity))
+
+	assert.False(t, isConsenterIdentity(consenter, []byte("garbage")))
+}
+
+func configTx(channel string, headerType common.HeaderType, data proto.Message) *common.Envelope {
+	return &common.Envelope{
+		Payload: utils.MarshalOrPanic(&common.Payload{
+			Header: &common.Header{
+				ChannelHeader: utils.MarshalOrPanic(&common.ChannelHeader{
+					Type:      int32(headerType),
+					ChannelId: channel,
+				}),
+			},
+			Data: utils.MarshalOrPanic(data),
+		}),
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	update := &common.Envelope{Payload: []byte("config update")}
+	config := configTx("mychannel", common.HeaderType_CONFIG, &common.ConfigEnvelope{
+		Config:     &common.Config{Sequence: 1},
+		LastUpdate: update,
+	})
+	// A config transaction reproduced from the same config update has the same identifier
+	reproduced := configTx("mychannel", common.HeaderType_CONFIG, &common.ConfigEnvelope{
+		Config:     &common.Config{Sequence: 1},
+		LastUpdate: update,
+	})
+	reproduced.Signature = []byte("another signature")
+	ordererTx := configTx("system", common.HeaderType_ORDERER_TRANSACTION, config)
+
+	id1, err := requestID(config)
+	require.NoError(t, err)
+	id2, err := requestID(reproduced)
+	require.NoError(t, err)
+	id3, err := requestID(ordererTx)
+	require.NoError(t, err)
+	assert.Equal(t, id1, id2)
+	assert.Equal(t, id1, id3)
+
+	normal := configTx("mychannel", common.HeaderType_ENDORSER_TRANSACTION, update)
+	id4, err := requestID(normal)
+	require.NoError(t, err)
+	assert.NotEqual(t, id1, id4)
+
+	_, err = requestID(configTx("mychannel", common.HeaderType_CONFIG, &common.ConfigEnvelope{}))
+	assert.EqualError(t, err, "config envelope is missing the config update")
+	_, err = requestID(&common.Envelope{Payload: []byte{1, 2, 3}})
+	assert.Error(t, err)
+}
+
+func TestIsConfigBlock(t *testing.T) {
+	config := configTx("mychannel", common.HeaderType_CONFIG, &common.ConfigEnvelope{})
+	ordererTx := configTx("system", common.HeaderType_ORDERER_TRANSACTION, config)
+	normal := configTx("mychannel", common.HeaderType_ENDORSER_TRANSACTION, &common.Envelope{})
+
+	block := common.NewBlock(1, nil)
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(config)}
+	assert.True(t, isConfigBlock(block))
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(ordererTx)}
+	assert.False(t, isConfigBlock(block))
+	block.Data.Data = [][]byte{utils.MarshalOrPanic(normal)}
+	assert.False(t, isConfigBlock(block))
+}
@@ -101,6 +101,10 @@ type ConsenterSupport interface {
 	// WriteConfigBlock commits a block to the ledger, and applies the config update inside.
 	WriteConfigBlock(block *cb.Block, encodedMetadataValue []byte)
 
+	// WriteSignedBlock commits a block which already carries its orderer metadata and
+	// the signatures of the consenters, and applies the config update inside, if any.
+	WriteSignedBlock(block *cb.Block)
+
 	// Sequence returns the current config squence.
 	Sequence() uint64
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/pem"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/common/configtx"
 	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/common/ledger/blockledger"
 	"github.com/hyperledger/fabric/orderer/common/cluster"
 	"github.com/hyperledger/fabric/orderer/consensus"
 	"github.com/hyperledger/fabric/protos/common"
@@ -57,6 +59,16 @@ const (
 	// DefaultLeaderlessCheckInterval is the interval that a chain checks
 	// its own leadership status.
 	DefaultLeaderlessCheckInterval = time.Second * 10
+
+	// DefaultLearnerPromotionThreshold is the default number of entries
+	// a learner may lag behind the commit index of the leader to be
+	// promoted to voter. It is used if LearnerPromotionThreshold is not
+	// provided in channel config options.
+	DefaultLearnerPromotionThreshold = uint64(10)
+
+	// pruningHoldBackWarningThreshold is the period after which a chain
+	// warns that it keeps holding back the pruning of its ledger.
+	pruningHoldBackWarningThreshold = time.Minute * 10
 )
 
 //go:generate counterfeiter -o mocks/configurator.go . Configurator
@@ -111,6 +123,12 @@ type Options struct {
 	MaxSizePerMsg     uint64
 	MaxInflightBlocks int
 
+	// Learners makes consenters added to the channel join as Raft learners,
+	// which are promoted to voters once they lag behind the leader by no
+	// more than LearnerPromotionThreshold entries.
+	Learners                  bool
+	LearnerPromotionThreshold uint64
+
 	// BlockMetdata and Consenters should only be modified while under lock
 	// of raftMetadataLock
 	BlockMetadata *etcdraft.BlockMetadata
@@ -156,6 +174,7 @@ type Chain struct {
 	startC   chan struct{}         // Closes when the node is started
 	snapC    chan *raftpb.Snapshot // Signal to catch up with snapshot
 	gcC      chan *gc              // Signal to take snapshot
+	promoteC chan uint64           // Signals a learner that caught up with the leader
 
 	errorCLock sync.RWMutex
 	errorC     chan struct{} // returned by Errored()
@@ -179,8 +198,18 @@ type Chain struct {
 	lastSnapBlockNum uint64
 	confState        raftpb.ConfState // Etcdraft requires ConfState to be persisted within snapshot
 
+	// promotionThreshold is LearnerPromotionThreshold, or its default value.
+	// It is accessed atomically since it is read by the raft node.
+	promotionThreshold uint64
+
 	createPuller CreateBlockPuller // func used to create BlockPuller on demand
 
+	// pruner is nil unless the ledger of the channel prunes blocks
+	pruner blockledger.Pruner
+	// pruningHeldBackSince is when pruning was first held back since the
+	// blocks were last pruned, and is only accessed by the gc goroutine
+	pruningHeldBackSince time.Time
+
 	fresh bool // indicate if this is a fresh raft node
 
 	// this is exported so that test can use `Node.Status()` to get raft node status.
@@ -224,6 +253,11 @@ func NewChain(
 		sizeLimit = DefaultSnapshotIntervalSize
 	}
 
+	promotionThreshold := opts.LearnerPromotionThreshold
+	if promotionThreshold == 0 {
+		promotionThreshold = DefaultLearnerPromotionThreshold
+	}
+
 	// get block number in last snapshot, if exists
 	var snapBlkNum uint64
 	var cc raftpb.ConfState
@@ -239,29 +273,31 @@ func NewChain(
 	}
 
 	c := &Chain{
-		configurator:     conf,
-		rpc:              rpc,
-		channelID:        support.ChainID(),
-		raftID:           opts.RaftID,
-		submitC:          make(chan *submit),
-		applyC:           make(chan apply),
-		haltC:            make(chan struct{}),
-		doneC:            make(chan struct{}),
-		startC:           make(chan struct{}),
-		snapC:            make(chan *raftpb.Snapshot),
-		errorC:           make(chan struct{}),
-		gcC:              make(chan *gc),
-		observeC:         observeC,
-		support:          support,
-		fresh:            fresh,
-		appliedIndex:     opts.BlockMetadata.RaftIndex,
-		lastBlock:        b,
-		sizeLimit:        sizeLimit,
-		lastSnapBlockNum: snapBlkNum,
-		confState:        cc,
-		createPuller:     f,
-		clock:            opts.Clock,
-		haltCallback:     haltCallback,
+		configurator:       conf,
+		rpc:                rpc,
+		channelID:          support.ChainID(),
+		raftID:             opts.RaftID,
+		submitC:            make(chan *submit),
+		applyC:             make(chan apply),
+		haltC:              make(chan struct{}),
+		doneC:              make(chan struct{}),
+		startC:             make(chan struct{}),
+		snapC:              make(chan *raftpb.Snapshot),
+		errorC:             make(chan struct{}),
+		gcC:                make(chan *gc),
+		promoteC:           make(chan uint64, 1),
+		observeC:           observeC,
+		support:            support,
+		fresh:              fresh,
+		appliedIndex:       opts.BlockMetadata.RaftIndex,
+		lastBlock:          b,
+		sizeLimit:          sizeLimit,
+		lastSnapBlockNum:   snapBlkNum,
+		confState:          cc,
+		promotionThreshold: promotionThreshold,
+		createPuller:       f,
+		clock:              opts.Clock,
+		haltCallback:       haltCallback,
 		Metrics: &Metrics{
 			ClusterSize:             opts.Metrics.ClusterSize.With("channel", support.ChainID()),
 			IsLeader:                opts.Metrics.IsLeader.With("channel", support.ChainID()),
@@ -272,16 +308,25 @@ func NewChain(
 			DataPersistDuration:     opts.Metrics.DataPersistDuration.With("channel", support.ChainID()),
 			NormalProposalsReceived: opts.Metrics.NormalProposalsReceived.With("channel", support.ChainID()),
 			ConfigProposalsReceived: opts.Metrics.ConfigProposalsReceived.With("channel", support.ChainID()),
+			Learners:                opts.Metrics.Learners.With("channel", support.ChainID()),
+			IsLearner:               opts.Metrics.IsLearner.With("channel", support.ChainID()),
 		},
 		logger: lg,
 		opts:   opts,
 	}
 
+	if pruner, ok := support.(blockledger.Pruner); ok && pruner.Prunes() {
+		// Blocks are not pruned until every consenter is known to have them
+		pruner.RetainFrom(0)
+		c.pruner = pruner
+	}
+
 	// Sets initial values for metrics
 	c.Metrics.ClusterSize.Set(float64(len(c.opts.BlockMetadata.ConsenterIds)))
 	c.Metrics.IsLeader.Set(float64(0)) // all nodes start out as followers
 	c.Metrics.CommittedBlockNumber.Set(float64(c.lastBlock.Header.Number))
 	c.Metrics.SnapshotBlockNumber.Set(float64(c.lastSnapBlockNum))
+	c.reportLearners()
 
 	// DO NOT use Applied option in config, see https://github.com/etcd-io/etcd/issues/10217
 	// We guard against replay of written blocks with `appliedIndex` instead.
@@ -795,6 +840,25 @@ func (c *Chain) serveRequest() {
 			c.logger.Debugf("Batch timer expired, creating block")
 			c.propose(propC, bc, batch) // we are certain this is normal block, no need to block
 
+		case id := <-c.promoteC:
+			if soft.Lead != c.raftID || c.justElected || c.configInflight || !NodeExists(id, c.confState.Learners) {
+				// Promotion is reconsidered with the next report of the raft node
+				break
+			}
+
+			cc := &raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: id}
+			// The reason `ProposeConfChange` should be called in go routine is documented in `writeConfigBlock` method.
+			go func() {
+				if err := c.Node.ProposeConfChange(context.TODO(), *cc); err != nil {
+					c.logger.Warnf("Failed to propose configuration update to Raft node: %s", err)
+				}
+			}()
+
+			c.logger.Infof("Learner %d caught up with the leader, pause accepting transactions till it is promoted to voter", id)
+			c.confChangeInProgress = cc
+			c.configInflight = true
+			submitC = nil
+
 		case sn := <-c.snapC:
 			if sn.Metadata.Index != 0 {
 				if sn.Metadata.Index <= c.appliedIndex {
@@ -802,7 +866,7 @@ func (c *Chain) serveRequest() {
 					break
 				}
 
-				c.confState = sn.Metadata.ConfState
+				c.setConfState(sn.Metadata.ConfState)
 				c.appliedIndex = sn.Metadata.Index
 			} else {
 				c.logger.Infof("Received artificial snapshot to trigger catchup")
@@ -996,11 +1060,28 @@ func (c *Chain) detectConfChange(block *common.Block) *MembershipChanges {
 		c.sizeLimit = configMetadata.Options.SnapshotIntervalSize
 	}
 
+	if configMetadata.Options != nil {
+		if configMetadata.Options.Learners != c.opts.Learners {
+			c.logger.Infof("Update adding consenters as learners to %t", configMetadata.Options.Learners)
+			c.opts.Learners = configMetadata.Options.Learners
+		}
+
+		threshold := uint64(configMetadata.Options.LearnerPromotionThreshold)
+		if threshold == 0 {
+			threshold = DefaultLearnerPromotionThreshold
+		}
+		atomic.StoreUint64(&c.promotionThreshold, threshold)
+	}
+
 	changes, err := ComputeMembershipChanges(c.opts.BlockMetadata, c.opts.Consenters, configMetadata.Consenters)
 	if err != nil {
 		c.logger.Panicf("illegal configuration change detected: %s", err)
 	}
 
+	if c.opts.Learners && changes.ConfChange != nil && changes.ConfChange.Type == raftpb.ConfChangeAddNode {
+		changes.ConfChange.Type = raftpb.ConfChangeAddLearnerNode
+	}
+
 	if changes.Rotated() {
 		c.logger.Infof("Config block [%d] rotates TLS certificate of node %d", block.Header.Number, changes.RotatedNode)
 	}
@@ -1046,11 +1127,18 @@ func (c *Chain) apply(ents []raftpb.Entry) {
 				continue
 			}
 
-			c.confState = *c.Node.ApplyConfChange(cc)
+			wasLearner := NodeExists(cc.NodeID, c.confState.Learners)
+			c.setConfState(*c.Node.ApplyConfChange(cc))
 
 			switch cc.Type {
 			case raftpb.ConfChangeAddNode:
-				c.logger.Infof("Applied config change to add node %d, current nodes in channel: %+v", cc.NodeID, c.confState.Nodes)
+				if wasLearner {
+					c.logger.Infof("Applied config change to promote learner %d, current nodes in channel: %+v", cc.NodeID, c.confState.Nodes)
+				} else {
+					c.logger.Infof("Applied config change to add node %d, current nodes in channel: %+v", cc.NodeID, c.confState.Nodes)
+				}
+			case raftpb.ConfChangeAddLearnerNode:
+				c.logger.Infof("Applied config change to add learner %d, current learners in channel: %+v", cc.NodeID, c.confState.Learners)
 			case raftpb.ConfChangeRemoveNode:
 				c.logger.Infof("Applied config change to remove node %d, current nodes in channel: %+v", cc.NodeID, c.confState.Nodes)
 			default:
@@ -1124,6 +1212,9 @@ func (c *Chain) gc() {
 		select {
 		case g := <-c.gcC:
 			c.Node.takeSnapshot(g.index, g.state, g.data)
+			if c.pruner != nil {
+				c.retainUnreplicatedBlocks()
+			}
 		case <-c.doneC:
 			c.logger.Infof("Stop garbage collecting")
 			return
@@ -1131,6 +1222,59 @@ func (c *Chain) gc() {
 	}
 }
 
+// retainUnreplicatedBlocks prevents the ledger from pruning the blocks which some
+// consenter of the channel has yet to replicate, so that a follower which lags behind
+// the snapshot of the leader can always pull the blocks it misses from the cluster.
+// Pruning is held back as long as the height of any consenter is unknown.
+func (c *Chain) retainUnreplicatedBlocks() {
+	if c.pruningHeldBackSince.IsZero() {
+		c.pruningHeldBackSince = c.clock.Now()
+	}
+
+	puller, err := c.createPuller()
+	if err != nil {
+		c.logger.Warningf("Failed creating block puller, not pruning blocks: %s", err)
+		return
+	}
+	defer puller.Close()
+
+	heights, err := puller.HeightsByEndpoints()
+	if err != nil {
+		c.logger.Warningf("Failed obtaining the heights of the consenters, not pruning blocks: %s", err)
+		return
+	}
+
+	c.raftMetadataLock.RLock()
+	consenterCount := len(c.opts.Consenters)
+	c.raftMetadataLock.RUnlock()
+	if len(heights) < consenterCount {
+		heldBack := c.clock.Since(c.pruningHeldBackSince)
+		if heldBack < pruningHoldBackWarningThreshold {
+			c.logger.Infof("Obtained the heights of %d out of %d consenters, not pruning blocks", len(heights), consenterCount)
+			return
+		}
+		endpoints := make([]string, 0, len(heights))
+		for endpoint := range heights {
+			endpoints = append(endpoints, endpoint)
+		}
+		sort.Strings(endpoints)
+		c.logger.Warningf("Pruning of blocks has been held back for %s: obtained the heights of %d out of %d consenters "+
+			"from the orderer endpoints %v; make sure the orderer endpoints of the channel reach every consenter",
+			heldBack, len(heights), consenterCount, endpoints)
+		return
+	}
+
+	lowestHeight := c.support.Height()
+	for _, height := range heights {
+		if height < lowestHeight {
+			lowestHeight = height
+		}
+	}
+	c.logger.Debugf("Retaining blocks starting from block [%d], the lowest height of the consenters", lowestHeight)
+	c.pruner.RetainFrom(lowestHeight)
+	c.pruningHeldBackSince = time.Time{}
+}
+
 func (c *Chain) isConfig(env *common.Envelope) bool {
 	h, err := utils.ChannelHeader(env)
 	if err != nil {
@@ -1241,6 +1385,8 @@ func (c *Chain) writeConfigBlock(block *common.Block, index uint64) {
 			switch configMembership.ConfChange.Type {
 			case raftpb.ConfChangeAddNode:
 				c.logger.Infof("Config block just committed adds node %d, pause accepting transactions till config change is applied", configMembership.ConfChange.NodeID)
+			case raftpb.ConfChangeAddLearnerNode:
+				c.logger.Infof("Config block just committed adds learner %d, pause accepting transactions till config change is applied", configMembership.ConfChange.NodeID)
 			case raftpb.ConfChangeRemoveNode:
 				c.logger.Infof("Config block just committed removes node %d, pause accepting transactions till config change is applied", configMembership.ConfChange.NodeID)
 			default:
@@ -1298,16 +1444,17 @@ func (c *Chain) getInFlightConfChange() *raftpb.ConfChange {
 	// extracting current Raft configuration state
 	confState := c.Node.ApplyConfChange(raftpb.ConfChange{})
 
-	if len(confState.Nodes) == len(c.opts.BlockMetadata.ConsenterIds) {
+	if len(confState.Nodes)+len(confState.Learners) == len(c.opts.BlockMetadata.ConsenterIds) {
 		// Raft configuration change could only add one node or
 		// remove one node at a time, if raft conf state size is
 		// equal to membership stored in block metadata field,
 		// that means everything is in sync and no need to propose
-		// config update.
+		// config update. Learners that are yet to be promoted are
+		// picked up by the raft node once they caught up.
 		return nil
 	}
 
-	return ConfChange(c.opts.BlockMetadata, confState)
+	return ConfChange(c.opts.BlockMetadata, confState, c.opts.Learners)
 }
 
 // newMetadata extract config metadata from the configuration block
@@ -1319,6 +1466,145 @@ func (c *Chain) newConfigMetadata(block *common.Block) *etcdraft.ConfigMetadata
 	return metadata
 }
 
+// setConfState records the Raft configuration state and reports its learners.
+func (c *Chain) setConfState(confState raftpb.ConfState) {
+	c.raftMetadataLock.Lock()
+	c.confState = confState
+	c.raftMetadataLock.Unlock()
+
+	c.reportLearners()
+}
+
+func (c *Chain) reportLearners() {
+	c.Metrics.Learners.Set(float64(len(c.confState.Learners)))
+	if NodeExists(c.raftID, c.confState.Learners) {
+		c.Metrics.IsLearner.Set(1)
+	} else {
+		c.Metrics.IsLearner.Set(0)
+	}
+}
+
+// ClusterStatus describes the Raft membership of a channel, as seen by a node.
+type ClusterStatus struct {
+	// Leader is the ID of the leader, or zero if there is none
+	Leader uint64
+	// Voters are the IDs of the nodes which take part in elections and commits
+	Voters []uint64
+	// Learners are the IDs of the nodes which replicate the log without voting,
+	// until they are promoted to voters
+	Learners []uint64
+	// IsLearner tells whether this node is a learner
+	IsLearner bool
+}
+
+// ClusterStatus returns the Raft membership of the channel, as seen by this node.
+func (c *Chain) ClusterStatus() ClusterStatus {
+	c.raftMetadataLock.RLock()
+	defer c.raftMetadataLock.RUnlock()
+
+	return ClusterStatus{
+		Leader:    atomic.LoadUint64(&c.lastKnownLeader),
+		Voters:    append([]uint64(nil), c.confState.Nodes...),
+		Learners:  append([]uint64(nil), c.confState.Learners...),
+		IsLearner: NodeExists(c.raftID, c.confState.Learners),
+	}
+}
+
+// RaftStatus describes the Raft state of a channel, as seen by a node.
+type RaftStatus struct {
+	// ID is the ID of this node
+	ID uint64
+	// State is the role of this node: follower, pre-candidate, candidate or leader
+	State string
+	// Leader is the ID of the leader, or zero if there is none
+	Leader uint64
+	// Term is the current term
+	Term uint64
+	// CommitIndex is the index of the latest entry known to be committed
+	CommitIndex uint64
+	// AppliedIndex is the index of the latest entry applied by this node
+	AppliedIndex uint64
+	// Consenters describes the connections of this node with the other consenters,
+	// if the communication layer reports them
+	Consenters []cluster.RemoteNodeStatus
+}
+
+// RemoteNodesStatusReporter reports the status of the connections
+// with the remote nodes of a channel.
+type RemoteNodesStatusReporter interface {
+	RemoteNodesStatus(channel string) []cluster.RemoteNodeStatus
+}
+
+// RaftStatus returns the Raft state of the channel, as seen by this node,
+// or an error if the chain is not running.
+func (c *Chain) RaftStatus() (RaftStatus, error) {
+	if err := c.isRunning(); err != nil {
+		return RaftStatus{}, err
+	}
+
+	status := c.Node.Status()
+	raftStatus := RaftStatus{
+		ID:           c.raftID,
+		State:        raftStateNames[status.RaftState],
+		Leader:       status.Lead,
+		Term:         status.Term,
+		CommitIndex:  status.Commit,
+		AppliedIndex: status.Applied,
+	}
+	if reporter, ok := c.configurator.(RemoteNodesStatusReporter); ok {
+		raftStatus.Consenters = reporter.RemoteNodesStatus(c.channelID)
+	}
+	return raftStatus, nil
+}
+
+var raftStateNames = map[raft.StateType]string{
+	raft.StateFollower:     "follower",
+	raft.StatePreCandidate: "pre-candidate",
+	raft.StateCandidate:    "candidate",
+	raft.StateLeader:       "leader",
+}
+
+// ErrNotLeader is returned when an operation which only the leader
+// can carry out is attempted on another node.
+var ErrNotLeader = errors.New("this node is not the Raft leader of the channel")
+
+// TransferLeadership hands the leadership of the channel over to the consenter
+// listening on the given endpoint (host:port), or to any qualified follower if
+// the endpoint is empty, and returns the ID of the new leader. It returns
+// ErrNotLeader if this node is not the leader, and an error if no new leader
+// is elected within ElectionTimeout.
+func (c *Chain) TransferLeadership(endpoint string) (uint64, error) {
+	if err := c.isRunning(); err != nil {
+		return raft.None, err
+	}
+
+	lead := atomic.LoadUint64(&c.lastKnownLeader)
+	if lead != c.raftID {
+		return raft.None, ErrNotLeader
+	}
+
+	transferee := raft.None
+	if endpoint != "" {
+		c.raftMetadataLock.RLock()
+		for id, consenter := range c.opts.Consenters {
+			if fmt.Sprintf("%s:%d", consenter.Host, consenter.Port) == endpoint {
+				transferee = id
+			}
+		}
+		c.raftMetadataLock.RUnlock()
+
+		switch transferee {
+		case raft.None:
+			return raft.None, errors.Errorf("no consenter of the channel listens on %s", endpoint)
+		case c.raftID:
+			return c.raftID, nil
+		}
+	}
+
+	c.logger.Infof("Transferring leadership on request")
+	return c.Node.transferLeadership(lead, transferee)
+}
+
 func (c *Chain) suspectEviction() bool {
 	if c.isRunning() != nil {
 		return false
@@ -13,6 +13,7 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"go.etcd.io/etcd/raft"
 	"go.etcd.io/etcd/raft/raftpb"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 const (
@@ -276,6 +278,22 @@ var _ = Describe("Chain", func() {
 				Expect(fakeFields.fakeLeaderChanges.AddArgsForCall(0)).To(Equal(float64(1)))
 			})
 
+			It("reports the Raft status", func() {
+				status, err := chain.RaftStatus()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.ID).To(Equal(uint64(1)))
+				Expect(status.State).To(Equal("leader"))
+				Expect(status.Leader).To(Equal(uint64(1)))
+				Expect(status.Term).To(BeNumerically(">", 0))
+				Expect(status.CommitIndex).To(BeNumerically(">=", status.AppliedIndex))
+				// The mock configurator does not report the status of the connections
+				Expect(status.Consenters).To(BeNil())
+
+				chain.Halt()
+				_, err = chain.RaftStatus()
+				Expect(err).To(MatchError("chain is stopped"))
+			})
+
 			It("fails to order envelope if chain is halted", func() {
 				chain.Halt()
 				err := chain.Order(env, 0)
@@ -933,6 +951,47 @@ var _ = Describe("Chain", func() {
 							Expect(fakeFields.fakeSnapshotBlockNumber.SetArgsForCall(2)).To(Equal(float64(b.Header.Number)))
 						})
 
+						It("does not prune blocks before every consenter has them", func() {
+							chain.Halt()
+
+							pruner := &pruningSupport{FakeConsenterSupport: support}
+							puller := &mocks.FakeBlockPuller{}
+							puller.HeightsByEndpointsReturns(map[string]uint64{}, nil)
+							opts.MemoryStorage = raft.NewMemoryStorage()
+							heldBackWarnings := make(chan string, 10)
+							opts.Logger = opts.Logger.WithOptions(zap.Hooks(func(entry zapcore.Entry) error {
+								if entry.Level == zapcore.WarnLevel && strings.Contains(entry.Message, "Pruning of blocks has been held back") {
+									heldBackWarnings <- entry.Message
+								}
+								return nil
+							}))
+							c, err := etcdraft.NewChain(pruner, opts, configurator, nil, func() (etcdraft.BlockPuller, error) { return puller, nil }, nil, observeC)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(pruner.retainedFrom()).To(Equal([]uint64{0}))
+
+							c.Start()
+							defer c.Halt()
+							campaign(c, observeC)
+
+							By("holding back pruning while the height of a consenter is unknown")
+							probes := puller.HeightsByEndpointsCallCount()
+							Expect(c.Order(env, uint64(0))).To(Succeed())
+							Eventually(puller.HeightsByEndpointsCallCount, LongEventualTimeout).Should(BeNumerically(">", probes))
+							Consistently(pruner.retainedFrom).Should(Equal([]uint64{0}))
+							Expect(heldBackWarnings).NotTo(Receive())
+
+							By("warning once pruning has been held back for long")
+							clock.Increment(10 * time.Minute)
+							Expect(c.Order(env, uint64(0))).To(Succeed())
+							Eventually(heldBackWarnings, LongEventualTimeout).Should(Receive(ContainSubstring("obtained the heights of 0 out of 1 consenters")))
+
+							By("retaining the blocks from the lowest height of the consenters")
+							puller.HeightsByEndpointsReturns(map[string]uint64{"orderer": 2}, nil)
+							Expect(c.Order(env, uint64(0))).To(Succeed())
+							Eventually(pruner.retainedFrom, LongEventualTimeout).Should(ContainElement(uint64(2)))
+							Expect(pruner.retainedFrom()[0]).To(Equal(uint64(0)))
+						})
+
 						It("pauses chain if sync is in progress", func() {
 							// Scenario:
 							// after a snapshot is taken, reboot chain with raftIndex = 0
@@ -2169,6 +2228,78 @@ var _ = Describe("Chain", func() {
 					Expect(err.Error()).To(ContainSubstring(string(duplicatedMetadata.Consenters[1].ClientTlsCert)))
 				})
 
+				It("adding node to the cluster as learner and promoting it", func() {
+					metadata := &raftprotos.ConfigMetadata{Options: proto.Clone(options).(*raftprotos.Options)}
+					metadata.Options.Learners = true
+					for _, consenter := range consenters {
+						metadata.Consenters = append(metadata.Consenters, consenter)
+					}
+					metadata.Consenters = append(metadata.Consenters, &raftprotos.Consenter{
+						Host:          "localhost",
+						Port:          7050,
+						ServerTlsCert: serverTLSCert(tlsCA),
+						ClientTlsCert: clientTLSCert(tlsCA),
+					})
+					configEnv := newConfigEnv(channelID, common.HeaderType_CONFIG, newConfigUpdateEnv(channelID, nil, updateRaftConfigValue(metadata)))
+					c1.cutter.CutNext = true
+
+					By("sending config transaction")
+					Expect(c1.Configure(configEnv, 0)).To(Succeed())
+
+					network.exec(func(c *chain) {
+						Eventually(c.support.WriteConfigBlockCallCount, defaultTimeout).Should(Equal(1))
+						Eventually(c.fakeFields.fakeClusterSize.SetCallCount, LongEventualTimeout).Should(Equal(2))
+						Expect(c.fakeFields.fakeClusterSize.SetArgsForCall(1)).To(Equal(float64(4)))
+						Eventually(func() []uint64 { return c.ClusterStatus().Learners }, LongEventualTimeout).Should(Equal([]uint64{4}))
+						Expect(c.ClusterStatus().Voters).To(ConsistOf(uint64(1), uint64(2), uint64(3)))
+					})
+					Eventually(func() float64 {
+						return c1.fakeFields.fakeLearners.SetArgsForCall(c1.fakeFields.fakeLearners.SetCallCount() - 1)
+					}, LongEventualTimeout).Should(Equal(float64(1)))
+
+					_, raftmetabytes := c1.support.WriteConfigBlockArgsForCall(0)
+					meta := &common.Metadata{Value: raftmetabytes}
+					raftmeta, err := etcdraft.ReadBlockMetadata(meta, nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					c4 := newChain(timeout, channelID, dataDir, 4, raftmeta, consenters)
+					c4.opts.Learners = true
+					c4.support.WriteBlock(c1.support.WriteBlockArgsForCall(0))
+					c4.support.WriteConfigBlock(c1.support.WriteConfigBlockArgsForCall(0))
+					c4.init()
+
+					network.addChain(c4)
+					c4.Start()
+
+					By("promoting the learner once it caught up with the leader")
+					Eventually(func() []uint64 {
+						c1.clock.Increment(interval)
+						return c1.ClusterStatus().Learners
+					}, LongEventualTimeout).Should(BeEmpty())
+
+					network.exec(func(c *chain) {
+						Eventually(func() []uint64 { return c.ClusterStatus().Voters }, LongEventualTimeout).Should(ConsistOf(uint64(1), uint64(2), uint64(3), uint64(4)))
+						Eventually(func() []uint64 { return c.ClusterStatus().Learners }, LongEventualTimeout).Should(BeEmpty())
+					})
+					Expect(c4.ClusterStatus().IsLearner).To(BeFalse())
+					Expect(c4.ClusterStatus().Leader).To(Equal(uint64(1)))
+					Eventually(func() float64 {
+						return c4.fakeFields.fakeIsLearner.SetArgsForCall(c4.fakeFields.fakeIsLearner.SetCallCount() - 1)
+					}, LongEventualTimeout).Should(Equal(float64(0)))
+					var isLearner []float64
+					for i := 0; i < c4.fakeFields.fakeIsLearner.SetCallCount(); i++ {
+						isLearner = append(isLearner, c4.fakeFields.fakeIsLearner.SetArgsForCall(i))
+					}
+					Expect(isLearner).To(ContainElement(float64(1)))
+
+					By("submitting new transaction to the promoted node")
+					c1.cutter.CutNext = true
+					Expect(c4.Order(env, 0)).To(Succeed())
+					network.exec(func(c *chain) {
+						Eventually(c.support.WriteBlockCallCount, defaultTimeout).Should(Equal(2))
+					})
+				})
+
 				It("does not reconfigure raft cluster if it's a channel creation tx", func() {
 					configEnv := newConfigEnv("another-channel",
 						common.HeaderType_CONFIG,
@@ -2642,6 +2773,41 @@ var _ = Describe("Chain", func() {
 				Expect(c3.fakeFields.fakeIsLeader.SetArgsForCall(0)).Should(Equal(float64(0)))
 			})
 
+			When("leadership is transferred on request", func() {
+				BeforeEach(func() {
+					consenters[3].Port = 7053
+				})
+
+				It("hands leadership over to the chosen node", func() {
+					_, err := c2.TransferLeadership("")
+					Expect(err).To(Equal(etcdraft.ErrNotLeader))
+
+					_, err = c1.TransferLeadership("localhost:7054")
+					Expect(err).To(MatchError("no consenter of the channel listens on localhost:7054"))
+
+					newLeader, err := c1.TransferLeadership("localhost:7053")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(newLeader).To(Equal(uint64(3)))
+					network.exec(func(c *chain) {
+						Eventually(func() uint64 { return c.ClusterStatus().Leader }, LongEventualTimeout).Should(Equal(uint64(3)))
+					})
+
+					By("ordering envelopes with the new leader")
+					c3.cutter.CutNext = true
+					Expect(c1.Order(env, 0)).To(Succeed())
+					network.exec(func(c *chain) {
+						Eventually(c.support.WriteBlockCallCount, LongEventualTimeout).Should(Equal(1))
+					})
+				})
+
+				It("hands leadership over to any follower", func() {
+					newLeader, err := c1.TransferLeadership("")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(newLeader).To(Or(Equal(uint64(2)), Equal(uint64(3))))
+					Eventually(c1.observe, LongEventualTimeout).Should(Receive(StateEqual(newLeader, raft.StateFollower)))
+				})
+			})
+
 			It("orders envelope on leader", func() {
 				By("instructed to cut next block")
 				c1.cutter.CutNext = true
@@ -4053,6 +4219,30 @@ func (stmatcher *StateMatcher) NegatedFailureMessage(actual interface{}) (messag
 	return fmt.Sprintf("Expected %s not to be %s", state.RaftState, stmatcher.expect)
 }
 
+// pruningSupport is a ConsenterSupport whose ledger prunes blocks
+type pruningSupport struct {
+	*consensusmocks.FakeConsenterSupport
+
+	lock     sync.Mutex
+	retained []uint64
+}
+
+func (ps *pruningSupport) Prunes() bool {
+	return true
+}
+
+func (ps *pruningSupport) RetainFrom(blockNum uint64) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	ps.retained = append(ps.retained, blockNum)
+}
+
+func (ps *pruningSupport) retainedFrom() []uint64 {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	return append([]uint64(nil), ps.retained...)
+}
+
 func noOpBlockPuller() (etcdraft.BlockPuller, error) {
 	bp := &mocks.FakeBlockPuller{}
 	return bp, nil
@@ -194,6 +194,9 @@ func (c *Consenter) HandleChain(support consensus.ConsenterSupport, metadata *co
 		MaxSizePerMsg:        uint64(support.SharedConfig().BatchSize().PreferredMaxBytes),
 		SnapshotIntervalSize: m.Options.SnapshotIntervalSize,
 
+		Learners:                  m.Options.Learners,
+		LearnerPromotionThreshold: uint64(m.Options.LearnerPromotionThreshold),
+
 		BlockMetadata: blockMetadata,
 		Consenters:    consenters,
 
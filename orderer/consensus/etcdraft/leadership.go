@@ -0,0 +1,160 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+)
+
+// LeadershipPath is the path of the operations endpoint that transfers the Raft
+// leadership of the channels of an ordering node.
+const LeadershipPath = "/leadership"
+
+// Outcomes of a leadership transfer in a channel.
+const (
+	TransferStatusTransferred = "transferred"
+	TransferStatusSkipped     = "skipped"
+	TransferStatusFailed      = "failed"
+)
+
+// LeadershipTransferer transfers the leadership of a channel.
+type LeadershipTransferer interface {
+	// TransferLeadership hands the leadership over to the consenter listening
+	// on the given endpoint, or to any qualified follower if it is empty
+	TransferLeadership(endpoint string) (uint64, error)
+}
+
+// Channels provides the chains of an ordering node.
+type Channels interface {
+	// ChannelIDs returns the IDs of the channels of the ordering node
+	ChannelIDs() []string
+	// GetChain returns the chain of a channel, or nil if it does not exist
+	GetChain(chainID string) *multichannel.ChainSupport
+}
+
+// TransferOutcome is the outcome of a leadership transfer in a channel.
+type TransferOutcome struct {
+	Channel   string `json:"channel"`
+	Status    string `json:"status"`
+	NewLeader uint64 `json:"newLeader,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// LeadershipHandler transfers the Raft leadership of the channels of an ordering
+// node, and reports the outcome of the latest transfer.
+type LeadershipHandler struct {
+	Channels Channels
+	Logger   *flogging.FabricLogger
+
+	mutex    sync.Mutex
+	outcomes []TransferOutcome
+}
+
+// TransferLeadership hands the leadership of the given channel, or of every channel
+// if channelID is empty, over to the consenter listening on the given endpoint, or to
+// any qualified follower if it is empty. Channels this node does not lead are skipped.
+// The returned outcomes are sorted by channel ID.
+func (h *LeadershipHandler) TransferLeadership(channelID, endpoint string) []TransferOutcome {
+	channelIDs := h.Channels.ChannelIDs()
+	if channelID != "" {
+		channelIDs = []string{channelID}
+	}
+	sort.Strings(channelIDs)
+
+	outcomes := make([]TransferOutcome, len(channelIDs))
+	var wg sync.WaitGroup
+	for i, channelID := range channelIDs {
+		wg.Add(1)
+		go func(outcome *TransferOutcome, channelID string) {
+			defer wg.Done()
+			*outcome = h.transfer(channelID, endpoint)
+		}(&outcomes[i], channelID)
+	}
+	wg.Wait()
+
+	h.mutex.Lock()
+	h.outcomes = outcomes
+	h.mutex.Unlock()
+
+	return outcomes
+}
+
+func (h *LeadershipHandler) transfer(channelID, endpoint string) TransferOutcome {
+	outcome := TransferOutcome{Channel: channelID}
+
+	cs := h.Channels.GetChain(channelID)
+	if cs == nil {
+		outcome.Status, outcome.Reason = TransferStatusFailed, "channel does not exist"
+		return outcome
+	}
+	transferer, ok := cs.Chain.(LeadershipTransferer)
+	if !ok {
+		outcome.Status, outcome.Reason = TransferStatusSkipped, "not a Raft channel"
+		return outcome
+	}
+
+	newLeader, err := transferer.TransferLeadership(endpoint)
+	switch {
+	case err == ErrNotLeader:
+		outcome.Status, outcome.Reason = TransferStatusSkipped, err.Error()
+	case err != nil:
+		h.Logger.Warnf("Failed to transfer leadership of channel %s: %s", channelID, err)
+		outcome.Status, outcome.Reason = TransferStatusFailed, err.Error()
+	default:
+		h.Logger.Infof("Transferred leadership of channel %s to node %d", channelID, newLeader)
+		outcome.Status, outcome.NewLeader = TransferStatusTransferred, newLeader
+	}
+	return outcome
+}
+
+// ServeHTTP transfers leadership on POST requests, optionally restricted to the
+// channel in the "channel" query parameter and directed to the consenter in the
+// "target" one, and responds with the outcomes. GET requests are responded with
+// the outcomes of the latest transfer.
+func (h *LeadershipHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		h.mutex.Lock()
+		outcomes := h.outcomes
+		h.mutex.Unlock()
+		if outcomes == nil {
+			outcomes = []TransferOutcome{}
+		}
+		h.sendResponse(resp, http.StatusOK, outcomes)
+
+	case http.MethodPost:
+		channelID := req.URL.Query().Get("channel")
+		if channelID != "" && h.Channels.GetChain(channelID) == nil {
+			h.sendResponse(resp, http.StatusNotFound, errorResponse{Error: fmt.Sprintf("channel %s does not exist", channelID)})
+			return
+		}
+		h.sendResponse(resp, http.StatusOK, h.TransferLeadership(channelID, req.URL.Query().Get("target")))
+
+	default:
+		resp.Header().Set("Allow", "GET, POST")
+		h.sendResponse(resp, http.StatusMethodNotAllowed, errorResponse{Error: fmt.Sprintf("invalid request method: %s", req.Method)})
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h *LeadershipHandler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		h.Logger.Errorf("Failed to encode payload: %s", err)
+	}
+}
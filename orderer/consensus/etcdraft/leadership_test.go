@@ -0,0 +1,120 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package etcdraft
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/orderer/common/multichannel"
+	"github.com/hyperledger/fabric/orderer/consensus"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transferringChain struct {
+	consensus.Chain
+	newLeader uint64
+	err       error
+	endpoints chan string
+}
+
+func (c *transferringChain) TransferLeadership(endpoint string) (uint64, error) {
+	c.endpoints <- endpoint
+	return c.newLeader, c.err
+}
+
+// solo chains cannot transfer leadership
+type soloChain struct {
+	consensus.Chain
+}
+
+type chains map[string]consensus.Chain
+
+func (c chains) ChannelIDs() []string {
+	var channelIDs []string
+	for channelID := range c {
+		channelIDs = append(channelIDs, channelID)
+	}
+	return channelIDs
+}
+
+func (c chains) GetChain(chainID string) *multichannel.ChainSupport {
+	chain, exists := c[chainID]
+	if !exists {
+		return nil
+	}
+	return &multichannel.ChainSupport{Chain: chain}
+}
+
+func TestLeadershipHandler(t *testing.T) {
+	leader := &transferringChain{newLeader: 2, endpoints: make(chan string, 10)}
+	follower := &transferringChain{err: ErrNotLeader, endpoints: make(chan string, 10)}
+	failing := &transferringChain{err: errors.New("leader transfer timeout"), endpoints: make(chan string, 10)}
+	handler := &LeadershipHandler{
+		Channels: chains{"c": leader, "b": follower, "a": failing, "solo": &soloChain{}},
+		Logger:   flogging.MustGetLogger("test"),
+	}
+
+	t.Run("no transfer yet", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, LeadershipPath, nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.JSONEq(t, "[]", resp.Body.String())
+	})
+
+	t.Run("all channels", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, LeadershipPath, nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		expected := []TransferOutcome{
+			{Channel: "a", Status: TransferStatusFailed, Reason: "leader transfer timeout"},
+			{Channel: "b", Status: TransferStatusSkipped, Reason: ErrNotLeader.Error()},
+			{Channel: "c", Status: TransferStatusTransferred, NewLeader: 2},
+			{Channel: "solo", Status: TransferStatusSkipped, Reason: "not a Raft channel"},
+		}
+		var outcomes []TransferOutcome
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &outcomes))
+		assert.Equal(t, expected, outcomes)
+		assert.Equal(t, "", <-leader.endpoints)
+
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, LeadershipPath, nil))
+		outcomes = nil
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &outcomes))
+		assert.Equal(t, expected, outcomes)
+	})
+
+	t.Run("single channel", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, LeadershipPath+"?channel=c&target=orderer2:7050", nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `[{"channel": "c", "status": "transferred", "newLeader": 2}]`, resp.Body.String())
+		assert.Equal(t, "orderer2:7050", <-leader.endpoints)
+	})
+
+	t.Run("missing channel", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, LeadershipPath+"?channel=d", nil))
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.JSONEq(t, `{"error": "channel d does not exist"}`, resp.Body.String())
+	})
+
+	t.Run("bad method", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodDelete, LeadershipPath, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+		assert.Equal(t, "GET, POST", resp.Header().Get("Allow"))
+		assert.JSONEq(t, `{"error": "invalid request method: DELETE"}`, resp.Body.String())
+	})
+}
@@ -81,6 +81,22 @@ var (
 		LabelNames:   []string{"channel"},
 		StatsdFormat: "%{#fqname}.%{channel}",
 	}
+	learnersOpts = metrics.GaugeOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "learners",
+		Help:         "Number of learner nodes, which are not yet promoted to voters, in this channel.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
+	isLearnerOpts = metrics.GaugeOpts{
+		Namespace:    "consensus",
+		Subsystem:    "etcdraft",
+		Name:         "is_learner",
+		Help:         "The learner status of the current node: 1 if it is a learner else 0.",
+		LabelNames:   []string{"channel"},
+		StatsdFormat: "%{#fqname}.%{channel}",
+	}
 )
 
 type Metrics struct {
@@ -93,6 +109,8 @@ type Metrics struct {
 	DataPersistDuration     metrics.Histogram
 	NormalProposalsReceived metrics.Counter
 	ConfigProposalsReceived metrics.Counter
+	Learners                metrics.Gauge
+	IsLearner               metrics.Gauge
 }
 
 func NewMetrics(p metrics.Provider) *Metrics {
@@ -106,5 +124,7 @@ func NewMetrics(p metrics.Provider) *Metrics {
 		DataPersistDuration:     p.NewHistogram(dataPersistDurationOpts),
 		NormalProposalsReceived: p.NewCounter(normalProposalsReceivedOpts),
 		ConfigProposalsReceived: p.NewCounter(configProposalsReceivedOpts),
+		Learners:                p.NewGauge(learnersOpts),
+		IsLearner:               p.NewGauge(isLearnerOpts),
 	}
 }
@@ -37,7 +37,7 @@ var _ = Describe("Metrics", func() {
 			metrics := etcdraft.NewMetrics(fakeProvider)
 
 			Expect(metrics).NotTo(BeNil())
-			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(4))
+			Expect(fakeProvider.NewGaugeCallCount()).To(Equal(6))
 			Expect(fakeProvider.NewCounterCallCount()).To(Equal(4))
 			Expect(fakeProvider.NewHistogramCallCount()).To(Equal(1))
 
@@ -50,6 +50,8 @@ var _ = Describe("Metrics", func() {
 			Expect(metrics.DataPersistDuration).To(Equal(fakeHistogram))
 			Expect(metrics.NormalProposalsReceived).To(Equal(fakeCounter))
 			Expect(metrics.ConfigProposalsReceived).To(Equal(fakeCounter))
+			Expect(metrics.Learners).To(Equal(fakeGauge))
+			Expect(metrics.IsLearner).To(Equal(fakeGauge))
 		})
 	})
 })
@@ -65,6 +67,8 @@ func newFakeMetrics(fakeFields *fakeMetricsFields) *etcdraft.Metrics {
 		DataPersistDuration:     fakeFields.fakeDataPersistDuration,
 		NormalProposalsReceived: fakeFields.fakeNormalProposalsReceived,
 		ConfigProposalsReceived: fakeFields.fakeConfigProposalsReceived,
+		Learners:                fakeFields.fakeLearners,
+		IsLearner:               fakeFields.fakeIsLearner,
 	}
 }
 
@@ -78,6 +82,8 @@ type fakeMetricsFields struct {
 	fakeDataPersistDuration     *metricsfakes.Histogram
 	fakeNormalProposalsReceived *metricsfakes.Counter
 	fakeConfigProposalsReceived *metricsfakes.Counter
+	fakeLearners                *metricsfakes.Gauge
+	fakeIsLearner               *metricsfakes.Gauge
 }
 
 func newFakeMetricsFields() *fakeMetricsFields {
@@ -91,6 +97,8 @@ func newFakeMetricsFields() *fakeMetricsFields {
 		fakeDataPersistDuration:     newFakeHistogram(),
 		fakeNormalProposalsReceived: newFakeCounter(),
 		fakeConfigProposalsReceived: newFakeCounter(),
+		fakeLearners:                newFakeGauge(),
+		fakeIsLearner:               newFakeGauge(),
 	}
 }
 
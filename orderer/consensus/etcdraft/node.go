@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric/protos/orderer"
 	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
 	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
 	"go.etcd.io/etcd/raft"
 	"go.etcd.io/etcd/raft/raftpb"
 )
@@ -55,6 +56,11 @@ func (n *node) start(fresh, join bool) {
 		if join {
 			raftPeers = nil
 			n.logger.Info("Starting raft node to join an existing channel")
+
+			if n.chain.opts.Learners {
+				n.logger.Info("Joining the channel as learner")
+				n.config.Storage = &learnerStorage{MemoryStorage: n.chain.opts.MemoryStorage, id: n.config.ID}
+			}
 		} else {
 			n.logger.Info("Starting raft node as part of a new channel")
 
@@ -80,6 +86,7 @@ func (n *node) run(campaign bool) {
 	halfElectionTimeout := electionTimeout / 2
 
 	raftTicker := n.clock.NewTicker(n.tickInterval)
+	var ticks int
 
 	if s := n.storage.Snapshot(); !raft.IsEmptySnap(s) {
 		n.chain.snapC <- &s
@@ -118,6 +125,12 @@ func (n *node) run(campaign bool) {
 		case <-raftTicker.C():
 			n.Tick()
 
+			// leader checks on learners as often as it sends heartbeats
+			if ticks++; ticks >= n.config.HeartbeatTick {
+				ticks = 0
+				n.reportCaughtUpLearner()
+			}
+
 		case rd := <-n.Ready():
 			startStoring := n.clock.Now()
 			if err := n.storage.Store(rd.Entries, rd.HardState, rd.Snapshot); err != nil {
@@ -198,11 +211,20 @@ func (n *node) send(msgs []raftpb.Message) {
 // If this is called on follower, it simply waits for a
 // leader change till timeout (ElectionTimeout).
 func (n *node) abdicateLeader(currentLead uint64) {
+	if _, err := n.transferLeadership(currentLead, raft.None); err != nil {
+		n.logger.Warnf("Failed to transfer leadership: %s", err)
+	}
+}
+
+// transferLeadership behaves like abdicateLeader, except that the leader
+// transfers its leadership to the given transferee unless it is raft.None.
+// It returns the new leader.
+func (n *node) transferLeadership(currentLead, transferee uint64) (uint64, error) {
 	status := n.Status()
 
 	if status.Lead != raft.None && status.Lead != currentLead {
 		n.logger.Warn("Leader has changed since asked to transfer leadership")
-		return
+		return status.Lead, nil
 	}
 
 	ctx, cancel := context.WithTimeout(context.TODO(), time.Duration(n.config.ElectionTick)*n.tickInterval)
@@ -210,23 +232,29 @@ func (n *node) abdicateLeader(currentLead uint64) {
 
 	// Leader initiates leader transfer
 	if status.RaftState == raft.StateLeader {
-		var transferee uint64
-		for id, pr := range status.Progress {
-			if id == status.ID {
-				continue // skip self
-			}
+		if transferee == raft.None {
+			for id, pr := range status.Progress {
+				if id == status.ID {
+					continue // skip self
+				}
 
-			if pr.RecentActive && !pr.Paused {
-				transferee = id
-				break
-			}
+				if pr.IsLearner {
+					continue // learners cannot lead
+				}
 
-			n.logger.Debugf("Node %d is not qualified as transferee because it's either paused or not active", id)
-		}
+				if pr.RecentActive && !pr.Paused {
+					transferee = id
+					break
+				}
 
-		if transferee == raft.None {
-			n.logger.Errorf("No follower is qualified as transferee, abort leader transfer")
-			return
+				n.logger.Debugf("Node %d is not qualified as transferee because it's either paused or not active", id)
+			}
+
+			if transferee == raft.None {
+				return raft.None, errors.New("no follower is qualified as transferee")
+			}
+		} else if pr, exists := status.Progress[transferee]; !exists || pr.IsLearner {
+			return raft.None, errors.Errorf("node %d is not a voter of the channel", transferee)
 		}
 
 		n.logger.Infof("Transferring leadership to %d", transferee)
@@ -238,15 +266,38 @@ func (n *node) abdicateLeader(currentLead uint64) {
 	for newLeader = n.Status().Lead; newLeader == status.Lead || newLeader == raft.None; newLeader = n.Status().Lead {
 		select {
 		case <-ctx.Done():
-			n.logger.Warn("Leader transfer timeout")
-			return
+			return raft.None, errors.New("leader transfer timeout")
 		case <-time.After(n.tickInterval):
 		case <-n.chain.doneC:
-			return
+			return raft.None, errors.New("chain is stopped")
 		}
 	}
 
 	n.logger.Infof("Leader has been transferred from %d to %d", currentLead, newLeader)
+	return newLeader, nil
+}
+
+// reportCaughtUpLearner notifies the chain of a learner whose log lags
+// behind the commit index by no more than the promotion threshold, if
+// this node is the leader.
+func (n *node) reportCaughtUpLearner() {
+	status := n.Status()
+	if status.RaftState != raft.StateLeader {
+		return
+	}
+
+	threshold := atomic.LoadUint64(&n.chain.promotionThreshold)
+	for id, pr := range status.Progress {
+		if !pr.IsLearner || pr.Match+threshold < status.Commit {
+			continue
+		}
+
+		select {
+		case n.chain.promoteC <- id:
+		default:
+		}
+		return
+	}
 }
 
 func (n *node) logSendFailure(dest uint64, err error) {
@@ -441,3 +441,20 @@ func (rs *RaftStorage) Close() error {
 
 	return nil
 }
+
+// learnerStorage is the storage of a node which joins a channel as learner.
+// Until the node restores a snapshot, it reports itself as the only learner,
+// since etcd/raft refuses to restore snapshots that make a voter a learner.
+type learnerStorage struct {
+	MemoryStorage
+	id uint64
+}
+
+// InitialState implements raft.Storage.
+func (ls *learnerStorage) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
+	hs, cs, err := ls.MemoryStorage.InitialState()
+	if err == nil && len(cs.Nodes) == 0 && len(cs.Learners) == 0 {
+		cs.Learners = []uint64{ls.id}
+	}
+	return hs, cs, err
+}
@@ -327,3 +327,22 @@ func TestTakeSnapshot(t *testing.T) {
 		})
 	})
 }
+
+func TestLearnerStorage(t *testing.T) {
+	ram := raft.NewMemoryStorage()
+	ls := &learnerStorage{MemoryStorage: ram, id: 4}
+
+	_, cs, err := ls.InitialState()
+	require.NoError(t, err)
+	assert.Equal(t, raftpb.ConfState{Learners: []uint64{4}}, cs)
+
+	snap := raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{
+		Index:     5,
+		Term:      1,
+		ConfState: raftpb.ConfState{Nodes: []uint64{1, 2, 3}, Learners: []uint64{4}},
+	}}
+	require.NoError(t, ram.ApplySnapshot(snap))
+	_, cs, err = ls.InitialState()
+	require.NoError(t, err)
+	assert.Equal(t, snap.Metadata.ConfState, cs)
+}
@@ -490,16 +490,22 @@ func NodeExists(id uint64, nodes []uint64) bool {
 }
 
 // ConfChange computes Raft configuration changes based on current Raft
-// configuration state and consenters IDs stored in RaftMetadata.
-func ConfChange(blockMetadata *etcdraft.BlockMetadata, confState *raftpb.ConfState) *raftpb.ConfChange {
+// configuration state and consenters IDs stored in RaftMetadata. Learners
+// count as members, and new nodes are added as learners if asked to.
+func ConfChange(blockMetadata *etcdraft.BlockMetadata, confState *raftpb.ConfState, addAsLearner bool) *raftpb.ConfChange {
 	raftConfChange := &raftpb.ConfChange{}
 
+	members := append(append([]uint64{}, confState.Nodes...), confState.Learners...)
+
 	// need to compute conf changes to propose
-	if len(confState.Nodes) < len(blockMetadata.ConsenterIds) {
+	if len(members) < len(blockMetadata.ConsenterIds) {
 		// adding new node
 		raftConfChange.Type = raftpb.ConfChangeAddNode
+		if addAsLearner {
+			raftConfChange.Type = raftpb.ConfChangeAddLearnerNode
+		}
 		for _, consenterID := range blockMetadata.ConsenterIds {
-			if NodeExists(consenterID, confState.Nodes) {
+			if NodeExists(consenterID, members) {
 				continue
 			}
 			raftConfChange.NodeID = consenterID
@@ -507,7 +513,7 @@ func ConfChange(blockMetadata *etcdraft.BlockMetadata, confState *raftpb.ConfSta
 	} else {
 		// removing node
 		raftConfChange.Type = raftpb.ConfChangeRemoveNode
-		for _, nodeID := range confState.Nodes {
+		for _, nodeID := range members {
 			if NodeExists(nodeID, blockMetadata.ConsenterIds) {
 				continue
 			}
@@ -556,3 +556,46 @@ func TestLedgerBlockPuller(t *testing.T) {
 	assert.Equal(t, genesisBlock, lbp.PullBlock(0))
 	assert.Equal(t, notGenesisBlock, lbp.PullBlock(1))
 }
+
+func TestConfChange(t *testing.T) {
+	blockMetadata := &etcdraft.BlockMetadata{ConsenterIds: []uint64{1, 2, 3}}
+
+	for _, tc := range []struct {
+		name         string
+		confState    raftpb.ConfState
+		addAsLearner bool
+		expected     raftpb.ConfChange
+	}{
+		{
+			name:      "add node",
+			confState: raftpb.ConfState{Nodes: []uint64{1, 2}},
+			expected:  raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 3},
+		},
+		{
+			name:         "add learner",
+			confState:    raftpb.ConfState{Nodes: []uint64{1, 2}},
+			addAsLearner: true,
+			expected:     raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3},
+		},
+		{
+			name:      "remove node",
+			confState: raftpb.ConfState{Nodes: []uint64{1, 2, 3, 4}},
+			expected:  raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 4},
+		},
+		{
+			name:      "remove learner",
+			confState: raftpb.ConfState{Nodes: []uint64{1, 2, 3}, Learners: []uint64{4}},
+			expected:  raftpb.ConfChange{Type: raftpb.ConfChangeRemoveNode, NodeID: 4},
+		},
+		{
+			name:         "add learner next to another one",
+			confState:    raftpb.ConfState{Nodes: []uint64{1}, Learners: []uint64{2}},
+			addAsLearner: true,
+			expected:     raftpb.ConfChange{Type: raftpb.ConfChangeAddLearnerNode, NodeID: 3},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, &tc.expected, ConfChange(blockMetadata, &tc.confState, tc.addAsLearner))
+		})
+	}
+}
@@ -3819,6 +3819,11 @@ func (c *mockConsenterSupport) WriteConfigBlock(block *cb.Block, encodedMetadata
 	return
 }
 
+func (c *mockConsenterSupport) WriteSignedBlock(block *cb.Block) {
+	c.Called(block)
+	return
+}
+
 func (c *mockConsenterSupport) Sequence() uint64 {
 	args := c.Called()
 	return args.Get(0).(uint64)
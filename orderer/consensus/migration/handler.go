@@ -0,0 +1,74 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/flogging"
+)
+
+// StatusPath is the path of the operations endpoint that reports the migration status of the channels.
+const StatusPath = "/migration"
+
+var logger = flogging.MustGetLogger("orderer.consensus.migration")
+
+// ChannelConfigs provides the orderer config of the channels of an ordering node.
+type ChannelConfigs interface {
+	// ChannelIDs returns the IDs of the channels of the ordering node
+	ChannelIDs() []string
+	// OrdererConfig returns the orderer config of the channel, and whether the channel exists
+	OrdererConfig(channelID string) (channelconfig.Orderer, bool)
+	// SystemChannelID returns the ID of the system channel
+	SystemChannelID() string
+}
+
+// StatusHandler reports the migration status of the channels of an ordering node.
+type StatusHandler struct {
+	Channels ChannelConfigs
+}
+
+// ServeHTTP responds to GET requests with the migration status of every channel, sorted by channel ID.
+func (h *StatusHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		h.sendResponse(resp, http.StatusMethodNotAllowed, errorResponse{Error: fmt.Sprintf("invalid request method: %s", req.Method)})
+		return
+	}
+
+	statuses := []*Status{}
+	systemChannelID := h.Channels.SystemChannelID()
+	for _, channelID := range h.Channels.ChannelIDs() {
+		ordererConfig, ok := h.Channels.OrdererConfig(channelID)
+		if !ok {
+			continue
+		}
+		status := StatusOf(channelID, ordererConfig)
+		status.SystemChannel = channelID == systemChannelID
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Channel < statuses[j].Channel
+	})
+
+	h.sendResponse(resp, http.StatusOK, statuses)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (h *StatusHandler) sendResponse(resp http.ResponseWriter, code int, payload interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(code)
+	if err := json.NewEncoder(resp).Encode(payload); err != nil {
+		logger.Errorw("failed to encode payload", "error", err)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package migration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/mocks/config"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type channelConfigs map[string]channelconfig.Orderer
+
+func (c channelConfigs) ChannelIDs() []string {
+	var channelIDs []string
+	for channelID := range c {
+		channelIDs = append(channelIDs, channelID)
+	}
+	return channelIDs
+}
+
+func (c channelConfigs) OrdererConfig(channelID string) (channelconfig.Orderer, bool) {
+	ordererConfig, ok := c[channelID]
+	return ordererConfig, ok
+}
+
+func (c channelConfigs) SystemChannelID() string {
+	return "sys"
+}
+
+func TestStatusHandler(t *testing.T) {
+	handler := &StatusHandler{
+		Channels: channelConfigs{
+			"sys": &config.Orderer{ConsensusTypeVal: "etcdraft", ConsensusTypeStateVal: orderer.ConsensusType_STATE_MAINTENANCE},
+			"b":   &config.Orderer{ConsensusTypeVal: "kafka", ConsensusTypeStateVal: orderer.ConsensusType_STATE_MAINTENANCE},
+			"a":   &config.Orderer{ConsensusTypeVal: "solo"},
+		},
+	}
+
+	t.Run("GET", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, StatusPath, nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+
+		var statuses []*Status
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &statuses))
+		assert.Equal(t, []*Status{
+			{Channel: "a", ConsensusType: "solo", State: "STATE_NORMAL", Step: StepNotApplicable},
+			{Channel: "b", ConsensusType: "kafka", State: "STATE_MAINTENANCE", Step: StepMaintenance},
+			{Channel: "sys", SystemChannel: true, ConsensusType: "etcdraft", State: "STATE_MAINTENANCE", Step: StepConsensusSwitched},
+		}, statuses)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, StatusPath, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+		assert.JSONEq(t, `{"error": "invalid request method: POST"}`, resp.Body.String())
+	})
+}
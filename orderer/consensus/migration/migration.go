@@ -0,0 +1,314 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package migration drives the migration of the channels of an ordering service
+// from Kafka to Raft, using the maintenance mode of the ConsensusType.
+//
+// A channel is migrated with three config updates, each applied to all the
+// channels before the next one is:
+//
+//  1. maintenance: the channel enters maintenance mode, in which only the ordering
+//     service admins may update the channel config.
+//  2. consensus: the consensus type of the channel changes from kafka to etcdraft.
+//  3. normal: after the ordering nodes were restarted, the channel exits
+//     maintenance mode.
+package migration
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/tools/configtxlator/update"
+	"github.com/hyperledger/fabric/orderer/consensus/etcdraft"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	protoetcdraft "github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+const (
+	kafkaType    = "kafka"
+	etcdraftType = "etcdraft"
+)
+
+// Step is the step of the migration a channel is at.
+type Step string
+
+const (
+	// StepNotStarted means the channel is ordered by Kafka and is not in maintenance mode.
+	StepNotStarted Step = "NOT_STARTED"
+	// StepMaintenance means the channel is ordered by Kafka and is in maintenance mode.
+	StepMaintenance Step = "MAINTENANCE"
+	// StepConsensusSwitched means the consensus type of the channel was changed to
+	// etcdraft, and the channel is still in maintenance mode.
+	StepConsensusSwitched Step = "CONSENSUS_SWITCHED"
+	// StepCompleted means the channel is ordered by Raft and is not in maintenance mode.
+	StepCompleted Step = "COMPLETED"
+	// StepNotApplicable means the channel is neither ordered by Kafka nor by Raft.
+	StepNotApplicable Step = "NOT_APPLICABLE"
+)
+
+// Transition is a config update that moves channels from one step of the migration to the next.
+type Transition string
+
+const (
+	// TransitionMaintenance makes a channel enter maintenance mode.
+	TransitionMaintenance Transition = "maintenance"
+	// TransitionConsensus changes the consensus type of a channel to etcdraft.
+	TransitionConsensus Transition = "consensus"
+	// TransitionNormal makes a channel exit maintenance mode.
+	TransitionNormal Transition = "normal"
+)
+
+// Status is the migration status of a channel.
+type Status struct {
+	Channel       string `json:"channel"`
+	SystemChannel bool   `json:"system_channel,omitempty"`
+	ConsensusType string `json:"consensus_type"`
+	State         string `json:"state"`
+	Step          Step   `json:"step"`
+}
+
+// StatusOf returns the migration status of a channel with the given orderer config.
+func StatusOf(channelID string, ordererConfig channelconfig.Orderer) *Status {
+	return &Status{
+		Channel:       channelID,
+		ConsensusType: ordererConfig.ConsensusType(),
+		State:         ordererConfig.ConsensusState().String(),
+		Step:          stepOf(ordererConfig.ConsensusType(), ordererConfig.ConsensusState()),
+	}
+}
+
+func stepOf(consensusType string, state orderer.ConsensusType_State) Step {
+	maintenance := state == orderer.ConsensusType_STATE_MAINTENANCE
+	switch {
+	case consensusType == kafkaType && !maintenance:
+		return StepNotStarted
+	case consensusType == kafkaType && maintenance:
+		return StepMaintenance
+	case consensusType == etcdraftType && maintenance:
+		return StepConsensusSwitched
+	case consensusType == etcdraftType && !maintenance:
+		return StepCompleted
+	default:
+		return StepNotApplicable
+	}
+}
+
+// channel is a parsed channel config
+type channel struct {
+	id      string
+	config  *cb.Config
+	bundle  *channelconfig.Bundle
+	orderer channelconfig.Orderer
+	status  *Status
+}
+
+func parse(channelID string, config *cb.Config) (*channel, error) {
+	bundle, err := channelconfig.NewBundle(channelID, config)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to parse config of channel "+channelID)
+	}
+	ordererConfig, ok := bundle.OrdererConfig()
+	if !ok {
+		return nil, errors.Errorf("config of channel %s has no orderer group", channelID)
+	}
+	status := StatusOf(channelID, ordererConfig)
+	_, status.SystemChannel = bundle.ConsortiumsConfig()
+	return &channel{
+		id:      channelID,
+		config:  config,
+		bundle:  bundle,
+		orderer: ordererConfig,
+		status:  status,
+	}, nil
+}
+
+// check verifies that the channel may undergo the given transition.
+func (c *channel) check(transition Transition) error {
+	switch transition {
+	case TransitionMaintenance:
+		if !c.orderer.Capabilities().ConsensusTypeMigration() || !c.bundle.ChannelConfig().Capabilities().ConsensusTypeMigration() {
+			return errors.Errorf("channel %s does not have the V1_4_2 channel and orderer capabilities enabled", c.id)
+		}
+		if c.status.Step != StepNotStarted {
+			return errors.Errorf("channel %s is at step %s, but entering maintenance mode requires step %s", c.id, c.status.Step, StepNotStarted)
+		}
+	case TransitionConsensus:
+		if c.status.Step != StepMaintenance {
+			return errors.Errorf("channel %s is at step %s, but changing the consensus type requires step %s", c.id, c.status.Step, StepMaintenance)
+		}
+	case TransitionNormal:
+		if c.status.Step != StepConsensusSwitched && c.status.Step != StepMaintenance {
+			return errors.Errorf("channel %s is at step %s, but exiting maintenance mode requires step %s or %s",
+				c.id, c.status.Step, StepMaintenance, StepConsensusSwitched)
+		}
+	default:
+		return errors.Errorf("unknown transition %s", transition)
+	}
+	return nil
+}
+
+// EnterMaintenance returns the config of the channel in maintenance mode.
+func EnterMaintenance(channelID string, config *cb.Config) (*cb.Config, error) {
+	c, err := parse(channelID, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check(TransitionMaintenance); err != nil {
+		return nil, err
+	}
+	return withConsensusType(config, kafkaType, c.orderer.ConsensusMetadata(), orderer.ConsensusType_STATE_MAINTENANCE)
+}
+
+// SwitchToRaft returns the config of the channel with the consensus type changed to etcdraft,
+// with the given Raft metadata.
+func SwitchToRaft(channelID string, config *cb.Config, metadata *protoetcdraft.ConfigMetadata) (*cb.Config, error) {
+	c, err := parse(channelID, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check(TransitionConsensus); err != nil {
+		return nil, err
+	}
+	if err := etcdraft.CheckConfigMetadata(metadata); err != nil {
+		return nil, errors.WithMessage(err, "invalid Raft metadata")
+	}
+	metadataBytes, err := proto.Marshal(metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal Raft metadata")
+	}
+	return withConsensusType(config, etcdraftType, metadataBytes, orderer.ConsensusType_STATE_MAINTENANCE)
+}
+
+// ExitMaintenance returns the config of the channel out of maintenance mode.
+// A channel may exit maintenance mode either after its consensus type changed,
+// or before, in order to abort the migration.
+func ExitMaintenance(channelID string, config *cb.Config) (*cb.Config, error) {
+	c, err := parse(channelID, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.check(TransitionNormal); err != nil {
+		return nil, err
+	}
+	return withConsensusType(config, c.orderer.ConsensusType(), c.orderer.ConsensusMetadata(), orderer.ConsensusType_STATE_NORMAL)
+}
+
+func withConsensusType(config *cb.Config, consensusType string, metadata []byte, state orderer.ConsensusType_State) (*cb.Config, error) {
+	updated := proto.Clone(config).(*cb.Config)
+	ordererGroup := updated.ChannelGroup.Groups[channelconfig.OrdererGroupKey]
+	value, ok := ordererGroup.Values[channelconfig.ConsensusTypeKey]
+	if !ok {
+		return nil, errors.Errorf("config has no %s value", channelconfig.ConsensusTypeKey)
+	}
+	value.Value = utils.MarshalOrPanic(&orderer.ConsensusType{
+		Type:     consensusType,
+		Metadata: metadata,
+		State:    state,
+	})
+	return updated, nil
+}
+
+// ConfigUpdate returns an unsigned CONFIG_UPDATE envelope which transitions the
+// channel from the original config to the updated one, to be signed by the
+// ordering service admins and submitted to the ordering service.
+func ConfigUpdate(channelID string, original, updated *cb.Config) (*cb.Envelope, error) {
+	configUpdate, err := update.Compute(original, updated)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to compute config update")
+	}
+	configUpdate.ChannelId = channelID
+	return utils.CreateSignedEnvelope(cb.HeaderType_CONFIG_UPDATE, channelID, nil, &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: utils.MarshalOrPanic(configUpdate),
+	}, 0, 0)
+}
+
+// CheckPreconditions verifies that all the channels of the ordering service, whose configs
+// are given by channel ID, may undergo the given transition, and returns their migration
+// status sorted by channel ID. The configs must include the config of the system channel.
+func CheckPreconditions(transition Transition, configs map[string]*cb.Config) ([]*Status, error) {
+	var channels []*channel
+	var statuses []*Status
+	var systemChannel *channel
+	for channelID, config := range configs {
+		c, err := parse(channelID, config)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, c)
+		statuses = append(statuses, c.status)
+		if !c.status.SystemChannel {
+			continue
+		}
+		if systemChannel != nil {
+			return nil, errors.Errorf("channels %s and %s are both system channels", systemChannel.id, c.id)
+		}
+		systemChannel = c
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].id < channels[j].id
+	})
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Channel < statuses[j].Channel
+	})
+
+	if systemChannel == nil {
+		return statuses, errors.New("the config of the system channel is missing")
+	}
+
+	for _, c := range channels {
+		if err := c.check(transition); err != nil {
+			return statuses, err
+		}
+	}
+
+	if transition != TransitionNormal {
+		return statuses, nil
+	}
+
+	// Channels either all complete the migration or all abort it, and if they complete it,
+	// they must all be ordered by the same Raft cluster, which is the one of the system channel.
+	for _, c := range channels {
+		if c.status.Step != systemChannel.status.Step {
+			return statuses, errors.Errorf("channel %s is at step %s, but the system channel is at step %s",
+				c.id, c.status.Step, systemChannel.status.Step)
+		}
+		if c.status.Step != StepConsensusSwitched {
+			continue
+		}
+		if !sameConsenters(c.orderer.ConsensusMetadata(), systemChannel.orderer.ConsensusMetadata()) {
+			return statuses, errors.Errorf("the Raft consenters of channel %s differ from those of the system channel %s", c.id, systemChannel.id)
+		}
+	}
+	return statuses, nil
+}
+
+func sameConsenters(metadata1, metadata2 []byte) bool {
+	consenters := func(metadata []byte) []*protoetcdraft.Consenter {
+		md := &protoetcdraft.ConfigMetadata{}
+		if err := proto.Unmarshal(metadata, md); err != nil {
+			return nil
+		}
+		sort.Slice(md.Consenters, func(i, j int) bool {
+			return bytes.Compare(md.Consenters[i].ClientTlsCert, md.Consenters[j].ClientTlsCert) < 0
+		})
+		return md.Consenters
+	}
+	consenters1, consenters2 := consenters(metadata1), consenters(metadata2)
+	if len(consenters1) == 0 || len(consenters1) != len(consenters2) {
+		return false
+	}
+	for i := range consenters1 {
+		if !proto.Equal(consenters1[i], consenters2[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,231 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/channelconfig"
+	"github.com/hyperledger/fabric/common/crypto/tlsgen"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/configtxgentest"
+	"github.com/hyperledger/fabric/common/tools/configtxgen/encoder"
+	genesisconfig "github.com/hyperledger/fabric/common/tools/configtxgen/localconfig"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func kafkaConfig(t *testing.T, systemChannel bool, ordererCapabilities map[string]bool) *cb.Config {
+	profile := configtxgentest.Load(genesisconfig.SampleDevModeKafkaProfile)
+	if ordererCapabilities != nil {
+		profile.Orderer.Capabilities = ordererCapabilities
+	}
+	group, err := encoder.NewChannelGroup(profile)
+	require.NoError(t, err)
+	if systemChannel {
+		delete(group.Groups, channelconfig.ApplicationGroupKey)
+	} else {
+		delete(group.Groups, channelconfig.ConsortiumsGroupKey)
+	}
+	return &cb.Config{ChannelGroup: group}
+}
+
+func raftMetadata(t *testing.T, hosts ...string) *etcdraft.ConfigMetadata {
+	ca, err := tlsgen.NewCA()
+	require.NoError(t, err)
+	metadata := &etcdraft.ConfigMetadata{
+		Options: &etcdraft.Options{
+			TickInterval:      "500ms",
+			ElectionTick:      10,
+			HeartbeatTick:     1,
+			MaxInflightBlocks: 5,
+		},
+	}
+	for _, host := range hosts {
+		kp, err := ca.NewServerCertKeyPair(host)
+		require.NoError(t, err)
+		metadata.Consenters = append(metadata.Consenters, &etcdraft.Consenter{
+			Host:          host,
+			Port:          7050,
+			ClientTlsCert: kp.Cert,
+			ServerTlsCert: kp.Cert,
+		})
+	}
+	return metadata
+}
+
+func stepOfConfig(t *testing.T, channelID string, config *cb.Config) Step {
+	c, err := parse(channelID, config)
+	require.NoError(t, err)
+	return c.status.Step
+}
+
+func TestMigrationSteps(t *testing.T) {
+	config := kafkaConfig(t, false, nil)
+	assert.Equal(t, StepNotStarted, stepOfConfig(t, "mychannel", config))
+
+	_, err := SwitchToRaft("mychannel", config, raftMetadata(t, "raft0"))
+	assert.EqualError(t, err, "channel mychannel is at step NOT_STARTED, but changing the consensus type requires step MAINTENANCE")
+	_, err = ExitMaintenance("mychannel", config)
+	assert.EqualError(t, err, "channel mychannel is at step NOT_STARTED, but exiting maintenance mode requires step MAINTENANCE or CONSENSUS_SWITCHED")
+
+	maintenance, err := EnterMaintenance("mychannel", config)
+	require.NoError(t, err)
+	assert.Equal(t, StepMaintenance, stepOfConfig(t, "mychannel", maintenance))
+	assert.Equal(t, StepNotStarted, stepOfConfig(t, "mychannel", config), "the original config must not be modified")
+
+	_, err = EnterMaintenance("mychannel", maintenance)
+	assert.EqualError(t, err, "channel mychannel is at step MAINTENANCE, but entering maintenance mode requires step NOT_STARTED")
+
+	_, err = SwitchToRaft("mychannel", maintenance, &etcdraft.ConfigMetadata{})
+	assert.EqualError(t, err, "invalid Raft metadata: nil Raft config metadata options")
+
+	metadata := raftMetadata(t, "raft0", "raft1")
+	switched, err := SwitchToRaft("mychannel", maintenance, metadata)
+	require.NoError(t, err)
+	assert.Equal(t, StepConsensusSwitched, stepOfConfig(t, "mychannel", switched))
+	c, err := parse("mychannel", switched)
+	require.NoError(t, err)
+	assert.Equal(t, utils.MarshalOrPanic(metadata), c.orderer.ConsensusMetadata())
+
+	completed, err := ExitMaintenance("mychannel", switched)
+	require.NoError(t, err)
+	assert.Equal(t, StepCompleted, stepOfConfig(t, "mychannel", completed))
+
+	aborted, err := ExitMaintenance("mychannel", maintenance)
+	require.NoError(t, err)
+	assert.Equal(t, StepNotStarted, stepOfConfig(t, "mychannel", aborted))
+}
+
+func TestEnterMaintenanceRequiresCapabilities(t *testing.T) {
+	config := kafkaConfig(t, false, map[string]bool{"V1_1": true})
+	_, err := EnterMaintenance("mychannel", config)
+	assert.EqualError(t, err, "channel mychannel does not have the V1_4_2 channel and orderer capabilities enabled")
+}
+
+func TestConfigUpdate(t *testing.T) {
+	config := kafkaConfig(t, false, nil)
+	maintenance, err := EnterMaintenance("mychannel", config)
+	require.NoError(t, err)
+
+	env, err := ConfigUpdate("mychannel", config, maintenance)
+	require.NoError(t, err)
+	assert.Nil(t, env.Signature)
+
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	require.NoError(t, err)
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	require.NoError(t, err)
+	assert.Equal(t, int32(cb.HeaderType_CONFIG_UPDATE), chdr.Type)
+	assert.Equal(t, "mychannel", chdr.ChannelId)
+
+	configUpdateEnv := &cb.ConfigUpdateEnvelope{}
+	require.NoError(t, proto.Unmarshal(payload.Data, configUpdateEnv))
+	configUpdate := &cb.ConfigUpdate{}
+	require.NoError(t, proto.Unmarshal(configUpdateEnv.ConfigUpdate, configUpdate))
+	assert.Equal(t, "mychannel", configUpdate.ChannelId)
+
+	value := configUpdate.WriteSet.Groups[channelconfig.OrdererGroupKey].Values[channelconfig.ConsensusTypeKey]
+	require.NotNil(t, value)
+	consensusType := &orderer.ConsensusType{}
+	require.NoError(t, proto.Unmarshal(value.Value, consensusType))
+	assert.Equal(t, "kafka", consensusType.Type)
+	assert.Equal(t, orderer.ConsensusType_STATE_MAINTENANCE, consensusType.State)
+
+	_, err = ConfigUpdate("mychannel", config, config)
+	assert.EqualError(t, err, "failed to compute config update: no differences detected between original and updated config")
+}
+
+func TestCheckPreconditions(t *testing.T) {
+	system := kafkaConfig(t, true, nil)
+	app := kafkaConfig(t, false, nil)
+
+	t.Run("missing system channel", func(t *testing.T) {
+		statuses, err := CheckPreconditions(TransitionMaintenance, map[string]*cb.Config{"app": app})
+		assert.EqualError(t, err, "the config of the system channel is missing")
+		assert.Len(t, statuses, 1)
+	})
+
+	t.Run("two system channels", func(t *testing.T) {
+		_, err := CheckPreconditions(TransitionMaintenance, map[string]*cb.Config{"sys1": system, "sys2": system})
+		assert.EqualError(t, err, "channels sys1 and sys2 are both system channels")
+	})
+
+	t.Run("unknown transition", func(t *testing.T) {
+		_, err := CheckPreconditions(Transition("bogus"), map[string]*cb.Config{"sys": system})
+		assert.EqualError(t, err, "unknown transition bogus")
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		statuses, err := CheckPreconditions(TransitionMaintenance, map[string]*cb.Config{"sys": system, "app": app})
+		require.NoError(t, err)
+		assert.Equal(t, []*Status{
+			{Channel: "app", ConsensusType: "kafka", State: "STATE_NORMAL", Step: StepNotStarted},
+			{Channel: "sys", SystemChannel: true, ConsensusType: "kafka", State: "STATE_NORMAL", Step: StepNotStarted},
+		}, statuses)
+	})
+
+	systemMaintenance, err := EnterMaintenance("sys", system)
+	require.NoError(t, err)
+	appMaintenance, err := EnterMaintenance("app", app)
+	require.NoError(t, err)
+
+	t.Run("consensus with a channel not in maintenance", func(t *testing.T) {
+		_, err := CheckPreconditions(TransitionConsensus, map[string]*cb.Config{"sys": systemMaintenance, "app": app})
+		assert.EqualError(t, err, "channel app is at step NOT_STARTED, but changing the consensus type requires step MAINTENANCE")
+	})
+
+	t.Run("consensus", func(t *testing.T) {
+		_, err := CheckPreconditions(TransitionConsensus, map[string]*cb.Config{"sys": systemMaintenance, "app": appMaintenance})
+		assert.NoError(t, err)
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		_, err := CheckPreconditions(TransitionNormal, map[string]*cb.Config{"sys": systemMaintenance, "app": appMaintenance})
+		assert.NoError(t, err)
+	})
+
+	metadata := raftMetadata(t, "raft0", "raft1", "raft2")
+	systemSwitched, err := SwitchToRaft("sys", systemMaintenance, metadata)
+	require.NoError(t, err)
+	appSwitched, err := SwitchToRaft("app", appMaintenance, metadata)
+	require.NoError(t, err)
+
+	t.Run("normal with channels at different steps", func(t *testing.T) {
+		_, err := CheckPreconditions(TransitionNormal, map[string]*cb.Config{"sys": systemSwitched, "app": appMaintenance})
+		assert.EqualError(t, err, "channel app is at step MAINTENANCE, but the system channel is at step CONSENSUS_SWITCHED")
+	})
+
+	t.Run("normal with different consenters", func(t *testing.T) {
+		otherSwitched, err := SwitchToRaft("app", appMaintenance, raftMetadata(t, "raft0", "raft1", "raft2"))
+		require.NoError(t, err)
+		_, err = CheckPreconditions(TransitionNormal, map[string]*cb.Config{"sys": systemSwitched, "app": otherSwitched})
+		assert.EqualError(t, err, "the Raft consenters of channel app differ from those of the system channel sys")
+	})
+
+	t.Run("normal with reordered consenters", func(t *testing.T) {
+		reordered := proto.Clone(metadata).(*etcdraft.ConfigMetadata)
+		reordered.Consenters[0], reordered.Consenters[2] = reordered.Consenters[2], reordered.Consenters[0]
+		otherSwitched, err := SwitchToRaft("app", appMaintenance, reordered)
+		require.NoError(t, err)
+		_, err = CheckPreconditions(TransitionNormal, map[string]*cb.Config{"sys": systemSwitched, "app": otherSwitched})
+		assert.NoError(t, err)
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		statuses, err := CheckPreconditions(TransitionNormal, map[string]*cb.Config{"sys": systemSwitched, "app": appSwitched})
+		require.NoError(t, err)
+		assert.Equal(t, []*Status{
+			{Channel: "app", ConsensusType: "etcdraft", State: "STATE_MAINTENANCE", Step: StepConsensusSwitched},
+			{Channel: "sys", SystemChannel: true, ConsensusType: "etcdraft", State: "STATE_MAINTENANCE", Step: StepConsensusSwitched},
+		}, statuses)
+	})
+}
@@ -208,6 +208,11 @@ type FakeConsenterSupport struct {
 		arg1 *common.Block
 		arg2 []byte
 	}
+	WriteSignedBlockStub        func(*common.Block)
+	writeSignedBlockMutex       sync.RWMutex
+	writeSignedBlockArgsForCall []struct {
+		arg1 *common.Block
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1227,6 +1232,37 @@ func (fake *FakeConsenterSupport) WriteConfigBlockArgsForCall(i int) (*common.Bl
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConsenterSupport) WriteSignedBlock(arg1 *common.Block) {
+	fake.writeSignedBlockMutex.Lock()
+	fake.writeSignedBlockArgsForCall = append(fake.writeSignedBlockArgsForCall, struct {
+		arg1 *common.Block
+	}{arg1})
+	fake.recordInvocation("WriteSignedBlock", []interface{}{arg1})
+	fake.writeSignedBlockMutex.Unlock()
+	if fake.WriteSignedBlockStub != nil {
+		fake.WriteSignedBlockStub(arg1)
+	}
+}
+
+func (fake *FakeConsenterSupport) WriteSignedBlockCallCount() int {
+	fake.writeSignedBlockMutex.RLock()
+	defer fake.writeSignedBlockMutex.RUnlock()
+	return len(fake.writeSignedBlockArgsForCall)
+}
+
+func (fake *FakeConsenterSupport) WriteSignedBlockCalls(stub func(*common.Block)) {
+	fake.writeSignedBlockMutex.Lock()
+	defer fake.writeSignedBlockMutex.Unlock()
+	fake.WriteSignedBlockStub = stub
+}
+
+func (fake *FakeConsenterSupport) WriteSignedBlockArgsForCall(i int) *common.Block {
+	fake.writeSignedBlockMutex.RLock()
+	defer fake.writeSignedBlockMutex.RUnlock()
+	argsForCall := fake.writeSignedBlockArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConsenterSupport) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1266,6 +1302,8 @@ func (fake *FakeConsenterSupport) Invocations() map[string][][]interface{} {
 	defer fake.writeBlockMutex.RUnlock()
 	fake.writeConfigBlockMutex.RLock()
 	defer fake.writeConfigBlockMutex.RUnlock()
+	fake.writeSignedBlockMutex.RLock()
+	defer fake.writeSignedBlockMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -116,6 +116,11 @@ func (mcs *ConsenterSupport) WriteConfigBlock(block *cb.Block, encodedMetadataVa
 	mcs.WriteBlock(block, encodedMetadataValue)
 }
 
+// WriteSignedBlock calls Append
+func (mcs *ConsenterSupport) WriteSignedBlock(block *cb.Block) {
+	mcs.Append(block)
+}
+
 // ChainID returns the chain ID this specific consenter instance is associated with
 func (mcs *ConsenterSupport) ChainID() string {
 	return mcs.ChainIDVal
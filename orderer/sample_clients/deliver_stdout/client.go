@@ -28,21 +28,23 @@ var (
 )
 
 type deliverClient struct {
-	client    ab.AtomicBroadcast_DeliverClient
-	channelID string
-	signer    crypto.LocalSigner
-	quiet     bool
+	client      ab.AtomicBroadcast_DeliverClient
+	channelID   string
+	signer      crypto.LocalSigner
+	quiet       bool
+	contentType ab.SeekInfo_SeekContentType
 }
 
-func newDeliverClient(client ab.AtomicBroadcast_DeliverClient, channelID string, signer crypto.LocalSigner, quiet bool) *deliverClient {
-	return &deliverClient{client: client, channelID: channelID, signer: signer, quiet: quiet}
+func newDeliverClient(client ab.AtomicBroadcast_DeliverClient, channelID string, signer crypto.LocalSigner, quiet bool, contentType ab.SeekInfo_SeekContentType) *deliverClient {
+	return &deliverClient{client: client, channelID: channelID, signer: signer, quiet: quiet, contentType: contentType}
 }
 
 func (r *deliverClient) seekHelper(start *ab.SeekPosition, stop *ab.SeekPosition) *cb.Envelope {
 	env, err := utils.CreateSignedEnvelope(cb.HeaderType_DELIVER_SEEK_INFO, r.channelID, r.signer, &ab.SeekInfo{
-		Start:    start,
-		Stop:     stop,
-		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
+		Start:       start,
+		Stop:        stop,
+		Behavior:    ab.SeekInfo_BLOCK_UNTIL_READY,
+		ContentType: r.contentType,
 	}, 0, 0)
 	if err != nil {
 		panic(err)
@@ -85,6 +87,13 @@ func (r *deliverClient) readUntilClose() {
 			} else {
 				fmt.Println("Received block: ", t.Block.Header.Number)
 			}
+		case *ab.DeliverResponse_BlockTransactionIds:
+			fmt.Println("Received block: ", t.BlockTransactionIds.Header.Number)
+			if !r.quiet {
+				for _, txID := range t.BlockTransactionIds.TxIds {
+					fmt.Println("  ", txID)
+				}
+			}
 		}
 	}
 }
@@ -109,10 +118,13 @@ func main() {
 	var serverAddr string
 	var seek int
 	var quiet bool
+	var content string
 
 	flag.StringVar(&serverAddr, "server", fmt.Sprintf("%s:%d", conf.General.ListenAddress, conf.General.ListenPort), "The RPC server to connect to.")
 	flag.StringVar(&channelID, "channelID", localconfig.Defaults.General.SystemChannel, "The channel ID to deliver from.")
 	flag.BoolVar(&quiet, "quiet", false, "Only print the block number, will not attempt to print its block contents.")
+	flag.StringVar(&content, "content", ab.SeekInfo_BLOCK.String(), "The content of the blocks to deliver: "+
+		"BLOCK, HEADER_WITH_SIG (header and metadata), or TX_IDS (header and transaction IDs).")
 	flag.IntVar(&seek, "seek", -2, "Specify the range of requested blocks."+
 		"Acceptable values:"+
 		"-2 (or -1) to start from oldest (or newest) and keep at it indefinitely."+
@@ -124,6 +136,13 @@ func main() {
 		flag.PrintDefaults()
 	}
 
+	contentType, ok := ab.SeekInfo_SeekContentType_value[content]
+	if !ok {
+		fmt.Println("Wrong content value.")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
 	conn, err := grpc.Dial(serverAddr, grpc.WithInsecure())
 	if err != nil {
 		fmt.Println("Error connecting:", err)
@@ -135,7 +154,7 @@ func main() {
 		return
 	}
 
-	s := newDeliverClient(client, channelID, signer, quiet)
+	s := newDeliverClient(client, channelID, signer, quiet, ab.SeekInfo_SeekContentType(contentType))
 	switch seek {
 	case -2:
 		err = s.seekOldest()
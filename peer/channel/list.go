@@ -109,6 +109,10 @@ func list(cf *ChannelCmdFactory) error {
 		fmt.Println("Channels peers has joined: ")
 
 		for _, channel := range channels {
+			if channel.Status == pb.ChannelInfo_INACTIVE {
+				fmt.Printf("%s (paused)\n", channel.ChannelId)
+				continue
+			}
 			fmt.Printf("%s\n", channel.ChannelId)
 		}
 	}
@@ -21,9 +21,15 @@ func TestListChannels(t *testing.T) {
 	InitMSP()
 
 	mockChannelResponse := &pb.ChannelQueryResponse{
-		Channels: []*pb.ChannelInfo{{
-			ChannelId: "TEST_LIST_CHANNELS",
-		}},
+		Channels: []*pb.ChannelInfo{
+			{
+				ChannelId: "TEST_LIST_CHANNELS",
+			},
+			{
+				ChannelId: "TEST_LIST_PAUSED_CHANNELS",
+				Status:    pb.ChannelInfo_INACTIVE,
+			},
+		},
 	}
 
 	mockPayload, err := proto.Marshal(mockChannelResponse)
@@ -0,0 +1,85 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+type envelopeWrapper func(msg proto.Message) *common2.Envelope
+
+// IndexCmdFactory holds the clients used by IndexCmd
+type IndexCmdFactory struct {
+	AdminClient      pb.AdminClient
+	wrapWithEnvelope envelopeWrapper
+}
+
+// InitCmdFactory init the IndexCmdFactory with default admin client
+func InitCmdFactory() (*IndexCmdFactory, error) {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return nil, errors.Errorf("failed obtaining default signer: %v", err)
+	}
+
+	localSigner := crypto.NewSignatureHeaderCreator(signer)
+	wrapEnv := func(msg proto.Message) *common2.Envelope {
+		env, err := utils.CreateSignedEnvelope(common2.HeaderType_PEER_ADMIN_OPERATION, "", localSigner, msg, 0, 0)
+		if err != nil {
+			logger.Panicf("Failed signing: %v", err)
+		}
+		return env
+	}
+
+	return &IndexCmdFactory{
+		AdminClient:      adminClient,
+		wrapWithEnvelope: wrapEnv,
+	}, nil
+}
+
+// checkIndexCmdParams checks the parameters that are common to all the index commands
+func checkIndexCmdParams(args []string) error {
+	if len(args) > 0 {
+		return errors.Errorf("more parameters than necessary were provided. Expected 0, received %d", len(args))
+	}
+	if channelID == common.UndefinedParamValue {
+		return errors.New("must supply channel ID")
+	}
+	if chaincodeName == common.UndefinedParamValue {
+		return errors.New("must supply chaincode name")
+	}
+	return nil
+}
+
+// newIndexOperation wraps the given request along with the namespace parameters in an AdminOperation
+func newIndexOperation(request *pb.IndexRequest) *pb.AdminOperation {
+	request.ChannelId = channelID
+	request.Namespace = chaincodeName
+	request.Collection = collection
+	return &pb.AdminOperation{
+		Content: &pb.AdminOperation_IndexReq{
+			IndexReq: request,
+		},
+	}
+}
+
+func printIndex(w io.Writer, index *pb.StateDBIndex) {
+	fmt.Fprintf(w, "Design document: %s, Name: %s, Definition: %s\n", index.DesignDoc, index.Name, index.Definition)
+}
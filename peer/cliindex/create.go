@@ -0,0 +1,78 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"context"
+	"io/ioutil"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func createCmd(cf *IndexCmdFactory) *cobra.Command {
+	var indexCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Creates an index for a chaincode.",
+		Long:  `Creates (or updates) a state database index for a chaincode (or for a private data collection of the chaincode) on a channel. The index definition has the same format as the index definitions packaged with a chaincode.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return create(cf, cmd, args)
+		},
+	}
+	addCommonFlags(indexCreateCmd)
+	flags := indexCreateCmd.Flags()
+	flags.StringVarP(&indexDefinition, "definition", "d", "", "The index definition in JSON format")
+	flags.StringVarP(&indexDefinitionFile, "definitionFile", "f", "", "The path to a file that contains the index definition in JSON format")
+
+	return indexCreateCmd
+}
+
+func create(cf *IndexCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkIndexCmdParams(args); err != nil {
+		return err
+	}
+	definition, err := readIndexDefinition()
+	if err != nil {
+		return err
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newIndexOperation(&pb.IndexRequest{IndexDefinition: definition}))
+	resp, err := cf.AdminClient.CreateIndex(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	for _, index := range resp.Indexes {
+		logger.Infof("Created index [%s] of design document [%s]", index.Name, index.DesignDoc)
+	}
+	return nil
+}
+
+func readIndexDefinition() (string, error) {
+	switch {
+	case indexDefinition != "" && indexDefinitionFile != "":
+		return "", errors.New("only one of the index definition and the index definition file can be supplied")
+	case indexDefinition != "":
+		return indexDefinition, nil
+	case indexDefinitionFile != "":
+		definition, err := ioutil.ReadFile(indexDefinitionFile)
+		if err != nil {
+			return "", errors.Wrapf(err, "error reading the index definition file [%s]", indexDefinitionFile)
+		}
+		return string(definition), nil
+	default:
+		return "", errors.New("must supply the index definition or the index definition file")
+	}
+}
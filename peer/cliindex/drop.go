@@ -0,0 +1,57 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"context"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func dropCmd(cf *IndexCmdFactory) *cobra.Command {
+	var indexDropCmd = &cobra.Command{
+		Use:   "drop",
+		Short: "Drops an index of a chaincode.",
+		Long:  `Drops a state database index of a chaincode (or of a private data collection of the chaincode) on a channel. Note that the indexes packaged with the chaincode are created again when the chaincode is instantiated or upgraded.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return drop(cf, cmd, args)
+		},
+	}
+	addCommonFlags(indexDropCmd)
+	flags := indexDropCmd.Flags()
+	flags.StringVarP(&designDoc, "designDoc", "", "", "The design document of the index")
+	flags.StringVarP(&indexName, "indexName", "", "", "The name of the index")
+
+	return indexDropCmd
+}
+
+func drop(cf *IndexCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkIndexCmdParams(args); err != nil {
+		return err
+	}
+	if designDoc == "" || indexName == "" {
+		return errors.New("must supply the design document and the name of the index")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newIndexOperation(&pb.IndexRequest{DesignDoc: designDoc, IndexName: indexName}))
+	if _, err := cf.AdminClient.DropIndex(context.Background(), env); err != nil {
+		return err
+	}
+	logger.Infof("Dropped index [%s] of design document [%s]", indexName, designDoc)
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func explainCmd(cf *IndexCmdFactory) *cobra.Command {
+	var indexExplainCmd = &cobra.Command{
+		Use:   "explain",
+		Short: "Reports the index that a rich query would use.",
+		Long:  `Reports the state database index that would be used for executing the given rich query against a chaincode (or a private data collection of the chaincode) on a channel. When no index of the chaincode can serve the query, the built-in index of the state database is reported (e.g., _all_docs for CouchDB), which implies a scan of all the data of the chaincode.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explain(cf, cmd, args)
+		},
+	}
+	addCommonFlags(indexExplainCmd)
+	flags := indexExplainCmd.Flags()
+	flags.StringVarP(&query, "query", "q", "", "The rich query in JSON format")
+
+	return indexExplainCmd
+}
+
+func explain(cf *IndexCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkIndexCmdParams(args); err != nil {
+		return err
+	}
+	if query == "" {
+		return errors.New("must supply the query")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newIndexOperation(&pb.IndexRequest{Query: query}))
+	resp, err := cf.AdminClient.ExplainQuery(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	for _, index := range resp.Indexes {
+		if index.DesignDoc == "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "No index of the chaincode can serve the query, the built-in index [%s] would be used\n", index.Name)
+			continue
+		}
+		printIndex(cmd.OutOrStdout(), index)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	indexFuncName = "index"
+	indexCmdDes   = "Manage the state database indexes of a chaincode: list|create|drop|explain."
+)
+
+var logger = flogging.MustGetLogger("cli.index")
+
+// Index-related variables.
+var (
+	channelID           string
+	chaincodeName       string
+	collection          string
+	indexDefinition     string
+	indexDefinitionFile string
+	designDoc           string
+	indexName           string
+	query               string
+)
+
+// Cmd returns the cobra command for Index
+func Cmd(cf *IndexCmdFactory) *cobra.Command {
+	indexCmd.AddCommand(listCmd(cf))
+	indexCmd.AddCommand(createCmd(cf))
+	indexCmd.AddCommand(dropCmd(cf))
+	indexCmd.AddCommand(explainCmd(cf))
+
+	return indexCmd
+}
+
+var indexCmd = &cobra.Command{
+	Use:              indexFuncName,
+	Short:            fmt.Sprint(indexCmdDes),
+	Long:             fmt.Sprint(indexCmdDes),
+	PersistentPreRun: common.InitCmd,
+}
+
+// addCommonFlags adds the flags that identify the namespace (or the collection) whose indexes are managed
+func addCommonFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "C", common.UndefinedParamValue, "The channel on which this command should be executed")
+	flags.StringVarP(&chaincodeName, "name", "n", common.UndefinedParamValue, "Name of the chaincode")
+	flags.StringVarP(&collection, "collection", "", "", "Name of the private data collection. If not specified, the command applies to the public state of the chaincode")
+}
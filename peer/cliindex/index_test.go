@@ -0,0 +1,155 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCase struct {
+	name           string
+	args           []string
+	expectedErr    string
+	expectedOutput string
+}
+
+func initIndexTest(command string, clientErr error) *cobra.Command {
+	mockCF := &IndexCmdFactory{
+		AdminClient: common.GetMockAdminClient(clientErr),
+		wrapWithEnvelope: func(msg proto.Message) *common2.Envelope {
+			pl := &common2.Payload{
+				Data: utils.MarshalOrPanic(msg),
+			}
+			env := &common2.Envelope{
+				Payload: utils.MarshalOrPanic(pl),
+			}
+			return env
+		},
+	}
+	var cmd *cobra.Command
+	switch command {
+	case "list":
+		cmd = listCmd(mockCF)
+	case "create":
+		cmd = createCmd(mockCF)
+	case "drop":
+		cmd = dropCmd(mockCF)
+	case "explain":
+		cmd = explainCmd(mockCF)
+	}
+	return cmd
+}
+
+func runTests(t *testing.T, command string, tc []testCase) {
+	for _, test := range tc {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := initIndexTest(command, nil)
+			buffer := &bytes.Buffer{}
+			cmd.SetOutput(buffer)
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, buffer.String())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	runTests(t, "list", []testCase{
+		{name: "NoChannel", args: []string{"-n", "mycc"}, expectedErr: "must supply channel ID"},
+		{name: "NoChaincode", args: []string{"-C", "mychannel"}, expectedErr: "must supply chaincode name"},
+		{name: "ExtraParameters", args: []string{"-C", "mychannel", "-n", "mycc", "extra"}, expectedErr: "more parameters than necessary were provided. Expected 0, received 1"},
+		{
+			name:           "Valid",
+			args:           []string{"-C", "mychannel", "-n", "mycc", "--collection", "coll1"},
+			expectedOutput: "Design document: indexOwnerDoc, Name: indexOwner, Definition: {\"fields\":[{\"owner\":\"asc\"}]}\n",
+		},
+	})
+}
+
+func TestCreate(t *testing.T) {
+	testDir, err := ioutil.TempDir("", "cliindex")
+	require.NoError(t, err)
+	defer os.RemoveAll(testDir)
+	definitionFile := filepath.Join(testDir, "index.json")
+	require.NoError(t, ioutil.WriteFile(definitionFile, []byte(`{"index":{"fields":["owner"]}}`), 0644))
+
+	runTests(t, "create", []testCase{
+		{name: "NoDefinition", args: []string{"-C", "mychannel", "-n", "mycc"}, expectedErr: "must supply the index definition or the index definition file"},
+		{
+			name:        "DefinitionAndFile",
+			args:        []string{"-C", "mychannel", "-n", "mycc", "-d", `{"index":{"fields":["owner"]}}`, "-f", definitionFile},
+			expectedErr: "only one of the index definition and the index definition file can be supplied",
+		},
+		{
+			name:        "MissingFile",
+			args:        []string{"-C", "mychannel", "-n", "mycc", "-f", filepath.Join(testDir, "missing.json")},
+			expectedErr: "error reading the index definition file [" + filepath.Join(testDir, "missing.json") + "]: open " + filepath.Join(testDir, "missing.json") + ": no such file or directory",
+		},
+		{name: "ValidDefinition", args: []string{"-C", "mychannel", "-n", "mycc", "-d", `{"index":{"fields":["owner"]}}`}},
+		{name: "ValidDefinitionFile", args: []string{"-C", "mychannel", "-n", "mycc", "-f", definitionFile}},
+	})
+}
+
+func TestDrop(t *testing.T) {
+	runTests(t, "drop", []testCase{
+		{name: "NoIndexName", args: []string{"-C", "mychannel", "-n", "mycc", "--designDoc", "indexOwnerDoc"}, expectedErr: "must supply the design document and the name of the index"},
+		{name: "NoDesignDoc", args: []string{"-C", "mychannel", "-n", "mycc", "--indexName", "indexOwner"}, expectedErr: "must supply the design document and the name of the index"},
+		{name: "Valid", args: []string{"-C", "mychannel", "-n", "mycc", "--designDoc", "indexOwnerDoc", "--indexName", "indexOwner"}},
+	})
+}
+
+func TestExplain(t *testing.T) {
+	runTests(t, "explain", []testCase{
+		{name: "NoQuery", args: []string{"-C", "mychannel", "-n", "mycc"}, expectedErr: "must supply the query"},
+		{
+			name:           "Valid",
+			args:           []string{"-C", "mychannel", "-n", "mycc", "-q", `{"selector":{"owner":"tom"}}`},
+			expectedOutput: "Design document: indexOwnerDoc, Name: indexOwner, Definition: {\"fields\":[{\"owner\":\"asc\"}]}\n",
+		},
+	})
+}
+
+func TestClientErrors(t *testing.T) {
+	args := map[string][]string{
+		"list":    {"-C", "mychannel", "-n", "mycc"},
+		"create":  {"-C", "mychannel", "-n", "mycc", "-d", `{"index":{"fields":["owner"]}}`},
+		"drop":    {"-C", "mychannel", "-n", "mycc", "--designDoc", "indexOwnerDoc", "--indexName", "indexOwner"},
+		"explain": {"-C", "mychannel", "-n", "mycc", "-q", `{"selector":{"owner":"tom"}}`},
+	}
+	for command, commandArgs := range args {
+		cmd := initIndexTest(command, errors.New("admin-error"))
+		cmd.SetArgs(commandArgs)
+		assert.EqualError(t, cmd.Execute(), "admin-error", command)
+	}
+}
+
+func TestIndexCmd(t *testing.T) {
+	cmd := Cmd(nil)
+	var names []string
+	for _, subCmd := range cmd.Commands() {
+		names = append(names, subCmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "create", "drop", "explain"}, names)
+}
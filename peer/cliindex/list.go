@@ -0,0 +1,53 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cliindex
+
+import (
+	"context"
+
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/spf13/cobra"
+)
+
+func listCmd(cf *IndexCmdFactory) *cobra.Command {
+	var indexListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Lists the indexes of a chaincode.",
+		Long:  `Lists the state database indexes of a chaincode (or of a private data collection of the chaincode) on a channel.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return list(cf, cmd, args)
+		},
+	}
+	addCommonFlags(indexListCmd)
+
+	return indexListCmd
+}
+
+func list(cf *IndexCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkIndexCmdParams(args); err != nil {
+		return err
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newIndexOperation(&pb.IndexRequest{}))
+	resp, err := cf.AdminClient.ListIndexes(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	for _, index := range resp.Indexes {
+		printIndex(cmd.OutOrStdout(), index)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/common/crypto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+)
+
+type envelopeWrapper func(msg proto.Message) *common2.Envelope
+
+// PvtdataCmdFactory holds the clients used by PvtdataCmd
+type PvtdataCmdFactory struct {
+	AdminClient      pb.AdminClient
+	wrapWithEnvelope envelopeWrapper
+}
+
+// InitCmdFactory init the PvtdataCmdFactory with default admin client
+func InitCmdFactory() (*PvtdataCmdFactory, error) {
+	adminClient, err := common.GetAdminClient()
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := common.GetDefaultSignerFnc()
+	if err != nil {
+		return nil, errors.Errorf("failed obtaining default signer: %v", err)
+	}
+
+	localSigner := crypto.NewSignatureHeaderCreator(signer)
+	wrapEnv := func(msg proto.Message) *common2.Envelope {
+		env, err := utils.CreateSignedEnvelope(common2.HeaderType_PEER_ADMIN_OPERATION, "", localSigner, msg, 0, 0)
+		if err != nil {
+			logger.Panicf("Failed signing: %v", err)
+		}
+		return env
+	}
+
+	return &PvtdataCmdFactory{
+		AdminClient:      adminClient,
+		wrapWithEnvelope: wrapEnv,
+	}, nil
+}
+
+// checkPvtdataCmdParams checks the parameters that are common to all the pvtdata commands
+func checkPvtdataCmdParams(args []string) error {
+	if len(args) > 0 {
+		return errors.Errorf("more parameters than necessary were provided. Expected 0, received %d", len(args))
+	}
+	if channelID == common.UndefinedParamValue {
+		return errors.New("must supply channel ID")
+	}
+	if startBlock > endBlock {
+		return errors.Errorf("the start block [%d] is greater than the end block [%d]", startBlock, endBlock)
+	}
+	return nil
+}
+
+// newPvtdataOperation wraps a PvtDataRequest built from the command parameters in an AdminOperation
+func newPvtdataOperation() *pb.AdminOperation {
+	return &pb.AdminOperation{
+		Content: &pb.AdminOperation_PvtDataReq{
+			PvtDataReq: &pb.PvtDataRequest{
+				ChannelId:  channelID,
+				StartBlock: startBlock,
+				EndBlock:   endBlock,
+				Namespace:  chaincodeName,
+				Collection: collection,
+			},
+		},
+	}
+}
+
+func printReconciliationStatus(w io.Writer, status *pb.ReconciliationStatus) {
+	if status == nil {
+		fmt.Fprintln(w, "Last reconciliation attempt: none")
+		return
+	}
+	startTime := time.Unix(status.StartTime.GetSeconds(), int64(status.StartTime.GetNanos())).UTC()
+	fmt.Fprintf(w, "Last reconciliation attempt: Started: %s, Duration: %s, Reconciled: %d",
+		startTime.Format(time.RFC3339), time.Duration(status.DurationMillis)*time.Millisecond, status.Reconciled)
+	if status.Error != "" {
+		fmt.Fprintf(w, ", Error: %s", status.Error)
+	}
+	fmt.Fprintln(w)
+}
+
+func printMissingPvtData(w io.Writer, missingPvtData []*pb.MissingPvtData) {
+	for _, missing := range missingPvtData {
+		status := "ineligible"
+		if missing.Unrecoverable {
+			status = "unrecoverable"
+		} else if missing.Eligible {
+			status = "eligible"
+		}
+		fmt.Fprintf(w, "Block: %d, Transaction: %d, Chaincode: %s, Collection: %s, Status: %s\n",
+			missing.BlockNum, missing.TxNum, missing.Namespace, missing.Collection, status)
+	}
+}
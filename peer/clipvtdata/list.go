@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
+
+func listCmd(cf *PvtdataCmdFactory) *cobra.Command {
+	var pvtdataListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Lists the missing private data.",
+		Long:  `Lists the private data that is missing on a channel within a range of blocks, along with the outcome of the last reconciliation attempt. The missing private data is eligible if the peer is a member of the collection, ineligible otherwise, and unrecoverable if it has been marked as such. Only the eligible private data is reconciled.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return list(cf, cmd, args)
+		},
+	}
+	addCommonFlags(pvtdataListCmd)
+	addNamespaceFlags(pvtdataListCmd)
+
+	return pvtdataListCmd
+}
+
+func list(cf *PvtdataCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkPvtdataCmdParams(args); err != nil {
+		return err
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newPvtdataOperation())
+	resp, err := cf.AdminClient.ListMissingPvtData(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	printReconciliationStatus(cmd.OutOrStdout(), resp.ReconciliationStatus)
+	printMissingPvtData(cmd.OutOrStdout(), resp.MissingPvtData)
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func markUnrecoverableCmd(cf *PvtdataCmdFactory) *cobra.Command {
+	var pvtdataMarkUnrecoverableCmd = &cobra.Command{
+		Use:   "markunrecoverable",
+		Short: "Marks the missing private data as unrecoverable.",
+		Long:  `Marks the eligible private data that is missing on a channel within a range of blocks as unrecoverable, so that the peer stops trying to reconcile it. This is meant for private data that is known to be unavailable on all the other peers. The private data marked as unrecoverable is still stored by the peer if it is delivered by a reconciliation that was already running, in which case it is no longer reported as missing.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return markUnrecoverable(cf, cmd, args)
+		},
+	}
+	addCommonFlags(pvtdataMarkUnrecoverableCmd)
+	addNamespaceFlags(pvtdataMarkUnrecoverableCmd)
+
+	return pvtdataMarkUnrecoverableCmd
+}
+
+func markUnrecoverable(cf *PvtdataCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkPvtdataCmdParams(args); err != nil {
+		return err
+	}
+	// the range is required so that the private data of new blocks is not marked inadvertently
+	if !cmd.Flags().Changed("endBlock") {
+		return errors.New("must supply the end block")
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newPvtdataOperation())
+	resp, err := cf.AdminClient.MarkPvtDataUnrecoverable(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	printMissingPvtData(cmd.OutOrStdout(), resp.MissingPvtData)
+	logger.Infof("Marked %d missing private data items as unrecoverable", len(resp.MissingPvtData))
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hyperledger/fabric/common/flogging"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pvtdataFuncName = "pvtdata"
+	pvtdataCmdDes   = "Inspect and drive the reconciliation of the missing private data: list|reconcile|markunrecoverable."
+)
+
+var logger = flogging.MustGetLogger("cli.pvtdata")
+
+// Pvtdata-related variables.
+var (
+	channelID     string
+	startBlock    uint64
+	endBlock      uint64
+	chaincodeName string
+	collection    string
+)
+
+// Cmd returns the cobra command for Pvtdata
+func Cmd(cf *PvtdataCmdFactory) *cobra.Command {
+	pvtdataCmd.AddCommand(listCmd(cf))
+	pvtdataCmd.AddCommand(reconcileCmd(cf))
+	pvtdataCmd.AddCommand(markUnrecoverableCmd(cf))
+
+	return pvtdataCmd
+}
+
+var pvtdataCmd = &cobra.Command{
+	Use:              pvtdataFuncName,
+	Short:            fmt.Sprint(pvtdataCmdDes),
+	Long:             fmt.Sprint(pvtdataCmdDes),
+	PersistentPreRun: common.InitCmd,
+}
+
+// addCommonFlags adds the flags that identify the channel and the range of blocks the command applies to
+func addCommonFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "C", common.UndefinedParamValue, "The channel on which this command should be executed")
+	flags.Uint64VarP(&startBlock, "startBlock", "", 0, "The first block of the range of blocks")
+	flags.Uint64VarP(&endBlock, "endBlock", "", math.MaxUint64, "The last block of the range of blocks. If not specified, the range ends with the most recent block")
+}
+
+// addNamespaceFlags adds the flags that restrict the command to the private data of a chaincode (or of a collection)
+func addNamespaceFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringVarP(&chaincodeName, "name", "n", "", "Name of the chaincode. If not specified, the command applies to all the chaincodes")
+	flags.StringVarP(&collection, "collection", "", "", "Name of the private data collection. If not specified, the command applies to all the collections")
+}
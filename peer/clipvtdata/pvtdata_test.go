@@ -0,0 +1,129 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/peer/common"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCase struct {
+	name           string
+	args           []string
+	expectedErr    string
+	expectedOutput string
+}
+
+func initPvtdataTest(command string, clientErr error) *cobra.Command {
+	mockCF := &PvtdataCmdFactory{
+		AdminClient: common.GetMockAdminClient(clientErr),
+		wrapWithEnvelope: func(msg proto.Message) *common2.Envelope {
+			pl := &common2.Payload{
+				Data: utils.MarshalOrPanic(msg),
+			}
+			env := &common2.Envelope{
+				Payload: utils.MarshalOrPanic(pl),
+			}
+			return env
+		},
+	}
+	var cmd *cobra.Command
+	switch command {
+	case "list":
+		cmd = listCmd(mockCF)
+	case "reconcile":
+		cmd = reconcileCmd(mockCF)
+	case "markunrecoverable":
+		cmd = markUnrecoverableCmd(mockCF)
+	}
+	return cmd
+}
+
+func runTests(t *testing.T, command string, tc []testCase) {
+	for _, test := range tc {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := initPvtdataTest(command, nil)
+			buffer := &bytes.Buffer{}
+			cmd.SetOutput(buffer)
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOutput, buffer.String())
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	runTests(t, "list", []testCase{
+		{name: "NoChannel", args: []string{"-n", "mycc"}, expectedErr: "must supply channel ID"},
+		{name: "ExtraParameters", args: []string{"-C", "mychannel", "extra"}, expectedErr: "more parameters than necessary were provided. Expected 0, received 1"},
+		{name: "InvalidRange", args: []string{"-C", "mychannel", "--startBlock", "10", "--endBlock", "5"}, expectedErr: "the start block [10] is greater than the end block [5]"},
+		{
+			name: "Valid",
+			args: []string{"-C", "mychannel", "-n", "mycc", "--collection", "coll1"},
+			expectedOutput: "Last reconciliation attempt: Started: 1970-01-01T00:00:00Z, Duration: 10ms, Reconciled: 2\n" +
+				"Block: 5, Transaction: 1, Chaincode: mycc, Collection: coll1, Status: eligible\n",
+		},
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	runTests(t, "reconcile", []testCase{
+		{name: "NoChannel", args: []string{"--startBlock", "1"}, expectedErr: "must supply channel ID"},
+		{
+			name:           "Valid",
+			args:           []string{"-C", "mychannel", "--startBlock", "1", "--endBlock", "10"},
+			expectedOutput: "Last reconciliation attempt: Started: 1970-01-01T00:00:00Z, Duration: 10ms, Reconciled: 1\n",
+		},
+	})
+}
+
+func TestMarkUnrecoverable(t *testing.T) {
+	runTests(t, "markunrecoverable", []testCase{
+		{name: "NoChannel", args: []string{"--endBlock", "10"}, expectedErr: "must supply channel ID"},
+		{name: "NoEndBlock", args: []string{"-C", "mychannel", "--startBlock", "1"}, expectedErr: "must supply the end block"},
+		{
+			name:           "Valid",
+			args:           []string{"-C", "mychannel", "-n", "mycc", "--collection", "coll1", "--startBlock", "5", "--endBlock", "5"},
+			expectedOutput: "Block: 5, Transaction: 1, Chaincode: mycc, Collection: coll1, Status: unrecoverable\n",
+		},
+	})
+}
+
+func TestClientErrors(t *testing.T) {
+	args := map[string][]string{
+		"list":              {"-C", "mychannel"},
+		"reconcile":         {"-C", "mychannel"},
+		"markunrecoverable": {"-C", "mychannel", "--endBlock", "10"},
+	}
+	for command, commandArgs := range args {
+		cmd := initPvtdataTest(command, errors.New("admin-error"))
+		cmd.SetArgs(commandArgs)
+		assert.EqualError(t, cmd.Execute(), "admin-error", command)
+	}
+}
+
+func TestPvtdataCmd(t *testing.T) {
+	cmd := Cmd(nil)
+	var names []string
+	for _, subCmd := range cmd.Commands() {
+		names = append(names, subCmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"list", "reconcile", "markunrecoverable"}, names)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clipvtdata
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+)
+
+func reconcileCmd(cf *PvtdataCmdFactory) *cobra.Command {
+	var pvtdataReconcileCmd = &cobra.Command{
+		Use:   "reconcile",
+		Short: "Reconciles the missing private data immediately.",
+		Long:  `Fetches from the other peers the eligible private data that is missing on a channel within a range of blocks, without waiting for the next scheduled reconciliation. The outcome of the reconciliation is reported along with the private data that is still missing. The chaincode and collection flags only restrict the listing of the private data that is still missing. This command requires the reconciliation of private data to be enabled on the peer.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return reconcile(cf, cmd, args)
+		},
+	}
+	addCommonFlags(pvtdataReconcileCmd)
+	addNamespaceFlags(pvtdataReconcileCmd)
+
+	return pvtdataReconcileCmd
+}
+
+func reconcile(cf *PvtdataCmdFactory, cmd *cobra.Command, args []string) error {
+	if err := checkPvtdataCmdParams(args); err != nil {
+		return err
+	}
+	// Parsing of the command line is done so silence cmd usage
+	cmd.SilenceUsage = true
+
+	if cf == nil {
+		var err error
+		cf, err = InitCmdFactory()
+		if err != nil {
+			return err
+		}
+	}
+	env := cf.wrapWithEnvelope(newPvtdataOperation())
+	resp, err := cf.AdminClient.ReconcilePvtData(context.Background(), env)
+	if err != nil {
+		return err
+	}
+	printReconciliationStatus(cmd.OutOrStdout(), resp.ReconciliationStatus)
+	printMissingPvtData(cmd.OutOrStdout(), resp.MissingPvtData)
+	return nil
+}
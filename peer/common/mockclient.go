@@ -113,3 +113,46 @@ func (m *mockAdminClient) SetLogSpec(ctx context.Context, in *cb.Envelope, opts
 	response := &pb.LogSpecResponse{LogSpec: "info"}
 	return response, m.err
 }
+
+func (m *mockAdminClient) ListIndexes(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.IndexesResponse, error) {
+	response := &pb.IndexesResponse{Indexes: []*pb.StateDBIndex{{DesignDoc: "indexOwnerDoc", Name: "indexOwner", Definition: `{"fields":[{"owner":"asc"}]}`}}}
+	return response, m.err
+}
+
+func (m *mockAdminClient) CreateIndex(ctx context.Context, env *cb.Envelope, opts ...grpc.CallOption) (*pb.IndexesResponse, error) {
+	op := &pb.AdminOperation{}
+	pl := &cb.Payload{}
+	proto.Unmarshal(env.Payload, pl)
+	proto.Unmarshal(pl.Data, op)
+	response := &pb.IndexesResponse{Indexes: []*pb.StateDBIndex{{DesignDoc: "indexOwnerDoc", Name: "indexOwner", Definition: op.GetIndexReq().IndexDefinition}}}
+	return response, m.err
+}
+
+func (m *mockAdminClient) DropIndex(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, m.err
+}
+
+func (m *mockAdminClient) ExplainQuery(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.IndexesResponse, error) {
+	response := &pb.IndexesResponse{Indexes: []*pb.StateDBIndex{{DesignDoc: "indexOwnerDoc", Name: "indexOwner", Definition: `{"fields":[{"owner":"asc"}]}`}}}
+	return response, m.err
+}
+
+func (m *mockAdminClient) ListMissingPvtData(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.PvtDataResponse, error) {
+	response := &pb.PvtDataResponse{
+		MissingPvtData:       []*pb.MissingPvtData{{BlockNum: 5, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true}},
+		ReconciliationStatus: &pb.ReconciliationStatus{DurationMillis: 10, Reconciled: 2},
+	}
+	return response, m.err
+}
+
+func (m *mockAdminClient) ReconcilePvtData(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.PvtDataResponse, error) {
+	response := &pb.PvtDataResponse{ReconciliationStatus: &pb.ReconciliationStatus{DurationMillis: 10, Reconciled: 1}}
+	return response, m.err
+}
+
+func (m *mockAdminClient) MarkPvtDataUnrecoverable(ctx context.Context, in *cb.Envelope, opts ...grpc.CallOption) (*pb.PvtDataResponse, error) {
+	response := &pb.PvtDataResponse{
+		MissingPvtData: []*pb.MissingPvtData{{BlockNum: 5, TxNum: 1, Namespace: "mycc", Collection: "coll1", Eligible: true, Unrecoverable: true}},
+	}
+	return response, m.err
+}
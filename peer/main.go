@@ -13,7 +13,9 @@ import (
 
 	"github.com/hyperledger/fabric/peer/chaincode"
 	"github.com/hyperledger/fabric/peer/channel"
+	"github.com/hyperledger/fabric/peer/cliindex"
 	"github.com/hyperledger/fabric/peer/clilogging"
+	"github.com/hyperledger/fabric/peer/clipvtdata"
 	"github.com/hyperledger/fabric/peer/common"
 	"github.com/hyperledger/fabric/peer/node"
 	"github.com/hyperledger/fabric/peer/version"
@@ -46,6 +48,8 @@ func main() {
 	mainCmd.AddCommand(node.Cmd())
 	mainCmd.AddCommand(chaincode.Cmd(nil))
 	mainCmd.AddCommand(clilogging.Cmd(nil))
+	mainCmd.AddCommand(cliindex.Cmd(nil))
+	mainCmd.AddCommand(clipvtdata.Cmd(nil))
 	mainCmd.AddCommand(channel.Cmd(nil))
 
 	// On failure Cobra prints the usage message and error string, so we only
@@ -169,6 +169,18 @@ type PeerLedger struct {
 		result1 []*ledger.TxPvtData
 		result2 error
 	}
+	GetStateDBIndexManagerStub        func() (ledger.StateDBIndexManager, error)
+	getStateDBIndexManagerMutex       sync.RWMutex
+	getStateDBIndexManagerArgsForCall []struct {
+	}
+	getStateDBIndexManagerReturns struct {
+		result1 ledger.StateDBIndexManager
+		result2 error
+	}
+	getStateDBIndexManagerReturnsOnCall map[int]struct {
+		result1 ledger.StateDBIndexManager
+		result2 error
+	}
 	GetTransactionByIDStub        func(string) (*peer.ProcessedTransaction, error)
 	getTransactionByIDMutex       sync.RWMutex
 	getTransactionByIDArgsForCall []struct {
@@ -1035,6 +1047,61 @@ func (fake *PeerLedger) GetPvtDataByNumReturnsOnCall(i int, result1 []*ledger.Tx
 	}{result1, result2}
 }
 
+func (fake *PeerLedger) GetStateDBIndexManager() (ledger.StateDBIndexManager, error) {
+	fake.getStateDBIndexManagerMutex.Lock()
+	ret, specificReturn := fake.getStateDBIndexManagerReturnsOnCall[len(fake.getStateDBIndexManagerArgsForCall)]
+	fake.getStateDBIndexManagerArgsForCall = append(fake.getStateDBIndexManagerArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetStateDBIndexManager", []interface{}{})
+	fake.getStateDBIndexManagerMutex.Unlock()
+	if fake.GetStateDBIndexManagerStub != nil {
+		return fake.GetStateDBIndexManagerStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getStateDBIndexManagerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *PeerLedger) GetStateDBIndexManagerCallCount() int {
+	fake.getStateDBIndexManagerMutex.RLock()
+	defer fake.getStateDBIndexManagerMutex.RUnlock()
+	return len(fake.getStateDBIndexManagerArgsForCall)
+}
+
+func (fake *PeerLedger) GetStateDBIndexManagerCalls(stub func() (ledger.StateDBIndexManager, error)) {
+	fake.getStateDBIndexManagerMutex.Lock()
+	defer fake.getStateDBIndexManagerMutex.Unlock()
+	fake.GetStateDBIndexManagerStub = stub
+}
+
+func (fake *PeerLedger) GetStateDBIndexManagerReturns(result1 ledger.StateDBIndexManager, result2 error) {
+	fake.getStateDBIndexManagerMutex.Lock()
+	defer fake.getStateDBIndexManagerMutex.Unlock()
+	fake.GetStateDBIndexManagerStub = nil
+	fake.getStateDBIndexManagerReturns = struct {
+		result1 ledger.StateDBIndexManager
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *PeerLedger) GetStateDBIndexManagerReturnsOnCall(i int, result1 ledger.StateDBIndexManager, result2 error) {
+	fake.getStateDBIndexManagerMutex.Lock()
+	defer fake.getStateDBIndexManagerMutex.Unlock()
+	fake.GetStateDBIndexManagerStub = nil
+	if fake.getStateDBIndexManagerReturnsOnCall == nil {
+		fake.getStateDBIndexManagerReturnsOnCall = make(map[int]struct {
+			result1 ledger.StateDBIndexManager
+			result2 error
+		})
+	}
+	fake.getStateDBIndexManagerReturnsOnCall[i] = struct {
+		result1 ledger.StateDBIndexManager
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *PeerLedger) GetTransactionByID(arg1 string) (*peer.ProcessedTransaction, error) {
 	fake.getTransactionByIDMutex.Lock()
 	ret, specificReturn := fake.getTransactionByIDReturnsOnCall[len(fake.getTransactionByIDArgsForCall)]
@@ -1538,6 +1605,8 @@ func (fake *PeerLedger) Invocations() map[string][][]interface{} {
 	defer fake.getPvtDataAndBlockByNumMutex.RUnlock()
 	fake.getPvtDataByNumMutex.RLock()
 	defer fake.getPvtDataByNumMutex.RUnlock()
+	fake.getStateDBIndexManagerMutex.RLock()
+	defer fake.getStateDBIndexManagerMutex.RUnlock()
 	fake.getTransactionByIDMutex.RLock()
 	defer fake.getTransactionByIDMutex.RUnlock()
 	fake.getTxValidationCodeByTxIDMutex.RLock()
@@ -37,6 +37,11 @@ func Cmd() *cobra.Command {
 	nodeCmd.AddCommand(statusCmd())
 	nodeCmd.AddCommand(resetCmd())
 	nodeCmd.AddCommand(rollbackCmd())
+	nodeCmd.AddCommand(snapshotCmd())
+	nodeCmd.AddCommand(verifyLedgerCmd())
+	nodeCmd.AddCommand(rebuildDBsCmd())
+	nodeCmd.AddCommand(pauseCmd())
+	nodeCmd.AddCommand(resumeCmd())
 
 	return nodeCmd
 }
@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func pauseCmd() *cobra.Command {
+	nodePauseCmd.ResetFlags()
+	flags := nodePauseCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel to pause.")
+
+	return nodePauseCmd
+}
+
+var nodePauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pauses a channel on the peer.",
+	Long:  `Pauses a channel on the peer. When the command is executed, the peer must be offline. When the peer starts after the pause, it does not load the paused channel and hence, it neither receives the blocks of the channel nor serves the requests for the channel, while the other channels keep running. The channel can be resumed via the command 'peer node resume'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if channelID == common.UndefinedParamValue {
+			return errors.New("Must supply channel ID")
+		}
+		return kvledger.PauseChannel(channelID)
+	},
+}
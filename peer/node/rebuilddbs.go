@@ -0,0 +1,83 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/core/chaincode/platforms"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/car"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/golang"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/java"
+	"github.com/hyperledger/fabric/core/chaincode/platforms/node"
+	"github.com/hyperledger/fabric/core/common/privdata"
+	"github.com/hyperledger/fabric/core/ledger/ledgermgmt"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/core/scc/lscc"
+	"github.com/hyperledger/fabric/msp"
+	"github.com/hyperledger/fabric/msp/mgmt"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	rebuildChannelIDs []string
+	targetStateDB     string
+)
+
+func rebuildDBsCmd() *cobra.Command {
+	nodeRebuildDBsCmd.ResetFlags()
+	flags := nodeRebuildDBsCmd.Flags()
+	flags.StringSliceVarP(&rebuildChannelIDs, "channelID", "c", nil,
+		"Channels whose databases are to be rebuilt. The flag can be repeated or take a comma separated list. If not supplied, the databases of all the channels are rebuilt.")
+	flags.StringVarP(&targetStateDB, "stateDatabase", "s", "",
+		"State database (goleveldb, CouchDB, or the name of an external state database) in which the state is to be rebuilt. Defaults to the one configured via 'ledger.state.stateDatabase'.")
+
+	return nodeRebuildDBsCmd
+}
+
+var nodeRebuildDBsCmd = &cobra.Command{
+	Use:   "rebuild-dbs",
+	Short: "Rebuilds the databases of the channels.",
+	Long:  `Drops the state database, the history database, the config history database, and the bookkeeping database of the specified channels (or of all the channels) and rebuilds them by recommitting the blocks from the block store. The state may be rebuilt in a state database other than the one used earlier, in which case the peer configuration must be updated accordingly before starting the peer. The progress is logged and reported via the recovery metrics of the ledger. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if targetStateDB != "" {
+			viper.Set("ledger.state.stateDatabase", targetStateDB)
+		}
+		return rebuildDBs(rebuildChannelIDs)
+	},
+}
+
+func rebuildDBs(channelIDs []string) error {
+	// the operations system is started so that the progress of the rebuild can be tracked via the metrics
+	opsSystem := newOperationsSystem()
+	if err := opsSystem.Start(); err != nil {
+		return errors.WithMessage(err, "failed to initialize operations subystems")
+	}
+	defer opsSystem.Stop()
+
+	identityDeserializerFactory := func(chainID string) msp.IdentityDeserializer {
+		return mgmt.GetManagerForChain(chainID)
+	}
+	membershipInfoProvider := privdata.NewMembershipInfoProvider(viper.GetString("peer.localMspId"), createSelfSignedData(), identityDeserializerFactory)
+
+	return ledgermgmt.RebuildDBs(
+		&ledgermgmt.Initializer{
+			CustomTxProcessors: peer.ConfigTxProcessors,
+			PlatformRegistry: platforms.NewRegistry(
+				&golang.Platform{},
+				&node.Platform{},
+				&java.Platform{},
+				&car.Platform{},
+			),
+			DeployedChaincodeInfoProvider: &lscc.DeployedCCInfoProvider{},
+			MembershipInfoProvider:        membershipInfoProvider,
+			MetricsProvider:               opsSystem.Provider,
+			HealthCheckRegistry:           opsSystem,
+		},
+		channelIDs,
+	)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	msptesttools "github.com/hyperledger/fabric/msp/mgmt/testtools"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildDBsCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "rebuilddbscmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	viper.Set("operations.listenAddress", "127.0.0.1:0")
+	defer viper.Reset()
+	require.NoError(t, msptesttools.LoadMSPSetupForTesting())
+
+	t.Run("when the specified channelID does not exist", func(t *testing.T) {
+		cmd := rebuildDBsCmd()
+		cmd.SetArgs([]string{"-c", "ch1"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "cannot rebuild the databases of the ledger [ch1]: LedgerID does not exist")
+	})
+
+	t.Run("when the specified state database is unknown", func(t *testing.T) {
+		cmd := rebuildDBsCmd()
+		cmd.SetArgs([]string{"-s", "unknowndb"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "unknown state database [unknowndb]: it is neither registered nor is a plugin library configured")
+		assert.Equal(t, "unknowndb", viper.GetString("ledger.state.stateDatabase"))
+	})
+}
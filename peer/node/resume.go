@@ -0,0 +1,34 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func resumeCmd() *cobra.Command {
+	nodeResumeCmd.ResetFlags()
+	flags := nodeResumeCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel to resume.")
+
+	return nodeResumeCmd
+}
+
+var nodeResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes a channel on the peer.",
+	Long:  `Resumes a channel on the peer that has been paused via the command 'peer node pause'. When the command is executed, the peer must be offline. When the peer starts after the resume, it loads the channel and receives the blocks of the channel that it missed while the channel was paused.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if channelID == common.UndefinedParamValue {
+			return errors.New("Must supply channel ID")
+		}
+		return kvledger.ResumeChannel(channelID)
+	},
+}
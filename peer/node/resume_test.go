@@ -0,0 +1,39 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "resumecmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+
+	t.Run("when the channelID is not supplied", func(t *testing.T) {
+		cmd := resumeCmd()
+		cmd.SetArgs([]string{})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "Must supply channel ID")
+	})
+
+	t.Run("when the specified channelID does not exist", func(t *testing.T) {
+		cmd := resumeCmd()
+		cmd.SetArgs([]string{"-c", "ch1"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "cannot resume the channel [ch1]: LedgerID does not exist")
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/hyperledger/fabric/peer/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var snapshotDir string
+
+func snapshotCmd() *cobra.Command {
+	nodeSnapshotCmd.ResetFlags()
+	flags := nodeSnapshotCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel to generate the snapshot for.")
+	flags.StringVarP(&snapshotDir, "snapshotDir", "o", common.UndefinedParamValue, "Directory in which the snapshot is to be generated. The directory must either be empty or not exist.")
+
+	return nodeSnapshotCmd
+}
+
+var nodeSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Generates a snapshot of a channel.",
+	Long:  `Generates a snapshot of the ledger of a channel at its current height. The snapshot contains the public state, the hashes of the private state, the collection config history, and the blocks starting from the last config block. The files in the snapshot are accompanied by their hashes so that the snapshots generated by the peers of different organizations can be compared. Another peer can join the channel using the snapshot via the command 'peer channel joinbysnapshot'. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if channelID == common.UndefinedParamValue {
+			return errors.New("Must supply channel ID")
+		}
+		if snapshotDir == common.UndefinedParamValue {
+			return errors.New("Must supply snapshot directory")
+		}
+		return kvledger.GenerateSnapshot(channelID, snapshotDir)
+	},
+}
@@ -0,0 +1,46 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "snapshotcmd")
+	assert.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+
+	t.Run("when the channelID is not supplied", func(t *testing.T) {
+		cmd := snapshotCmd()
+		cmd.SetArgs([]string{"-o", filepath.Join(testPath, "snapshot")})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "Must supply channel ID")
+	})
+
+	t.Run("when the snapshot directory is not supplied", func(t *testing.T) {
+		cmd := snapshotCmd()
+		cmd.SetArgs([]string{"-c", "ch1"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "Must supply snapshot directory")
+	})
+
+	t.Run("when the specified channelID does not exist", func(t *testing.T) {
+		cmd := snapshotCmd()
+		cmd.SetArgs([]string{"-c", "ch1", "-o", filepath.Join(testPath, "snapshot")})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "LedgerID does not exist")
+	})
+}
@@ -76,6 +76,7 @@ import (
 	"github.com/hyperledger/fabric/discovery/support/config"
 	"github.com/hyperledger/fabric/discovery/support/gossip"
 	gossipcommon "github.com/hyperledger/fabric/gossip/common"
+	gossipprivdata "github.com/hyperledger/fabric/gossip/privdata"
 	"github.com/hyperledger/fabric/gossip/service"
 	"github.com/hyperledger/fabric/msp"
 	"github.com/hyperledger/fabric/msp/mgmt"
@@ -178,6 +179,12 @@ func serve(args []string) error {
 	logObserver := floggingmetrics.NewObserver(metricsProvider)
 	flogging.Global.SetObserver(logObserver)
 
+	// the missing private data of the channels is managed on the operations endpoint as well
+	pvtDataHandler := admin.NewPvtDataHandler(&pvtDataReconciliationSupport{})
+	for _, path := range []string{admin.MissingPvtDataPath, admin.ReconcilePvtDataPath, admin.UnrecoverablePvtDataPath} {
+		opsSystem.RegisterHandler(path, pvtDataHandler)
+	}
+
 	membershipInfoProvider := privdata.NewMembershipInfoProvider(mspID, createSelfSignedData(), identityDeserializerFactory)
 	//initialize resource management exit
 	ledgermgmt.Initialize(
@@ -838,7 +845,35 @@ func startAdminServer(peerListenAddr string, peerServer *grpc.Server, baseServer
 		}()
 	}
 
-	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy))
+	pb.RegisterAdminServer(gRPCService, admin.NewAdminServer(adminPolicy,
+		admin.StateDBIndexManagerProviderFunc(getStateDBIndexManager), &pvtDataReconciliationSupport{}))
+}
+
+// getStateDBIndexManager returns the StateDBIndexManager of the ledger of the given channel
+func getStateDBIndexManager(channelID string) (ledger.StateDBIndexManager, error) {
+	l := peer.GetLedger(channelID)
+	if l == nil {
+		return nil, errors.Errorf("channel [%s] does not exist", channelID)
+	}
+	return l.GetStateDBIndexManager()
+}
+
+// pvtDataReconciliationSupport provides the admin service and the operations endpoint with the MissingPvtDataTracker
+// and the private data reconciler of the channels the peer has joined
+type pvtDataReconciliationSupport struct{}
+
+// GetMissingPvtDataTracker returns the MissingPvtDataTracker of the ledger of the given channel
+func (*pvtDataReconciliationSupport) GetMissingPvtDataTracker(channelID string) (ledger.MissingPvtDataTracker, error) {
+	l := peer.GetLedger(channelID)
+	if l == nil {
+		return nil, errors.Errorf("channel [%s] does not exist", channelID)
+	}
+	return l.GetMissingPvtDataTracker()
+}
+
+// GetPvtDataReconciler returns the reconciler of the missing private data of the given channel
+func (*pvtDataReconciliationSupport) GetPvtDataReconciler(channelID string) (gossipprivdata.PvtDataReconciler, error) {
+	return service.GetGossipService().PvtDataReconciler(channelID)
 }
 
 // secureDialOpts is the callback function for secure dial options for gossip service
@@ -0,0 +1,116 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/core/ledger/customtx"
+	"github.com/hyperledger/fabric/core/ledger/kvledger"
+	"github.com/hyperledger/fabric/core/peer"
+	"github.com/hyperledger/fabric/core/scc/lscc"
+	"github.com/hyperledger/fabric/peer/common"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var referenceBlocksDir string
+
+func verifyLedgerCmd() *cobra.Command {
+	nodeVerifyLedgerCmd.ResetFlags()
+	flags := nodeVerifyLedgerCmd.Flags()
+	flags.StringVarP(&channelID, "channelID", "c", common.UndefinedParamValue, "Channel to verify.")
+	flags.StringVarP(&referenceBlocksDir, "referenceBlocks", "r", "", "Directory containing the blocks of the channel obtained from another peer "+
+		"(e.g., via the function GetBlockByNumber of qscc), one block per file. The commit hashes in these blocks are compared with the ones of this peer.")
+
+	return nodeVerifyLedgerCmd
+}
+
+var nodeVerifyLedgerCmd = &cobra.Command{
+	Use:   "verify-ledger",
+	Short: "Verifies the integrity of the ledger of a channel.",
+	Long:  `Verifies the integrity of the ledger of a channel. The block hash chain and the data hashes of the blocks are recomputed from the block files. The blocks are replayed to recompute the commit hashes, which are matched against the ones stored in the blocks, and the state database and the history database are checked against the replayed blocks. Optionally, the commit hashes are compared with the ones in the blocks obtained from another peer. The first divergent block, if any, is reported. When the command is executed, the peer must be offline.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if channelID == common.UndefinedParamValue {
+			return errors.New("Must supply channel ID")
+		}
+		return verifyLedger(cmd.OutOrStdout(), channelID, referenceBlocksDir)
+	},
+}
+
+func verifyLedger(out io.Writer, channelID, referenceBlocksDir string) error {
+	referenceCommitHashes, err := loadReferenceCommitHashes(referenceBlocksDir)
+	if err != nil {
+		return err
+	}
+	// the custom transaction processors are initialized in the same way as in the peer so that the
+	// blocks are replayed in the same way as they were committed
+	customtx.Initialize(peer.ConfigTxProcessors)
+	result, err := kvledger.VerifyLedger(channelID, &lscc.DeployedCCInfoProvider{}, referenceCommitHashes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Verified the blocks [%d] to [%d] of the channel [%s]\n", result.FirstBlockNum, result.LastBlockNum, channelID)
+	fmt.Fprintf(out, "Recomputed commit hashes matching the stored ones: %d\n", result.NumVerifiedCommitHashes)
+	if referenceBlocksDir != "" {
+		fmt.Fprintf(out, "Commit hashes compared with the reference blocks: %d\n", result.NumComparedReferenceCommitHashes)
+	}
+	for _, note := range result.Notes {
+		fmt.Fprintf(out, "Note: %s\n", note)
+	}
+	if result.Divergence != nil {
+		return errors.Errorf("the ledger of the channel [%s] diverges at the block [%d]: %s",
+			channelID, result.Divergence.BlockNum, result.Divergence.Reason)
+	}
+	fmt.Fprintln(out, "No divergence found")
+	return nil
+}
+
+// loadReferenceCommitHashes reads the blocks present in the given directory and returns the commit hashes
+// present in their metadata, indexed by the block number
+func loadReferenceCommitHashes(dir string) (map[uint64][]byte, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the reference blocks directory [%s]", dir)
+	}
+	commitHashes := map[uint64][]byte{}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		filePath := filepath.Join(dir, f.Name())
+		blockBytes, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading the reference block file [%s]", filePath)
+		}
+		block := &cb.Block{}
+		if err := proto.Unmarshal(blockBytes, block); err != nil {
+			return nil, errors.Wrapf(err, "error unmarshaling the reference block file [%s]", filePath)
+		}
+		if block.Header == nil || block.Metadata == nil || len(block.Metadata.Metadata) <= int(cb.BlockMetadataIndex_COMMIT_HASH) {
+			return nil, errors.Errorf("the reference block file [%s] does not contain a commit hash", filePath)
+		}
+		commitHash := &cb.Metadata{}
+		if err := proto.Unmarshal(block.Metadata.Metadata[cb.BlockMetadataIndex_COMMIT_HASH], commitHash); err != nil {
+			return nil, errors.Wrapf(err, "error unmarshaling the commit hash in the reference block file [%s]", filePath)
+		}
+		if len(commitHash.Value) == 0 {
+			return nil, errors.Errorf("the reference block file [%s] does not contain a commit hash", filePath)
+		}
+		commitHashes[block.Header.Number] = commitHash.Value
+	}
+	return commitHashes, nil
+}
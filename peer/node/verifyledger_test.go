@@ -0,0 +1,89 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/utils"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLedgerCmd(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "verifyledgercmd")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+	viper.Set("peer.fileSystemPath", testPath)
+	defer viper.Reset()
+
+	t.Run("when the channelID is not supplied", func(t *testing.T) {
+		cmd := verifyLedgerCmd()
+		cmd.SetArgs([]string{})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "Must supply channel ID")
+	})
+
+	t.Run("when the specified channelID does not exist", func(t *testing.T) {
+		cmd := verifyLedgerCmd()
+		cmd.SetArgs([]string{"-c", "ch1"})
+		err := cmd.Execute()
+		assert.EqualError(t, err, "LedgerID does not exist")
+	})
+
+	t.Run("when the reference blocks directory does not exist", func(t *testing.T) {
+		cmd := verifyLedgerCmd()
+		cmd.SetArgs([]string{"-c", "ch1", "-r", filepath.Join(testPath, "non-existing-dir")})
+		err := cmd.Execute()
+		assert.Contains(t, err.Error(), "error reading the reference blocks directory")
+	})
+}
+
+func TestLoadReferenceCommitHashes(t *testing.T) {
+	testPath, err := ioutil.TempDir("", "referencecommithashes")
+	require.NoError(t, err)
+	defer os.RemoveAll(testPath)
+
+	writeBlock := func(fileName string, block *cb.Block) {
+		blockBytes, err := proto.Marshal(block)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(testPath, fileName), blockBytes, 0644))
+	}
+	blockWithCommitHash := func(blockNum uint64, commitHash []byte) *cb.Block {
+		block := cb.NewBlock(blockNum, nil)
+		block.Metadata.Metadata[cb.BlockMetadataIndex_COMMIT_HASH] = utils.MarshalOrPanic(&cb.Metadata{Value: commitHash})
+		return block
+	}
+
+	commitHashes, err := loadReferenceCommitHashes("")
+	assert.NoError(t, err)
+	assert.Nil(t, commitHashes)
+
+	writeBlock("block1", blockWithCommitHash(1, []byte("commit-hash-1")))
+	writeBlock("block2", blockWithCommitHash(2, []byte("commit-hash-2")))
+	require.NoError(t, os.Mkdir(filepath.Join(testPath, "subdir"), 0755))
+	commitHashes, err = loadReferenceCommitHashes(testPath)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64][]byte{
+		1: []byte("commit-hash-1"),
+		2: []byte("commit-hash-2"),
+	}, commitHashes)
+
+	writeBlock("block3", cb.NewBlock(3, nil))
+	_, err = loadReferenceCommitHashes(testPath)
+	assert.EqualError(t, err, "the reference block file ["+filepath.Join(testPath, "block3")+"] does not contain a commit hash")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(testPath, "block3"), []byte("garbage"), 0644))
+	_, err = loadReferenceCommitHashes(testPath)
+	assert.Contains(t, err.Error(), "error unmarshaling the reference block file")
+}
@@ -28,7 +28,9 @@ const (
 	Status_BAD_REQUEST              Status = 400
 	Status_FORBIDDEN                Status = 403
 	Status_NOT_FOUND                Status = 404
+	Status_GONE                     Status = 410
 	Status_REQUEST_ENTITY_TOO_LARGE Status = 413
+	Status_TOO_MANY_REQUESTS        Status = 429
 	Status_INTERNAL_SERVER_ERROR    Status = 500
 	Status_NOT_IMPLEMENTED          Status = 501
 	Status_SERVICE_UNAVAILABLE      Status = 503
@@ -40,7 +42,9 @@ var Status_name = map[int32]string{
 	400: "BAD_REQUEST",
 	403: "FORBIDDEN",
 	404: "NOT_FOUND",
+	410: "GONE",
 	413: "REQUEST_ENTITY_TOO_LARGE",
+	429: "TOO_MANY_REQUESTS",
 	500: "INTERNAL_SERVER_ERROR",
 	501: "NOT_IMPLEMENTED",
 	503: "SERVICE_UNAVAILABLE",
@@ -51,7 +55,9 @@ var Status_value = map[string]int32{
 	"BAD_REQUEST":              400,
 	"FORBIDDEN":                403,
 	"NOT_FOUND":                404,
+	"GONE":                     410,
 	"REQUEST_ENTITY_TOO_LARGE": 413,
+	"TOO_MANY_REQUESTS":        429,
 	"INTERNAL_SERVER_ERROR":    500,
 	"NOT_IMPLEMENTED":          501,
 	"SERVICE_UNAVAILABLE":      503,
@@ -840,69 +846,71 @@ func init() {
 func init() { proto.RegisterFile("common/common.proto", fileDescriptor_common_72f685cee4d0b877) }
 
 var fileDescriptor_common_72f685cee4d0b877 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xde, 0xc4, 0xf9, 0xf9, 0xb2, 0x69, 0xdd, 0x49, 0x97, 0x35, 0x85, 0xd5, 0x56, 0x81, 0x45,
-	0xa5, 0x15, 0xa9, 0xe8, 0x5e, 0xe0, 0xe8, 0xd8, 0xd3, 0xd6, 0x6a, 0x62, 0x87, 0xb1, 0xb3, 0x88,
-	0x05, 0x69, 0xe4, 0x26, 0xd3, 0x24, 0xc2, 0xb1, 0x23, 0x7b, 0x52, 0xb5, 0x5c, 0xb9, 0x23, 0x24,
-	0xb8, 0xf2, 0xbf, 0x70, 0x44, 0xfc, 0x3d, 0x20, 0xae, 0x68, 0x3c, 0xb6, 0x9b, 0x94, 0x95, 0x38,
-	0xc5, 0xdf, 0x9b, 0x6f, 0xde, 0xfb, 0xe6, 0x7d, 0x2f, 0x33, 0xd0, 0x99, 0x44, 0xcb, 0x65, 0x14,
-	0x9e, 0xca, 0x9f, 0xde, 0x2a, 0x8e, 0x78, 0x84, 0x6a, 0x12, 0x1d, 0xbc, 0x9c, 0x45, 0xd1, 0x2c,
-	0x60, 0xa7, 0x69, 0xf4, 0x7a, 0x7d, 0x73, 0xca, 0x17, 0x4b, 0x96, 0x70, 0x7f, 0xb9, 0x92, 0xc4,
-	0x6e, 0x17, 0x60, 0xe0, 0x27, 0xdc, 0x88, 0xc2, 0x9b, 0xc5, 0x0c, 0xed, 0x43, 0x75, 0x11, 0x4e,
-	0xd9, 0x9d, 0x56, 0x3a, 0x2c, 0x1d, 0x55, 0x88, 0x04, 0xdd, 0x6f, 0xa1, 0x31, 0x64, 0xdc, 0x9f,
-	0xfa, 0xdc, 0x17, 0x8c, 0x5b, 0x3f, 0x58, 0xb3, 0x94, 0xf1, 0x94, 0x48, 0x80, 0xbe, 0x04, 0x48,
-	0x16, 0xb3, 0xd0, 0xe7, 0xeb, 0x98, 0x25, 0x5a, 0xf9, 0x50, 0x39, 0x6a, 0x9d, 0xbd, 0xdf, 0xcb,
-	0x14, 0xe5, 0x7b, 0xdd, 0x9c, 0x41, 0x36, 0xc8, 0xdd, 0xef, 0x60, 0xef, 0x3f, 0x04, 0xf4, 0x29,
-	0xa8, 0x05, 0x85, 0xce, 0x99, 0x3f, 0x65, 0x71, 0x56, 0x70, 0xb7, 0x88, 0x5f, 0xa6, 0x61, 0xf4,
-	0x21, 0x34, 0x8b, 0x90, 0x56, 0x4e, 0x39, 0x0f, 0x81, 0xee, 0x5b, 0xa8, 0x65, 0xbc, 0x57, 0xb0,
-	0x33, 0x99, 0xfb, 0x61, 0xc8, 0x82, 0xed, 0x84, 0xed, 0x2c, 0x9a, 0xd1, 0xde, 0x55, 0xb9, 0xfc,
-	0xce, 0xca, 0xdd, 0x1f, 0xcb, 0xd0, 0x36, 0xb6, 0x36, 0x23, 0xa8, 0xf0, 0xfb, 0x95, 0xec, 0x4d,
-	0x95, 0xa4, 0xdf, 0x48, 0x83, 0xfa, 0x2d, 0x8b, 0x93, 0x45, 0x14, 0xa6, 0x79, 0xaa, 0x24, 0x87,
-	0xe8, 0x0b, 0x68, 0x16, 0x6e, 0x68, 0xca, 0x61, 0xe9, 0xa8, 0x75, 0x76, 0xd0, 0x93, 0x7e, 0xf5,
-	0x72, 0xbf, 0x7a, 0x5e, 0xce, 0x20, 0x0f, 0x64, 0xf4, 0x02, 0x20, 0x3f, 0xcb, 0x62, 0xaa, 0x55,
-	0x0e, 0x4b, 0x47, 0x4d, 0xd2, 0xcc, 0x22, 0xd6, 0x14, 0x75, 0xa0, 0xca, 0xef, 0xc4, 0x4a, 0x35,
-	0x5d, 0xa9, 0xf0, 0x3b, 0x6b, 0x2a, 0x8c, 0x63, 0xab, 0x68, 0x32, 0xd7, 0x6a, 0xd2, 0xda, 0x14,
-	0x88, 0xee, 0xb1, 0x3b, 0xce, 0xc2, 0x54, 0x5f, 0x5d, 0x76, 0xaf, 0x08, 0xa0, 0x2e, 0xb4, 0x79,
-	0x90, 0xd0, 0x09, 0x8b, 0x39, 0x9d, 0xfb, 0xc9, 0x5c, 0x6b, 0xa4, 0x8c, 0x16, 0x0f, 0x12, 0x83,
-	0xc5, 0xfc, 0xd2, 0x4f, 0xe6, 0x5d, 0x1d, 0x76, 0xdd, 0x47, 0x96, 0x68, 0x50, 0x9f, 0xc4, 0xcc,
-	0xe7, 0x51, 0xde, 0xe3, 0x1c, 0x0a, 0x11, 0x61, 0x14, 0x4e, 0x72, 0xa3, 0x24, 0xe8, 0x62, 0xa8,
-	0x8f, 0xfc, 0xfb, 0x20, 0xf2, 0xa7, 0xe8, 0x13, 0xa8, 0x6d, 0xb8, 0xd3, 0x3a, 0xdb, 0xc9, 0x87,
-	0x48, 0xa6, 0x26, 0xd9, 0xaa, 0xe8, 0xb4, 0x98, 0x98, 0x2c, 0x4f, 0xfa, 0xdd, 0xed, 0x43, 0x03,
-	0x87, 0xb7, 0x2c, 0x88, 0x64, 0xd7, 0x57, 0x32, 0x65, 0x2e, 0x21, 0x83, 0xff, 0x33, 0x2f, 0x3f,
-	0x95, 0xa0, 0xda, 0x0f, 0xa2, 0xc9, 0xf7, 0xe8, 0xe4, 0x91, 0x92, 0x4e, 0xae, 0x24, 0x5d, 0x7e,
-	0x24, 0xe7, 0xd5, 0x86, 0x9c, 0xd6, 0xd9, 0xde, 0x16, 0xd5, 0xf4, 0xb9, 0x2f, 0x15, 0xa2, 0xcf,
-	0xa1, 0xb1, 0xcc, 0x66, 0x3d, 0x33, 0xfc, 0xd9, 0x16, 0x35, 0xff, 0x23, 0x90, 0x82, 0xd6, 0x9d,
-	0x41, 0x6b, 0xa3, 0x20, 0x7a, 0x0f, 0x6a, 0xe1, 0x7a, 0x79, 0x9d, 0xa9, 0xaa, 0x90, 0x0c, 0xa1,
-	0x8f, 0xa0, 0xbd, 0x8a, 0xd9, 0xed, 0x22, 0x5a, 0x27, 0xd2, 0x29, 0x79, 0xb2, 0xa7, 0x79, 0x50,
-	0x58, 0x85, 0x3e, 0x80, 0xa6, 0xc8, 0x29, 0x09, 0x4a, 0x4a, 0x68, 0x88, 0x40, 0xea, 0xe3, 0x4b,
-	0x68, 0x16, 0x72, 0x8b, 0xf6, 0x96, 0x0e, 0x95, 0xa2, 0xbd, 0x27, 0xd0, 0xde, 0x12, 0x89, 0x0e,
-	0x36, 0x4e, 0x23, 0x89, 0x0f, 0xb2, 0x7f, 0x80, 0x7d, 0x27, 0x9e, 0xb2, 0x98, 0xc5, 0xdb, 0x7b,
-	0x5e, 0x43, 0x2b, 0xf0, 0x13, 0x4e, 0x27, 0xe9, 0x7d, 0x93, 0xb5, 0x16, 0xe5, 0x4d, 0x78, 0xb8,
-	0x89, 0x08, 0x04, 0x0f, 0xb7, 0xd2, 0x67, 0x80, 0x26, 0x51, 0x98, 0xb0, 0x90, 0xb3, 0x98, 0x16,
-	0x25, 0xe5, 0x09, 0xf7, 0x8a, 0x95, 0xbc, 0xc6, 0xf1, 0xef, 0x25, 0xa8, 0xb9, 0xdc, 0xe7, 0xeb,
-	0x04, 0xb5, 0xa0, 0x3e, 0xb6, 0xaf, 0x6c, 0xe7, 0x6b, 0x5b, 0x7d, 0x82, 0x9e, 0x42, 0xdd, 0x1d,
-	0x1b, 0x06, 0x76, 0x5d, 0xf5, 0x8f, 0x12, 0x52, 0xa1, 0xd5, 0xd7, 0x4d, 0x4a, 0xf0, 0x57, 0x63,
-	0xec, 0x7a, 0xea, 0xcf, 0x0a, 0xda, 0x81, 0xe6, 0xb9, 0x43, 0xfa, 0x96, 0x69, 0x62, 0x5b, 0xfd,
-	0x25, 0xc5, 0xb6, 0xe3, 0xd1, 0x73, 0x67, 0x6c, 0x9b, 0xea, 0xaf, 0x0a, 0x7a, 0x01, 0x5a, 0xc6,
-	0xa6, 0xd8, 0xf6, 0x2c, 0xef, 0x1b, 0xea, 0x39, 0x0e, 0x1d, 0xe8, 0xe4, 0x02, 0xab, 0xbf, 0x29,
-	0xe8, 0x00, 0x9e, 0x59, 0xb6, 0x87, 0x89, 0xad, 0x0f, 0xa8, 0x8b, 0xc9, 0x1b, 0x4c, 0x28, 0x26,
-	0xc4, 0x21, 0xea, 0x5f, 0x0a, 0xda, 0x87, 0x5d, 0x91, 0xca, 0x1a, 0x8e, 0x06, 0x78, 0x88, 0x6d,
-	0x0f, 0x9b, 0xea, 0xdf, 0x0a, 0xd2, 0xa0, 0x23, 0x88, 0x96, 0x81, 0xe9, 0xd8, 0xd6, 0xdf, 0xe8,
-	0xd6, 0x40, 0xef, 0x0f, 0xb0, 0xfa, 0x8f, 0x72, 0xfc, 0x67, 0x09, 0x40, 0x3a, 0xee, 0x89, 0x3b,
-	0xa4, 0x05, 0xf5, 0x21, 0x76, 0x5d, 0xfd, 0x02, 0xab, 0x4f, 0x10, 0x40, 0xcd, 0x70, 0xec, 0x73,
-	0xeb, 0x42, 0x2d, 0xa1, 0x3d, 0x68, 0xcb, 0x6f, 0x3a, 0x1e, 0x99, 0xba, 0x87, 0xd5, 0x32, 0xd2,
-	0x60, 0x1f, 0xdb, 0xa6, 0x43, 0x5c, 0x4c, 0xa8, 0x47, 0x74, 0xdb, 0xd5, 0x0d, 0xcf, 0x72, 0x6c,
-	0x55, 0x41, 0xcf, 0xa1, 0xe3, 0x10, 0x13, 0x93, 0x47, 0x0b, 0x15, 0xf4, 0x0c, 0xf6, 0x4c, 0x3c,
-	0xb0, 0x84, 0x62, 0x17, 0xe3, 0x2b, 0x6a, 0xd9, 0xe7, 0x8e, 0x5a, 0x15, 0x61, 0xe3, 0x52, 0xb7,
-	0x6c, 0xc3, 0x31, 0x31, 0x1d, 0xe9, 0xc6, 0x95, 0xa8, 0x5f, 0x13, 0x05, 0x46, 0x18, 0x13, 0xaa,
-	0x9b, 0x43, 0xcb, 0xa6, 0xce, 0x08, 0x13, 0x3d, 0xcd, 0xd3, 0x10, 0x1b, 0x3c, 0xe7, 0x0a, 0xdb,
-	0x5b, 0xe9, 0x9b, 0xc7, 0x01, 0xa0, 0xad, 0x21, 0xb0, 0xc4, 0xa3, 0x82, 0x76, 0x00, 0x5c, 0xeb,
-	0xc2, 0xd6, 0xbd, 0x31, 0xc1, 0xae, 0xfa, 0x04, 0xed, 0x42, 0x6b, 0xa0, 0xbb, 0x1e, 0x2d, 0xce,
-	0xf6, 0x1c, 0x3a, 0x1b, 0x79, 0x5c, 0x7a, 0x6e, 0x0d, 0x3c, 0x4c, 0xd4, 0xb2, 0xe8, 0x46, 0x76,
-	0x0e, 0x55, 0x11, 0xdb, 0x0c, 0x67, 0x38, 0xb4, 0x3c, 0x7a, 0xa9, 0xbb, 0x97, 0x6a, 0xa5, 0xef,
-	0xc2, 0xc7, 0x51, 0x3c, 0xeb, 0xcd, 0xef, 0x57, 0x2c, 0x0e, 0xd8, 0x74, 0xc6, 0xe2, 0xde, 0x8d,
-	0x7f, 0x1d, 0x2f, 0x26, 0xf2, 0x4e, 0x4d, 0xb2, 0x59, 0x7b, 0x7b, 0x32, 0x5b, 0xf0, 0xf9, 0xfa,
-	0x5a, 0xc0, 0xd3, 0x0d, 0xf2, 0xa9, 0x24, 0xcb, 0x07, 0x33, 0xc9, 0x1e, 0xd5, 0xeb, 0x5a, 0x0a,
-	0x5f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xad, 0x85, 0xf1, 0xab, 0x6c, 0x07, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xde, 0xc4, 0xf9, 0x3d, 0xd9, 0xb4, 0xce, 0xa4, 0xbb, 0x6b, 0x0a, 0xab, 0xad, 0x0c, 0x8b,
+	0x4a, 0x2b, 0x52, 0xd1, 0xbd, 0x81, 0x4b, 0xc7, 0x9e, 0xb6, 0x56, 0x13, 0x3b, 0x8c, 0x9d, 0x45,
+	0xbb, 0x20, 0x59, 0x6e, 0x32, 0x4d, 0x22, 0x1c, 0x3b, 0xb2, 0x27, 0x55, 0xcb, 0x2d, 0xf7, 0x08,
+	0x09, 0xae, 0x90, 0x78, 0x04, 0xde, 0x03, 0xf1, 0x1a, 0xbc, 0x02, 0x88, 0x5b, 0x34, 0x1e, 0xdb,
+	0x4d, 0xca, 0x4a, 0x5c, 0x65, 0xbe, 0x33, 0xdf, 0x9c, 0xf3, 0xcd, 0xf9, 0x4e, 0xc6, 0xd0, 0x9d,
+	0x44, 0xcb, 0x65, 0x14, 0x9e, 0x88, 0x9f, 0xde, 0x2a, 0x8e, 0x58, 0x84, 0x6a, 0x02, 0xed, 0xbf,
+	0x98, 0x45, 0xd1, 0x2c, 0xa0, 0x27, 0x69, 0xf4, 0x6a, 0x7d, 0x7d, 0xc2, 0x16, 0x4b, 0x9a, 0x30,
+	0x7f, 0xb9, 0x12, 0x44, 0x55, 0x05, 0x18, 0xf8, 0x09, 0xd3, 0xa3, 0xf0, 0x7a, 0x31, 0x43, 0x7b,
+	0x50, 0x5d, 0x84, 0x53, 0x7a, 0xab, 0x94, 0x0e, 0x4a, 0x87, 0x15, 0x22, 0x80, 0xfa, 0x35, 0x34,
+	0x86, 0x94, 0xf9, 0x53, 0x9f, 0xf9, 0x9c, 0x71, 0xe3, 0x07, 0x6b, 0x9a, 0x32, 0x1e, 0x13, 0x01,
+	0xd0, 0x17, 0x00, 0xc9, 0x62, 0x16, 0xfa, 0x6c, 0x1d, 0xd3, 0x44, 0x29, 0x1f, 0x48, 0x87, 0xad,
+	0xd3, 0xf7, 0x7a, 0x99, 0xa2, 0xfc, 0xac, 0x93, 0x33, 0xc8, 0x06, 0x59, 0xfd, 0x06, 0x3a, 0xff,
+	0x21, 0xa0, 0x4f, 0x40, 0x2e, 0x28, 0xde, 0x9c, 0xfa, 0x53, 0x1a, 0x67, 0x05, 0x77, 0x8b, 0xf8,
+	0x45, 0x1a, 0x46, 0x1f, 0x40, 0xb3, 0x08, 0x29, 0xe5, 0x94, 0x73, 0x1f, 0x50, 0xdf, 0x42, 0x2d,
+	0xe3, 0xbd, 0x84, 0x9d, 0xc9, 0xdc, 0x0f, 0x43, 0x1a, 0x6c, 0x27, 0x6c, 0x67, 0xd1, 0x8c, 0xf6,
+	0xae, 0xca, 0xe5, 0x77, 0x56, 0x56, 0xbf, 0x2f, 0x43, 0x5b, 0xdf, 0x3a, 0x8c, 0xa0, 0xc2, 0xee,
+	0x56, 0xa2, 0x37, 0x55, 0x92, 0xae, 0x91, 0x02, 0xf5, 0x1b, 0x1a, 0x27, 0x8b, 0x28, 0x4c, 0xf3,
+	0x54, 0x49, 0x0e, 0xd1, 0xe7, 0xd0, 0x2c, 0xdc, 0x50, 0xa4, 0x83, 0xd2, 0x61, 0xeb, 0x74, 0xbf,
+	0x27, 0xfc, 0xea, 0xe5, 0x7e, 0xf5, 0xdc, 0x9c, 0x41, 0xee, 0xc9, 0xe8, 0x39, 0x40, 0x7e, 0x97,
+	0xc5, 0x54, 0xa9, 0x1c, 0x94, 0x0e, 0x9b, 0xa4, 0x99, 0x45, 0xcc, 0x29, 0xea, 0x42, 0x95, 0xdd,
+	0xf2, 0x9d, 0x6a, 0xba, 0x53, 0x61, 0xb7, 0xe6, 0x94, 0x1b, 0x47, 0x57, 0xd1, 0x64, 0xae, 0xd4,
+	0x84, 0xb5, 0x29, 0xe0, 0xdd, 0xa3, 0xb7, 0x8c, 0x86, 0xa9, 0xbe, 0xba, 0xe8, 0x5e, 0x11, 0x40,
+	0x2a, 0xb4, 0x59, 0x90, 0x78, 0x13, 0x1a, 0x33, 0x6f, 0xee, 0x27, 0x73, 0xa5, 0x91, 0x32, 0x5a,
+	0x2c, 0x48, 0x74, 0x1a, 0xb3, 0x0b, 0x3f, 0x99, 0xab, 0x1a, 0xec, 0x3a, 0x0f, 0x2c, 0x51, 0xa0,
+	0x3e, 0x89, 0xa9, 0xcf, 0xa2, 0xbc, 0xc7, 0x39, 0xe4, 0x22, 0xc2, 0x28, 0x9c, 0xe4, 0x46, 0x09,
+	0xa0, 0x62, 0xa8, 0x8f, 0xfc, 0xbb, 0x20, 0xf2, 0xa7, 0xe8, 0x63, 0xa8, 0x6d, 0xb8, 0xd3, 0x3a,
+	0xdd, 0xc9, 0x87, 0x48, 0xa4, 0x26, 0xb5, 0x79, 0xd1, 0x69, 0x3e, 0x31, 0x59, 0x9e, 0x74, 0xad,
+	0xf6, 0xa1, 0x81, 0xc3, 0x1b, 0x1a, 0x44, 0xa2, 0xeb, 0x2b, 0x91, 0x32, 0x97, 0x90, 0xc1, 0xff,
+	0x99, 0x97, 0x1f, 0x4a, 0x50, 0xed, 0x07, 0xd1, 0xe4, 0x5b, 0x74, 0xfc, 0x40, 0x49, 0x37, 0x57,
+	0x92, 0x6e, 0x3f, 0x90, 0xf3, 0x72, 0x43, 0x4e, 0xeb, 0xb4, 0xb3, 0x45, 0x35, 0x7c, 0xe6, 0x0b,
+	0x85, 0xe8, 0x33, 0x68, 0x2c, 0xb3, 0x59, 0xcf, 0x0c, 0x7f, 0xb2, 0x45, 0xcd, 0xff, 0x08, 0xa4,
+	0xa0, 0xa9, 0x33, 0x68, 0x6d, 0x14, 0x44, 0x4f, 0xa1, 0x16, 0xae, 0x97, 0x57, 0x99, 0xaa, 0x0a,
+	0xc9, 0x10, 0xfa, 0x10, 0xda, 0xab, 0x98, 0xde, 0x2c, 0xa2, 0x75, 0x22, 0x9c, 0x12, 0x37, 0x7b,
+	0x9c, 0x07, 0xb9, 0x55, 0xe8, 0x7d, 0x68, 0xf2, 0x9c, 0x82, 0x20, 0xa5, 0x84, 0x06, 0x0f, 0xa4,
+	0x3e, 0xbe, 0x80, 0x66, 0x21, 0xb7, 0x68, 0x6f, 0xe9, 0x40, 0x2a, 0xda, 0x7b, 0x0c, 0xed, 0x2d,
+	0x91, 0x68, 0x7f, 0xe3, 0x36, 0x82, 0x78, 0x2f, 0xfb, 0x3b, 0xd8, 0xb3, 0xe3, 0x29, 0x8d, 0x69,
+	0xbc, 0x7d, 0xe6, 0x15, 0xb4, 0x02, 0x3f, 0x61, 0xde, 0x24, 0x7d, 0x6f, 0xb2, 0xd6, 0xa2, 0xbc,
+	0x09, 0xf7, 0x2f, 0x11, 0x81, 0xa0, 0x58, 0xa3, 0x4f, 0x01, 0x4d, 0xa2, 0x30, 0xa1, 0x21, 0xa3,
+	0xb1, 0x57, 0x94, 0x14, 0x37, 0xec, 0x14, 0x3b, 0x79, 0x8d, 0xa3, 0x3f, 0x4b, 0x50, 0x73, 0x98,
+	0xcf, 0xd6, 0x09, 0x6a, 0x41, 0x7d, 0x6c, 0x5d, 0x5a, 0xf6, 0x57, 0x96, 0xfc, 0x08, 0x3d, 0x86,
+	0xba, 0x33, 0xd6, 0x75, 0xec, 0x38, 0xf2, 0xef, 0x25, 0x24, 0x43, 0xab, 0xaf, 0x19, 0x1e, 0xc1,
+	0x5f, 0x8e, 0xb1, 0xe3, 0xca, 0x3f, 0x4a, 0x68, 0x07, 0x9a, 0x67, 0x36, 0xe9, 0x9b, 0x86, 0x81,
+	0x2d, 0xf9, 0xa7, 0x14, 0x5b, 0xb6, 0xeb, 0x9d, 0xd9, 0x63, 0xcb, 0x90, 0x7f, 0x96, 0x50, 0x13,
+	0x2a, 0xe7, 0xb6, 0x85, 0xe5, 0x5f, 0x24, 0xf4, 0x1c, 0x94, 0xec, 0xa0, 0x87, 0x2d, 0xd7, 0x74,
+	0xdf, 0x78, 0xae, 0x6d, 0x7b, 0x03, 0x8d, 0x9c, 0x63, 0xf9, 0x57, 0x09, 0x3d, 0x85, 0x0e, 0xc7,
+	0x43, 0xcd, 0x7a, 0x93, 0x17, 0x70, 0xe4, 0xdf, 0x24, 0xb4, 0x0f, 0x4f, 0x4c, 0xcb, 0xc5, 0xc4,
+	0xd2, 0x06, 0x9e, 0x83, 0xc9, 0x6b, 0x4c, 0x3c, 0x4c, 0x88, 0x4d, 0xe4, 0xbf, 0x24, 0xb4, 0x07,
+	0xbb, 0xbc, 0x9a, 0x39, 0x1c, 0x0d, 0xf0, 0x10, 0x5b, 0x2e, 0x36, 0xe4, 0xbf, 0x25, 0xa4, 0x40,
+	0x97, 0x13, 0x4d, 0x1d, 0x7b, 0x63, 0x4b, 0x7b, 0xad, 0x99, 0x03, 0xad, 0x3f, 0xc0, 0xf2, 0x3f,
+	0xd2, 0xd1, 0x1f, 0x25, 0x00, 0x31, 0x14, 0x2e, 0x7f, 0x66, 0x5a, 0x50, 0x1f, 0x62, 0xc7, 0xd1,
+	0xce, 0xb1, 0xfc, 0x08, 0x01, 0xd4, 0x74, 0xdb, 0x3a, 0x33, 0xcf, 0xe5, 0x12, 0xea, 0x40, 0x5b,
+	0xac, 0xbd, 0xf1, 0xc8, 0xd0, 0x5c, 0x2c, 0x97, 0x91, 0x02, 0x7b, 0xd8, 0x32, 0x6c, 0xe2, 0x60,
+	0xe2, 0xb9, 0x44, 0xb3, 0x1c, 0x4d, 0x77, 0x4d, 0xdb, 0x92, 0x25, 0xf4, 0x0c, 0xba, 0x36, 0x31,
+	0x30, 0x79, 0xb0, 0x51, 0x41, 0x4f, 0xa0, 0x63, 0xe0, 0x81, 0xc9, 0x15, 0x3b, 0x18, 0x5f, 0x7a,
+	0xa6, 0x75, 0x66, 0xcb, 0x55, 0x1e, 0xd6, 0x2f, 0x34, 0xd3, 0xd2, 0x6d, 0x03, 0x7b, 0x23, 0x4d,
+	0xbf, 0xe4, 0xf5, 0x6b, 0xbc, 0xc0, 0x08, 0x63, 0xe2, 0x69, 0xc6, 0xd0, 0xb4, 0x3c, 0x7b, 0x84,
+	0x89, 0x96, 0xe6, 0x69, 0xf0, 0x03, 0xae, 0x7d, 0x89, 0xad, 0xad, 0xf4, 0xcd, 0xa3, 0x00, 0xd0,
+	0xd6, 0x9c, 0x98, 0xfc, 0xbb, 0x83, 0x76, 0x00, 0x1c, 0xf3, 0xdc, 0xd2, 0xdc, 0x31, 0xc1, 0x8e,
+	0xfc, 0x08, 0xed, 0x42, 0x6b, 0xa0, 0x39, 0xae, 0x57, 0xdc, 0xed, 0x19, 0x74, 0x37, 0xf2, 0x38,
+	0xde, 0x99, 0x39, 0x70, 0x31, 0x91, 0xcb, 0xbc, 0x1b, 0xd9, 0x3d, 0x64, 0x89, 0x1f, 0xd3, 0xed,
+	0xe1, 0xd0, 0x74, 0xbd, 0x0b, 0xcd, 0xb9, 0x90, 0x2b, 0x7d, 0x07, 0x3e, 0x8a, 0xe2, 0x59, 0x6f,
+	0x7e, 0xb7, 0xa2, 0x71, 0x40, 0xa7, 0x33, 0x1a, 0xf7, 0xae, 0xfd, 0xab, 0x78, 0x31, 0x11, 0xcf,
+	0x6e, 0x92, 0x8d, 0xe3, 0xdb, 0xe3, 0xd9, 0x82, 0xcd, 0xd7, 0x57, 0x1c, 0x9e, 0x6c, 0x90, 0x4f,
+	0x04, 0x59, 0x7c, 0x53, 0x93, 0xec, 0xbb, 0x7b, 0x55, 0x4b, 0xe1, 0xab, 0x7f, 0x07, 0x00, 0x84,
+	0x54, 0xb1, 0xc4, 0x8f, 0x07, 0x00, 0x00,
 }
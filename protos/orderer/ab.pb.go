@@ -82,6 +82,36 @@ func (SeekInfo_SeekErrorResponse) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ab_237e854d47750248, []int{5, 1}
 }
 
+// SeekContentType indicates what the deliver service returns for each block.  By default, whole blocks
+// are returned.  Clients which only follow the progress of a channel may request the header and metadata
+// of blocks, which carry the signatures of the orderers, with HEADER_WITH_SIG, or the header of blocks
+// together with the IDs of the transactions they contain with TX_IDS.
+type SeekInfo_SeekContentType int32
+
+const (
+	SeekInfo_BLOCK           SeekInfo_SeekContentType = 0
+	SeekInfo_HEADER_WITH_SIG SeekInfo_SeekContentType = 1
+	SeekInfo_TX_IDS          SeekInfo_SeekContentType = 2
+)
+
+var SeekInfo_SeekContentType_name = map[int32]string{
+	0: "BLOCK",
+	1: "HEADER_WITH_SIG",
+	2: "TX_IDS",
+}
+var SeekInfo_SeekContentType_value = map[string]int32{
+	"BLOCK":           0,
+	"HEADER_WITH_SIG": 1,
+	"TX_IDS":          2,
+}
+
+func (x SeekInfo_SeekContentType) String() string {
+	return proto.EnumName(SeekInfo_SeekContentType_name, int32(x))
+}
+func (SeekInfo_SeekContentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab_237e854d47750248, []int{5, 2}
+}
+
 type BroadcastResponse struct {
 	// Status code, which may be used to programatically respond to success/failure
 	Status common.Status `protobuf:"varint,1,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
@@ -415,6 +445,7 @@ type SeekInfo struct {
 	Stop                 *SeekPosition              `protobuf:"bytes,2,opt,name=stop,proto3" json:"stop,omitempty"`
 	Behavior             SeekInfo_SeekBehavior      `protobuf:"varint,3,opt,name=behavior,proto3,enum=orderer.SeekInfo_SeekBehavior" json:"behavior,omitempty"`
 	ErrorResponse        SeekInfo_SeekErrorResponse `protobuf:"varint,4,opt,name=error_response,json=errorResponse,proto3,enum=orderer.SeekInfo_SeekErrorResponse" json:"error_response,omitempty"`
+	ContentType          SeekInfo_SeekContentType   `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=orderer.SeekInfo_SeekContentType" json:"content_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -472,10 +503,18 @@ func (m *SeekInfo) GetErrorResponse() SeekInfo_SeekErrorResponse {
 	return SeekInfo_STRICT
 }
 
+func (m *SeekInfo) GetContentType() SeekInfo_SeekContentType {
+	if m != nil {
+		return m.ContentType
+	}
+	return SeekInfo_BLOCK
+}
+
 type DeliverResponse struct {
 	// Types that are valid to be assigned to Type:
 	//	*DeliverResponse_Status
 	//	*DeliverResponse_Block
+	//	*DeliverResponse_BlockTransactionIds
 	Type                 isDeliverResponse_Type `protobuf_oneof:"Type"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
@@ -506,6 +545,54 @@ func (m *DeliverResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeliverResponse proto.InternalMessageInfo
 
+// BlockTransactionIDs carries the header of a block and the IDs of the
+// transactions it contains, in the order of the block.
+type BlockTransactionIDs struct {
+	Header               *common.BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxIds                []string            `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BlockTransactionIDs) Reset()         { *m = BlockTransactionIDs{} }
+func (m *BlockTransactionIDs) String() string { return proto.CompactTextString(m) }
+func (*BlockTransactionIDs) ProtoMessage()    {}
+func (*BlockTransactionIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab_237e854d47750248, []int{7}
+}
+func (m *BlockTransactionIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTransactionIDs.Unmarshal(m, b)
+}
+func (m *BlockTransactionIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTransactionIDs.Marshal(b, m, deterministic)
+}
+func (dst *BlockTransactionIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTransactionIDs.Merge(dst, src)
+}
+func (m *BlockTransactionIDs) XXX_Size() int {
+	return xxx_messageInfo_BlockTransactionIDs.Size(m)
+}
+func (m *BlockTransactionIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTransactionIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTransactionIDs proto.InternalMessageInfo
+
+func (m *BlockTransactionIDs) GetHeader() *common.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockTransactionIDs) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
+}
+
 type isDeliverResponse_Type interface {
 	isDeliverResponse_Type()
 }
@@ -518,10 +605,16 @@ type DeliverResponse_Block struct {
 	Block *common.Block `protobuf:"bytes,2,opt,name=block,proto3,oneof"`
 }
 
+type DeliverResponse_BlockTransactionIds struct {
+	BlockTransactionIds *BlockTransactionIDs `protobuf:"bytes,3,opt,name=block_transaction_ids,json=blockTransactionIds,proto3,oneof"`
+}
+
 func (*DeliverResponse_Status) isDeliverResponse_Type() {}
 
 func (*DeliverResponse_Block) isDeliverResponse_Type() {}
 
+func (*DeliverResponse_BlockTransactionIds) isDeliverResponse_Type() {}
+
 func (m *DeliverResponse) GetType() isDeliverResponse_Type {
 	if m != nil {
 		return m.Type
@@ -543,11 +636,19 @@ func (m *DeliverResponse) GetBlock() *common.Block {
 	return nil
 }
 
+func (m *DeliverResponse) GetBlockTransactionIds() *BlockTransactionIDs {
+	if x, ok := m.GetType().(*DeliverResponse_BlockTransactionIds); ok {
+		return x.BlockTransactionIds
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*DeliverResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _DeliverResponse_OneofMarshaler, _DeliverResponse_OneofUnmarshaler, _DeliverResponse_OneofSizer, []interface{}{
 		(*DeliverResponse_Status)(nil),
 		(*DeliverResponse_Block)(nil),
+		(*DeliverResponse_BlockTransactionIds)(nil),
 	}
 }
 
@@ -563,6 +664,11 @@ func _DeliverResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Block); err != nil {
 			return err
 		}
+	case *DeliverResponse_BlockTransactionIds:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlockTransactionIds); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("DeliverResponse.Type has unexpected type %T", x)
@@ -588,6 +694,14 @@ func _DeliverResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Type = &DeliverResponse_Block{msg}
 		return true, err
+	case 3: // Type.block_transaction_ids
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BlockTransactionIDs)
+		err := b.DecodeMessage(msg)
+		m.Type = &DeliverResponse_BlockTransactionIds{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -605,6 +719,11 @@ func _DeliverResponse_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *DeliverResponse_BlockTransactionIds:
+		s := proto.Size(x.BlockTransactionIds)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -620,8 +739,10 @@ func init() {
 	proto.RegisterType((*SeekPosition)(nil), "orderer.SeekPosition")
 	proto.RegisterType((*SeekInfo)(nil), "orderer.SeekInfo")
 	proto.RegisterType((*DeliverResponse)(nil), "orderer.DeliverResponse")
+	proto.RegisterType((*BlockTransactionIDs)(nil), "orderer.BlockTransactionIDs")
 	proto.RegisterEnum("orderer.SeekInfo_SeekBehavior", SeekInfo_SeekBehavior_name, SeekInfo_SeekBehavior_value)
 	proto.RegisterEnum("orderer.SeekInfo_SeekErrorResponse", SeekInfo_SeekErrorResponse_name, SeekInfo_SeekErrorResponse_value)
+	proto.RegisterEnum("orderer.SeekInfo_SeekContentType", SeekInfo_SeekContentType_name, SeekInfo_SeekContentType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -800,40 +921,49 @@ var _AtomicBroadcast_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("orderer/ab.proto", fileDescriptor_ab_237e854d47750248) }
 
 var fileDescriptor_ab_237e854d47750248 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0xc7, 0x93, 0xd1, 0x65, 0xdb, 0xd9, 0xfa, 0xe5, 0x69, 0x53, 0xd5, 0x0b, 0x34, 0x05, 0x0d,
-	0x8a, 0x80, 0x64, 0x2a, 0x12, 0x17, 0x80, 0x84, 0x9a, 0xad, 0x55, 0x0b, 0x55, 0x8b, 0xdc, 0xec,
-	0x02, 0x6e, 0xa2, 0x24, 0x75, 0xdb, 0xb0, 0x36, 0x8e, 0x9c, 0xb4, 0x68, 0x4f, 0xc1, 0x8b, 0xf0,
-	0x44, 0x3c, 0x0d, 0xf2, 0x47, 0xda, 0x75, 0x1b, 0x5c, 0x25, 0xe7, 0xf8, 0x77, 0xce, 0xff, 0x6f,
-	0x1f, 0x1b, 0x2a, 0x94, 0x8d, 0x09, 0x23, 0xcc, 0xf6, 0x03, 0x2b, 0x61, 0x34, 0xa3, 0x68, 0x4f,
-	0x65, 0xea, 0xc7, 0x21, 0x5d, 0x2c, 0x68, 0x6c, 0xcb, 0x8f, 0x5c, 0x35, 0x87, 0x50, 0x75, 0x18,
-	0xf5, 0xc7, 0xa1, 0x9f, 0x66, 0x98, 0xa4, 0x09, 0x8d, 0x53, 0x82, 0x9e, 0x83, 0x91, 0x66, 0x7e,
-	0xb6, 0x4c, 0x6b, 0xfa, 0x99, 0xde, 0x28, 0x35, 0x4b, 0x96, 0xaa, 0x19, 0x89, 0x2c, 0x56, 0xab,
-	0x08, 0x41, 0x21, 0x8a, 0x27, 0xb4, 0xb6, 0x73, 0xa6, 0x37, 0x0e, 0xb0, 0xf8, 0x37, 0x8f, 0x00,
-	0x46, 0x84, 0xdc, 0x0c, 0xc8, 0x4f, 0x92, 0x66, 0x79, 0x34, 0x9c, 0x8f, 0x79, 0xf4, 0x02, 0x8a,
-	0x3c, 0x1a, 0x25, 0x24, 0x8c, 0x26, 0x11, 0x19, 0xa3, 0x53, 0x30, 0xe2, 0xe5, 0x22, 0x20, 0x4c,
-	0x08, 0x15, 0xb0, 0x8a, 0xcc, 0xdf, 0x3a, 0x1c, 0x71, 0xf2, 0x2b, 0x4d, 0xa3, 0x2c, 0xa2, 0x31,
-	0x7a, 0x03, 0x46, 0x2c, 0x3a, 0x0a, 0xf0, 0xb0, 0x79, 0x6c, 0xa9, 0x5d, 0x59, 0x1b, 0xb1, 0xae,
-	0x86, 0x15, 0xc4, 0x71, 0x2a, 0x24, 0x85, 0xb5, 0xfb, 0xb8, 0x74, 0xc3, 0x71, 0x09, 0xa1, 0x77,
-	0x70, 0x90, 0xe6, 0x9e, 0x6a, 0x4f, 0x44, 0xc5, 0xe9, 0x56, 0xc5, 0xda, 0x71, 0x57, 0xc3, 0x1b,
-	0xd4, 0x31, 0xa0, 0xe0, 0xde, 0x26, 0xc4, 0xfc, 0xb3, 0x03, 0xfb, 0x1c, 0xeb, 0xc5, 0x13, 0x8a,
-	0x5e, 0xc1, 0x6e, 0x9a, 0xf9, 0x2c, 0x77, 0x7a, 0xb2, 0xd5, 0x28, 0xdf, 0x10, 0x96, 0x0c, 0x7a,
-	0x09, 0x85, 0x34, 0xa3, 0x89, 0xb2, 0xf9, 0x0f, 0x56, 0x20, 0xe8, 0x3d, 0xec, 0x07, 0x64, 0xe6,
-	0xaf, 0x22, 0xca, 0x84, 0xc7, 0x52, 0xf3, 0xe9, 0x16, 0xce, 0xc5, 0xc5, 0x8f, 0xa3, 0x28, 0xbc,
-	0xe6, 0xd1, 0x67, 0x28, 0x11, 0xc6, 0x28, 0xf3, 0x98, 0x1a, 0x71, 0xad, 0x20, 0x3a, 0x3c, 0x7b,
-	0xbc, 0x43, 0x9b, 0xb3, 0xf9, 0x6d, 0xc0, 0x45, 0x72, 0x37, 0x34, 0x3f, 0xca, 0xd1, 0xe4, 0x2a,
-	0xe8, 0x04, 0xaa, 0x4e, 0x7f, 0x78, 0xf9, 0xc5, 0xbb, 0x1e, 0xb8, 0xbd, 0xbe, 0x87, 0xdb, 0xad,
-	0xab, 0x6f, 0x15, 0x8d, 0xa7, 0x3b, 0xad, 0x5e, 0xdf, 0xeb, 0x75, 0xbc, 0xc1, 0xd0, 0x55, 0x69,
-	0xdd, 0xbc, 0x80, 0xea, 0x03, 0x05, 0x04, 0x60, 0x8c, 0x5c, 0xdc, 0xbb, 0x74, 0x2b, 0x1a, 0x2a,
-	0xc3, 0xa1, 0xd3, 0x1e, 0xb9, 0x5e, 0xbb, 0xd3, 0x19, 0x62, 0xb7, 0xa2, 0x9b, 0x3f, 0xa0, 0x7c,
-	0x45, 0xe6, 0xd1, 0x8a, 0x6c, 0xf8, 0xc6, 0xff, 0xef, 0x27, 0x9f, 0xac, 0xba, 0xa1, 0xe7, 0xb0,
-	0x1b, 0xcc, 0x69, 0x78, 0xa3, 0x0e, 0xb8, 0x98, 0x83, 0x0e, 0x4f, 0x76, 0x35, 0x2c, 0x57, 0xf3,
-	0x41, 0x36, 0x7f, 0xe9, 0x50, 0x6e, 0x65, 0x74, 0x11, 0x85, 0xeb, 0x47, 0x81, 0x3e, 0xc1, 0xc1,
-	0x26, 0xa8, 0xe4, 0x0d, 0xda, 0xf1, 0x8a, 0xcc, 0x69, 0x42, 0xea, 0xf5, 0xf5, 0x11, 0x3e, 0x78,
-	0x47, 0xa6, 0xd6, 0xd0, 0x2f, 0x74, 0xf4, 0x01, 0xf6, 0xd4, 0x06, 0x1e, 0x29, 0xaf, 0xad, 0xcb,
-	0xef, 0x6d, 0x52, 0x16, 0x3b, 0xd7, 0x70, 0x4e, 0xd9, 0xd4, 0x9a, 0xdd, 0x26, 0x84, 0xcd, 0xc9,
-	0x78, 0x4a, 0x98, 0x35, 0xf1, 0x03, 0x16, 0x85, 0xf2, 0xfd, 0xa6, 0x79, 0xf9, 0xf7, 0xd7, 0xd3,
-	0x28, 0x9b, 0x2d, 0x03, 0x2e, 0x60, 0xdf, 0xa1, 0x6d, 0x49, 0xdb, 0x92, 0xb6, 0x15, 0x1d, 0x18,
-	0x22, 0x7e, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6c, 0xc9, 0x45, 0x2f, 0x04, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xdd, 0x6e, 0xe2, 0x46,
+	0x14, 0xc7, 0x31, 0x01, 0x27, 0x9c, 0x10, 0x70, 0x06, 0x11, 0x59, 0x51, 0x55, 0xa5, 0xae, 0xd2,
+	0x52, 0xa5, 0x85, 0x88, 0x4a, 0xbd, 0xe8, 0x87, 0x2a, 0x08, 0x50, 0xdc, 0x46, 0xa1, 0x1a, 0x1c,
+	0xb5, 0xe9, 0x8d, 0xe5, 0x8f, 0x21, 0x58, 0x01, 0x8f, 0x35, 0x9e, 0xa4, 0xc9, 0x53, 0xec, 0x8b,
+	0xec, 0x33, 0xec, 0xab, 0xec, 0xab, 0xac, 0x66, 0x3c, 0x86, 0xc0, 0xa2, 0x5c, 0xd9, 0xe7, 0xcc,
+	0xef, 0x7f, 0xce, 0x7f, 0x3e, 0xc1, 0xa0, 0x2c, 0x24, 0x8c, 0xb0, 0x8e, 0xe7, 0xb7, 0x13, 0x46,
+	0x39, 0x45, 0xfb, 0x2a, 0x73, 0xda, 0x08, 0xe8, 0x72, 0x49, 0xe3, 0x4e, 0xf6, 0xc9, 0x46, 0xad,
+	0x09, 0x1c, 0xf7, 0x19, 0xf5, 0xc2, 0xc0, 0x4b, 0x39, 0x26, 0x69, 0x42, 0xe3, 0x94, 0xa0, 0x6f,
+	0x40, 0x4f, 0xb9, 0xc7, 0x1f, 0x53, 0x53, 0x3b, 0xd3, 0x5a, 0xb5, 0x6e, 0xad, 0xad, 0x34, 0x53,
+	0x99, 0xc5, 0x6a, 0x14, 0x21, 0x28, 0x45, 0xf1, 0x8c, 0x9a, 0xc5, 0x33, 0xad, 0x55, 0xc1, 0xf2,
+	0xdf, 0xaa, 0x02, 0x4c, 0x09, 0x79, 0xb8, 0x21, 0xff, 0x93, 0x94, 0xe7, 0xd1, 0x64, 0x11, 0x8a,
+	0xe8, 0x5b, 0x38, 0x12, 0xd1, 0x34, 0x21, 0x41, 0x34, 0x8b, 0x48, 0x88, 0x4e, 0x40, 0x8f, 0x1f,
+	0x97, 0x3e, 0x61, 0xb2, 0x51, 0x09, 0xab, 0xc8, 0x7a, 0xaf, 0x41, 0x55, 0x90, 0x7f, 0xd3, 0x34,
+	0xe2, 0x11, 0x8d, 0xd1, 0x0f, 0xa0, 0xc7, 0xb2, 0xa2, 0x04, 0x0f, 0xbb, 0x8d, 0xb6, 0x9a, 0x55,
+	0x7b, 0xdd, 0x6c, 0x5c, 0xc0, 0x0a, 0x12, 0x38, 0x95, 0x2d, 0xcd, 0xe2, 0x0e, 0x3c, 0x73, 0x23,
+	0xf0, 0x0c, 0x42, 0x3f, 0x41, 0x25, 0xcd, 0x3d, 0x99, 0x7b, 0x52, 0x71, 0xb2, 0xa1, 0x58, 0x39,
+	0x1e, 0x17, 0xf0, 0x1a, 0xed, 0xeb, 0x50, 0x72, 0x5e, 0x12, 0x62, 0x7d, 0xdc, 0x83, 0x03, 0x81,
+	0xd9, 0xf1, 0x8c, 0xa2, 0x0b, 0x28, 0xa7, 0xdc, 0x63, 0xb9, 0xd3, 0xe6, 0x46, 0xa1, 0x7c, 0x42,
+	0x38, 0x63, 0xd0, 0x77, 0x50, 0x4a, 0x39, 0x4d, 0xcc, 0xe2, 0x5b, 0xac, 0x44, 0xd0, 0xcf, 0x70,
+	0xe0, 0x93, 0xb9, 0xf7, 0x14, 0x51, 0x26, 0x3d, 0xd6, 0xba, 0x5f, 0x6e, 0xe0, 0xa2, 0xb9, 0xfc,
+	0xe9, 0x2b, 0x0a, 0xaf, 0x78, 0xf4, 0x27, 0xd4, 0x08, 0x63, 0x94, 0xb9, 0x4c, 0x6d, 0xb1, 0x59,
+	0x92, 0x15, 0xbe, 0xde, 0x5d, 0x61, 0x28, 0xd8, 0xfc, 0x34, 0xe0, 0x23, 0xf2, 0x3a, 0x44, 0x03,
+	0xa8, 0x06, 0x34, 0xe6, 0x24, 0xe6, 0x2e, 0x7f, 0x49, 0x88, 0x59, 0x96, 0x95, 0xbe, 0xda, 0x5d,
+	0xe9, 0x2a, 0x23, 0xc5, 0x2a, 0xe1, 0xc3, 0x60, 0x1d, 0x58, 0xbf, 0x42, 0xf5, 0xb5, 0x57, 0xd4,
+	0x84, 0xe3, 0xfe, 0xf5, 0xe4, 0xea, 0x2f, 0xf7, 0xf6, 0xc6, 0xb1, 0xaf, 0x5d, 0x3c, 0xec, 0x0d,
+	0xee, 0x8c, 0x82, 0x48, 0x8f, 0x7a, 0xf6, 0xb5, 0x6b, 0x8f, 0xdc, 0x9b, 0x89, 0xa3, 0xd2, 0x9a,
+	0x75, 0x09, 0xc7, 0x9f, 0xf9, 0x44, 0x00, 0xfa, 0xd4, 0xc1, 0xf6, 0x95, 0x63, 0x14, 0x50, 0x1d,
+	0x0e, 0xfb, 0xc3, 0xa9, 0xe3, 0x0e, 0x47, 0xa3, 0x09, 0x76, 0x0c, 0xcd, 0xfa, 0x0d, 0xea, 0x5b,
+	0x7e, 0x50, 0x05, 0xca, 0xb2, 0xa5, 0x51, 0x40, 0x0d, 0xa8, 0x8f, 0x87, 0xbd, 0xc1, 0x10, 0xbb,
+	0xff, 0xd8, 0xce, 0xd8, 0x9d, 0xda, 0x7f, 0x18, 0x9a, 0xa8, 0xe7, 0xfc, 0xeb, 0xda, 0x83, 0xa9,
+	0x51, 0xb4, 0x3e, 0x68, 0x50, 0x1f, 0x90, 0x45, 0xf4, 0x44, 0xd6, 0xfd, 0x5a, 0x6f, 0xdf, 0x12,
+	0x71, 0xbe, 0xd4, 0x3d, 0x39, 0x87, 0xb2, 0xbf, 0xa0, 0xc1, 0x83, 0xda, 0xe6, 0xa3, 0x1c, 0xec,
+	0x8b, 0xe4, 0xb8, 0x80, 0xb3, 0x51, 0x84, 0xa1, 0x29, 0x7f, 0x5c, 0xce, 0xbc, 0x38, 0xf5, 0x02,
+	0xb1, 0xf9, 0x6e, 0x14, 0xa6, 0xea, 0x48, 0x7e, 0xb1, 0x5a, 0x62, 0xa9, 0x73, 0xd6, 0x90, 0x3d,
+	0x10, 0xdd, 0x1a, 0xfe, 0x76, 0x3a, 0x4c, 0x57, 0x47, 0xf4, 0x0e, 0x1a, 0x3b, 0x54, 0xe8, 0x02,
+	0xf4, 0x39, 0xf1, 0x42, 0x75, 0x01, 0xc5, 0x45, 0xd9, 0xb0, 0x26, 0x87, 0xb0, 0x42, 0x50, 0x13,
+	0x74, 0xfe, 0x2c, 0x0d, 0x15, 0xcf, 0xf6, 0x5a, 0x15, 0x5c, 0xe6, 0xcf, 0x76, 0x98, 0x76, 0xdf,
+	0x69, 0x50, 0xef, 0x71, 0xba, 0x8c, 0x82, 0xd5, 0x4b, 0x82, 0x7e, 0x87, 0xca, 0x3a, 0x30, 0xf2,
+	0xa2, 0xc3, 0xf8, 0x89, 0x2c, 0x68, 0x42, 0x4e, 0x4f, 0xd7, 0x53, 0xd9, 0x7e, 0x7c, 0xac, 0x42,
+	0x4b, 0xbb, 0xd4, 0xd0, 0x2f, 0xb0, 0xaf, 0xd6, 0x7b, 0x87, 0xdc, 0x5c, 0xc9, 0xb7, 0xf6, 0x24,
+	0x13, 0xf7, 0x6f, 0xe1, 0x9c, 0xb2, 0xfb, 0xf6, 0xfc, 0x25, 0x21, 0x6c, 0x41, 0xc2, 0x7b, 0xc2,
+	0xda, 0x33, 0xcf, 0x67, 0x51, 0x90, 0x3d, 0x7a, 0x69, 0x2e, 0xff, 0xef, 0xfb, 0xfb, 0x88, 0xcf,
+	0x1f, 0x7d, 0xd1, 0xa0, 0xf3, 0x8a, 0xee, 0x64, 0x74, 0x27, 0xa3, 0x3b, 0x8a, 0xf6, 0x75, 0x19,
+	0xff, 0xf8, 0x69, 0x00, 0xe4, 0x85, 0x2a, 0x24, 0x64, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,72 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bft_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric/protos/orderer/bft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal(t *testing.T) {
+	md := &bft.ConfigMetadata{}
+	for i := 1; i <= 3; i++ {
+		md.Consenters = append(md.Consenters, &bft.Consenter{
+			Id:            uint64(i),
+			Host:          fmt.Sprintf("node-%d.example.com", i),
+			Port:          7050,
+			MspId:         "OrdererMSP",
+			Identity:      []byte(fmt.Sprintf("../etcdraft/testdata/tls-client-%d.pem", i)),
+			ClientTlsCert: []byte(fmt.Sprintf("../etcdraft/testdata/tls-client-%d.pem", i)),
+			ServerTlsCert: []byte(fmt.Sprintf("../etcdraft/testdata/tls-server-%d.pem", i)),
+		})
+	}
+	packed, err := bft.Marshal(md)
+	require.Nil(t, err, "marshalling should succeed")
+
+	packed, err = bft.Marshal(md)
+	require.Nil(t, err, "marshalling should succeed a second time because we did not mutate ourselves")
+
+	unpacked := &bft.ConfigMetadata{}
+	require.Nil(t, proto.Unmarshal(packed, unpacked), "unmarshalling should succeed")
+
+	for i, c := range unpacked.GetConsenters() {
+		clientCert, _ := ioutil.ReadFile(fmt.Sprintf("../etcdraft/testdata/tls-client-%d.pem", i+1))
+		serverCert, _ := ioutil.ReadFile(fmt.Sprintf("../etcdraft/testdata/tls-server-%d.pem", i+1))
+		require.Equal(t, clientCert, c.GetIdentity())
+		require.Equal(t, clientCert, c.GetClientTlsCert())
+		require.Equal(t, serverCert, c.GetServerTlsCert())
+	}
+
+	md.Consenters[0].Identity = []byte("../etcdraft/testdata/missing.pem")
+	_, err = bft.Marshal(md)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot load identity for consenter node-1.example.com:7050")
+}
+
+func TestQuorum(t *testing.T) {
+	for _, tst := range []struct {
+		n, f, q int
+	}{
+		{n: 1, f: 0, q: 1},
+		{n: 3, f: 0, q: 2},
+		{n: 4, f: 1, q: 3},
+		{n: 5, f: 1, q: 4},
+		{n: 6, f: 1, q: 4},
+		{n: 7, f: 2, q: 5},
+		{n: 10, f: 3, q: 7},
+	} {
+		f, q := bft.Quorum(tst.n)
+		assert.Equal(t, tst.f, f, "cluster of %d", tst.n)
+		assert.Equal(t, tst.q, q, "cluster of %d", tst.n)
+	}
+}
@@ -110,10 +110,14 @@ type BatchSize struct {
 	AbsoluteMaxBytes uint32 `protobuf:"varint,2,opt,name=absolute_max_bytes,json=absoluteMaxBytes,proto3" json:"absolute_max_bytes,omitempty"`
 	// The byte count of the serialized messages in a batch should not
 	// exceed this value.
-	PreferredMaxBytes    uint32   `protobuf:"varint,3,opt,name=preferred_max_bytes,json=preferredMaxBytes,proto3" json:"preferred_max_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PreferredMaxBytes uint32 `protobuf:"varint,3,opt,name=preferred_max_bytes,json=preferredMaxBytes,proto3" json:"preferred_max_bytes,omitempty"`
+	// When set, the number of messages at which a batch is cut is adjusted
+	// to the observed load, between adaptive.min_message_count and
+	// max_message_count.
+	Adaptive             *AdaptiveBatching `protobuf:"bytes,4,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BatchSize) Reset()         { *m = BatchSize{} }
@@ -161,6 +165,64 @@ func (m *BatchSize) GetPreferredMaxBytes() uint32 {
 	return 0
 }
 
+func (m *BatchSize) GetAdaptive() *AdaptiveBatching {
+	if m != nil {
+		return m.Adaptive
+	}
+	return nil
+}
+
+// AdaptiveBatching configures the block cutter to adjust the size of batches
+// from the arrival rate of messages and the commit latency of blocks.
+type AdaptiveBatching struct {
+	// The smallest number of messages at which a batch is cut.
+	MinMessageCount uint32 `protobuf:"varint,1,opt,name=min_message_count,json=minMessageCount,proto3" json:"min_message_count,omitempty"`
+	// The time a batch should take to fill, any duration string parseable
+	// by ParseDuration(): https://golang.org/pkg/time/#ParseDuration
+	TargetLatency        string   `protobuf:"bytes,2,opt,name=target_latency,json=targetLatency,proto3" json:"target_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdaptiveBatching) Reset()         { *m = AdaptiveBatching{} }
+func (m *AdaptiveBatching) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveBatching) ProtoMessage()    {}
+func (*AdaptiveBatching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{2}
+}
+func (m *AdaptiveBatching) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdaptiveBatching.Unmarshal(m, b)
+}
+func (m *AdaptiveBatching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdaptiveBatching.Marshal(b, m, deterministic)
+}
+func (dst *AdaptiveBatching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveBatching.Merge(dst, src)
+}
+func (m *AdaptiveBatching) XXX_Size() int {
+	return xxx_messageInfo_AdaptiveBatching.Size(m)
+}
+func (m *AdaptiveBatching) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveBatching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveBatching proto.InternalMessageInfo
+
+func (m *AdaptiveBatching) GetMinMessageCount() uint32 {
+	if m != nil {
+		return m.MinMessageCount
+	}
+	return 0
+}
+
+func (m *AdaptiveBatching) GetTargetLatency() string {
+	if m != nil {
+		return m.TargetLatency
+	}
+	return ""
+}
+
 type BatchTimeout struct {
 	// Any duration string parseable by ParseDuration():
 	// https://golang.org/pkg/time/#ParseDuration
@@ -174,7 +236,7 @@ func (m *BatchTimeout) Reset()         { *m = BatchTimeout{} }
 func (m *BatchTimeout) String() string { return proto.CompactTextString(m) }
 func (*BatchTimeout) ProtoMessage()    {}
 func (*BatchTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{2}
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{3}
 }
 func (m *BatchTimeout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BatchTimeout.Unmarshal(m, b)
@@ -216,7 +278,7 @@ func (m *KafkaBrokers) Reset()         { *m = KafkaBrokers{} }
 func (m *KafkaBrokers) String() string { return proto.CompactTextString(m) }
 func (*KafkaBrokers) ProtoMessage()    {}
 func (*KafkaBrokers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{3}
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{4}
 }
 func (m *KafkaBrokers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KafkaBrokers.Unmarshal(m, b)
@@ -255,7 +317,7 @@ func (m *ChannelRestrictions) Reset()         { *m = ChannelRestrictions{} }
 func (m *ChannelRestrictions) String() string { return proto.CompactTextString(m) }
 func (*ChannelRestrictions) ProtoMessage()    {}
 func (*ChannelRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_configuration_3a2420d24de6d468, []int{4}
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{5}
 }
 func (m *ChannelRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelRestrictions.Unmarshal(m, b)
@@ -282,12 +344,162 @@ func (m *ChannelRestrictions) GetMaxCount() uint64 {
 	return 0
 }
 
+// RateLimits bounds the number of transactions each organization may broadcast
+// to the channel per second.
+type RateLimits struct {
+	// The limit of organizations which have no limit of their own. No limit
+	// is enforced on them when unset.
+	Default *RateLimit `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	// The limits of individual organizations, identified by their MSP ID.
+	Organizations        []*OrganizationRateLimit `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RateLimits) Reset()         { *m = RateLimits{} }
+func (m *RateLimits) String() string { return proto.CompactTextString(m) }
+func (*RateLimits) ProtoMessage()    {}
+func (*RateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{6}
+}
+func (m *RateLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimits.Unmarshal(m, b)
+}
+func (m *RateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimits.Marshal(b, m, deterministic)
+}
+func (dst *RateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimits.Merge(dst, src)
+}
+func (m *RateLimits) XXX_Size() int {
+	return xxx_messageInfo_RateLimits.Size(m)
+}
+func (m *RateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimits proto.InternalMessageInfo
+
+func (m *RateLimits) GetDefault() *RateLimit {
+	if m != nil {
+		return m.Default
+	}
+	return nil
+}
+
+func (m *RateLimits) GetOrganizations() []*OrganizationRateLimit {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+// RateLimit is a token bucket refilled with transactions_per_second tokens
+// every second, which holds up to burst tokens.
+type RateLimit struct {
+	TransactionsPerSecond uint32   `protobuf:"varint,1,opt,name=transactions_per_second,json=transactionsPerSecond,proto3" json:"transactions_per_second,omitempty"`
+	Burst                 uint32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{7}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimit.Unmarshal(m, b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+}
+func (dst *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(dst, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return xxx_messageInfo_RateLimit.Size(m)
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetTransactionsPerSecond() uint32 {
+	if m != nil {
+		return m.TransactionsPerSecond
+	}
+	return 0
+}
+
+func (m *RateLimit) GetBurst() uint32 {
+	if m != nil {
+		return m.Burst
+	}
+	return 0
+}
+
+// OrganizationRateLimit is the rate limit of a single organization.
+type OrganizationRateLimit struct {
+	MspId                string     `protobuf:"bytes,1,opt,name=msp_id,json=mspId,proto3" json:"msp_id,omitempty"`
+	Limit                *RateLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *OrganizationRateLimit) Reset()         { *m = OrganizationRateLimit{} }
+func (m *OrganizationRateLimit) String() string { return proto.CompactTextString(m) }
+func (*OrganizationRateLimit) ProtoMessage()    {}
+func (*OrganizationRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_configuration_3a2420d24de6d468, []int{8}
+}
+func (m *OrganizationRateLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationRateLimit.Unmarshal(m, b)
+}
+func (m *OrganizationRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationRateLimit.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationRateLimit.Merge(dst, src)
+}
+func (m *OrganizationRateLimit) XXX_Size() int {
+	return xxx_messageInfo_OrganizationRateLimit.Size(m)
+}
+func (m *OrganizationRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationRateLimit proto.InternalMessageInfo
+
+func (m *OrganizationRateLimit) GetMspId() string {
+	if m != nil {
+		return m.MspId
+	}
+	return ""
+}
+
+func (m *OrganizationRateLimit) GetLimit() *RateLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConsensusType)(nil), "orderer.ConsensusType")
 	proto.RegisterType((*BatchSize)(nil), "orderer.BatchSize")
+	proto.RegisterType((*AdaptiveBatching)(nil), "orderer.AdaptiveBatching")
 	proto.RegisterType((*BatchTimeout)(nil), "orderer.BatchTimeout")
 	proto.RegisterType((*KafkaBrokers)(nil), "orderer.KafkaBrokers")
 	proto.RegisterType((*ChannelRestrictions)(nil), "orderer.ChannelRestrictions")
+	proto.RegisterType((*RateLimits)(nil), "orderer.RateLimits")
+	proto.RegisterType((*RateLimit)(nil), "orderer.RateLimit")
+	proto.RegisterType((*OrganizationRateLimit)(nil), "orderer.OrganizationRateLimit")
 	proto.RegisterEnum("orderer.ConsensusType_State", ConsensusType_State_name, ConsensusType_State_value)
 }
 
@@ -296,31 +508,43 @@ func init() {
 }
 
 var fileDescriptor_configuration_3a2420d24de6d468 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x8a, 0xdb, 0x30,
-	0x10, 0x86, 0xeb, 0x66, 0xb7, 0xbb, 0x19, 0x92, 0x36, 0xd1, 0x52, 0x30, 0xdd, 0x1e, 0x82, 0xa1,
-	0x10, 0xca, 0x22, 0x97, 0xf4, 0x09, 0x92, 0x90, 0x43, 0x69, 0x93, 0x82, 0xe2, 0x5e, 0x7a, 0x09,
-	0x63, 0x67, 0xe2, 0x98, 0x8d, 0x2d, 0x23, 0xc9, 0x90, 0xf4, 0x3d, 0xfa, 0x08, 0x7d, 0xcf, 0x22,
-	0xc9, 0xde, 0x6e, 0x6f, 0xf3, 0xff, 0xf3, 0x69, 0x98, 0xd1, 0x0f, 0xf7, 0x52, 0xed, 0x49, 0x91,
-	0x8a, 0x33, 0x59, 0x1d, 0x8a, 0xbc, 0x51, 0x68, 0x0a, 0x59, 0xf1, 0x5a, 0x49, 0x23, 0xd9, 0x4d,
-	0xdb, 0x8c, 0xfe, 0x04, 0x30, 0x5c, 0xca, 0x4a, 0x53, 0xa5, 0x1b, 0x9d, 0x5c, 0x6a, 0x62, 0x0c,
-	0xae, 0xcc, 0xa5, 0xa6, 0x30, 0x98, 0x04, 0xd3, 0xbe, 0x70, 0x35, 0x7b, 0x07, 0xb7, 0x25, 0x19,
-	0xdc, 0xa3, 0xc1, 0xf0, 0xe5, 0x24, 0x98, 0x0e, 0xc4, 0x93, 0x66, 0x33, 0xb8, 0xd6, 0x06, 0x0d,
-	0x85, 0xbd, 0x49, 0x30, 0x7d, 0x3d, 0x7b, 0xcf, 0xdb, 0xd1, 0xfc, 0xbf, 0xb1, 0x7c, 0x6b, 0x19,
-	0xe1, 0xd1, 0xe8, 0x13, 0x5c, 0x3b, 0xcd, 0x46, 0x30, 0xd8, 0x26, 0xf3, 0x64, 0xb5, 0xdb, 0x7c,
-	0x17, 0xeb, 0xf9, 0xb7, 0xd1, 0x0b, 0xf6, 0x16, 0xc6, 0xde, 0x59, 0xcf, 0xbf, 0x6c, 0x92, 0xd5,
-	0x66, 0xbe, 0x59, 0xae, 0x46, 0x41, 0xf4, 0x3b, 0x80, 0xfe, 0x02, 0x4d, 0x76, 0xdc, 0x16, 0xbf,
-	0x88, 0x7d, 0x84, 0x71, 0x89, 0xe7, 0x5d, 0x49, 0x5a, 0x63, 0x4e, 0xbb, 0x4c, 0x36, 0x95, 0x71,
-	0x0b, 0x0f, 0xc5, 0x9b, 0x12, 0xcf, 0x6b, 0xef, 0x2f, 0xad, 0xcd, 0x1e, 0x80, 0x61, 0xaa, 0xe5,
-	0xa9, 0x31, 0xb4, 0xb3, 0x8f, 0xd2, 0x8b, 0x21, 0xed, 0xae, 0x18, 0x8a, 0x51, 0xd7, 0x59, 0xe3,
-	0x79, 0x61, 0x7d, 0xc6, 0xe1, 0xae, 0x56, 0x74, 0x20, 0xa5, 0x68, 0xff, 0x0c, 0xef, 0x39, 0x7c,
-	0xfc, 0xd4, 0xea, 0xf8, 0x68, 0x0a, 0x03, 0xb7, 0x56, 0x52, 0x94, 0x24, 0x1b, 0xc3, 0x42, 0xb8,
-	0x31, 0xbe, 0x6c, 0x3f, 0xb0, 0x93, 0x96, 0xfc, 0x8a, 0x87, 0x47, 0x5c, 0x28, 0xf9, 0x48, 0x4a,
-	0x5b, 0x32, 0xf5, 0x65, 0x18, 0x4c, 0x7a, 0x96, 0x6c, 0x65, 0x34, 0x83, 0xbb, 0xe5, 0x11, 0xab,
-	0x8a, 0x4e, 0x82, 0xb4, 0x51, 0x45, 0x66, 0x83, 0xd3, 0xec, 0x1e, 0xfa, 0x76, 0xa1, 0x7f, 0xc7,
-	0x5e, 0x89, 0xdb, 0x12, 0xcf, 0xee, 0xca, 0xc5, 0x0f, 0xf8, 0x20, 0x55, 0xce, 0x8f, 0x97, 0x9a,
-	0xd4, 0x89, 0xf6, 0x39, 0x29, 0x7e, 0xc0, 0x54, 0x15, 0x99, 0x0f, 0x5c, 0x77, 0xa9, 0xfc, 0x7c,
-	0xc8, 0x0b, 0x73, 0x6c, 0x52, 0x9e, 0xc9, 0x32, 0x7e, 0x46, 0xc7, 0x9e, 0x8e, 0x3d, 0x1d, 0xb7,
-	0x74, 0xfa, 0xca, 0xe9, 0xcf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0x7c, 0x05, 0xd3, 0x4d,
-	0x02, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xd1, 0x6a, 0xdb, 0x48,
+	0x14, 0x86, 0x57, 0x71, 0x9c, 0xc4, 0x27, 0x71, 0xd6, 0x99, 0xac, 0x59, 0xed, 0xa6, 0x14, 0x23,
+	0x08, 0x88, 0x12, 0xe4, 0xe2, 0xd2, 0xde, 0xdb, 0x6e, 0x2e, 0x42, 0x63, 0xa7, 0xc8, 0x2e, 0xb4,
+	0xbd, 0x11, 0x23, 0xe9, 0x58, 0x1e, 0x62, 0xcd, 0x88, 0x99, 0x51, 0xb1, 0x73, 0xd5, 0x97, 0xe9,
+	0xdb, 0xf4, 0xa1, 0x8a, 0x34, 0xb2, 0xe2, 0x94, 0xe6, 0x6e, 0xce, 0xf9, 0xbf, 0x33, 0xfc, 0xff,
+	0xd1, 0x08, 0x2e, 0x84, 0x8c, 0x51, 0xa2, 0xec, 0x47, 0x82, 0x2f, 0x58, 0x92, 0x4b, 0xaa, 0x99,
+	0xe0, 0x5e, 0x26, 0x85, 0x16, 0xe4, 0xb0, 0x12, 0x9d, 0x1f, 0x16, 0xb4, 0xc7, 0x82, 0x2b, 0xe4,
+	0x2a, 0x57, 0xf3, 0x4d, 0x86, 0x84, 0xc0, 0xbe, 0xde, 0x64, 0x68, 0x5b, 0x3d, 0xcb, 0x6d, 0xf9,
+	0xe5, 0x99, 0xfc, 0x0f, 0x47, 0x29, 0x6a, 0x1a, 0x53, 0x4d, 0xed, 0xbd, 0x9e, 0xe5, 0x9e, 0xf8,
+	0x75, 0x4d, 0x06, 0xd0, 0x54, 0x9a, 0x6a, 0xb4, 0x1b, 0x3d, 0xcb, 0x3d, 0x1d, 0xbc, 0xf0, 0xaa,
+	0xab, 0xbd, 0x27, 0xd7, 0x7a, 0xb3, 0x82, 0xf1, 0x0d, 0xea, 0xbc, 0x86, 0x66, 0x59, 0x93, 0x0e,
+	0x9c, 0xcc, 0xe6, 0xc3, 0xf9, 0x75, 0x30, 0xbd, 0xf3, 0x27, 0xc3, 0xdb, 0xce, 0x5f, 0xa4, 0x0b,
+	0x67, 0xa6, 0x33, 0x19, 0xde, 0x4c, 0xe7, 0xd7, 0xd3, 0xe1, 0x74, 0x7c, 0xdd, 0xb1, 0x9c, 0x9f,
+	0x16, 0xb4, 0x46, 0x54, 0x47, 0xcb, 0x19, 0x7b, 0x40, 0xf2, 0x0a, 0xce, 0x52, 0xba, 0x0e, 0x52,
+	0x54, 0x8a, 0x26, 0x18, 0x44, 0x22, 0xe7, 0xba, 0x34, 0xdc, 0xf6, 0xff, 0x4e, 0xe9, 0x7a, 0x62,
+	0xfa, 0xe3, 0xa2, 0x4d, 0xae, 0x80, 0xd0, 0x50, 0x89, 0x55, 0xae, 0x31, 0x28, 0x86, 0xc2, 0x8d,
+	0x46, 0x55, 0xa6, 0x68, 0xfb, 0x9d, 0xad, 0x32, 0xa1, 0xeb, 0x51, 0xd1, 0x27, 0x1e, 0x9c, 0x67,
+	0x12, 0x17, 0x28, 0x25, 0xc6, 0x3b, 0x78, 0xa3, 0xc4, 0xcf, 0x6a, 0xa9, 0xe6, 0xdf, 0xc2, 0x11,
+	0x8d, 0x69, 0xa6, 0xd9, 0x37, 0xb4, 0xf7, 0x7b, 0x96, 0x7b, 0x3c, 0xf8, 0xaf, 0x5e, 0xc0, 0xb0,
+	0x12, 0x4a, 0xdf, 0x8c, 0x27, 0x7e, 0x8d, 0x3a, 0x08, 0x9d, 0xdf, 0xd5, 0x32, 0x14, 0xe3, 0xcf,
+	0x84, 0x62, 0xfc, 0x49, 0xa8, 0x4b, 0x38, 0xd5, 0x54, 0x26, 0xa8, 0x83, 0x15, 0xd5, 0xc8, 0xa3,
+	0x4d, 0x19, 0xa8, 0xe5, 0xb7, 0x4d, 0xf7, 0xd6, 0x34, 0x1d, 0x17, 0x4e, 0xca, 0xeb, 0xe7, 0x2c,
+	0x45, 0x91, 0x6b, 0x62, 0xc3, 0xa1, 0x36, 0xc7, 0xea, 0xf3, 0x6e, 0xcb, 0x82, 0xfc, 0x40, 0x17,
+	0xf7, 0x74, 0x24, 0xc5, 0x3d, 0x4a, 0x55, 0x90, 0xa1, 0x39, 0xda, 0x56, 0xaf, 0x51, 0x90, 0x55,
+	0xe9, 0x0c, 0xe0, 0x7c, 0xbc, 0xa4, 0x9c, 0xe3, 0xca, 0x47, 0xa5, 0x25, 0x8b, 0x8a, 0x67, 0xa5,
+	0xc8, 0x05, 0xb4, 0x8a, 0x75, 0x3d, 0xba, 0xde, 0xf7, 0x8f, 0x52, 0xba, 0x2e, 0xed, 0x3a, 0xdf,
+	0x2d, 0x00, 0x9f, 0x6a, 0xbc, 0x65, 0x29, 0xd3, 0x8a, 0x5c, 0xc1, 0x61, 0x8c, 0x0b, 0x9a, 0xaf,
+	0x0c, 0x79, 0x3c, 0x20, 0xf5, 0xce, 0x6a, 0xca, 0xdf, 0x22, 0xe4, 0x3d, 0xb4, 0x85, 0x4c, 0x28,
+	0x67, 0x0f, 0xe5, 0x0b, 0x2e, 0xbe, 0x5d, 0xc3, 0x3d, 0x1e, 0xbc, 0xac, 0x67, 0xee, 0x76, 0xd4,
+	0xc7, 0xf9, 0xa7, 0x43, 0xce, 0x17, 0x68, 0xd5, 0x1a, 0x79, 0x07, 0xff, 0x6a, 0x49, 0xb9, 0xa2,
+	0xc6, 0x7c, 0x90, 0xa1, 0x0c, 0x14, 0x46, 0x82, 0xc7, 0xd5, 0xc2, 0xbb, 0xbb, 0xf2, 0x47, 0x94,
+	0xb3, 0x52, 0x24, 0xff, 0x40, 0x33, 0xcc, 0xa5, 0xd2, 0xd5, 0xf3, 0x31, 0x85, 0xf3, 0x19, 0xba,
+	0x7f, 0xb4, 0x40, 0xba, 0x70, 0x90, 0xaa, 0x2c, 0x60, 0x71, 0xb5, 0xed, 0x66, 0xaa, 0xb2, 0x9b,
+	0x98, 0xb8, 0xd0, 0x5c, 0x15, 0xba, 0xbd, 0xf7, 0x6c, 0x78, 0x03, 0x8c, 0x3e, 0xc1, 0xa5, 0x90,
+	0x89, 0xb7, 0xdc, 0x64, 0x28, 0x57, 0x18, 0x27, 0x28, 0xbd, 0x05, 0x0d, 0x25, 0x8b, 0xcc, 0x6f,
+	0xac, 0xb6, 0x93, 0x5f, 0xaf, 0x12, 0xa6, 0x97, 0x79, 0xe8, 0x45, 0x22, 0xed, 0xef, 0xd0, 0x7d,
+	0x43, 0xf7, 0x0d, 0xdd, 0xaf, 0xe8, 0xf0, 0xa0, 0xac, 0xdf, 0xfc, 0x1a, 0x00, 0x7a, 0x2a, 0x3f,
+	0x5d, 0x23, 0x04, 0x00, 0x00,
 }
@@ -137,10 +137,16 @@ type Options struct {
 	HeartbeatTick     uint32 `protobuf:"varint,3,opt,name=heartbeat_tick,json=heartbeatTick,proto3" json:"heartbeat_tick,omitempty"`
 	MaxInflightBlocks uint32 `protobuf:"varint,4,opt,name=max_inflight_blocks,json=maxInflightBlocks,proto3" json:"max_inflight_blocks,omitempty"`
 	// Take snapshot when cumulative data exceeds certain size in bytes.
-	SnapshotIntervalSize uint32   `protobuf:"varint,5,opt,name=snapshot_interval_size,json=snapshotIntervalSize,proto3" json:"snapshot_interval_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SnapshotIntervalSize uint32 `protobuf:"varint,5,opt,name=snapshot_interval_size,json=snapshotIntervalSize,proto3" json:"snapshot_interval_size,omitempty"`
+	// Add new consenters as non-voting learners, and promote them to voters once
+	// they caught up with the leader.
+	Learners bool `protobuf:"varint,6,opt,name=learners,proto3" json:"learners,omitempty"`
+	// Number of Raft entries a learner may lag behind the commit index of the
+	// leader to be promoted. A default value is used when zero.
+	LearnerPromotionThreshold uint32   `protobuf:"varint,7,opt,name=learner_promotion_threshold,json=learnerPromotionThreshold,proto3" json:"learner_promotion_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *Options) Reset()         { *m = Options{} }
@@ -202,6 +208,20 @@ func (m *Options) GetSnapshotIntervalSize() uint32 {
 	return 0
 }
 
+func (m *Options) GetLearners() bool {
+	if m != nil {
+		return m.Learners
+	}
+	return false
+}
+
+func (m *Options) GetLearnerPromotionThreshold() uint32 {
+	if m != nil {
+		return m.LearnerPromotionThreshold
+	}
+	return 0
+}
+
 // BlockMetadata stores data used by the Raft OSNs when
 // coordinating with each other, to be serialized into
 // block meta dta field and used after failres and restarts.
@@ -276,33 +296,36 @@ func init() {
 }
 
 var fileDescriptor_configuration_780531726dd41db7 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
-	0x14, 0xc6, 0x15, 0x56, 0x18, 0x7d, 0x6b, 0x36, 0xd5, 0x43, 0x28, 0x17, 0xa4, 0xaa, 0x03, 0x54,
-	0x81, 0x94, 0x48, 0x1b, 0xfc, 0x03, 0xeb, 0xa9, 0x07, 0x84, 0x14, 0x76, 0xe2, 0x62, 0x39, 0xce,
-	0x6b, 0x62, 0x35, 0x8d, 0x23, 0xfb, 0x6d, 0x2a, 0xbb, 0x70, 0xe0, 0x1f, 0xe5, 0x4f, 0x41, 0xb6,
-	0x93, 0xb4, 0xe2, 0x66, 0x7d, 0xdf, 0xef, 0xb3, 0x3f, 0xeb, 0x3d, 0x78, 0xaf, 0x4d, 0x89, 0x06,
-	0x4d, 0x86, 0x24, 0x4b, 0x23, 0xb6, 0x94, 0x49, 0xdd, 0x6e, 0x55, 0xf5, 0x68, 0x04, 0x29, 0xdd,
-	0xa6, 0x9d, 0xd1, 0xa4, 0xd9, 0xeb, 0xc1, 0x5d, 0x1a, 0xb8, 0x5c, 0x7b, 0xe0, 0x1b, 0x92, 0x28,
-	0x05, 0x09, 0x76, 0x07, 0x20, 0x75, 0x6b, 0xb1, 0x25, 0x34, 0x36, 0x89, 0x16, 0x67, 0xab, 0x8b,
-	0xdb, 0xeb, 0x74, 0x08, 0xa4, 0xeb, 0xc1, 0xcb, 0x4f, 0x30, 0xf6, 0x19, 0xce, 0x75, 0xe7, 0x1e,
-	0xb0, 0xc9, 0x8b, 0x45, 0xb4, 0xba, 0xb8, 0x9d, 0x1f, 0x13, 0xdf, 0x83, 0x91, 0x0f, 0xc4, 0xf2,
-	0x4f, 0x04, 0xd3, 0xf1, 0x1a, 0xc6, 0x60, 0x52, 0x6b, 0x4b, 0x49, 0xb4, 0x88, 0x56, 0xd3, 0xdc,
-	0x9f, 0x9d, 0xd6, 0x69, 0x43, 0xfe, 0xae, 0x38, 0xf7, 0x67, 0xf6, 0x11, 0xae, 0x64, 0xa3, 0xb0,
-	0x25, 0x4e, 0x8d, 0xe5, 0x12, 0x0d, 0x25, 0x67, 0x8b, 0x68, 0x35, 0xcb, 0xe3, 0x20, 0x3f, 0x34,
-	0x76, 0x8d, 0x81, 0xb3, 0x68, 0x9e, 0xd0, 0x1c, 0xb9, 0x49, 0xe0, 0x82, 0xdc, 0x73, 0xcb, 0xbf,
-	0x11, 0x9c, 0xf7, 0xd5, 0xd8, 0x0d, 0xc4, 0xa4, 0xe4, 0x8e, 0x2b, 0xd7, 0xe8, 0x49, 0x34, 0x7d,
-	0x99, 0x99, 0x13, 0x37, 0xbd, 0xe6, 0x20, 0x6c, 0x50, 0xba, 0x04, 0x77, 0x46, 0xdf, 0x6e, 0x36,
-	0x88, 0x0f, 0x4a, 0xee, 0xd8, 0x07, 0xb8, 0xac, 0x51, 0x18, 0x2a, 0x50, 0x50, 0xa0, 0xce, 0x3c,
-	0x15, 0x8f, 0xaa, 0xc7, 0x52, 0xb8, 0xde, 0x8b, 0x03, 0x57, 0xed, 0xb6, 0x51, 0x55, 0x4d, 0xbc,
-	0x68, 0xb4, 0xdc, 0x59, 0x5f, 0x34, 0xce, 0xe7, 0x7b, 0x71, 0xd8, 0xf4, 0xce, 0xbd, 0x37, 0xd8,
-	0x17, 0x78, 0x6b, 0x5b, 0xd1, 0xd9, 0x5a, 0xd3, 0x58, 0x92, 0x5b, 0xf5, 0x8c, 0xc9, 0x4b, 0x1f,
-	0x79, 0x33, 0xb8, 0x43, 0xdb, 0x1f, 0xea, 0x19, 0x97, 0xbf, 0x21, 0xf6, 0xf9, 0x71, 0xb6, 0x37,
-	0x10, 0x8f, 0x43, 0xe3, 0xaa, 0x0c, 0xe3, 0x9d, 0xe4, 0xb3, 0x51, 0xdc, 0x94, 0x96, 0x7d, 0x82,
-	0x79, 0x8b, 0x07, 0xe2, 0xa7, 0xa4, 0xff, 0xeb, 0x24, 0xbf, 0x72, 0xc6, 0xfa, 0x08, 0xb3, 0x77,
-	0x00, 0x6e, 0xc6, 0x5c, 0xb5, 0x25, 0x1e, 0xfc, 0x57, 0x27, 0xf9, 0xd4, 0x29, 0x1b, 0x27, 0xdc,
-	0x57, 0x90, 0x6a, 0x53, 0xa5, 0xf5, 0xaf, 0x0e, 0x4d, 0x83, 0x65, 0x85, 0x26, 0xdd, 0x8a, 0xc2,
-	0x28, 0x19, 0xf6, 0xd0, 0xa6, 0xfd, 0xb6, 0x8e, 0xcb, 0xf2, 0xf3, 0x6b, 0xa5, 0xa8, 0x7e, 0x2c,
-	0x52, 0xa9, 0xf7, 0xd9, 0x49, 0x2c, 0x0b, 0xb1, 0x2c, 0xc4, 0xb2, 0xff, 0x97, 0xbc, 0x78, 0xe5,
-	0x8d, 0xbb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x1e, 0x2b, 0x0a, 0xff, 0x02, 0x00, 0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xc1, 0x6f, 0xd3, 0x30,
+	0x18, 0xc5, 0x95, 0xb5, 0xac, 0xed, 0xb7, 0x66, 0x53, 0x3d, 0x84, 0x02, 0x08, 0xa9, 0xea, 0x00,
+	0x55, 0x20, 0x25, 0xd2, 0x06, 0x57, 0x0e, 0xeb, 0xa9, 0x07, 0x04, 0x0a, 0x3d, 0x71, 0xb1, 0x1c,
+	0xe7, 0x6b, 0x62, 0x35, 0x8d, 0x23, 0xdb, 0x9b, 0xca, 0x2e, 0x1c, 0xf8, 0xb7, 0xf8, 0xe3, 0x90,
+	0xed, 0x24, 0xad, 0xb8, 0x39, 0xef, 0xfd, 0x9e, 0xf3, 0xe2, 0x7c, 0x86, 0xb7, 0x52, 0xe5, 0xa8,
+	0x50, 0x25, 0x68, 0x78, 0xae, 0xd8, 0xd6, 0x24, 0x5c, 0xd6, 0x5b, 0x51, 0x3c, 0x28, 0x66, 0x84,
+	0xac, 0xe3, 0x46, 0x49, 0x23, 0xc9, 0xb8, 0x73, 0x17, 0x0a, 0x2e, 0x57, 0x0e, 0xf8, 0x8a, 0x86,
+	0xe5, 0xcc, 0x30, 0x72, 0x07, 0xc0, 0x65, 0xad, 0xb1, 0x36, 0xa8, 0x74, 0x14, 0xcc, 0x07, 0xcb,
+	0x8b, 0xdb, 0xeb, 0xb8, 0x0b, 0xc4, 0xab, 0xce, 0x4b, 0x4f, 0x30, 0xf2, 0x11, 0x46, 0xb2, 0xb1,
+	0x2f, 0xd0, 0xd1, 0xd9, 0x3c, 0x58, 0x5e, 0xdc, 0xce, 0x8e, 0x89, 0x6f, 0xde, 0x48, 0x3b, 0x62,
+	0xf1, 0x27, 0x80, 0x49, 0xbf, 0x0d, 0x21, 0x30, 0x2c, 0xa5, 0x36, 0x51, 0x30, 0x0f, 0x96, 0x93,
+	0xd4, 0xad, 0xad, 0xd6, 0x48, 0x65, 0xdc, 0x5e, 0x61, 0xea, 0xd6, 0xe4, 0x3d, 0x5c, 0xf1, 0x4a,
+	0x60, 0x6d, 0xa8, 0xa9, 0x34, 0xe5, 0xa8, 0x4c, 0x34, 0x98, 0x07, 0xcb, 0x69, 0x1a, 0x7a, 0x79,
+	0x53, 0xe9, 0x15, 0x7a, 0x4e, 0xa3, 0x7a, 0x44, 0x75, 0xe4, 0x86, 0x9e, 0xf3, 0x72, 0xcb, 0x2d,
+	0xfe, 0x9e, 0xc1, 0xa8, 0xad, 0x46, 0x6e, 0x20, 0x34, 0x82, 0xef, 0xa8, 0xb0, 0x8d, 0x1e, 0x59,
+	0xd5, 0x96, 0x99, 0x5a, 0x71, 0xdd, 0x6a, 0x16, 0xc2, 0x0a, 0xb9, 0x4d, 0x50, 0x6b, 0xb4, 0xed,
+	0xa6, 0x9d, 0xb8, 0x11, 0x7c, 0x47, 0xde, 0xc1, 0x65, 0x89, 0x4c, 0x99, 0x0c, 0x99, 0xf1, 0xd4,
+	0xc0, 0x51, 0x61, 0xaf, 0x3a, 0x2c, 0x86, 0xeb, 0x3d, 0x3b, 0x50, 0x51, 0x6f, 0x2b, 0x51, 0x94,
+	0x86, 0x66, 0x95, 0xe4, 0x3b, 0xed, 0x8a, 0x86, 0xe9, 0x6c, 0xcf, 0x0e, 0xeb, 0xd6, 0xb9, 0x77,
+	0x06, 0xf9, 0x04, 0x2f, 0x74, 0xcd, 0x1a, 0x5d, 0x4a, 0xd3, 0x97, 0xa4, 0x5a, 0x3c, 0x61, 0xf4,
+	0xcc, 0x45, 0x9e, 0x77, 0x6e, 0xd7, 0xf6, 0x87, 0x78, 0x42, 0xf2, 0x0a, 0xc6, 0x15, 0x32, 0x55,
+	0xdb, 0x1f, 0x79, 0x3e, 0x0f, 0x96, 0xe3, 0xb4, 0x7f, 0x26, 0x5f, 0xe0, 0x75, 0xbb, 0xa6, 0x8d,
+	0x92, 0x7b, 0xe9, 0x3f, 0xab, 0x54, 0xa8, 0x4b, 0x59, 0xe5, 0xd1, 0xc8, 0x6d, 0xfb, 0xb2, 0x45,
+	0xbe, 0x77, 0xc4, 0xa6, 0x03, 0x16, 0xbf, 0x21, 0x74, 0xdd, 0xfa, 0xb9, 0xb9, 0x81, 0xb0, 0x1f,
+	0x08, 0x2a, 0x72, 0x3f, 0x3a, 0xc3, 0x74, 0xda, 0x8b, 0xeb, 0x5c, 0x93, 0x0f, 0x30, 0xab, 0xf1,
+	0x60, 0xe8, 0x29, 0xe9, 0xce, 0x71, 0x98, 0x5e, 0x59, 0x63, 0x75, 0x84, 0xc9, 0x1b, 0x00, 0x3b,
+	0x3f, 0x54, 0xd4, 0x39, 0x1e, 0xdc, 0x31, 0x0e, 0xd3, 0x89, 0x55, 0xd6, 0x56, 0xb8, 0x2f, 0x20,
+	0x96, 0xaa, 0x88, 0xcb, 0x5f, 0x0d, 0xaa, 0x0a, 0xf3, 0x02, 0x55, 0xbc, 0x65, 0x99, 0x12, 0xdc,
+	0xcf, 0xb8, 0x8e, 0xdb, 0x9b, 0xd0, 0x0f, 0xe2, 0xcf, 0xcf, 0x85, 0x30, 0xe5, 0x43, 0x16, 0x73,
+	0xb9, 0x4f, 0x4e, 0x62, 0x89, 0x8f, 0x25, 0x3e, 0x96, 0xfc, 0x7f, 0x81, 0xb2, 0x73, 0x67, 0xdc,
+	0xfd, 0x1b, 0x00, 0xca, 0xc7, 0xa5, 0xcd, 0x5b, 0x03, 0x00, 0x00,
 }
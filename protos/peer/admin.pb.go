@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import common "github.com/hyperledger/fabric/protos/common"
 
 import (
@@ -274,10 +275,457 @@ func (m *LogSpecResponse) GetError() string {
 	return ""
 }
 
+// IndexRequest is used for managing the indexes of the state database of a
+// channel. An empty collection refers to the public state of the namespace
+type IndexRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Collection           string   `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	IndexDefinition      string   `protobuf:"bytes,4,opt,name=index_definition,json=indexDefinition,proto3" json:"index_definition,omitempty"`
+	DesignDoc            string   `protobuf:"bytes,5,opt,name=design_doc,json=designDoc,proto3" json:"design_doc,omitempty"`
+	IndexName            string   `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	Query                string   `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexRequest) Reset()         { *m = IndexRequest{} }
+func (m *IndexRequest) String() string { return proto.CompactTextString(m) }
+func (*IndexRequest) ProtoMessage()    {}
+func (*IndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{5}
+}
+func (m *IndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexRequest.Unmarshal(m, b)
+}
+func (m *IndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexRequest.Marshal(b, m, deterministic)
+}
+func (dst *IndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexRequest.Merge(dst, src)
+}
+func (m *IndexRequest) XXX_Size() int {
+	return xxx_messageInfo_IndexRequest.Size(m)
+}
+func (m *IndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexRequest proto.InternalMessageInfo
+
+func (m *IndexRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetIndexDefinition() string {
+	if m != nil {
+		return m.IndexDefinition
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetDesignDoc() string {
+	if m != nil {
+		return m.DesignDoc
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+// StateDBIndex describes an index of the state database
+type StateDBIndex struct {
+	DesignDoc            string   `protobuf:"bytes,1,opt,name=design_doc,json=designDoc,proto3" json:"design_doc,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Definition           string   `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateDBIndex) Reset()         { *m = StateDBIndex{} }
+func (m *StateDBIndex) String() string { return proto.CompactTextString(m) }
+func (*StateDBIndex) ProtoMessage()    {}
+func (*StateDBIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{6}
+}
+func (m *StateDBIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateDBIndex.Unmarshal(m, b)
+}
+func (m *StateDBIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateDBIndex.Marshal(b, m, deterministic)
+}
+func (dst *StateDBIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDBIndex.Merge(dst, src)
+}
+func (m *StateDBIndex) XXX_Size() int {
+	return xxx_messageInfo_StateDBIndex.Size(m)
+}
+func (m *StateDBIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDBIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDBIndex proto.InternalMessageInfo
+
+func (m *StateDBIndex) GetDesignDoc() string {
+	if m != nil {
+		return m.DesignDoc
+	}
+	return ""
+}
+
+func (m *StateDBIndex) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StateDBIndex) GetDefinition() string {
+	if m != nil {
+		return m.Definition
+	}
+	return ""
+}
+
+type IndexesResponse struct {
+	Indexes              []*StateDBIndex `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *IndexesResponse) Reset()         { *m = IndexesResponse{} }
+func (m *IndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*IndexesResponse) ProtoMessage()    {}
+func (*IndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{7}
+}
+func (m *IndexesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexesResponse.Unmarshal(m, b)
+}
+func (m *IndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexesResponse.Marshal(b, m, deterministic)
+}
+func (dst *IndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexesResponse.Merge(dst, src)
+}
+func (m *IndexesResponse) XXX_Size() int {
+	return xxx_messageInfo_IndexesResponse.Size(m)
+}
+func (m *IndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexesResponse proto.InternalMessageInfo
+
+func (m *IndexesResponse) GetIndexes() []*StateDBIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// PvtDataRequest is used for inspecting and driving the reconciliation of
+// the private data missing on a channel within a range of blocks. An empty
+// namespace (or collection) matches all the namespaces (or collections)
+type PvtDataRequest struct {
+	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	StartBlock           uint64   `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock             uint64   `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	Namespace            string   `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Collection           string   `protobuf:"bytes,5,opt,name=collection,proto3" json:"collection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PvtDataRequest) Reset()         { *m = PvtDataRequest{} }
+func (m *PvtDataRequest) String() string { return proto.CompactTextString(m) }
+func (*PvtDataRequest) ProtoMessage()    {}
+func (*PvtDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{8}
+}
+func (m *PvtDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PvtDataRequest.Unmarshal(m, b)
+}
+func (m *PvtDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PvtDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *PvtDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PvtDataRequest.Merge(dst, src)
+}
+func (m *PvtDataRequest) XXX_Size() int {
+	return xxx_messageInfo_PvtDataRequest.Size(m)
+}
+func (m *PvtDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PvtDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PvtDataRequest proto.InternalMessageInfo
+
+func (m *PvtDataRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PvtDataRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *PvtDataRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *PvtDataRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PvtDataRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+// MissingPvtData describes the private data of a collection that is missing
+// for a transaction. The private data is eligible if the peer is a member of
+// the collection and unrecoverable if it is no longer reconciled
+type MissingPvtData struct {
+	BlockNum             uint64   `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	TxNum                uint64   `protobuf:"varint,2,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Collection           string   `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	Eligible             bool     `protobuf:"varint,5,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Unrecoverable        bool     `protobuf:"varint,6,opt,name=unrecoverable,proto3" json:"unrecoverable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MissingPvtData) Reset()         { *m = MissingPvtData{} }
+func (m *MissingPvtData) String() string { return proto.CompactTextString(m) }
+func (*MissingPvtData) ProtoMessage()    {}
+func (*MissingPvtData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{9}
+}
+func (m *MissingPvtData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissingPvtData.Unmarshal(m, b)
+}
+func (m *MissingPvtData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissingPvtData.Marshal(b, m, deterministic)
+}
+func (dst *MissingPvtData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingPvtData.Merge(dst, src)
+}
+func (m *MissingPvtData) XXX_Size() int {
+	return xxx_messageInfo_MissingPvtData.Size(m)
+}
+func (m *MissingPvtData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingPvtData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingPvtData proto.InternalMessageInfo
+
+func (m *MissingPvtData) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *MissingPvtData) GetTxNum() uint64 {
+	if m != nil {
+		return m.TxNum
+	}
+	return 0
+}
+
+func (m *MissingPvtData) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *MissingPvtData) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *MissingPvtData) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *MissingPvtData) GetUnrecoverable() bool {
+	if m != nil {
+		return m.Unrecoverable
+	}
+	return false
+}
+
+// ReconciliationStatus describes the outcome of the most recent attempt to
+// reconcile the missing private data of a channel
+type ReconciliationStatus struct {
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationMillis       int64                `protobuf:"varint,2,opt,name=duration_millis,json=durationMillis,proto3" json:"duration_millis,omitempty"`
+	Reconciled           uint64               `protobuf:"varint,3,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	Error                string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReconciliationStatus) Reset()         { *m = ReconciliationStatus{} }
+func (m *ReconciliationStatus) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationStatus) ProtoMessage()    {}
+func (*ReconciliationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{10}
+}
+func (m *ReconciliationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationStatus.Unmarshal(m, b)
+}
+func (m *ReconciliationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationStatus.Marshal(b, m, deterministic)
+}
+func (dst *ReconciliationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationStatus.Merge(dst, src)
+}
+func (m *ReconciliationStatus) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationStatus.Size(m)
+}
+func (m *ReconciliationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciliationStatus proto.InternalMessageInfo
+
+func (m *ReconciliationStatus) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ReconciliationStatus) GetDurationMillis() int64 {
+	if m != nil {
+		return m.DurationMillis
+	}
+	return 0
+}
+
+func (m *ReconciliationStatus) GetReconciled() uint64 {
+	if m != nil {
+		return m.Reconciled
+	}
+	return 0
+}
+
+func (m *ReconciliationStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PvtDataResponse struct {
+	MissingPvtData       []*MissingPvtData     `protobuf:"bytes,1,rep,name=missing_pvt_data,json=missingPvtData,proto3" json:"missing_pvt_data,omitempty"`
+	ReconciliationStatus *ReconciliationStatus `protobuf:"bytes,2,opt,name=reconciliation_status,json=reconciliationStatus,proto3" json:"reconciliation_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PvtDataResponse) Reset()         { *m = PvtDataResponse{} }
+func (m *PvtDataResponse) String() string { return proto.CompactTextString(m) }
+func (*PvtDataResponse) ProtoMessage()    {}
+func (*PvtDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_admin_b2904393863b6bc5, []int{11}
+}
+func (m *PvtDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PvtDataResponse.Unmarshal(m, b)
+}
+func (m *PvtDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PvtDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *PvtDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PvtDataResponse.Merge(dst, src)
+}
+func (m *PvtDataResponse) XXX_Size() int {
+	return xxx_messageInfo_PvtDataResponse.Size(m)
+}
+func (m *PvtDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PvtDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PvtDataResponse proto.InternalMessageInfo
+
+func (m *PvtDataResponse) GetMissingPvtData() []*MissingPvtData {
+	if m != nil {
+		return m.MissingPvtData
+	}
+	return nil
+}
+
+func (m *PvtDataResponse) GetReconciliationStatus() *ReconciliationStatus {
+	if m != nil {
+		return m.ReconciliationStatus
+	}
+	return nil
+}
+
 type AdminOperation struct {
 	// Types that are valid to be assigned to Content:
 	//	*AdminOperation_LogReq
 	//	*AdminOperation_LogSpecReq
+	//	*AdminOperation_IndexReq
+	//	*AdminOperation_PvtDataReq
 	Content              isAdminOperation_Content `protobuf_oneof:"content"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
@@ -288,7 +736,7 @@ func (m *AdminOperation) Reset()         { *m = AdminOperation{} }
 func (m *AdminOperation) String() string { return proto.CompactTextString(m) }
 func (*AdminOperation) ProtoMessage()    {}
 func (*AdminOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_admin_b2904393863b6bc5, []int{5}
+	return fileDescriptor_admin_b2904393863b6bc5, []int{12}
 }
 func (m *AdminOperation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AdminOperation.Unmarshal(m, b)
@@ -320,10 +768,22 @@ type AdminOperation_LogSpecReq struct {
 	LogSpecReq *LogSpecRequest `protobuf:"bytes,2,opt,name=logSpecReq,proto3,oneof"`
 }
 
+type AdminOperation_IndexReq struct {
+	IndexReq *IndexRequest `protobuf:"bytes,3,opt,name=indexReq,proto3,oneof"`
+}
+
+type AdminOperation_PvtDataReq struct {
+	PvtDataReq *PvtDataRequest `protobuf:"bytes,4,opt,name=pvtDataReq,proto3,oneof"`
+}
+
 func (*AdminOperation_LogReq) isAdminOperation_Content() {}
 
 func (*AdminOperation_LogSpecReq) isAdminOperation_Content() {}
 
+func (*AdminOperation_IndexReq) isAdminOperation_Content() {}
+
+func (*AdminOperation_PvtDataReq) isAdminOperation_Content() {}
+
 func (m *AdminOperation) GetContent() isAdminOperation_Content {
 	if m != nil {
 		return m.Content
@@ -345,11 +805,27 @@ func (m *AdminOperation) GetLogSpecReq() *LogSpecRequest {
 	return nil
 }
 
+func (m *AdminOperation) GetIndexReq() *IndexRequest {
+	if x, ok := m.GetContent().(*AdminOperation_IndexReq); ok {
+		return x.IndexReq
+	}
+	return nil
+}
+
+func (m *AdminOperation) GetPvtDataReq() *PvtDataRequest {
+	if x, ok := m.GetContent().(*AdminOperation_PvtDataReq); ok {
+		return x.PvtDataReq
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*AdminOperation) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _AdminOperation_OneofMarshaler, _AdminOperation_OneofUnmarshaler, _AdminOperation_OneofSizer, []interface{}{
 		(*AdminOperation_LogReq)(nil),
 		(*AdminOperation_LogSpecReq)(nil),
+		(*AdminOperation_IndexReq)(nil),
+		(*AdminOperation_PvtDataReq)(nil),
 	}
 }
 
@@ -367,6 +843,16 @@ func _AdminOperation_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LogSpecReq); err != nil {
 			return err
 		}
+	case *AdminOperation_IndexReq:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.IndexReq); err != nil {
+			return err
+		}
+	case *AdminOperation_PvtDataReq:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PvtDataReq); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("AdminOperation.Content has unexpected type %T", x)
@@ -393,6 +879,22 @@ func _AdminOperation_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Content = &AdminOperation_LogSpecReq{msg}
 		return true, err
+	case 3: // content.indexReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(IndexRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_IndexReq{msg}
+		return true, err
+	case 4: // content.pvtDataReq
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PvtDataRequest)
+		err := b.DecodeMessage(msg)
+		m.Content = &AdminOperation_PvtDataReq{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -412,6 +914,16 @@ func _AdminOperation_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *AdminOperation_IndexReq:
+		s := proto.Size(x.IndexReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *AdminOperation_PvtDataReq:
+		s := proto.Size(x.PvtDataReq)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -425,6 +937,13 @@ func init() {
 	proto.RegisterType((*LogLevelResponse)(nil), "protos.LogLevelResponse")
 	proto.RegisterType((*LogSpecRequest)(nil), "protos.LogSpecRequest")
 	proto.RegisterType((*LogSpecResponse)(nil), "protos.LogSpecResponse")
+	proto.RegisterType((*IndexRequest)(nil), "protos.IndexRequest")
+	proto.RegisterType((*StateDBIndex)(nil), "protos.StateDBIndex")
+	proto.RegisterType((*IndexesResponse)(nil), "protos.IndexesResponse")
+	proto.RegisterType((*PvtDataRequest)(nil), "protos.PvtDataRequest")
+	proto.RegisterType((*MissingPvtData)(nil), "protos.MissingPvtData")
+	proto.RegisterType((*ReconciliationStatus)(nil), "protos.ReconciliationStatus")
+	proto.RegisterType((*PvtDataResponse)(nil), "protos.PvtDataResponse")
 	proto.RegisterType((*AdminOperation)(nil), "protos.AdminOperation")
 	proto.RegisterEnum("protos.ServerStatus_StatusCode", ServerStatus_StatusCode_name, ServerStatus_StatusCode_value)
 }
@@ -448,6 +967,13 @@ type AdminClient interface {
 	RevertLogLevels(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetLogSpec(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogSpecResponse, error)
 	SetLogSpec(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*LogSpecResponse, error)
+	ListIndexes(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error)
+	CreateIndex(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error)
+	DropIndex(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error)
+	ExplainQuery(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error)
+	ListMissingPvtData(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error)
+	ReconcilePvtData(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error)
+	MarkPvtDataUnrecoverable(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error)
 }
 
 type adminClient struct {
@@ -521,6 +1047,69 @@ func (c *adminClient) SetLogSpec(ctx context.Context, in *common.Envelope, opts
 	return out, nil
 }
 
+func (c *adminClient) ListIndexes(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error) {
+	out := new(IndexesResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/ListIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateIndex(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error) {
+	out := new(IndexesResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DropIndex(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/protos.Admin/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExplainQuery(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*IndexesResponse, error) {
+	out := new(IndexesResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/ExplainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListMissingPvtData(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error) {
+	out := new(PvtDataResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/ListMissingPvtData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReconcilePvtData(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error) {
+	out := new(PvtDataResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/ReconcilePvtData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) MarkPvtDataUnrecoverable(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (*PvtDataResponse, error) {
+	out := new(PvtDataResponse)
+	err := c.cc.Invoke(ctx, "/protos.Admin/MarkPvtDataUnrecoverable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	GetStatus(context.Context, *common.Envelope) (*ServerStatus, error)
@@ -530,6 +1119,13 @@ type AdminServer interface {
 	RevertLogLevels(context.Context, *common.Envelope) (*empty.Empty, error)
 	GetLogSpec(context.Context, *common.Envelope) (*LogSpecResponse, error)
 	SetLogSpec(context.Context, *common.Envelope) (*LogSpecResponse, error)
+	ListIndexes(context.Context, *common.Envelope) (*IndexesResponse, error)
+	CreateIndex(context.Context, *common.Envelope) (*IndexesResponse, error)
+	DropIndex(context.Context, *common.Envelope) (*empty.Empty, error)
+	ExplainQuery(context.Context, *common.Envelope) (*IndexesResponse, error)
+	ListMissingPvtData(context.Context, *common.Envelope) (*PvtDataResponse, error)
+	ReconcilePvtData(context.Context, *common.Envelope) (*PvtDataResponse, error)
+	MarkPvtDataUnrecoverable(context.Context, *common.Envelope) (*PvtDataResponse, error)
 }
 
 func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
@@ -662,6 +1258,132 @@ func _Admin_SetLogSpec_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/ListIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListIndexes(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateIndex(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DropIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/DropIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DropIndex(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/ExplainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ExplainQuery(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListMissingPvtData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListMissingPvtData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/ListMissingPvtData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListMissingPvtData(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReconcilePvtData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReconcilePvtData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/ReconcilePvtData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReconcilePvtData(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_MarkPvtDataUnrecoverable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Envelope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).MarkPvtDataUnrecoverable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.Admin/MarkPvtDataUnrecoverable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).MarkPvtDataUnrecoverable(ctx, req.(*common.Envelope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Admin",
 	HandlerType: (*AdminServer)(nil),
@@ -694,6 +1416,34 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetLogSpec",
 			Handler:    _Admin_SetLogSpec_Handler,
 		},
+		{
+			MethodName: "ListIndexes",
+			Handler:    _Admin_ListIndexes_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _Admin_CreateIndex_Handler,
+		},
+		{
+			MethodName: "DropIndex",
+			Handler:    _Admin_DropIndex_Handler,
+		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _Admin_ExplainQuery_Handler,
+		},
+		{
+			MethodName: "ListMissingPvtData",
+			Handler:    _Admin_ListMissingPvtData_Handler,
+		},
+		{
+			MethodName: "ReconcilePvtData",
+			Handler:    _Admin_ReconcilePvtData_Handler,
+		},
+		{
+			MethodName: "MarkPvtDataUnrecoverable",
+			Handler:    _Admin_MarkPvtDataUnrecoverable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "peer/admin.proto",
@@ -702,40 +1452,76 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("peer/admin.proto", fileDescriptor_admin_b2904393863b6bc5) }
 
 var fileDescriptor_admin_b2904393863b6bc5 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0x37, 0xda, 0x92, 0xd3, 0xd1, 0x05, 0x33, 0x6d, 0xa5, 0x13, 0x02, 0xe5, 0x0a, 0x84,
-	0x94, 0x88, 0x22, 0x34, 0x76, 0xc1, 0x45, 0x4b, 0x43, 0x87, 0xe8, 0xd2, 0x2a, 0x59, 0x85, 0x40,
-	0x42, 0x55, 0x9a, 0x9e, 0x79, 0x15, 0x6e, 0x9c, 0x39, 0x6e, 0xa5, 0xbd, 0x01, 0xcf, 0xc1, 0x23,
-	0xf0, 0x84, 0x28, 0x76, 0xc2, 0x2a, 0x56, 0x2e, 0xd8, 0xae, 0xec, 0x73, 0xfc, 0x7d, 0xdf, 0xf9,
-	0xb3, 0x0d, 0x66, 0x82, 0x28, 0x9c, 0x70, 0xb6, 0x98, 0xc7, 0x76, 0x22, 0xb8, 0xe4, 0xa4, 0xaa,
-	0x96, 0xb4, 0x75, 0x48, 0x39, 0xa7, 0x0c, 0x1d, 0x65, 0x4e, 0x97, 0xe7, 0x0e, 0x2e, 0x12, 0x79,
-	0xa5, 0x41, 0xad, 0x47, 0x11, 0x5f, 0x2c, 0x78, 0xec, 0xe8, 0x45, 0x3b, 0xad, 0x9f, 0x65, 0xd8,
-	0x09, 0x50, 0xac, 0x50, 0x04, 0x32, 0x94, 0xcb, 0x94, 0x1c, 0x41, 0x35, 0x55, 0xbb, 0x66, 0xf9,
-	0x59, 0xf9, 0x79, 0xa3, 0xfd, 0x54, 0x03, 0x53, 0x7b, 0x1d, 0x65, 0xeb, 0xe5, 0x3d, 0x9f, 0xa1,
-	0x9f, 0xc3, 0xad, 0x2f, 0x00, 0xd7, 0x5e, 0xf2, 0x00, 0x8c, 0xb1, 0xd7, 0x73, 0x3f, 0x7c, 0xf4,
-	0xdc, 0x9e, 0x59, 0x22, 0x75, 0xa8, 0x05, 0x67, 0x1d, 0xff, 0xcc, 0xed, 0x99, 0x65, 0x6d, 0x0c,
-	0x47, 0x23, 0xb7, 0x67, 0x6e, 0x11, 0x80, 0xea, 0xa8, 0x33, 0x0e, 0xdc, 0x9e, 0xb9, 0x4d, 0x0c,
-	0xa8, 0xb8, 0xbe, 0x3f, 0xf4, 0xcd, 0x7b, 0x19, 0x66, 0xec, 0x7d, 0xf2, 0x86, 0x9f, 0x3d, 0xb3,
-	0x62, 0x9d, 0xc2, 0xee, 0x80, 0xd3, 0x01, 0xae, 0x90, 0xf9, 0x78, 0xb9, 0xc4, 0x54, 0x92, 0x27,
-	0x00, 0x8c, 0xd3, 0xc9, 0x82, 0xcf, 0x96, 0x0c, 0x55, 0xaa, 0x86, 0x6f, 0x30, 0x4e, 0x4f, 0x95,
-	0x83, 0x1c, 0x42, 0x66, 0x4c, 0x58, 0x46, 0x69, 0x6e, 0xa9, 0xd3, 0xfb, 0x2c, 0x97, 0xb0, 0x3c,
-	0x30, 0xaf, 0xe5, 0xd2, 0x84, 0xc7, 0x29, 0xde, 0x49, 0xef, 0x25, 0x34, 0x06, 0x9c, 0x06, 0x09,
-	0x46, 0x45, 0x76, 0x8f, 0x21, 0x3b, 0x9d, 0xa4, 0x09, 0x46, 0xb9, 0x56, 0x8d, 0x69, 0x84, 0xd5,
-	0x55, 0xb5, 0x68, 0x70, 0x1e, 0xfb, 0xdf, 0x68, 0xb2, 0x07, 0x15, 0x14, 0x82, 0x8b, 0x3c, 0xa6,
-	0x36, 0xac, 0x1f, 0x65, 0x68, 0x74, 0xb2, 0xf1, 0x0f, 0x13, 0x14, 0xa1, 0x9c, 0xf3, 0x98, 0xbc,
-	0x82, 0x2a, 0xe3, 0xd4, 0xc7, 0x4b, 0xa5, 0x50, 0x6f, 0x1f, 0x14, 0x63, 0xfb, 0xab, 0x71, 0x27,
-	0x25, 0x3f, 0x07, 0x92, 0xb7, 0xaa, 0xe4, 0x3c, 0x6d, 0x15, 0xa0, 0xde, 0xde, 0x5f, 0xa3, 0xad,
-	0x15, 0x74, 0x52, 0xf2, 0xd7, 0xb0, 0x5d, 0x03, 0x6a, 0x11, 0x8f, 0x25, 0xc6, 0xb2, 0xfd, 0x6b,
-	0x1b, 0x2a, 0x2a, 0x15, 0xf2, 0x06, 0x8c, 0x3e, 0xca, 0xfc, 0x16, 0x99, 0x76, 0x7e, 0xcb, 0xdc,
-	0x78, 0x85, 0x8c, 0x27, 0xd8, 0xda, 0xdb, 0x74, 0x8f, 0xac, 0x12, 0x39, 0x82, 0x7a, 0x20, 0x43,
-	0x21, 0xb5, 0xfb, 0x3f, 0x88, 0x1d, 0x78, 0xd8, 0x47, 0xa9, 0xe7, 0x53, 0x14, 0xb9, 0x81, 0xde,
-	0xbc, 0xd9, 0x08, 0xdd, 0x76, 0x2d, 0x11, 0xdc, 0x51, 0xe2, 0x1d, 0xec, 0xfa, 0xb8, 0x42, 0x21,
-	0x8b, 0xb3, 0x4d, 0xb5, 0xef, 0xdb, 0xfa, 0x5d, 0xda, 0xc5, 0xbb, 0xb4, 0xdd, 0xec, 0x5d, 0x5a,
-	0x25, 0x72, 0x0c, 0xd0, 0x47, 0x99, 0x37, 0x7b, 0x03, 0xf3, 0xe0, 0xc6, 0x3c, 0xfe, 0x44, 0x3e,
-	0x06, 0x08, 0x6e, 0x47, 0xed, 0x7e, 0x03, 0x8b, 0x0b, 0x6a, 0x5f, 0x5c, 0x25, 0x28, 0x18, 0xce,
-	0x28, 0x0a, 0xfb, 0x3c, 0x9c, 0x8a, 0x79, 0x54, 0x50, 0xb2, 0x0f, 0xa6, 0xbb, 0xa3, 0xe6, 0x3a,
-	0x0a, 0xa3, 0xef, 0x21, 0xc5, 0xaf, 0x2f, 0xe8, 0x5c, 0x5e, 0x2c, 0xa7, 0x59, 0x18, 0x67, 0x8d,
-	0xe8, 0x68, 0xa2, 0xfe, 0x71, 0x52, 0x27, 0x23, 0x4e, 0xf5, 0x6f, 0xf4, 0xfa, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0x77, 0x16, 0x8f, 0xa8, 0x04, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
+	0x17, 0x8e, 0x37, 0x1f, 0x4d, 0x4e, 0xba, 0x89, 0x5f, 0xbf, 0xdd, 0x6e, 0xe8, 0x2e, 0x74, 0x65,
+	0x21, 0xb1, 0x2b, 0xa4, 0x44, 0x14, 0xa1, 0xb2, 0x20, 0x04, 0xc9, 0x26, 0xb4, 0x15, 0x6d, 0xda,
+	0x75, 0x5a, 0x21, 0x90, 0x90, 0xe5, 0xd8, 0xa7, 0xae, 0x55, 0xdb, 0xe3, 0x8e, 0x27, 0x51, 0xfb,
+	0x77, 0xb8, 0xe0, 0x86, 0x1b, 0x7e, 0x04, 0xbf, 0x06, 0x89, 0x5b, 0xae, 0xd1, 0x7c, 0x38, 0x71,
+	0xd2, 0xb4, 0xa2, 0xbb, 0x57, 0xc9, 0x3c, 0xe7, 0x7b, 0xce, 0x39, 0xcf, 0x18, 0xf4, 0x04, 0x91,
+	0x76, 0x1c, 0x2f, 0x0a, 0xe2, 0x76, 0x42, 0x09, 0x23, 0x46, 0x45, 0xfc, 0xa4, 0x5b, 0xcf, 0x7c,
+	0x42, 0xfc, 0x10, 0x3b, 0xe2, 0x38, 0x9e, 0x9c, 0x77, 0x30, 0x4a, 0xd8, 0x8d, 0x54, 0xda, 0xda,
+	0x5e, 0x16, 0xb2, 0x20, 0xc2, 0x94, 0x39, 0x51, 0xa2, 0x14, 0xfe, 0xef, 0x92, 0x28, 0x22, 0x71,
+	0x47, 0xfe, 0x48, 0xd0, 0xfc, 0x55, 0x83, 0xf5, 0x11, 0xd2, 0x29, 0xd2, 0x11, 0x73, 0xd8, 0x24,
+	0x35, 0x76, 0xa1, 0x92, 0x8a, 0x7f, 0x2d, 0xed, 0x85, 0xf6, 0xb2, 0xb1, 0xb3, 0x2d, 0x15, 0xd3,
+	0x76, 0x5e, 0xab, 0x2d, 0x7f, 0xde, 0x10, 0x0f, 0x2d, 0xa5, 0x6e, 0xfe, 0x04, 0x30, 0x47, 0x8d,
+	0xc7, 0x50, 0x3b, 0x1b, 0xf6, 0x07, 0xdf, 0x1f, 0x0c, 0x07, 0x7d, 0xbd, 0x60, 0xd4, 0x61, 0x6d,
+	0x74, 0xda, 0xb5, 0x4e, 0x07, 0x7d, 0x5d, 0x93, 0x87, 0xe3, 0x93, 0x93, 0x41, 0x5f, 0x7f, 0x64,
+	0x00, 0x54, 0x4e, 0xba, 0x67, 0xa3, 0x41, 0x5f, 0x2f, 0x1a, 0x35, 0x28, 0x0f, 0x2c, 0xeb, 0xd8,
+	0xd2, 0x4b, 0x5c, 0xe7, 0x6c, 0xf8, 0xc3, 0xf0, 0xf8, 0xc7, 0xa1, 0x5e, 0x36, 0x8f, 0xa0, 0x79,
+	0x48, 0xfc, 0x43, 0x9c, 0x62, 0x68, 0xe1, 0xd5, 0x04, 0x53, 0x66, 0x7c, 0x08, 0x10, 0x12, 0xdf,
+	0x8e, 0x88, 0x37, 0x09, 0x51, 0xa4, 0x5a, 0xb3, 0x6a, 0x21, 0xf1, 0x8f, 0x04, 0x60, 0x3c, 0x03,
+	0x7e, 0xb0, 0x43, 0x6e, 0xd2, 0x7a, 0x24, 0xa4, 0xd5, 0x50, 0xb9, 0x30, 0x87, 0xa0, 0xcf, 0xdd,
+	0xa5, 0x09, 0x89, 0x53, 0x7c, 0x2f, 0x7f, 0x9f, 0x42, 0xe3, 0x90, 0xf8, 0xa3, 0x04, 0xdd, 0x2c,
+	0xbb, 0x0f, 0x80, 0x4b, 0xed, 0x34, 0x41, 0x57, 0xf9, 0x5a, 0x0b, 0xa5, 0x86, 0xd9, 0x13, 0xb5,
+	0x48, 0x65, 0x15, 0xfb, 0x6e, 0x6d, 0x63, 0x03, 0xca, 0x48, 0x29, 0xa1, 0x2a, 0xa6, 0x3c, 0x98,
+	0x7f, 0x69, 0xb0, 0x7e, 0x10, 0x7b, 0x78, 0x9d, 0xbb, 0x0d, 0xf7, 0xc2, 0x89, 0x63, 0x0c, 0xed,
+	0xc0, 0xcb, 0xb2, 0x57, 0xc8, 0x81, 0x67, 0x3c, 0x87, 0x5a, 0xec, 0x44, 0x98, 0x26, 0x8e, 0x8b,
+	0xca, 0xd3, 0x1c, 0x30, 0x3e, 0x02, 0x70, 0x49, 0x18, 0xa2, 0xcb, 0x02, 0x12, 0xb7, 0x8a, 0x42,
+	0x9c, 0x43, 0x8c, 0x57, 0xa0, 0x07, 0x3c, 0x98, 0xed, 0xe1, 0x79, 0x10, 0x07, 0x42, 0xab, 0x24,
+	0xb4, 0x9a, 0x02, 0xef, 0xcf, 0x60, 0x9e, 0x87, 0x87, 0x69, 0xe0, 0xc7, 0xb6, 0x47, 0xdc, 0x56,
+	0x59, 0x46, 0x92, 0x48, 0x9f, 0xb8, 0x5c, 0x2c, 0x3d, 0xf1, 0xe0, 0xad, 0x8a, 0x14, 0x0b, 0x64,
+	0xe8, 0x44, 0xc8, 0x8b, 0xbd, 0x9a, 0x20, 0xbd, 0x69, 0xad, 0xc9, 0x62, 0xc5, 0xc1, 0x74, 0x60,
+	0x9d, 0xcf, 0x15, 0xf6, 0x7b, 0xa2, 0xe4, 0xa5, 0x18, 0xda, 0x72, 0x0c, 0x03, 0x4a, 0xc2, 0xbb,
+	0x2c, 0x53, 0xfc, 0xe7, 0x15, 0xe6, 0x72, 0x57, 0x15, 0xce, 0x11, 0xb3, 0x0b, 0x4d, 0xe1, 0x1b,
+	0xd3, 0x59, 0x4f, 0xda, 0xb0, 0x16, 0x48, 0xa8, 0xa5, 0xbd, 0x28, 0xbe, 0xac, 0xef, 0x6c, 0xcc,
+	0xf6, 0x20, 0x97, 0x8c, 0x95, 0x29, 0x99, 0xbf, 0x6b, 0xd0, 0x38, 0x99, 0xb2, 0xbe, 0xc3, 0x9c,
+	0xff, 0xd8, 0x94, 0x6d, 0xa8, 0xa7, 0xcc, 0xa1, 0xcc, 0x1e, 0x87, 0xc4, 0xbd, 0x14, 0xf9, 0x96,
+	0x2c, 0x10, 0x50, 0x8f, 0x23, 0x7c, 0xe6, 0x30, 0xf6, 0x94, 0xb8, 0x28, 0xc4, 0x55, 0x8c, 0x3d,
+	0x29, 0x5c, 0x68, 0x69, 0xe9, 0xfe, 0x96, 0x96, 0x97, 0x5b, 0x6a, 0xfe, 0xa9, 0x41, 0xe3, 0x28,
+	0x48, 0xd3, 0x20, 0xf6, 0x55, 0xd2, 0x3c, 0x9a, 0x88, 0x64, 0xc7, 0x93, 0x48, 0x24, 0x5b, 0xb2,
+	0xaa, 0x02, 0x18, 0x4e, 0x22, 0xe3, 0x09, 0x54, 0xd8, 0xb5, 0x90, 0xc8, 0x34, 0xcb, 0xec, 0x9a,
+	0xc3, 0x0b, 0x49, 0x14, 0xef, 0x4f, 0xa2, 0x74, 0x6b, 0xae, 0xb6, 0xa0, 0x8a, 0x61, 0xe0, 0x07,
+	0xe3, 0x10, 0x45, 0x8a, 0x55, 0x6b, 0x76, 0x36, 0x3e, 0x86, 0xc7, 0x93, 0x98, 0xa2, 0x4b, 0xa6,
+	0x48, 0x9d, 0x71, 0x28, 0x87, 0xa5, 0x6a, 0x2d, 0x82, 0xe6, 0x1f, 0x1a, 0x6c, 0x58, 0xe8, 0x92,
+	0xd8, 0x0d, 0xc2, 0xc0, 0xe1, 0x4e, 0x15, 0x89, 0xbd, 0x06, 0x79, 0x91, 0x36, 0xe7, 0x40, 0x51,
+	0x4d, 0x7d, 0x67, 0xab, 0x2d, 0x09, 0xb2, 0x9d, 0x11, 0x64, 0xfb, 0x34, 0x23, 0x48, 0xab, 0x26,
+	0xb4, 0xf9, 0xd9, 0xf8, 0x04, 0x9a, 0xde, 0x84, 0x0a, 0x67, 0x76, 0x14, 0x84, 0x61, 0x90, 0x8a,
+	0x9a, 0x8b, 0x56, 0x23, 0x83, 0x8f, 0x04, 0xca, 0xcb, 0xa3, 0x2a, 0x36, 0x7a, 0xaa, 0x3f, 0x39,
+	0x64, 0xbe, 0xba, 0xa5, 0xfc, 0xea, 0xfe, 0xa6, 0x41, 0x73, 0x36, 0x27, 0x6a, 0xd6, 0xbe, 0x03,
+	0x3d, 0x92, 0xcd, 0xb0, 0x93, 0x29, 0xb3, 0x3d, 0x87, 0x39, 0x6a, 0xe8, 0x36, 0xb3, 0xa1, 0x5b,
+	0x6c, 0x96, 0xd5, 0x88, 0x16, 0x9b, 0xf7, 0x16, 0x9e, 0xd0, 0x85, 0x7b, 0xb0, 0x15, 0x87, 0x3f,
+	0x12, 0xa5, 0x3f, 0xcf, 0xdc, 0xac, 0xba, 0x2c, 0x6b, 0x83, 0xae, 0x40, 0xcd, 0x7f, 0x34, 0x68,
+	0x74, 0xf9, 0x1b, 0x74, 0x9c, 0xa0, 0xac, 0xdb, 0xf8, 0x0c, 0x2a, 0x21, 0xf1, 0x2d, 0xbc, 0x52,
+	0x37, 0xfa, 0x34, 0x73, 0xbb, 0x44, 0xce, 0xfb, 0x05, 0x4b, 0x29, 0x1a, 0x5f, 0x02, 0x28, 0x2a,
+	0xe3, 0x66, 0x32, 0x9b, 0xcd, 0x9c, 0x59, 0x8e, 0x34, 0xf7, 0x0b, 0x56, 0x4e, 0xd7, 0xd8, 0x81,
+	0x6a, 0xa0, 0x28, 0x4e, 0x5c, 0x6e, 0x6e, 0x03, 0xf3, 0xd4, 0xb7, 0x5f, 0xb0, 0x66, 0x7a, 0x3c,
+	0x5a, 0x32, 0xdb, 0xc1, 0x56, 0x69, 0x31, 0xda, 0xe2, 0x76, 0xf2, 0x68, 0x73, 0xdd, 0x5e, 0x0d,
+	0xd6, 0x5c, 0x12, 0x33, 0x8c, 0xd9, 0xce, 0xdf, 0x15, 0x28, 0x8b, 0xc2, 0x8d, 0x2f, 0xa0, 0xb6,
+	0x87, 0x4c, 0x8d, 0x94, 0xde, 0x56, 0xef, 0xe6, 0x20, 0x9e, 0x62, 0x48, 0x12, 0xdc, 0xda, 0x58,
+	0xf5, 0x32, 0x9a, 0x05, 0x63, 0x17, 0xea, 0x23, 0x3e, 0x4e, 0x12, 0x7e, 0x80, 0x61, 0x17, 0xfe,
+	0xb7, 0x87, 0x4c, 0xbe, 0x38, 0xd9, 0x95, 0xae, 0x30, 0x6f, 0xdd, 0xbe, 0x76, 0x39, 0x48, 0xd2,
+	0xc5, 0xe8, 0x3d, 0x5d, 0x7c, 0x03, 0x4d, 0x0b, 0xa7, 0x48, 0x59, 0x26, 0x5b, 0x55, 0xfb, 0xe6,
+	0xad, 0x65, 0x1a, 0xf0, 0x4f, 0x11, 0xb3, 0xc0, 0x57, 0x6f, 0x0f, 0x99, 0x6a, 0xed, 0x0a, 0xcb,
+	0xa7, 0xb7, 0xba, 0x3f, 0x8b, 0xfc, 0x1a, 0x60, 0xf4, 0x8e, 0xa6, 0x5f, 0x41, 0xfd, 0x30, 0x48,
+	0x99, 0x62, 0xf1, 0xfb, 0x6c, 0x97, 0x88, 0x5e, 0xda, 0xbe, 0xa1, 0xe8, 0x30, 0x14, 0xa2, 0x87,
+	0xd9, 0xee, 0x42, 0xad, 0x4f, 0x49, 0x72, 0x97, 0xe5, 0xdd, 0xd7, 0xf4, 0x35, 0xac, 0x0f, 0xae,
+	0x93, 0xd0, 0x09, 0xe2, 0xb7, 0xfc, 0x95, 0x7b, 0x58, 0xd4, 0x2e, 0x18, 0xbc, 0xda, 0x25, 0x06,
+	0xbf, 0xc7, 0xc5, 0x12, 0xe3, 0x98, 0x05, 0xe3, 0x5b, 0xd0, 0x33, 0x32, 0xc0, 0x77, 0x72, 0xb0,
+	0x07, 0xad, 0x23, 0x87, 0x5e, 0x2a, 0xc1, 0x59, 0x9e, 0x97, 0x1f, 0xe4, 0xa8, 0xf7, 0x0b, 0x98,
+	0x84, 0xfa, 0xed, 0x8b, 0x9b, 0x04, 0x69, 0x88, 0x9e, 0x8f, 0xb4, 0x7d, 0xee, 0x8c, 0x69, 0xe0,
+	0x66, 0x26, 0x09, 0x22, 0xed, 0xad, 0x8b, 0x95, 0x3c, 0x71, 0xdc, 0x4b, 0xc7, 0xc7, 0x9f, 0x5f,
+	0xf9, 0x01, 0xbb, 0x98, 0x8c, 0x79, 0x98, 0x4e, 0xce, 0xb0, 0x23, 0x0d, 0xe5, 0x27, 0x70, 0xda,
+	0xe1, 0x86, 0x63, 0xf9, 0xed, 0xfc, 0xf9, 0xbf, 0x03, 0x00, 0x1a, 0xf6, 0x7b, 0x51, 0x56, 0x0b,
+	0x00, 0x00,
 }
@@ -49,6 +49,7 @@ const (
 	ChaincodeMessage_GET_STATE_METADATA    ChaincodeMessage_Type = 20
 	ChaincodeMessage_PUT_STATE_METADATA    ChaincodeMessage_Type = 21
 	ChaincodeMessage_GET_PRIVATE_DATA_HASH ChaincodeMessage_Type = 22
+	ChaincodeMessage_PURGE_PRIVATE_DATA    ChaincodeMessage_Type = 23
 )
 
 var ChaincodeMessage_Type_name = map[int32]string{
@@ -74,6 +75,7 @@ var ChaincodeMessage_Type_name = map[int32]string{
 	20: "GET_STATE_METADATA",
 	21: "PUT_STATE_METADATA",
 	22: "GET_PRIVATE_DATA_HASH",
+	23: "PURGE_PRIVATE_DATA",
 }
 var ChaincodeMessage_Type_value = map[string]int32{
 	"UNDEFINED":             0,
@@ -98,6 +100,7 @@ var ChaincodeMessage_Type_value = map[string]int32{
 	"GET_STATE_METADATA":    20,
 	"PUT_STATE_METADATA":    21,
 	"GET_PRIVATE_DATA_HASH": 22,
+	"PURGE_PRIVATE_DATA":    23,
 }
 
 func (x ChaincodeMessage_Type) String() string {
@@ -407,7 +410,8 @@ func (m *PutStateMetadata) GetMetadata() *StateMetadata {
 // DelState is the payload of a ChaincodeMessage. It contains a key which
 // needs to be recorded in the transaction's write set as a delete operation.
 // If the collection is specified, the key needs to be recorded in the
-// transaction's private write set as a delete operation.
+// transaction's private write set as a delete operation. DelState is also the
+// payload of PURGE_PRIVATE_DATA, in which case the collection is mandatory.
 type DelState struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
@@ -628,9 +632,14 @@ func (m *QueryMetadata) GetBookmark() string {
 }
 
 // GetHistoryForKey is the payload of a ChaincodeMessage. It contains a key
-// for which the historical values need to be retrieved.
+// for which the historical values need to be retrieved. When a collection is
+// set, the history of the hashes of the values of the private data key is
+// retrieved. The optional metadata (HistoryQueryMetadata) bounds, orders, and
+// paginates the history.
 type GetHistoryForKey struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Collection           string   `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -667,6 +676,111 @@ func (m *GetHistoryForKey) GetKey() string {
 	return ""
 }
 
+func (m *GetHistoryForKey) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *GetHistoryForKey) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// HistoryQueryMetadata is the metadata of a GetHistoryForKey. It bounds the
+// history by a range of blocks (both inclusive, an endBlock of 0 denotes no
+// upper bound) and by a range of transaction timestamps (startTime inclusive,
+// endTime exclusive), sets the order of the results, and contains a pageSize
+// which denotes the number of records to be fetched and a bookmark.
+type HistoryQueryMetadata struct {
+	StartBlock           uint64               `protobuf:"varint,1,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	EndBlock             uint64               `protobuf:"varint,2,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Descending           bool                 `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	PageSize             int32                `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Bookmark             string               `protobuf:"bytes,7,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HistoryQueryMetadata) Reset()         { *m = HistoryQueryMetadata{} }
+func (m *HistoryQueryMetadata) String() string { return proto.CompactTextString(m) }
+func (*HistoryQueryMetadata) ProtoMessage()    {}
+func (*HistoryQueryMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{10}
+}
+func (m *HistoryQueryMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryQueryMetadata.Unmarshal(m, b)
+}
+func (m *HistoryQueryMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryQueryMetadata.Marshal(b, m, deterministic)
+}
+func (dst *HistoryQueryMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryQueryMetadata.Merge(dst, src)
+}
+func (m *HistoryQueryMetadata) XXX_Size() int {
+	return xxx_messageInfo_HistoryQueryMetadata.Size(m)
+}
+func (m *HistoryQueryMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryQueryMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryQueryMetadata proto.InternalMessageInfo
+
+func (m *HistoryQueryMetadata) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *HistoryQueryMetadata) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *HistoryQueryMetadata) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *HistoryQueryMetadata) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *HistoryQueryMetadata) GetBookmark() string {
+	if m != nil {
+		return m.Bookmark
+	}
+	return ""
+}
+
 type QueryStateNext struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -678,7 +792,7 @@ func (m *QueryStateNext) Reset()         { *m = QueryStateNext{} }
 func (m *QueryStateNext) String() string { return proto.CompactTextString(m) }
 func (*QueryStateNext) ProtoMessage()    {}
 func (*QueryStateNext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{10}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{11}
 }
 func (m *QueryStateNext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateNext.Unmarshal(m, b)
@@ -716,7 +830,7 @@ func (m *QueryStateClose) Reset()         { *m = QueryStateClose{} }
 func (m *QueryStateClose) String() string { return proto.CompactTextString(m) }
 func (*QueryStateClose) ProtoMessage()    {}
 func (*QueryStateClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{11}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{12}
 }
 func (m *QueryStateClose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryStateClose.Unmarshal(m, b)
@@ -755,7 +869,7 @@ func (m *QueryResultBytes) Reset()         { *m = QueryResultBytes{} }
 func (m *QueryResultBytes) String() string { return proto.CompactTextString(m) }
 func (*QueryResultBytes) ProtoMessage()    {}
 func (*QueryResultBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{12}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{13}
 }
 func (m *QueryResultBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResultBytes.Unmarshal(m, b)
@@ -801,7 +915,7 @@ func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{13}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{14}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -863,7 +977,7 @@ func (m *QueryResponseMetadata) Reset()         { *m = QueryResponseMetadata{} }
 func (m *QueryResponseMetadata) String() string { return proto.CompactTextString(m) }
 func (*QueryResponseMetadata) ProtoMessage()    {}
 func (*QueryResponseMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{14}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{15}
 }
 func (m *QueryResponseMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponseMetadata.Unmarshal(m, b)
@@ -909,7 +1023,7 @@ func (m *StateMetadata) Reset()         { *m = StateMetadata{} }
 func (m *StateMetadata) String() string { return proto.CompactTextString(m) }
 func (*StateMetadata) ProtoMessage()    {}
 func (*StateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{15}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{16}
 }
 func (m *StateMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadata.Unmarshal(m, b)
@@ -954,7 +1068,7 @@ func (m *StateMetadataResult) Reset()         { *m = StateMetadataResult{} }
 func (m *StateMetadataResult) String() string { return proto.CompactTextString(m) }
 func (*StateMetadataResult) ProtoMessage()    {}
 func (*StateMetadataResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{16}
+	return fileDescriptor_chaincode_shim_b04d3028f86b65a2, []int{17}
 }
 func (m *StateMetadataResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateMetadataResult.Unmarshal(m, b)
@@ -992,6 +1106,7 @@ func init() {
 	proto.RegisterType((*GetQueryResult)(nil), "protos.GetQueryResult")
 	proto.RegisterType((*QueryMetadata)(nil), "protos.QueryMetadata")
 	proto.RegisterType((*GetHistoryForKey)(nil), "protos.GetHistoryForKey")
+	proto.RegisterType((*HistoryQueryMetadata)(nil), "protos.HistoryQueryMetadata")
 	proto.RegisterType((*QueryStateNext)(nil), "protos.QueryStateNext")
 	proto.RegisterType((*QueryStateClose)(nil), "protos.QueryStateClose")
 	proto.RegisterType((*QueryResultBytes)(nil), "protos.QueryResultBytes")
@@ -1111,70 +1226,76 @@ func init() {
 }
 
 var fileDescriptor_chaincode_shim_b04d3028f86b65a2 = []byte{
-	// 1027 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x73, 0xda, 0x46,
-	0x14, 0x0e, 0x06, 0x8c, 0x78, 0xd8, 0x78, 0xb3, 0x0e, 0x2e, 0x66, 0x26, 0x2d, 0x65, 0x7a, 0xa0,
-	0x17, 0x68, 0x68, 0x0f, 0x3d, 0x74, 0x26, 0x83, 0x61, 0x8d, 0x19, 0xdb, 0x40, 0x56, 0xb2, 0x27,
-	0xee, 0x45, 0x23, 0xa4, 0xb5, 0xd0, 0x58, 0x68, 0x55, 0x69, 0x49, 0x43, 0x6f, 0xbd, 0xf6, 0xd8,
-	0x3f, 0xae, 0x7f, 0x4f, 0x67, 0xf5, 0xcb, 0x80, 0xeb, 0xa4, 0x93, 0x13, 0xfa, 0xde, 0xfb, 0xf6,
-	0x7b, 0xbf, 0xf6, 0x21, 0xc1, 0xa9, 0xcf, 0x58, 0xd0, 0x35, 0x17, 0x86, 0xe3, 0x99, 0xdc, 0x62,
-	0x7a, 0xb8, 0x70, 0x96, 0x1d, 0x3f, 0xe0, 0x82, 0xe3, 0xfd, 0xe8, 0x27, 0x6c, 0x34, 0x76, 0x28,
-	0xec, 0x03, 0xf3, 0x44, 0xcc, 0x69, 0x1c, 0x47, 0x3e, 0x3f, 0xe0, 0x3e, 0x0f, 0x0d, 0x37, 0x31,
-	0x7e, 0x63, 0x73, 0x6e, 0xbb, 0xac, 0x1b, 0xa1, 0xf9, 0xea, 0xbe, 0x2b, 0x9c, 0x25, 0x0b, 0x85,
-	0xb1, 0xf4, 0x63, 0x42, 0xeb, 0x9f, 0x22, 0xa0, 0x41, 0xaa, 0x77, 0xcd, 0xc2, 0xd0, 0xb0, 0x19,
-	0x7e, 0x03, 0x05, 0xb1, 0xf6, 0x59, 0x3d, 0xd7, 0xcc, 0xb5, 0xab, 0xbd, 0xd7, 0x31, 0x35, 0xec,
-	0xec, 0xf2, 0x3a, 0xda, 0xda, 0x67, 0x34, 0xa2, 0xe2, 0x9f, 0xa1, 0x9c, 0x49, 0xd7, 0xf7, 0x9a,
-	0xb9, 0x76, 0xa5, 0xd7, 0xe8, 0xc4, 0xc1, 0x3b, 0x69, 0xf0, 0x8e, 0x96, 0x32, 0xe8, 0x23, 0x19,
-	0xd7, 0xa1, 0xe4, 0x1b, 0x6b, 0x97, 0x1b, 0x56, 0x3d, 0xdf, 0xcc, 0xb5, 0x0f, 0x68, 0x0a, 0x31,
-	0x86, 0x82, 0xf8, 0xe8, 0x58, 0xf5, 0x42, 0x33, 0xd7, 0x2e, 0xd3, 0xe8, 0x19, 0xf7, 0x40, 0x49,
-	0x4b, 0xac, 0x17, 0xa3, 0x30, 0x27, 0x69, 0x7a, 0xaa, 0x63, 0x7b, 0xcc, 0x9a, 0x25, 0x5e, 0x9a,
-	0xf1, 0xf0, 0x5b, 0x38, 0xda, 0x69, 0x59, 0x7d, 0x7f, 0xfb, 0x68, 0x56, 0x19, 0x91, 0x5e, 0x5a,
-	0x35, 0xb7, 0x30, 0x7e, 0x0d, 0x60, 0x2e, 0x0c, 0xcf, 0x63, 0xae, 0xee, 0x58, 0xf5, 0x52, 0x94,
-	0x4e, 0x39, 0xb1, 0x8c, 0xad, 0xd6, 0xdf, 0x79, 0x28, 0xc8, 0x56, 0xe0, 0x43, 0x28, 0xdf, 0x4c,
-	0x86, 0xe4, 0x7c, 0x3c, 0x21, 0x43, 0xf4, 0x02, 0x1f, 0x80, 0x42, 0xc9, 0x68, 0xac, 0x6a, 0x84,
-	0xa2, 0x1c, 0xae, 0x02, 0xa4, 0x88, 0x0c, 0xd1, 0x1e, 0x56, 0xa0, 0x30, 0x9e, 0x8c, 0x35, 0x94,
-	0xc7, 0x65, 0x28, 0x52, 0xd2, 0x1f, 0xde, 0xa1, 0x02, 0x3e, 0x82, 0x8a, 0x46, 0xfb, 0x13, 0xb5,
-	0x3f, 0xd0, 0xc6, 0xd3, 0x09, 0x2a, 0x4a, 0xc9, 0xc1, 0xf4, 0x7a, 0x76, 0x45, 0x34, 0x32, 0x44,
-	0xfb, 0x92, 0x4a, 0x28, 0x9d, 0x52, 0x54, 0x92, 0x9e, 0x11, 0xd1, 0x74, 0x55, 0xeb, 0x6b, 0x04,
-	0x29, 0x12, 0xce, 0x6e, 0x52, 0x58, 0x96, 0x70, 0x48, 0xae, 0x12, 0x08, 0xf8, 0x15, 0xa0, 0xf1,
-	0xe4, 0x76, 0x7a, 0x49, 0xf4, 0xc1, 0x45, 0x7f, 0x3c, 0x19, 0x4c, 0x87, 0x04, 0x55, 0xe2, 0x04,
-	0xd5, 0xd9, 0x74, 0xa2, 0x12, 0x74, 0x88, 0x4f, 0x00, 0x67, 0x82, 0xfa, 0xd9, 0x9d, 0x4e, 0xfb,
-	0x93, 0x11, 0x41, 0x55, 0x79, 0x56, 0xda, 0xdf, 0xdd, 0x10, 0x7a, 0xa7, 0x53, 0xa2, 0xde, 0x5c,
-	0x69, 0xe8, 0x48, 0x5a, 0x63, 0x4b, 0xcc, 0x9f, 0x90, 0xf7, 0x1a, 0x42, 0xb8, 0x06, 0x2f, 0x37,
-	0xad, 0x83, 0xab, 0xa9, 0x4a, 0xd0, 0x4b, 0x99, 0xcd, 0x25, 0x21, 0xb3, 0xfe, 0xd5, 0xf8, 0x96,
-	0x20, 0x8c, 0xbf, 0x82, 0x63, 0xa9, 0x78, 0x31, 0x56, 0xb5, 0x29, 0xbd, 0xd3, 0xcf, 0xa7, 0x54,
-	0xbf, 0x24, 0x77, 0xe8, 0x78, 0x3b, 0x85, 0x6b, 0xa2, 0xf5, 0x87, 0x7d, 0xad, 0x8f, 0x5e, 0x49,
-	0x7b, 0x56, 0xdc, 0xa3, 0xbd, 0x86, 0x4f, 0xa1, 0x26, 0xf9, 0x33, 0x3a, 0xbe, 0x95, 0x1e, 0x69,
-	0xd5, 0x2f, 0xfa, 0xea, 0x05, 0x3a, 0x69, 0xfd, 0x02, 0xca, 0x88, 0x09, 0x55, 0x18, 0x82, 0x61,
-	0x04, 0xf9, 0x07, 0xb6, 0x8e, 0xae, 0x73, 0x99, 0xca, 0x47, 0xfc, 0x35, 0x80, 0xc9, 0x5d, 0x97,
-	0x99, 0xc2, 0xe1, 0x5e, 0x74, 0x5f, 0xcb, 0x74, 0xc3, 0xd2, 0x1a, 0x02, 0x4a, 0x4f, 0x5f, 0x33,
-	0x61, 0x58, 0x86, 0x30, 0xbe, 0x40, 0x85, 0x82, 0x32, 0x5b, 0x3d, 0x9b, 0xc3, 0x2b, 0x28, 0x7e,
-	0x30, 0xdc, 0x15, 0x8b, 0x0e, 0x1e, 0xd0, 0x18, 0xec, 0x68, 0xe6, 0x9f, 0x68, 0xfe, 0x0e, 0x28,
-	0xd5, 0xfc, 0xdf, 0x99, 0x3d, 0x51, 0xc1, 0x6f, 0x40, 0x59, 0x26, 0xa7, 0xa3, 0xf5, 0xaa, 0xf4,
-	0x6a, 0xd9, 0x1a, 0x6d, 0x4a, 0xd3, 0x8c, 0x26, 0x1b, 0x3a, 0x64, 0xee, 0x97, 0x36, 0xf4, 0xcf,
-	0x1c, 0x1c, 0xa5, 0x1d, 0x3d, 0x5b, 0x53, 0xc3, 0xb3, 0x19, 0x6e, 0x80, 0x12, 0x0a, 0x23, 0x10,
-	0x97, 0x99, 0x54, 0x86, 0xf1, 0x09, 0xec, 0x33, 0xcf, 0x92, 0x9e, 0x58, 0x2b, 0x41, 0x9f, 0x2d,
-	0xac, 0xb1, 0x53, 0xd8, 0xc1, 0x46, 0x05, 0x73, 0xa8, 0x8e, 0x98, 0x78, 0xb7, 0x62, 0xc1, 0x9a,
-	0xb2, 0x70, 0xe5, 0x0a, 0x39, 0x82, 0xdf, 0x24, 0x4c, 0xc2, 0xc7, 0xe0, 0x73, 0xb5, 0x6c, 0xc5,
-	0xc8, 0xef, 0xc4, 0x18, 0xc1, 0x61, 0x14, 0x20, 0x9b, 0x4d, 0x03, 0x14, 0xdf, 0xb0, 0x99, 0xea,
-	0xfc, 0x11, 0xff, 0x9f, 0x16, 0x69, 0x86, 0xa5, 0x6f, 0xce, 0xf9, 0xc3, 0xd2, 0x08, 0x1e, 0x92,
-	0x30, 0x19, 0x6e, 0x7d, 0x17, 0xdd, 0xc0, 0x0b, 0x27, 0x14, 0x3c, 0x58, 0x9f, 0xf3, 0x40, 0x16,
-	0xff, 0xa4, 0xed, 0xad, 0x26, 0x54, 0xa3, 0x70, 0x51, 0x5f, 0x27, 0xec, 0xa3, 0xc0, 0x55, 0xd8,
-	0x73, 0xac, 0x84, 0xb2, 0xe7, 0x58, 0xad, 0x6f, 0xe1, 0xe8, 0x91, 0x31, 0x70, 0x79, 0xc8, 0x9e,
-	0x50, 0x7e, 0x02, 0xb4, 0xd1, 0x94, 0xb3, 0xb5, 0x60, 0x21, 0x6e, 0x42, 0x25, 0x78, 0x84, 0x11,
-	0xf9, 0x80, 0x6e, 0x9a, 0x5a, 0x7f, 0xe5, 0x92, 0x52, 0x29, 0x0b, 0x7d, 0xee, 0x85, 0x0c, 0xf7,
-	0xa0, 0x14, 0x13, 0x24, 0x3f, 0xdf, 0xae, 0xf4, 0xea, 0xe9, 0x9d, 0xda, 0x95, 0xa7, 0x29, 0x11,
-	0x9f, 0x82, 0xb2, 0x30, 0x42, 0x7d, 0xc9, 0x83, 0x78, 0x0f, 0x14, 0x5a, 0x5a, 0x18, 0xe1, 0x35,
-	0x0f, 0xd2, 0x34, 0xf3, 0x69, 0x9a, 0x9f, 0x1c, 0xad, 0x0d, 0xb5, 0xad, 0x5c, 0xb2, 0xf6, 0xf7,
-	0xa0, 0x76, 0xcf, 0x84, 0xb9, 0x60, 0x96, 0x1e, 0x30, 0x93, 0x07, 0x56, 0xa8, 0x9b, 0x7c, 0xe5,
-	0x89, 0x64, 0x16, 0xc7, 0x89, 0x93, 0xc6, 0xbe, 0x81, 0x74, 0x7d, 0x72, 0x2c, 0x6f, 0xe1, 0x70,
-	0x7b, 0xf7, 0xea, 0x50, 0x92, 0x59, 0x3c, 0xce, 0x25, 0x85, 0xff, 0xbd, 0xdf, 0xad, 0x73, 0x38,
-	0xde, 0xde, 0xb0, 0xf8, 0x26, 0x76, 0xa1, 0xc4, 0x3c, 0x11, 0x38, 0x2c, 0xed, 0xdd, 0x33, 0xfb,
-	0x98, 0xb2, 0x7a, 0xef, 0x37, 0xde, 0xdb, 0xea, 0xca, 0xf7, 0x79, 0x20, 0xf0, 0x10, 0x14, 0xca,
-	0x6c, 0x27, 0x14, 0x2c, 0xc0, 0xf5, 0xe7, 0xde, 0xda, 0x8d, 0x67, 0x3d, 0xad, 0x17, 0xed, 0xdc,
-	0x0f, 0xb9, 0xb3, 0x29, 0xb4, 0x78, 0x60, 0x77, 0x16, 0x6b, 0x9f, 0x05, 0x2e, 0xb3, 0x6c, 0x16,
-	0x74, 0xee, 0x8d, 0x79, 0xe0, 0x98, 0xe9, 0x39, 0xf9, 0xa1, 0xf1, 0xeb, 0xf7, 0xb6, 0x23, 0x16,
-	0xab, 0x79, 0xc7, 0xe4, 0xcb, 0xee, 0x06, 0xb5, 0x1b, 0x53, 0xe3, 0x0f, 0x8e, 0xb0, 0x2b, 0xa9,
-	0xf3, 0xf8, 0xeb, 0xe5, 0xc7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x82, 0x3d, 0x52, 0xe1,
-	0x08, 0x00, 0x00,
+	// 1121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5d, 0x73, 0xda, 0x46,
+	0x17, 0x0e, 0x06, 0x1b, 0x71, 0x6c, 0xe3, 0xcd, 0xfa, 0x23, 0x0a, 0x33, 0xc9, 0xcb, 0xcb, 0x15,
+	0xbd, 0x81, 0x86, 0xe6, 0xa2, 0x17, 0x9d, 0xc9, 0x60, 0x58, 0x63, 0xc6, 0x36, 0x90, 0x95, 0x9c,
+	0x89, 0x7b, 0xa3, 0x0a, 0x69, 0x23, 0x34, 0x16, 0x5a, 0x55, 0x5a, 0xd2, 0xd0, 0xbb, 0xde, 0xf6,
+	0x27, 0xf4, 0x27, 0xf4, 0xc7, 0xf5, 0x37, 0x74, 0x56, 0x5f, 0x06, 0x5c, 0xdb, 0x93, 0x5c, 0xc1,
+	0x73, 0xce, 0x73, 0x9e, 0x73, 0xf6, 0xe8, 0xec, 0x07, 0xbc, 0x0c, 0x18, 0x0b, 0xdb, 0xd6, 0xcc,
+	0x74, 0x7d, 0x8b, 0xdb, 0xcc, 0x88, 0x66, 0xee, 0xbc, 0x15, 0x84, 0x5c, 0x70, 0xbc, 0x13, 0xff,
+	0x44, 0xb5, 0xda, 0x06, 0x85, 0x7d, 0x66, 0xbe, 0x48, 0x38, 0xb5, 0xc3, 0xd8, 0x17, 0x84, 0x3c,
+	0xe0, 0x91, 0xe9, 0xa5, 0xc6, 0xff, 0x39, 0x9c, 0x3b, 0x1e, 0x6b, 0xc7, 0x68, 0xba, 0xf8, 0xd4,
+	0x16, 0xee, 0x9c, 0x45, 0xc2, 0x9c, 0x07, 0x09, 0xa1, 0xf1, 0xcf, 0x36, 0xa0, 0x5e, 0xa6, 0x77,
+	0xc5, 0xa2, 0xc8, 0x74, 0x18, 0x7e, 0x03, 0x25, 0xb1, 0x0c, 0x98, 0x5a, 0xa8, 0x17, 0x9a, 0xd5,
+	0xce, 0xab, 0x84, 0x1a, 0xb5, 0x36, 0x79, 0x2d, 0x7d, 0x19, 0x30, 0x1a, 0x53, 0xf1, 0x8f, 0x50,
+	0xc9, 0xa5, 0xd5, 0xad, 0x7a, 0xa1, 0xb9, 0xdb, 0xa9, 0xb5, 0x92, 0xe4, 0xad, 0x2c, 0x79, 0x4b,
+	0xcf, 0x18, 0xf4, 0x8e, 0x8c, 0x55, 0x28, 0x07, 0xe6, 0xd2, 0xe3, 0xa6, 0xad, 0x16, 0xeb, 0x85,
+	0xe6, 0x1e, 0xcd, 0x20, 0xc6, 0x50, 0x12, 0x5f, 0x5c, 0x5b, 0x2d, 0xd5, 0x0b, 0xcd, 0x0a, 0x8d,
+	0xff, 0xe3, 0x0e, 0x28, 0xd9, 0x12, 0xd5, 0xed, 0x38, 0xcd, 0x49, 0x56, 0x9e, 0xe6, 0x3a, 0x3e,
+	0xb3, 0x27, 0xa9, 0x97, 0xe6, 0x3c, 0xfc, 0x0e, 0x0e, 0x36, 0x5a, 0xa6, 0xee, 0xac, 0x87, 0xe6,
+	0x2b, 0x23, 0xd2, 0x4b, 0xab, 0xd6, 0x1a, 0xc6, 0xaf, 0x00, 0xac, 0x99, 0xe9, 0xfb, 0xcc, 0x33,
+	0x5c, 0x5b, 0x2d, 0xc7, 0xe5, 0x54, 0x52, 0xcb, 0xd0, 0x6e, 0xfc, 0x5d, 0x84, 0x92, 0x6c, 0x05,
+	0xde, 0x87, 0xca, 0xf5, 0xa8, 0x4f, 0xce, 0x86, 0x23, 0xd2, 0x47, 0xcf, 0xf0, 0x1e, 0x28, 0x94,
+	0x0c, 0x86, 0x9a, 0x4e, 0x28, 0x2a, 0xe0, 0x2a, 0x40, 0x86, 0x48, 0x1f, 0x6d, 0x61, 0x05, 0x4a,
+	0xc3, 0xd1, 0x50, 0x47, 0x45, 0x5c, 0x81, 0x6d, 0x4a, 0xba, 0xfd, 0x1b, 0x54, 0xc2, 0x07, 0xb0,
+	0xab, 0xd3, 0xee, 0x48, 0xeb, 0xf6, 0xf4, 0xe1, 0x78, 0x84, 0xb6, 0xa5, 0x64, 0x6f, 0x7c, 0x35,
+	0xb9, 0x24, 0x3a, 0xe9, 0xa3, 0x1d, 0x49, 0x25, 0x94, 0x8e, 0x29, 0x2a, 0x4b, 0xcf, 0x80, 0xe8,
+	0x86, 0xa6, 0x77, 0x75, 0x82, 0x14, 0x09, 0x27, 0xd7, 0x19, 0xac, 0x48, 0xd8, 0x27, 0x97, 0x29,
+	0x04, 0x7c, 0x04, 0x68, 0x38, 0xfa, 0x30, 0xbe, 0x20, 0x46, 0xef, 0xbc, 0x3b, 0x1c, 0xf5, 0xc6,
+	0x7d, 0x82, 0x76, 0x93, 0x02, 0xb5, 0xc9, 0x78, 0xa4, 0x11, 0xb4, 0x8f, 0x4f, 0x00, 0xe7, 0x82,
+	0xc6, 0xe9, 0x8d, 0x41, 0xbb, 0xa3, 0x01, 0x41, 0x55, 0x19, 0x2b, 0xed, 0xef, 0xaf, 0x09, 0xbd,
+	0x31, 0x28, 0xd1, 0xae, 0x2f, 0x75, 0x74, 0x20, 0xad, 0x89, 0x25, 0xe1, 0x8f, 0xc8, 0x47, 0x1d,
+	0x21, 0x7c, 0x0c, 0xcf, 0x57, 0xad, 0xbd, 0xcb, 0xb1, 0x46, 0xd0, 0x73, 0x59, 0xcd, 0x05, 0x21,
+	0x93, 0xee, 0xe5, 0xf0, 0x03, 0x41, 0x18, 0xbf, 0x80, 0x43, 0xa9, 0x78, 0x3e, 0xd4, 0xf4, 0x31,
+	0xbd, 0x31, 0xce, 0xc6, 0xd4, 0xb8, 0x20, 0x37, 0xe8, 0x70, 0xbd, 0x84, 0x2b, 0xa2, 0x77, 0xfb,
+	0x5d, 0xbd, 0x8b, 0x8e, 0xa4, 0x7d, 0x72, 0x7d, 0xcf, 0x7e, 0x8c, 0x5f, 0xc2, 0xb1, 0xe4, 0x4f,
+	0xe8, 0xf0, 0x83, 0xf4, 0x48, 0xab, 0x71, 0xde, 0xd5, 0xce, 0xd1, 0x49, 0x12, 0x42, 0x07, 0x64,
+	0xcd, 0x89, 0x5e, 0x34, 0x7e, 0x02, 0x65, 0xc0, 0x84, 0x26, 0x4c, 0xc1, 0x30, 0x82, 0xe2, 0x2d,
+	0x5b, 0xc6, 0x63, 0x5e, 0xa1, 0xf2, 0x2f, 0x7e, 0x0d, 0x60, 0x71, 0xcf, 0x63, 0x96, 0x70, 0xb9,
+	0x1f, 0xcf, 0x71, 0x85, 0xae, 0x58, 0x1a, 0x7d, 0x40, 0x59, 0xf4, 0x15, 0x13, 0xa6, 0x6d, 0x0a,
+	0xf3, 0x1b, 0x54, 0x28, 0x28, 0x93, 0xc5, 0x83, 0x35, 0x1c, 0xc1, 0xf6, 0x67, 0xd3, 0x5b, 0xb0,
+	0x38, 0x70, 0x8f, 0x26, 0x60, 0x43, 0xb3, 0x78, 0x4f, 0xf3, 0x37, 0x40, 0x93, 0xc5, 0x57, 0x56,
+	0x76, 0x4f, 0x05, 0xbf, 0x01, 0x65, 0x9e, 0x46, 0xc7, 0xdb, 0x6e, 0xb7, 0x73, 0x9c, 0x6f, 0xaf,
+	0x55, 0x69, 0x9a, 0xd3, 0x64, 0x43, 0xfb, 0xcc, 0xfb, 0xd6, 0x86, 0xfe, 0x51, 0x80, 0x83, 0xac,
+	0xa3, 0xa7, 0x4b, 0x6a, 0xfa, 0x0e, 0xc3, 0x35, 0x50, 0x22, 0x61, 0x86, 0xe2, 0x22, 0x97, 0xca,
+	0x31, 0x3e, 0x81, 0x1d, 0xe6, 0xdb, 0xd2, 0x93, 0x68, 0xa5, 0xe8, 0xc9, 0x85, 0xd5, 0x36, 0x16,
+	0xb6, 0xb7, 0xb2, 0x82, 0x29, 0x54, 0x07, 0x4c, 0xbc, 0x5f, 0xb0, 0x70, 0x49, 0x59, 0xb4, 0xf0,
+	0x84, 0xfc, 0x04, 0xbf, 0x4a, 0x98, 0xa6, 0x4f, 0xc0, 0x53, 0x6b, 0x59, 0xcb, 0x51, 0xdc, 0xc8,
+	0x31, 0x80, 0xfd, 0x38, 0x41, 0xfe, 0x6d, 0x6a, 0xa0, 0x04, 0xa6, 0xc3, 0x34, 0xf7, 0xf7, 0xe4,
+	0x9c, 0xdd, 0xa6, 0x39, 0x96, 0xbe, 0x29, 0xe7, 0xb7, 0x73, 0x33, 0xbc, 0x4d, 0xd3, 0xe4, 0xb8,
+	0xf1, 0x4b, 0x3c, 0x81, 0xe7, 0x6e, 0x24, 0x78, 0xb8, 0x3c, 0xe3, 0xa1, 0x5c, 0xfc, 0x57, 0xb7,
+	0xfd, 0xd1, 0x52, 0xff, 0xda, 0x82, 0xa3, 0x54, 0x7f, 0xbd, 0xe4, 0xd7, 0x00, 0xf1, 0x77, 0x38,
+	0xf5, 0xb8, 0x75, 0x1b, 0x67, 0x2b, 0xd1, 0x15, 0x8b, 0x14, 0x65, 0xbe, 0x9d, 0x78, 0xb7, 0x62,
+	0x6f, 0x8e, 0xe5, 0xfd, 0x10, 0x33, 0xe5, 0x15, 0xa0, 0x16, 0x9f, 0xbe, 0x1f, 0x72, 0x32, 0x7e,
+	0x0b, 0x65, 0xe6, 0xdb, 0x71, 0x5c, 0xe9, 0xc9, 0xb8, 0x8c, 0x2a, 0x6b, 0xb5, 0x59, 0x64, 0x31,
+	0xdf, 0x76, 0x7d, 0x27, 0xbe, 0x29, 0x14, 0xba, 0x62, 0x59, 0x6b, 0xff, 0xce, 0x23, 0xed, 0x2f,
+	0x6f, 0xb4, 0xbf, 0x0e, 0xd5, 0xb8, 0x29, 0xf1, 0xc0, 0x8e, 0xd8, 0x17, 0x81, 0xab, 0xb0, 0xe5,
+	0xda, 0x69, 0xef, 0xb7, 0x5c, 0xbb, 0xf1, 0x7f, 0x38, 0xb8, 0x63, 0xf4, 0x3c, 0x1e, 0xb1, 0x7b,
+	0x94, 0xb7, 0x80, 0x56, 0xa6, 0xed, 0x74, 0x29, 0x58, 0x84, 0xeb, 0xb0, 0x1b, 0xde, 0xc1, 0x98,
+	0xbc, 0x47, 0x57, 0x4d, 0x8d, 0x3f, 0x0b, 0xe9, 0x0c, 0x51, 0x16, 0x05, 0xdc, 0x8f, 0x18, 0xee,
+	0x40, 0x39, 0x21, 0x48, 0x7e, 0xb1, 0xb9, 0xdb, 0x51, 0xb3, 0xcd, 0xba, 0x29, 0x4f, 0x33, 0x22,
+	0x7e, 0x09, 0xca, 0xcc, 0x8c, 0x8c, 0x39, 0x0f, 0x93, 0x03, 0x46, 0xa1, 0xe5, 0x99, 0x19, 0x5d,
+	0xf1, 0x30, 0x2b, 0xb3, 0x98, 0x95, 0xf9, 0xe8, 0x9e, 0x71, 0xe0, 0x78, 0xad, 0x96, 0x7c, 0x48,
+	0x3a, 0x70, 0xfc, 0x89, 0x09, 0x6b, 0xc6, 0x6c, 0x23, 0x64, 0x16, 0x0f, 0xed, 0xc8, 0xb0, 0xf8,
+	0xc2, 0x17, 0xe9, 0x90, 0x1f, 0xa6, 0x4e, 0x9a, 0xf8, 0x7a, 0xd2, 0xf5, 0xe8, 0xbc, 0xbf, 0x83,
+	0xfd, 0xf5, 0x43, 0x4d, 0x85, 0xb2, 0xac, 0xe2, 0x6e, 0xe0, 0x33, 0xf8, 0xdf, 0x07, 0x67, 0xe3,
+	0x0c, 0x0e, 0xd7, 0x8f, 0xae, 0x64, 0x8b, 0xb7, 0xe5, 0x58, 0x89, 0xd0, 0x65, 0x59, 0xef, 0x1e,
+	0x38, 0xe8, 0x32, 0x56, 0xe7, 0xe3, 0xca, 0x43, 0x49, 0x5b, 0x04, 0x01, 0x0f, 0x05, 0xee, 0x83,
+	0x42, 0x99, 0xe3, 0x46, 0x82, 0x85, 0x58, 0x7d, 0xe8, 0x99, 0x54, 0x7b, 0xd0, 0xd3, 0x78, 0xd6,
+	0x2c, 0x7c, 0x5f, 0x38, 0x1d, 0x43, 0x83, 0x87, 0x4e, 0x6b, 0xb6, 0x0c, 0x58, 0xe8, 0x31, 0xdb,
+	0x61, 0x61, 0xeb, 0x93, 0x39, 0x0d, 0x5d, 0x2b, 0x8b, 0x93, 0x2f, 0xbb, 0x9f, 0xbf, 0x73, 0x5c,
+	0x31, 0x5b, 0x4c, 0x5b, 0x16, 0x9f, 0xb7, 0x57, 0xa8, 0xed, 0x84, 0x9a, 0xbc, 0xf0, 0xa2, 0xb6,
+	0xa4, 0x4e, 0x93, 0xe7, 0xe2, 0x0f, 0xff, 0x0e, 0x00, 0xde, 0x4a, 0x0b, 0x62, 0x52, 0x0a, 0x00,
+	0x00,
 }
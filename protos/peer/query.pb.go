@@ -18,6 +18,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Status indicates whether the channel is active on the peer or has been paused
+type ChannelInfo_Status int32
+
+const (
+	ChannelInfo_ACTIVE   ChannelInfo_Status = 0
+	ChannelInfo_INACTIVE ChannelInfo_Status = 1
+)
+
+var ChannelInfo_Status_name = map[int32]string{
+	0: "ACTIVE",
+	1: "INACTIVE",
+}
+var ChannelInfo_Status_value = map[string]int32{
+	"ACTIVE":   0,
+	"INACTIVE": 1,
+}
+
+func (x ChannelInfo_Status) String() string {
+	return proto.EnumName(ChannelInfo_Status_name, int32(x))
+}
+func (ChannelInfo_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_query_37e5d407118e73f5, []int{3, 0}
+}
+
 // ChaincodeQueryResponse returns information about each chaincode that pertains
 // to a query in lscc.go, such as GetChaincodes (returns all chaincodes
 // instantiated on a channel), and GetInstalledChaincodes (returns all chaincodes
@@ -203,10 +227,11 @@ func (m *ChannelQueryResponse) GetChannels() []*ChannelInfo {
 
 // ChannelInfo contains general information about channels
 type ChannelInfo struct {
-	ChannelId            string   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ChannelId            string             `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Status               ChannelInfo_Status `protobuf:"varint,2,opt,name=status,proto3,enum=protos.ChannelInfo_Status" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
@@ -240,34 +265,45 @@ func (m *ChannelInfo) GetChannelId() string {
 	return ""
 }
 
+func (m *ChannelInfo) GetStatus() ChannelInfo_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ChannelInfo_ACTIVE
+}
+
 func init() {
 	proto.RegisterType((*ChaincodeQueryResponse)(nil), "protos.ChaincodeQueryResponse")
 	proto.RegisterType((*ChaincodeInfo)(nil), "protos.ChaincodeInfo")
 	proto.RegisterType((*ChannelQueryResponse)(nil), "protos.ChannelQueryResponse")
 	proto.RegisterType((*ChannelInfo)(nil), "protos.ChannelInfo")
+	proto.RegisterEnum("protos.ChannelInfo_Status", ChannelInfo_Status_name, ChannelInfo_Status_value)
 }
 
 func init() { proto.RegisterFile("peer/query.proto", fileDescriptor_query_37e5d407118e73f5) }
 
 var fileDescriptor_query_37e5d407118e73f5 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xdf, 0x4a, 0xc3, 0x30,
-	0x14, 0xc6, 0xe9, 0xfe, 0xba, 0x33, 0x15, 0x89, 0x53, 0x72, 0x23, 0x8c, 0x5e, 0x4d, 0x90, 0x16,
-	0x14, 0x5f, 0xc0, 0x5d, 0xc8, 0xae, 0x86, 0xbd, 0xf4, 0x46, 0xba, 0xe4, 0x6c, 0x0d, 0x6c, 0x49,
-	0x4c, 0xba, 0xc1, 0x9e, 0xc6, 0x57, 0x95, 0x93, 0xac, 0xa3, 0xbb, 0xea, 0x39, 0xbf, 0xef, 0x17,
-	0xca, 0x97, 0xc0, 0x9d, 0x45, 0x74, 0xf9, 0xef, 0x1e, 0xdd, 0x31, 0xb3, 0xce, 0xd4, 0x86, 0x0d,
-	0xc2, 0xc7, 0xa7, 0x4b, 0x78, 0x9c, 0x57, 0xa5, 0xd2, 0xc2, 0x48, 0xfc, 0xa2, 0xbc, 0x40, 0x6f,
-	0x8d, 0xf6, 0xc8, 0xde, 0x01, 0x44, 0x93, 0x78, 0x9e, 0x4c, 0xbb, 0xb3, 0xf1, 0xeb, 0x43, 0x3c,
-	0xed, 0xb3, 0xf3, 0x99, 0x85, 0x5e, 0x9b, 0xa2, 0x25, 0xa6, 0x7f, 0x09, 0xdc, 0x5c, 0xa4, 0x8c,
-	0x41, 0x4f, 0x97, 0x3b, 0xe4, 0xc9, 0x34, 0x99, 0x8d, 0x8a, 0x30, 0x33, 0x0e, 0xc3, 0x03, 0x3a,
-	0xaf, 0x8c, 0xe6, 0x9d, 0x80, 0x9b, 0x95, 0x6c, 0x5b, 0xd6, 0x15, 0xef, 0x46, 0x9b, 0x66, 0x36,
-	0x81, 0xbe, 0xd2, 0x76, 0x5f, 0xf3, 0x5e, 0x80, 0x71, 0x21, 0x13, 0xbd, 0x10, 0xbc, 0x1f, 0x4d,
-	0x9a, 0x89, 0x1d, 0x88, 0x0d, 0x22, 0xa3, 0x99, 0xdd, 0x42, 0x47, 0x49, 0x3e, 0x9c, 0x26, 0xb3,
-	0xeb, 0xa2, 0xa3, 0x64, 0xfa, 0x09, 0x93, 0x79, 0x55, 0x6a, 0x8d, 0xdb, 0xcb, 0xc2, 0x39, 0x5c,
-	0x89, 0xc8, 0x9b, 0xba, 0xf7, 0xad, 0xba, 0xc4, 0x43, 0xd9, 0xb3, 0x94, 0xbe, 0xc0, 0xb8, 0x15,
-	0xb0, 0xa7, 0x70, 0x61, 0xb4, 0xfe, 0x28, 0x79, 0x6a, 0x3b, 0x3a, 0x91, 0x85, 0xfc, 0x58, 0x42,
-	0x6a, 0xdc, 0x26, 0xab, 0x8e, 0x16, 0xdd, 0x16, 0xe5, 0x06, 0x5d, 0xb6, 0x2e, 0x57, 0x4e, 0x89,
-	0xe6, 0x27, 0xf4, 0x46, 0xdf, 0xcf, 0x1b, 0x55, 0x57, 0xfb, 0x55, 0x26, 0xcc, 0x2e, 0x6f, 0xa9,
-	0x79, 0x54, 0xf3, 0xa8, 0xe6, 0xa4, 0xae, 0xe2, 0x13, 0xbe, 0xfd, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0x94, 0xb3, 0xbd, 0xdd, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x5f, 0x6b, 0xea, 0x30,
+	0x18, 0xc6, 0x4f, 0xfd, 0x53, 0xf5, 0xd5, 0x23, 0x92, 0xe3, 0x19, 0x61, 0x30, 0x90, 0x5c, 0xb9,
+	0x9b, 0x16, 0x1c, 0xfb, 0x00, 0x9b, 0x8c, 0xd1, 0x9b, 0xc9, 0xba, 0xb1, 0x8b, 0xdd, 0x8c, 0x9a,
+	0x46, 0x1b, 0xd0, 0xa4, 0x4b, 0x5a, 0xc1, 0xfb, 0x7d, 0x8f, 0x7d, 0xd5, 0x91, 0xa4, 0x95, 0x0a,
+	0xbb, 0xea, 0xfb, 0xfe, 0x9e, 0x5f, 0x02, 0x4f, 0x5b, 0x98, 0xe4, 0x8c, 0xa9, 0xf0, 0xb3, 0x64,
+	0xea, 0x18, 0xe4, 0x4a, 0x16, 0x12, 0xf9, 0xf6, 0xa1, 0xc9, 0x0a, 0x2e, 0x96, 0x59, 0xc2, 0x05,
+	0x95, 0x29, 0x7b, 0x36, 0x79, 0xcc, 0x74, 0x2e, 0x85, 0x66, 0xe8, 0x16, 0x80, 0xd6, 0x89, 0xc6,
+	0xde, 0xac, 0x3d, 0x1f, 0x2e, 0xfe, 0xbb, 0xd3, 0x3a, 0x38, 0x9d, 0x89, 0xc4, 0x46, 0xc6, 0x0d,
+	0x91, 0x7c, 0x7b, 0xf0, 0xf7, 0x2c, 0x45, 0x08, 0x3a, 0x22, 0xd9, 0x33, 0xec, 0xcd, 0xbc, 0xf9,
+	0x20, 0xb6, 0x33, 0xc2, 0xd0, 0x3b, 0x30, 0xa5, 0xb9, 0x14, 0xb8, 0x65, 0x71, 0xbd, 0x1a, 0x3b,
+	0x4f, 0x8a, 0x0c, 0xb7, 0x9d, 0x6d, 0x66, 0x34, 0x85, 0x2e, 0x17, 0x79, 0x59, 0xe0, 0x8e, 0x85,
+	0x6e, 0x31, 0x26, 0xd3, 0x94, 0xe2, 0xae, 0x33, 0xcd, 0x6c, 0xd8, 0xc1, 0x30, 0xdf, 0x31, 0x33,
+	0xa3, 0x31, 0xb4, 0x78, 0x8a, 0x7b, 0x33, 0x6f, 0x3e, 0x8a, 0x5b, 0x3c, 0x25, 0x8f, 0x30, 0x5d,
+	0x66, 0x89, 0x10, 0x6c, 0x77, 0x5e, 0x38, 0x84, 0x3e, 0x75, 0xbc, 0xae, 0xfb, 0xaf, 0x51, 0xd7,
+	0x70, 0x5b, 0xf6, 0x24, 0x91, 0x2f, 0x0f, 0x86, 0x8d, 0x04, 0x5d, 0xd9, 0x37, 0x66, 0xd6, 0x0f,
+	0x9e, 0x56, 0x75, 0x07, 0x15, 0x89, 0x52, 0xb4, 0x00, 0x5f, 0x17, 0x49, 0x51, 0x6a, 0x5b, 0x79,
+	0xbc, 0xb8, 0xfc, 0xe5, 0xf6, 0xe0, 0xc5, 0x1a, 0x71, 0x65, 0x12, 0x02, 0xbe, 0x23, 0x08, 0xc0,
+	0xbf, 0x5b, 0xbe, 0x46, 0x6f, 0x0f, 0x93, 0x3f, 0x68, 0x04, 0xfd, 0xe8, 0xa9, 0xda, 0xbc, 0xfb,
+	0x15, 0x10, 0xa9, 0xb6, 0x41, 0x76, 0xcc, 0x99, 0xda, 0xb1, 0x74, 0xcb, 0x54, 0xb0, 0x49, 0xd6,
+	0x8a, 0xd3, 0xfa, 0x7e, 0xf3, 0xf1, 0xdf, 0xaf, 0xb7, 0xbc, 0xc8, 0xca, 0x75, 0x40, 0xe5, 0x3e,
+	0x6c, 0xa8, 0xa1, 0x53, 0x43, 0xa7, 0x86, 0x46, 0x5d, 0xbb, 0x7f, 0xe3, 0xe6, 0x67, 0x00, 0xe6,
+	0x7e, 0xaf, 0x34, 0x36, 0x02, 0x00, 0x00,
 }
@@ -34,7 +34,7 @@ cat docs/wrappers/peer_chaincode_postscript.md >> $DOC
 DOC=docs/source/commands/peerchannel.md
 cat docs/wrappers/peer_channel_preamble.md > $DOC
 
-for x in "peer channel" "peer channel create" "peer channel fetch" "peer channel getinfo" "peer channel join" "peer channel list" "peer channel signconfigtx" "peer channel update"; do
+for x in "peer channel" "peer channel create" "peer channel fetch" "peer channel getinfo" "peer channel join" "peer channel joinbysnapshot" "peer channel list" "peer channel signconfigtx" "peer channel update"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -57,10 +57,36 @@ for x in "peer logging" "peer logging getlevel" "peer logging revertlevels" "pee
 done
 cat docs/wrappers/peer_logging_postscript.md >> $DOC
 
+DOC=docs/source/commands/peerindex.md
+cat docs/wrappers/peer_index_preamble.md > $DOC
+
+for x in "peer index" "peer index list" "peer index create" "peer index drop" "peer index explain"; do
+  echo "" >> $DOC
+  echo "##" $x >> $DOC
+  echo "\`\`\`" >> $DOC
+  .build/bin/${x} --help 1>> $DOC 2>/dev/null
+  echo "\`\`\`" >> $DOC
+  echo "" >> $DOC
+done
+cat docs/wrappers/peer_index_postscript.md >> $DOC
+
+DOC=docs/source/commands/peerpvtdata.md
+cat docs/wrappers/peer_pvtdata_preamble.md > $DOC
+
+for x in "peer pvtdata" "peer pvtdata list" "peer pvtdata reconcile" "peer pvtdata markunrecoverable"; do
+  echo "" >> $DOC
+  echo "##" $x >> $DOC
+  echo "\`\`\`" >> $DOC
+  .build/bin/${x} --help 1>> $DOC 2>/dev/null
+  echo "\`\`\`" >> $DOC
+  echo "" >> $DOC
+done
+cat docs/wrappers/peer_pvtdata_postscript.md >> $DOC
+
 DOC=docs/source/commands/peernode.md
 cat docs/wrappers/peer_node_preamble.md > $DOC
 
-for x in "peer node start" "peer node status" "peer node reset" "peer node rollback"; do
+for x in "peer node start" "peer node status" "peer node reset" "peer node rollback" "peer node snapshot" "peer node verify-ledger" "peer node rebuild-dbs" "peer node pause" "peer node resume"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC
@@ -104,7 +130,7 @@ DOC=docs/source/commands/configtxlator.md
 
 cat docs/wrappers/configtxlator_preamble.md > $DOC
 
-for x in "configtxlator start" "configtxlator proto_encode" "configtxlator proto_decode" "configtxlator compute_update" "configtxlator version"; do
+for x in "configtxlator start" "configtxlator proto_encode" "configtxlator proto_decode" "configtxlator compute_update" "configtxlator migrate maintenance" "configtxlator migrate consensus" "configtxlator migrate normal" "configtxlator migrate check" "configtxlator version"; do
   echo "" >> $DOC
   echo "##" $x >> $DOC
   echo "\`\`\`" >> $DOC